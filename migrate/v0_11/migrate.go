@@ -770,9 +770,9 @@ func MigratePricefeed(oldGenState v0_9pricefeed.GenesisState) v0_11pricefeed.Gen
 		newPrice := v0_11pricefeed.NewPostedPrice(price.MarketID, price.OracleAddress, price.Price, price.Expiry)
 		newPostedPrices = append(newPostedPrices, newPrice)
 	}
-	newParams := v0_11pricefeed.NewParams(newMarkets)
+	newParams := v0_11pricefeed.NewParams(newMarkets, v0_11pricefeed.DefaultOracleBond)
 
-	return v0_11pricefeed.NewGenesisState(newParams, newPostedPrices)
+	return v0_11pricefeed.NewGenesisState(newParams, newPostedPrices, v0_11pricefeed.PriceObservations{}, v0_11pricefeed.GuardTrips{}, v0_11pricefeed.OracleRecords{}, v0_11pricefeed.OracleBonds{}, v0_11pricefeed.PriceCommits{}, v0_11pricefeed.HistoricalPrices{})
 }
 
 func mustAccAddressFromBech32(bech32Addr string) sdk.AccAddress {
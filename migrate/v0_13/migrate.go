@@ -145,7 +145,7 @@ func CDP(oldGenState v0_11cdp.GenesisState) v0_13cdp.GenesisState {
 	}
 
 	for _, cp := range oldGenState.Params.CollateralParams {
-		newCollateralParam := v0_13cdp.NewCollateralParam(cp.Denom, cp.Type, cp.LiquidationRatio, cp.DebtLimit, cp.StabilityFee, cp.AuctionSize, cp.LiquidationPenalty, cp.Prefix, cp.SpotMarketID, cp.LiquidationMarketID, sdk.MustNewDecFromStr("0.01"), sdk.NewInt(10), cp.ConversionFactor)
+		newCollateralParam := v0_13cdp.NewCollateralParam(cp.Denom, cp.Type, cp.LiquidationRatio, cp.DebtLimit, cp.StabilityFee, cp.AuctionSize, cp.LiquidationPenalty, cp.Prefix, cp.SpotMarketID, cp.LiquidationMarketID, sdk.MustNewDecFromStr("0.01"), sdk.NewInt(10), cp.ConversionFactor, v0_13cdp.EnglishAuctionType)
 		newCollateralParams = append(newCollateralParams, newCollateralParam)
 		newGenesisAccumulationTime := v0_13cdp.NewGenesisAccumulationTime(cp.Type, previousAccumulationTime, sdk.OneDec())
 		newGenesisAccumulationTimes = append(newGenesisAccumulationTimes, newGenesisAccumulationTime)
@@ -209,36 +209,43 @@ func Hard(genesisState v0_11hard.GenesisState) v0_13hard.GenesisState {
 			v0_13hard.NewMoneyMarket("btcb", v0_13hard.NewBorrowLimit(true, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "btc:usd", sdk.NewInt(100000000),
 				defaultInterestModel,
 				sdk.MustNewDecFromStr("0.025"), sdk.MustNewDecFromStr("0.02"),
+				v0_13hard.EnglishAuctionType,
 			),
 			// bnb
 			v0_13hard.NewMoneyMarket("bnb", v0_13hard.NewBorrowLimit(true, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "bnb:usd", sdk.NewInt(100000000),
 				defaultInterestModel,
 				sdk.MustNewDecFromStr("0.025"), sdk.MustNewDecFromStr("0.02"),
+				v0_13hard.EnglishAuctionType,
 			),
 			// xrpb
 			v0_13hard.NewMoneyMarket("xrpb", v0_13hard.NewBorrowLimit(true, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "xrp:usd", sdk.NewInt(100000000),
 				defaultInterestModel,
 				sdk.MustNewDecFromStr("0.025"), sdk.MustNewDecFromStr("0.02"),
+				v0_13hard.EnglishAuctionType,
 			),
 			// busd
 			v0_13hard.NewMoneyMarket("busd", v0_13hard.NewBorrowLimit(true, sdk.MustNewDecFromStr("100000000000000"), sdk.MustNewDecFromStr("0.5")), "busd:usd", sdk.NewInt(100000000),
 				defaultInterestModel,
 				sdk.MustNewDecFromStr("0.025"), sdk.MustNewDecFromStr("0.02"),
+				v0_13hard.EnglishAuctionType,
 			),
 			// usdx
 			v0_13hard.NewMoneyMarket("usdx", v0_13hard.NewBorrowLimit(true, sdk.ZeroDec(), sdk.ZeroDec()), "usdx:usd", sdk.NewInt(1000000),
 				defaultInterestModel,
 				sdk.MustNewDecFromStr("0.025"), sdk.MustNewDecFromStr("0.02"),
+				v0_13hard.EnglishAuctionType,
 			),
 			// ukava
 			v0_13hard.NewMoneyMarket("ukava", v0_13hard.NewBorrowLimit(true, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "kava:usd", sdk.NewInt(1000000),
 				defaultInterestModel,
 				sdk.MustNewDecFromStr("0.025"), sdk.MustNewDecFromStr("0.02"),
+				v0_13hard.EnglishAuctionType,
 			),
 			// hard
 			v0_13hard.NewMoneyMarket("hard", v0_13hard.NewBorrowLimit(true, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "hard:usd", sdk.NewInt(1000000),
 				defaultInterestModel,
 				sdk.MustNewDecFromStr("0.025"), sdk.MustNewDecFromStr("0.02"),
+				v0_13hard.EnglishAuctionType,
 			),
 		},
 		sdk.MustNewDecFromStr("10.0"),
@@ -257,7 +264,7 @@ func Hard(genesisState v0_11hard.GenesisState) v0_13hard.GenesisState {
 		v13GenesisAccumulationTimes = append(v13GenesisAccumulationTimes, genAccumulationTime)
 	}
 
-	return v0_13hard.NewGenesisState(newParams, v13GenesisAccumulationTimes, v13Deposits, v0_13hard.DefaultBorrows, v13TotalSupplied, v0_13hard.DefaultTotalBorrowed, v0_13hard.DefaultTotalReserves)
+	return v0_13hard.NewGenesisState(newParams, v13GenesisAccumulationTimes, v13Deposits, v0_13hard.DefaultBorrows, v13TotalSupplied, v0_13hard.DefaultTotalBorrowed, v0_13hard.DefaultTotalReserves, v0_13hard.DefaultPausedMoneyMarkets)
 }
 
 // Incentive migrates from a v0.11 incentive genesis state to a v0.13 incentive genesis state
@@ -291,7 +298,7 @@ func Incentive(hardGS v0_11hard.GenesisState, incentiveGS v0_11incentive.Genesis
 		newRP := v0_13incentive.NewRewardPeriod(rp.DistributionSchedule.Active, rp.DistributionSchedule.DepositDenom, rp.DistributionSchedule.Start, rp.DistributionSchedule.End, rp.DistributionSchedule.RewardsPerSecond)
 		hardDelegatorRewardPeriods = append(hardDelegatorRewardPeriods, newRP)
 	}
-	params := v0_13incentive.NewParams(usdxMintingRewardPeriods, hardSupplyRewardPeriods, hardBorrowRewardPeriods, hardDelegatorRewardPeriods, v0_13incentive.Multipliers{v0_13incentive.NewMultiplier(v0_13incentive.Small, 1, sdk.MustNewDecFromStr("0.2")), v0_13incentive.NewMultiplier(v0_13incentive.Large, 12, sdk.MustNewDecFromStr("1.0"))}, ClaimEndTime)
+	params := v0_13incentive.NewParams(usdxMintingRewardPeriods, hardSupplyRewardPeriods, hardBorrowRewardPeriods, hardDelegatorRewardPeriods, v0_13incentive.Multipliers{v0_13incentive.NewMultiplier(v0_13incentive.Small, 1, sdk.MustNewDecFromStr("0.2")), v0_13incentive.NewMultiplier(v0_13incentive.Large, 12, sdk.MustNewDecFromStr("1.0"))}, ClaimEndTime, v0_13incentive.DefaultClaimGracePeriods, v0_13incentive.DefaultUnclaimedRewardsDestination, v0_13incentive.DefaultHoldingBoosts, v0_13incentive.DefaultHoldingBoosts)
 
 	usdxGenAccumulationTimes := v0_13incentive.GenesisAccumulationTimes{}
 
@@ -395,6 +402,8 @@ func Incentive(hardGS v0_11hard.GenesisState, incentiveGS v0_11incentive.Genesis
 		hardDelegatorGenAccumulationTimes,
 		usdxClaims,
 		hardClaims,
+		v0_13incentive.DefaultRewardBudgetSpends,
+		v0_13incentive.DefaultClaimSweepEnds,
 	)
 }
 
@@ -527,7 +536,7 @@ func Bep3(genesisState bep3.GenesisState) bep3.GenesisState {
 		newAssetParams = append(newAssetParams, ap)
 	}
 	newParams := bep3.NewParams(newAssetParams)
-	return bep3.NewGenesisState(newParams, newSwaps, newSupplies, genesisState.PreviousBlockTime)
+	return bep3.NewGenesisState(newParams, newSwaps, newSupplies, genesisState.PreviousBlockTime, bep3.DeputySupplies{})
 }
 
 // Committee migrates from a v0.11 (or v0.12) committee genesis state to a v0.13 committee genesis state
@@ -586,6 +595,7 @@ func Committee(genesisState v0_11committee.GenesisState) v0_13committee.GenesisS
 							cp.ConversionFactor,
 							true,
 							true,
+							false,
 						)
 						newCollateralParams = append(newCollateralParams, newCP)
 					}
@@ -597,7 +607,7 @@ func Committee(genesisState v0_11committee.GenesisState) v0_13committee.GenesisS
 							}
 						}
 						if !foundCtype {
-							newCP := v0_13committee.NewAllowedCollateralParam(cType, false, false, true, true, true, false, false, false, false, false, true, true)
+							newCP := v0_13committee.NewAllowedCollateralParam(cType, false, false, true, true, true, false, false, false, false, false, true, true, false)
 							newCollateralParams = append(newCollateralParams, newCP)
 						}
 					}
@@ -615,7 +625,14 @@ func Committee(genesisState v0_11committee.GenesisState) v0_13committee.GenesisS
 					// update AllowedAssetParams
 					var newAssetParams v0_13committee.AllowedAssetParams
 					for _, ap := range subPerm.AllowedAssetParams {
-						newAP := v0_13committee.AllowedAssetParam(ap)
+						newAP := v0_13committee.AllowedAssetParam{
+							Denom:         ap.Denom,
+							CoinID:        ap.CoinID,
+							Limit:         ap.Limit,
+							Active:        ap.Active,
+							MaxSwapAmount: ap.MaxSwapAmount,
+							MinBlockLock:  ap.MinBlockLock,
+						}
 						newAssetParams = append(newAssetParams, newAP)
 					}
 					newStabilitySubParamPermissions.AllowedAssetParams = newAssetParams
@@ -623,7 +640,13 @@ func Committee(genesisState v0_11committee.GenesisState) v0_13committee.GenesisS
 					// Update Allowed Markets
 					var newMarketParams v0_13committee.AllowedMarkets
 					for _, mp := range subPerm.AllowedMarkets {
-						newMP := v0_13committee.AllowedMarket(mp)
+						newMP := v0_13committee.AllowedMarket{
+							MarketID:   mp.MarketID,
+							BaseAsset:  mp.BaseAsset,
+							QuoteAsset: mp.QuoteAsset,
+							Oracles:    mp.Oracles,
+							Active:     mp.Active,
+						}
 						newMarketParams = append(newMarketParams, newMP)
 					}
 					newStabilitySubParamPermissions.AllowedMarkets = newMarketParams
@@ -632,7 +655,7 @@ func Committee(genesisState v0_11committee.GenesisState) v0_13committee.GenesisS
 					var newMoneyMarketParams v0_13committee.AllowedMoneyMarkets
 					hardMMDenoms := []string{"bnb", "busd", "btcb", "xrpb", "usdx", "ukava", "hard"}
 					for _, mmDenom := range hardMMDenoms {
-						newMoneyMarketParam := v0_13committee.NewAllowedMoneyMarket(mmDenom, true, false, false, true, true, true)
+						newMoneyMarketParam := v0_13committee.NewAllowedMoneyMarket(mmDenom, true, false, false, true, true, true, false)
 						newMoneyMarketParams = append(newMoneyMarketParams, newMoneyMarketParam)
 					}
 					newStabilitySubParamPermissions.AllowedMoneyMarkets = newMoneyMarketParams
@@ -654,7 +677,8 @@ func Committee(genesisState v0_11committee.GenesisState) v0_13committee.GenesisS
 	}
 
 	for _, v := range genesisState.Votes {
-		votes = append(votes, v0_13committee.Vote(v))
+		// all votes prior to vote types were yes votes
+		votes = append(votes, v0_13committee.NewVote(v.ProposalID, v.Voter, v0_13committee.Yes))
 	}
 
 	for _, p := range genesisState.Proposals {
@@ -663,7 +687,7 @@ func Committee(genesisState v0_11committee.GenesisState) v0_13committee.GenesisS
 		proposals = append(proposals, newProp)
 	}
 	return v0_13committee.NewGenesisState(
-		genesisState.NextProposalID, committees, proposals, votes)
+		genesisState.NextProposalID, committees, proposals, votes, []v0_13committee.VotingPowerSnapshot{}, []v0_13committee.ParamChangeTime{}, []v0_13committee.QueuedProposal{}, v0_13committee.DefaultParams(), []v0_13committee.ArchivedProposal{})
 }
 
 // Pricefeed migrates from a v0.11 (or v0.12) pricefeed genesis state to a v0.13 pricefeed genesis state
@@ -685,7 +709,7 @@ func Pricefeed(genesisState v0_11pricefeed.GenesisState) v0_13pricefeed.GenesisS
 		}
 	}
 
-	return v0_13pricefeed.NewGenesisState(v0_13pricefeed.NewParams(newMarkets), newPrices)
+	return v0_13pricefeed.NewGenesisState(v0_13pricefeed.NewParams(newMarkets, v0_13pricefeed.DefaultOracleBond), newPrices, v0_13pricefeed.PriceObservations{}, v0_13pricefeed.GuardTrips{}, v0_13pricefeed.OracleRecords{}, v0_13pricefeed.OracleBonds{}, v0_13pricefeed.PriceCommits{}, v0_13pricefeed.HistoricalPrices{})
 }
 
 func removeIndex(accs authexported.GenesisAccounts, index int) authexported.GenesisAccounts {
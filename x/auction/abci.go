@@ -7,11 +7,12 @@ import (
 	"github.com/kava-labs/kava/x/auction/types"
 )
 
-// BeginBlocker closes all expired auctions at the end of each block. It panics if
-// there's an error other than ErrAuctionNotFound.
+// BeginBlocker closes all expired auctions at the end of each block, then prunes the records of auctions closed longer ago than the record retention.
+// It panics if there's an error other than ErrAuctionNotFound.
 func BeginBlocker(ctx sdk.Context, k Keeper) {
 	err := k.CloseExpiredAuctions(ctx)
 	if err != nil && !errors.Is(err, types.ErrAuctionNotFound) {
 		panic(err)
 	}
+	k.PruneAuctionRecords(ctx)
 }
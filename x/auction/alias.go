@@ -8,105 +8,173 @@ import (
 )
 
 const (
-	AttributeKeyAuctionID     = types.AttributeKeyAuctionID
-	AttributeKeyAuctionType   = types.AttributeKeyAuctionType
-	AttributeKeyBid           = types.AttributeKeyBid
-	AttributeKeyBidder        = types.AttributeKeyBidder
-	AttributeKeyCloseBlock    = types.AttributeKeyCloseBlock
-	AttributeKeyEndTime       = types.AttributeKeyEndTime
-	AttributeKeyLot           = types.AttributeKeyLot
-	AttributeKeyMaxBid        = types.AttributeKeyMaxBid
-	AttributeValueCategory    = types.AttributeValueCategory
-	CollateralAuctionType     = types.CollateralAuctionType
-	DebtAuctionType           = types.DebtAuctionType
-	DefaultBidDuration        = types.DefaultBidDuration
-	DefaultMaxAuctionDuration = types.DefaultMaxAuctionDuration
-	DefaultNextAuctionID      = types.DefaultNextAuctionID
-	DefaultParamspace         = types.DefaultParamspace
-	EventTypeAuctionBid       = types.EventTypeAuctionBid
-	EventTypeAuctionClose     = types.EventTypeAuctionClose
-	EventTypeAuctionStart     = types.EventTypeAuctionStart
-	ForwardAuctionPhase       = types.ForwardAuctionPhase
-	ModuleName                = types.ModuleName
-	QuerierRoute              = types.QuerierRoute
-	QueryGetAuction           = types.QueryGetAuction
-	QueryGetAuctions          = types.QueryGetAuctions
-	QueryGetParams            = types.QueryGetParams
-	QueryNextAuctionID        = types.QueryNextAuctionID
-	ReverseAuctionPhase       = types.ReverseAuctionPhase
-	RouterKey                 = types.RouterKey
-	StoreKey                  = types.StoreKey
-	SurplusAuctionType        = types.SurplusAuctionType
+	AttributeKeyAuctionID      = types.AttributeKeyAuctionID
+	AttributeKeyAuctionType    = types.AttributeKeyAuctionType
+	AttributeKeyBid            = types.AttributeKeyBid
+	AttributeKeyBidder         = types.AttributeKeyBidder
+	AttributeKeyCloseBlock     = types.AttributeKeyCloseBlock
+	AttributeKeyEndTime        = types.AttributeKeyEndTime
+	AttributeKeyLot            = types.AttributeKeyLot
+	AttributeKeyMaxBid         = types.AttributeKeyMaxBid
+	AttributeKeyMinLot         = types.AttributeKeyMinLot
+	AttributeKeyPrice          = types.AttributeKeyPrice
+	AttributeValueCategory     = types.AttributeValueCategory
+	CollateralAuctionType      = types.CollateralAuctionType
+	DebtAuctionType            = types.DebtAuctionType
+	DefaultBidDuration         = types.DefaultBidDuration
+	DefaultMaxAuctionDuration  = types.DefaultMaxAuctionDuration
+	DefaultNextAuctionID       = types.DefaultNextAuctionID
+	DefaultParamspace          = types.DefaultParamspace
+	DefaultRecordRetention     = types.DefaultRecordRetention
+	DescendingAuctionPhase     = types.DescendingAuctionPhase
+	DutchCollateralAuctionType = types.DutchCollateralAuctionType
+	EventTypeAuctionBid        = types.EventTypeAuctionBid
+	EventTypeAuctionClose      = types.EventTypeAuctionClose
+	EventTypeAuctionProxyBid   = types.EventTypeAuctionProxyBid
+	EventTypeAuctionStart      = types.EventTypeAuctionStart
+	ExponentialDecayCurve      = types.ExponentialDecayCurve
+	ForwardAuctionPhase        = types.ForwardAuctionPhase
+	LinearDecayCurve           = types.LinearDecayCurve
+	ModuleName                 = types.ModuleName
+	QuerierRoute               = types.QuerierRoute
+	QueryGetAuction            = types.QueryGetAuction
+	QueryGetAuctionRecord      = types.QueryGetAuctionRecord
+	QueryGetAuctionRecords     = types.QueryGetAuctionRecords
+	QueryGetAuctions           = types.QueryGetAuctions
+	QueryGetParams             = types.QueryGetParams
+	QueryNextAuctionID         = types.QueryNextAuctionID
+	ReverseAuctionPhase        = types.ReverseAuctionPhase
+	RouterKey                  = types.RouterKey
+	StoreKey                   = types.StoreKey
+	SurplusAuctionType         = types.SurplusAuctionType
 )
 
 var (
 	// function aliases
-	ModuleAccountInvariants  = keeper.ModuleAccountInvariants
-	NewKeeper                = keeper.NewKeeper
-	NewQuerier               = keeper.NewQuerier
-	RegisterInvariants       = keeper.RegisterInvariants
-	ValidAuctionInvariant    = keeper.ValidAuctionInvariant
-	ValidIndexInvariant      = keeper.ValidIndexInvariant
-	DefaultGenesisState      = types.DefaultGenesisState
-	DefaultParams            = types.DefaultParams
-	GetAuctionByTimeKey      = types.GetAuctionByTimeKey
-	GetAuctionKey            = types.GetAuctionKey
-	NewAuctionWithPhase      = types.NewAuctionWithPhase
-	NewCollateralAuction     = types.NewCollateralAuction
-	NewDebtAuction           = types.NewDebtAuction
-	NewGenesisState          = types.NewGenesisState
-	NewMsgPlaceBid           = types.NewMsgPlaceBid
-	NewParams                = types.NewParams
-	NewQueryAllAuctionParams = types.NewQueryAllAuctionParams
-	NewQueryAuctionParams    = types.NewQueryAuctionParams
-	NewSurplusAuction        = types.NewSurplusAuction
-	NewWeightedAddresses     = types.NewWeightedAddresses
-	ParamKeyTable            = types.ParamKeyTable
-	RegisterCodec            = types.RegisterCodec
-	Uint64FromBytes          = types.Uint64FromBytes
-	Uint64ToBytes            = types.Uint64ToBytes
+	ModuleAccountInvariants      = keeper.ModuleAccountInvariants
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	RegisterInvariants           = keeper.RegisterInvariants
+	ValidAuctionInvariant        = keeper.ValidAuctionInvariant
+	ValidIndexInvariant          = keeper.ValidIndexInvariant
+	DefaultGenesisState          = types.DefaultGenesisState
+	DefaultParams                = types.DefaultParams
+	GetAuctionByTimeKey          = types.GetAuctionByTimeKey
+	GetAuctionKey                = types.GetAuctionKey
+	GetAuctionRecordByBidderKey  = types.GetAuctionRecordByBidderKey
+	GetAuctionRecordByTimeKey    = types.GetAuctionRecordByTimeKey
+	GetBidRecordKey              = types.GetBidRecordKey
+	NewAuctionBidHistory         = types.NewAuctionBidHistory
+	NewAuctionRecord             = types.NewAuctionRecord
+	NewAuctionWithPhase          = types.NewAuctionWithPhase
+	NewBidRecord                 = types.NewBidRecord
+	NewCollateralAuction         = types.NewCollateralAuction
+	NewDebtAuction               = types.NewDebtAuction
+	NewDutchCollateralAuction    = types.NewDutchCollateralAuction
+	NewExponentialDecayCurve     = types.NewExponentialDecayCurve
+	NewGenesisState              = types.NewGenesisState
+	NewLinearDecayCurve          = types.NewLinearDecayCurve
+	NewLotReturn                 = types.NewLotReturn
+	NewMsgPlaceBid               = types.NewMsgPlaceBid
+	NewMsgPlacePartialBid        = types.NewMsgPlacePartialBid
+	NewMsgPlaceProxyBid          = types.NewMsgPlaceProxyBid
+	NewParams                    = types.NewParams
+	NewPartialBid                = types.NewPartialBid
+	NewPartialBidFill            = types.NewPartialBidFill
+	NewProxyBid                  = types.NewProxyBid
+	NewQueryAllAuctionParams     = types.NewQueryAllAuctionParams
+	NewQueryAuctionParams        = types.NewQueryAuctionParams
+	NewQueryAuctionRecordsParams = types.NewQueryAuctionRecordsParams
+	NewReservePrice              = types.NewReservePrice
+	NewReservePriceParam         = types.NewReservePriceParam
+	NewSurplusAuction            = types.NewSurplusAuction
+	NewWeightedAddresses         = types.NewWeightedAddresses
+	ParamKeyTable                = types.ParamKeyTable
+	RegisterCodec                = types.RegisterCodec
+	Uint64FromBytes              = types.Uint64FromBytes
+	Uint64ToBytes                = types.Uint64ToBytes
 
 	// variable aliases
-	AuctionByTimeKeyPrefix     = types.AuctionByTimeKeyPrefix
-	AuctionKeyPrefix           = types.AuctionKeyPrefix
-	DefaultIncrement           = types.DefaultIncrement
-	DistantFuture              = types.DistantFuture
-	ErrAuctionHasExpired       = types.ErrAuctionHasExpired
-	ErrAuctionHasNotExpired    = types.ErrAuctionHasNotExpired
-	ErrAuctionNotFound         = types.ErrAuctionNotFound
-	ErrBidTooLarge             = types.ErrBidTooLarge
-	ErrBidTooSmall             = types.ErrBidTooSmall
-	ErrInvalidBidDenom         = types.ErrInvalidBidDenom
-	ErrInvalidInitialAuctionID = types.ErrInvalidInitialAuctionID
-	ErrInvalidLotDenom         = types.ErrInvalidLotDenom
-	ErrLotTooLarge             = types.ErrLotTooLarge
-	ErrLotTooSmall             = types.ErrLotTooSmall
-	ErrUnrecognizedAuctionType = types.ErrUnrecognizedAuctionType
-	KeyBidDuration             = types.KeyBidDuration
-	KeyIncrementCollateral     = types.KeyIncrementCollateral
-	KeyIncrementDebt           = types.KeyIncrementDebt
-	KeyIncrementSurplus        = types.KeyIncrementSurplus
-	KeyMaxAuctionDuration      = types.KeyMaxAuctionDuration
-	ModuleCdc                  = types.ModuleCdc
-	NextAuctionIDKey           = types.NextAuctionIDKey
+	AuctionByTimeKeyPrefix         = types.AuctionByTimeKeyPrefix
+	AuctionKeyPrefix               = types.AuctionKeyPrefix
+	AuctionRecordByBidderKeyPrefix = types.AuctionRecordByBidderKeyPrefix
+	AuctionRecordByTimeKeyPrefix   = types.AuctionRecordByTimeKeyPrefix
+	AuctionRecordKeyPrefix         = types.AuctionRecordKeyPrefix
+	BidRecordKeyPrefix             = types.BidRecordKeyPrefix
+	DefaultDutchDecayCurve         = types.DefaultDutchDecayCurve
+	DefaultDutchPriceFloor         = types.DefaultDutchPriceFloor
+	DefaultDutchStartMarkup        = types.DefaultDutchStartMarkup
+	DefaultIncrement               = types.DefaultIncrement
+	DefaultReservePrices           = types.DefaultReservePrices
+	DistantFuture                  = types.DistantFuture
+	ErrAuctionHasExpired           = types.ErrAuctionHasExpired
+	ErrAuctionHasNotExpired        = types.ErrAuctionHasNotExpired
+	ErrAuctionNotFound             = types.ErrAuctionNotFound
+	ErrBidBelowReserve             = types.ErrBidBelowReserve
+	ErrBidTooLarge                 = types.ErrBidTooLarge
+	ErrBidTooSmall                 = types.ErrBidTooSmall
+	ErrInvalidBidDenom             = types.ErrInvalidBidDenom
+	ErrInvalidInitialAuctionID     = types.ErrInvalidInitialAuctionID
+	ErrInvalidLotDenom             = types.ErrInvalidLotDenom
+	ErrInvalidStartPrice           = types.ErrInvalidStartPrice
+	ErrLotTooLarge                 = types.ErrLotTooLarge
+	ErrLotTooSmall                 = types.ErrLotTooSmall
+	ErrMixedBidTypes               = types.ErrMixedBidTypes
+	ErrPartialBidsNotSupported     = types.ErrPartialBidsNotSupported
+	ErrProxyBidsNotSupported       = types.ErrProxyBidsNotSupported
+	ErrUnrecognizedAuctionType     = types.ErrUnrecognizedAuctionType
+	KeyBidDuration                 = types.KeyBidDuration
+	KeyDutchDecayCurve             = types.KeyDutchDecayCurve
+	KeyDutchPriceFloor             = types.KeyDutchPriceFloor
+	KeyDutchStartMarkup            = types.KeyDutchStartMarkup
+	KeyIncrementCollateral         = types.KeyIncrementCollateral
+	KeyIncrementDebt               = types.KeyIncrementDebt
+	KeyIncrementSurplus            = types.KeyIncrementSurplus
+	KeyMaxAuctionDuration          = types.KeyMaxAuctionDuration
+	KeyRecordRetention             = types.KeyRecordRetention
+	KeyReservePrices               = types.KeyReservePrices
+	ModuleCdc                      = types.ModuleCdc
+	NextAuctionIDKey               = types.NextAuctionIDKey
 )
 
 type (
-	Keeper                = keeper.Keeper
-	Auction               = types.Auction
-	AuctionWithPhase      = types.AuctionWithPhase
-	Auctions              = types.Auctions
-	BaseAuction           = types.BaseAuction
-	CollateralAuction     = types.CollateralAuction
-	DebtAuction           = types.DebtAuction
-	GenesisAuction        = types.GenesisAuction
-	GenesisAuctions       = types.GenesisAuctions
-	GenesisState          = types.GenesisState
-	MsgPlaceBid           = types.MsgPlaceBid
-	Params                = types.Params
-	QueryAllAuctionParams = types.QueryAllAuctionParams
-	QueryAuctionParams    = types.QueryAuctionParams
-	SupplyKeeper          = types.SupplyKeeper
-	SurplusAuction        = types.SurplusAuction
-	WeightedAddresses     = types.WeightedAddresses
+	Keeper                    = keeper.Keeper
+	Auction                   = types.Auction
+	AuctionBidHistories       = types.AuctionBidHistories
+	AuctionBidHistory         = types.AuctionBidHistory
+	AuctionRecord             = types.AuctionRecord
+	AuctionRecords            = types.AuctionRecords
+	AuctionWithPhase          = types.AuctionWithPhase
+	Auctions                  = types.Auctions
+	BaseAuction               = types.BaseAuction
+	BidRecord                 = types.BidRecord
+	BidRecords                = types.BidRecords
+	CollateralAuction         = types.CollateralAuction
+	DebtAuction               = types.DebtAuction
+	DecayCurve                = types.DecayCurve
+	DutchCollateralAuction    = types.DutchCollateralAuction
+	GenesisAuction            = types.GenesisAuction
+	GenesisAuctions           = types.GenesisAuctions
+	GenesisState              = types.GenesisState
+	LotReturn                 = types.LotReturn
+	LotReturns                = types.LotReturns
+	MsgPlaceBid               = types.MsgPlaceBid
+	MsgPlacePartialBid        = types.MsgPlacePartialBid
+	MsgPlaceProxyBid          = types.MsgPlaceProxyBid
+	Params                    = types.Params
+	PartialBid                = types.PartialBid
+	PartialBidFill            = types.PartialBidFill
+	PartialBids               = types.PartialBids
+	PriceReference            = types.PriceReference
+	ProxyBid                  = types.ProxyBid
+	ProxyBids                 = types.ProxyBids
+	QueryAllAuctionParams     = types.QueryAllAuctionParams
+	QueryAuctionParams        = types.QueryAuctionParams
+	QueryAuctionRecordsParams = types.QueryAuctionRecordsParams
+	ReservePrice              = types.ReservePrice
+	ReservePriceParam         = types.ReservePriceParam
+	ReservePriceParams        = types.ReservePriceParams
+	SupplyKeeper              = types.SupplyKeeper
+	SurplusAuction            = types.SurplusAuction
+	WeightedAddresses         = types.WeightedAddresses
 )
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -23,6 +24,11 @@ const (
 	flagDenom = "denom"
 	flagPhase = "phase"
 	flagOwner = "owner"
+
+	flagBidder    = "bidder"
+	flagInitiator = "initiator"
+	flagStartTime = "start-time"
+	flagEndTime   = "end-time"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -36,6 +42,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	auctionQueryCmd.AddCommand(flags.GetCommands(
 		QueryGetAuctionCmd(queryRoute, cdc),
 		QueryGetAuctionsCmd(queryRoute, cdc),
+		QueryGetAuctionRecordCmd(queryRoute, cdc),
+		QueryGetAuctionRecordsCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
 	)...)
 
@@ -77,10 +85,10 @@ func QueryGetAuctionsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		Short: "query auctions with optional filters",
 		Long: strings.TrimSpace(`Query for all paginated auctions that match optional filters:
 Example:
-$ kvcli q auction auctions --type=(collateral|surplus|debt)
+$ kvcli q auction auctions --type=(collateral|dutch_collateral|surplus|debt)
 $ kvcli q auction auctions --owner=kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm
 $ kvcli q auction auctions --denom=bnb
-$ kvcli q auction auctions --phase=(forward|reverse)
+$ kvcli q auction auctions --phase=(forward|reverse|descending)
 $ kvcli q auction auctions --page=2 --limit=100
 `,
 		),
@@ -104,6 +112,7 @@ $ kvcli q auction auctions --page=2 --limit=100
 			if len(strType) != 0 {
 				auctionType = strings.ToLower(strings.TrimSpace(strType))
 				if auctionType != types.CollateralAuctionType &&
+					auctionType != types.DutchCollateralAuctionType &&
 					auctionType != types.SurplusAuctionType &&
 					auctionType != types.DebtAuctionType {
 					return fmt.Errorf("invalid auction type %s", strType)
@@ -112,7 +121,7 @@ $ kvcli q auction auctions --page=2 --limit=100
 			}
 
 			if len(auctionOwner) != 0 {
-				if auctionType != types.CollateralAuctionType {
+				if auctionType != types.CollateralAuctionType && auctionType != types.DutchCollateralAuctionType {
 					return fmt.Errorf("cannot apply owner flag to non-collateral auction type")
 				}
 				auctionOwnerStr := strings.ToLower(strings.TrimSpace(strOwner))
@@ -134,10 +143,10 @@ $ kvcli q auction auctions --page=2 --limit=100
 
 			if len(strPhase) != 0 {
 				auctionPhase := strings.ToLower(strings.TrimSpace(strPhase))
-				if auctionType != types.CollateralAuctionType && len(auctionType) > 0 {
+				if auctionType != types.CollateralAuctionType && auctionType != types.DutchCollateralAuctionType && len(auctionType) > 0 {
 					return fmt.Errorf("cannot apply phase flag to non-collateral auction type")
 				}
-				if auctionPhase != types.ForwardAuctionPhase && auctionPhase != types.ReverseAuctionPhase {
+				if auctionPhase != types.ForwardAuctionPhase && auctionPhase != types.ReverseAuctionPhase && auctionPhase != types.DescendingAuctionPhase {
 					return fmt.Errorf("invalid auction phase %s", strPhase)
 				}
 				params.Phase = auctionPhase
@@ -175,10 +184,124 @@ $ kvcli q auction auctions --page=2 --limit=100
 
 	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of auctions to to query for")
 	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of auctions to query for")
-	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, debt, surplus")
+	cmd.Flags().String(flagType, "", "(optional) filter by auction type, type: collateral, dutch_collateral, debt, surplus")
 	cmd.Flags().String(flagOwner, "", "(optional) filter by collateral auction owner")
 	cmd.Flags().String(flagDenom, "", "(optional) filter by auction denom")
-	cmd.Flags().String(flagPhase, "", "(optional) filter by collateral auction phase, phase: forward/reverse")
+	cmd.Flags().String(flagPhase, "", "(optional) filter by collateral auction phase, phase: forward/reverse/descending")
+
+	return cmd
+}
+
+// QueryGetAuctionRecordCmd queries the record of one closed auction
+func QueryGetAuctionRecordCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "record [auction-id]",
+		Short: "get the record of a closed auction",
+		Long:  "Get the lot, final bid, winner, bid history and lot returns of a closed auction.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryAuctionParams(id))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAuctionRecord), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var record types.AuctionRecord
+			cdc.MustUnmarshalJSON(res, &record)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(record)
+		},
+	}
+}
+
+// QueryGetAuctionRecordsCmd queries the records of closed auctions
+func QueryGetAuctionRecordsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "records",
+		Short: "query closed auction records with optional filters",
+		Long: strings.TrimSpace(`Query for paginated records of closed auctions, ordered by close time, that match optional filters.
+Times are in RFC3339 format, the start time is inclusive and the end time exclusive:
+Example:
+$ kvcli q auction records --bidder=kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm
+$ kvcli q auction records --initiator=liquidator
+$ kvcli q auction records --start-time=2021-01-01T00:00:00Z --end-time=2021-02-01T00:00:00Z
+$ kvcli q auction records --page=2 --limit=100
+`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			strBidder := viper.GetString(flagBidder)
+			strInitiator := viper.GetString(flagInitiator)
+			strStartTime := viper.GetString(flagStartTime)
+			strEndTime := viper.GetString(flagEndTime)
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			var (
+				bidder             sdk.AccAddress
+				startTime, endTime time.Time
+				err                error
+			)
+
+			if len(strBidder) != 0 {
+				bidder, err = sdk.AccAddressFromBech32(strings.TrimSpace(strBidder))
+				if err != nil {
+					return fmt.Errorf("cannot parse address from bidder %s", strBidder)
+				}
+			}
+			if len(strStartTime) != 0 {
+				startTime, err = time.Parse(time.RFC3339, strings.TrimSpace(strStartTime))
+				if err != nil {
+					return fmt.Errorf("cannot parse start time %s: %w", strStartTime, err)
+				}
+			}
+			if len(strEndTime) != 0 {
+				endTime, err = time.Parse(time.RFC3339, strings.TrimSpace(strEndTime))
+				if err != nil {
+					return fmt.Errorf("cannot parse end time %s: %w", strEndTime, err)
+				}
+			}
+
+			params := types.NewQueryAuctionRecordsParams(page, limit, bidder, strings.TrimSpace(strInitiator), startTime, endTime)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAuctionRecords), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var records types.AuctionRecords
+			cdc.MustUnmarshalJSON(res, &records)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(records)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of auction records to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of auction records to query for")
+	cmd.Flags().String(flagBidder, "", "(optional) filter by an address that bid on the auction")
+	cmd.Flags().String(flagInitiator, "", "(optional) filter by the module that started the auction")
+	cmd.Flags().String(flagStartTime, "", "(optional) filter by auctions closing at or after a time")
+	cmd.Flags().String(flagEndTime, "", "(optional) filter by auctions closing before a time")
 
 	return cmd
 }
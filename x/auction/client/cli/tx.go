@@ -28,6 +28,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	auctionTxCmd.AddCommand(flags.PostCommands(
 		GetCmdPlaceBid(cdc),
+		GetCmdPlacePartialBid(cdc),
+		GetCmdPlaceProxyBid(cdc),
 	)...)
 
 	return auctionTxCmd
@@ -69,3 +71,90 @@ $ %s tx %s bid 34 1000usdx --from myKeyName
 		},
 	}
 }
+
+// GetCmdPlacePartialBid cli command for placing partial bids on collateral auctions
+func GetCmdPlacePartialBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "partial-bid [auction-id] [lot] [price]",
+		Short: "bid for part of a collateral auction's lot at a price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Bid for [lot] of a collateral auction's lot, paying [price] bid coins for each unit of lot. The cost of the whole bid is held until the auction closes, when bids are filled from the highest price until the lot is sold or the max bid is raised, and any unfilled part is refunded.
+
+Example:
+$ %s tx %s partial-bid 34 1000000bnb 0.000025 --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			lot, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlacePartialBid(id, cliCtx.GetFromAddress(), lot, price)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdPlaceProxyBid cli command for placing proxy bids on auctions
+func GetCmdPlaceProxyBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proxy-bid [auction-id] [max-bid] [min-lot]",
+		Short: "have bids placed automatically on an auction when outbid",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Escrow coins to have bids placed on your behalf whenever you are outbid. In the forward phase bids are raised by the minimum increment up to [max-bid], and in the reverse phase the lot is bid down to [min-lot]. Leave out [min-lot] to only bid in the forward phase. Any escrow left over is refunded when the auction closes.
+
+Example:
+$ %s tx %s proxy-bid 34 1000usdx 200bnb --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			maxBid, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			var minLot sdk.Coin
+			if len(args) > 2 {
+				minLot, err = sdk.ParseCoin(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgPlaceProxyBid(id, cliCtx.GetFromAddress(), maxBid, minLot)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -20,6 +21,8 @@ const restAuctionID = "auction-id"
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/auctions", types.ModuleName), queryAuctionsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}", types.ModuleName, restAuctionID), queryAuctionHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/records", types.ModuleName), queryAuctionRecordsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/records/{%s}", types.ModuleName, restAuctionID), queryAuctionRecordHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/parameters", types.ModuleName), getParamsHandlerFn(cliCtx)).Methods("GET")
 }
 
@@ -76,6 +79,7 @@ func queryAuctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		if x := r.URL.Query().Get(RestType); len(x) != 0 {
 			auctionType = strings.ToLower(strings.TrimSpace(x))
 			if auctionType != types.CollateralAuctionType &&
+				auctionType != types.DutchCollateralAuctionType &&
 				auctionType != types.SurplusAuctionType &&
 				auctionType != types.DebtAuctionType {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid auction type %s", x))
@@ -84,7 +88,7 @@ func queryAuctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		if x := r.URL.Query().Get(RestOwner); len(x) != 0 {
-			if auctionType != types.CollateralAuctionType {
+			if auctionType != types.CollateralAuctionType && auctionType != types.DutchCollateralAuctionType {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, "cannot apply owner flag to non-collateral auction type")
 			}
 			auctionOwnerStr := strings.ToLower(strings.TrimSpace(x))
@@ -105,11 +109,11 @@ func queryAuctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		if x := r.URL.Query().Get(RestPhase); len(x) != 0 {
 			auctionPhase = strings.ToLower(strings.TrimSpace(x))
-			if auctionType != types.CollateralAuctionType && len(auctionType) > 0 {
+			if auctionType != types.CollateralAuctionType && auctionType != types.DutchCollateralAuctionType && len(auctionType) > 0 {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, "cannot apply phase flag to non-collateral auction type")
 				return
 			}
-			if auctionPhase != types.ForwardAuctionPhase && auctionPhase != types.ReverseAuctionPhase {
+			if auctionPhase != types.ForwardAuctionPhase && auctionPhase != types.ReverseAuctionPhase && auctionPhase != types.DescendingAuctionPhase {
 				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid auction phase %s", x))
 				return
 			}
@@ -147,6 +151,105 @@ func queryAuctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func queryAuctionRecordHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Parse the query height
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// Prepare params for querier
+		vars := mux.Vars(r)
+		if len(vars[restAuctionID]) == 0 {
+			err := fmt.Errorf("%s required but not specified", restAuctionID)
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		auctionID, ok := rest.ParseUint64OrReturnBadRequest(w, vars[restAuctionID])
+		if !ok {
+			return
+		}
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAuctionParams(auctionID))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Query
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryGetAuctionRecord), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		// Decode and return results
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryAuctionRecordsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Parse the query height
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var bidder sdk.AccAddress
+		var startTime, endTime time.Time
+
+		if x := r.URL.Query().Get(RestBidder); len(x) != 0 {
+			bidder, err = sdk.AccAddressFromBech32(strings.TrimSpace(x))
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("cannot parse address from bidder %s", x))
+				return
+			}
+		}
+
+		if x := r.URL.Query().Get(RestStartTime); len(x) != 0 {
+			startTime, err = time.Parse(time.RFC3339, strings.TrimSpace(x))
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("cannot parse start time %s: %s", x, err))
+				return
+			}
+		}
+
+		if x := r.URL.Query().Get(RestEndTime); len(x) != 0 {
+			endTime, err = time.Parse(time.RFC3339, strings.TrimSpace(x))
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("cannot parse end time %s: %s", x, err))
+				return
+			}
+		}
+
+		initiator := strings.TrimSpace(r.URL.Query().Get(RestInitiator))
+
+		params := types.NewQueryAuctionRecordsParams(page, limit, bidder, initiator, startTime, endTime)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryGetAuctionRecords)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func getParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Parse the query height
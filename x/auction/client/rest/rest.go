@@ -15,6 +15,11 @@ const (
 	RestOwner = "owner"
 	RestDenom = "denom"
 	RestPhase = "phase"
+
+	RestBidder    = "bidder"
+	RestInitiator = "initiator"
+	RestStartTime = "start_time"
+	RestEndTime   = "end_time"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
@@ -28,3 +33,17 @@ type placeBidReq struct {
 	BaseReq rest.BaseReq `json:"base_req"`
 	Amount  sdk.Coin     `json:"amount"`
 }
+
+// placePartialBidReq defines the properties of a partial bid request's body
+type placePartialBidReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Lot     sdk.Coin     `json:"lot"`
+	Price   sdk.Dec      `json:"price"`
+}
+
+// placeProxyBidReq defines the properties of a proxy bid request's body
+type placeProxyBidReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	MaxBid  sdk.Coin     `json:"max_bid"`
+	MinLot  sdk.Coin     `json:"min_lot"`
+}
@@ -25,6 +25,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 		totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins()...)
 	}
 
+	for _, h := range gs.Bids {
+		keeper.SetBidRecords(ctx, h.AuctionID, h.Bids)
+	}
+
+	for _, r := range gs.Records {
+		keeper.SetAuctionRecord(ctx, r)
+	}
+
 	// check if the module account exists
 	moduleAcc := supplyKeeper.GetModuleAccount(ctx, ModuleName)
 	if moduleAcc == nil {
@@ -47,14 +55,18 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	params := keeper.GetParams(ctx)
 
 	genAuctions := GenesisAuctions{} // return empty list instead of nil if no auctions
+	bids := types.AuctionBidHistories{}
 	keeper.IterateAuctions(ctx, func(a Auction) bool {
 		ga, ok := a.(types.GenesisAuction)
 		if !ok {
 			panic("could not convert stored auction to GenesisAuction type")
 		}
 		genAuctions = append(genAuctions, ga)
+		if auctionBids := keeper.GetBidRecords(ctx, a.GetID()); len(auctionBids) > 0 {
+			bids = append(bids, types.NewAuctionBidHistory(a.GetID(), auctionBids))
+		}
 		return false
 	})
 
-	return NewGenesisState(nextAuctionID, params, genAuctions)
+	return NewGenesisState(nextAuctionID, params, genAuctions, bids, keeper.GetAuctionRecords(ctx))
 }
@@ -43,6 +43,8 @@ func TestInitGenesis(t *testing.T) {
 			10,
 			auction.DefaultParams(),
 			auction.GenesisAuctions{testAuction},
+			auction.AuctionBidHistories{},
+			auction.AuctionRecords{},
 		)
 
 		// run init
@@ -78,6 +80,8 @@ func TestInitGenesis(t *testing.T) {
 			0, // next id < testAuction ID
 			auction.DefaultParams(),
 			auction.GenesisAuctions{testAuction},
+			auction.AuctionBidHistories{},
+			auction.AuctionRecords{},
 		)
 
 		// check init fails
@@ -95,6 +99,8 @@ func TestInitGenesis(t *testing.T) {
 			10,
 			auction.DefaultParams(),
 			auction.GenesisAuctions{testAuction},
+			auction.AuctionBidHistories{},
+			auction.AuctionRecords{},
 		)
 		// invalid as there is no module account setup
 
@@ -133,4 +139,36 @@ func TestExportGenesis(t *testing.T) {
 		expectedGenesisState.Auctions = append(expectedGenesisState.Auctions, testAuction)
 		require.Equal(t, expectedGenesisState, gs)
 	})
+	t.Run("auction with bids", func(t *testing.T) {
+		// setup state
+		tApp := app.NewTestApp()
+		ctx := tApp.NewContext(true, abci.Header{})
+		tApp.InitializeFromGenesisStates()
+		bids := auction.BidRecords{
+			auction.NewBidRecord(testAddrs[0], c("biddenom", 10), testAuction.GetLot(), 1, testTime.Add(-time.Hour)),
+			auction.NewBidRecord(testAddrs[1], c("biddenom", 20), testAuction.GetLot(), 2, testTime.Add(-time.Minute)),
+		}
+		tApp.GetAuctionKeeper().SetNextAuctionID(ctx, 10)
+		tApp.GetAuctionKeeper().SetAuction(ctx, testAuction)
+		tApp.GetAuctionKeeper().SetBidRecords(ctx, testAuction.GetID(), bids)
+
+		// export
+		gs := auction.ExportGenesis(ctx, tApp.GetAuctionKeeper())
+
+		// check state matches
+		expectedGenesisState := auction.DefaultGenesisState()
+		expectedGenesisState.NextAuctionID = 10
+		expectedGenesisState.Auctions = append(expectedGenesisState.Auctions, testAuction)
+		expectedGenesisState.Bids = auction.AuctionBidHistories{auction.NewAuctionBidHistory(testAuction.GetID(), bids)}
+		require.Equal(t, expectedGenesisState, gs)
+
+		// import into a new app and export again
+		tApp2 := app.NewTestApp()
+		ctx2 := tApp2.NewContext(true, abci.Header{})
+		moduleAcc := tApp2.GetSupplyKeeper().GetModuleAccount(ctx2, auction.ModuleName)
+		require.NoError(t, moduleAcc.SetCoins(testAuction.GetModuleAccountCoins()))
+		tApp2.GetSupplyKeeper().SetModuleAccount(ctx2, moduleAcc)
+		auction.InitGenesis(ctx2, tApp2.GetAuctionKeeper(), tApp2.GetSupplyKeeper(), gs)
+		require.Equal(t, gs, auction.ExportGenesis(ctx2, tApp2.GetAuctionKeeper()))
+	})
 }
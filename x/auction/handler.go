@@ -15,6 +15,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgPlaceBid:
 			return handleMsgPlaceBid(ctx, keeper, msg)
+		case MsgPlacePartialBid:
+			return handleMsgPlacePartialBid(ctx, keeper, msg)
+		case MsgPlaceProxyBid:
+			return handleMsgPlaceProxyBid(ctx, keeper, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -40,3 +44,43 @@ func handleMsgPlaceBid(ctx sdk.Context, keeper Keeper, msg MsgPlaceBid) (*sdk.Re
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+func handleMsgPlacePartialBid(ctx sdk.Context, keeper Keeper, msg MsgPlacePartialBid) (*sdk.Result, error) {
+
+	err := keeper.PlacePartialBid(ctx, msg.AuctionID, msg.Bidder, msg.Lot, msg.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgPlaceProxyBid(ctx sdk.Context, keeper Keeper, msg MsgPlaceProxyBid) (*sdk.Result, error) {
+
+	err := keeper.PlaceProxyBid(ctx, msg.AuctionID, msg.Bidder, msg.MaxBid, msg.MinLot)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
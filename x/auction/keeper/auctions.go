@@ -20,6 +20,7 @@ func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin
 		bidDenom,
 		types.DistantFuture,
 	)
+	auction.ReservePrice = k.newReservePrice(ctx, auction.GetType(), seller, lot.Denom, bidDenom)
 
 	// NOTE: for the duration of the auction the auction module account holds the lot
 	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
@@ -54,6 +55,7 @@ func (k Keeper) StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, in
 		types.DistantFuture,
 		debt,
 	)
+	auction.ReservePrice = k.newReservePrice(ctx, auction.GetType(), buyer, initialLot.Denom, bid.Denom)
 
 	// This auction type mints coins at close. Need to check module account has minting privileges to avoid potential err in endblocker.
 	macc := k.supplyKeeper.GetModuleAccount(ctx, buyer)
@@ -101,6 +103,7 @@ func (k Keeper) StartCollateralAuction(
 		weightedAddresses,
 		debt,
 	)
+	auction.ReservePrice = k.newReservePrice(ctx, auction.GetType(), seller, lot.Denom, maxBid.Denom)
 
 	// NOTE: for the duration of the auction the auction module account holds the debt and the lot
 	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
@@ -130,6 +133,64 @@ func (k Keeper) StartCollateralAuction(
 	return auctionID, nil
 }
 
+// StartDutchCollateralAuction starts a new dutch (descending price) collateral auction.
+// The oracle price is the price of one unit of the lot in units of the max bid. The auction starts above it by the DutchStartMarkup param,
+// does not fall below the DutchPriceFloor fraction of it, and ends after the MaxAuctionDuration param.
+func (k Keeper) StartDutchCollateralAuction(
+	ctx sdk.Context, seller string, lot, maxBid sdk.Coin,
+	lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin, oraclePrice sdk.Dec,
+) (uint64, error) {
+	if oraclePrice.IsNil() || !oraclePrice.IsPositive() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidStartPrice, "%s", oraclePrice)
+	}
+	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
+	if err != nil {
+		return 0, err
+	}
+	params := k.GetParams(ctx)
+	auction := types.NewDutchCollateralAuction(
+		seller,
+		lot,
+		maxBid,
+		weightedAddresses,
+		debt,
+		oraclePrice.Mul(params.DutchStartMarkup),
+		oraclePrice.Mul(params.DutchPriceFloor),
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(params.MaxAuctionDuration),
+		params.DutchDecayCurve,
+	)
+	auction.ReservePrice = k.newReservePrice(ctx, auction.GetType(), seller, lot.Denom, maxBid.Denom)
+
+	// NOTE: for the duration of the auction the auction module account holds the debt and the lot
+	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
+	if err != nil {
+		return 0, err
+	}
+	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(debt))
+	if err != nil {
+		return 0, err
+	}
+
+	auctionID, err := k.StoreNewAuction(ctx, auction)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBid, auction.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, auction.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, auction.MaxBid.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.StartPrice.String()),
+		),
+	)
+	return auctionID, nil
+}
+
 // PlaceBid places a bid on any auction.
 func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) error {
 
@@ -143,7 +204,31 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		return sdkerrors.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
 	}
 
-	// move coins and return updated auction
+	previousBidder, previousBid := auction.GetBidder(), auction.GetBid()
+	updatedAuction, err := k.placeBid(ctx, auction, bidder, newAmount)
+	if err != nil {
+		return err
+	}
+
+	// bid again on behalf of any proxy bidders that have been outbid
+	if len(updatedAuction.GetProxyBids()) > 0 {
+		updatedAuction, err = k.escrowOutbidProxyRefund(ctx, updatedAuction, bidder, previousBidder, previousBid)
+		if err != nil {
+			return err
+		}
+		updatedAuction, err = k.placeProxyBids(ctx, updatedAuction)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.SetAuction(ctx, updatedAuction)
+
+	return nil
+}
+
+// placeBid places a bid on any type of auction, moving coins and returning the updated auction.
+func (k Keeper) placeBid(ctx sdk.Context, auction types.Auction, bidder sdk.AccAddress, newAmount sdk.Coin) (types.Auction, error) {
 	var (
 		err            error
 		updatedAuction types.Auction
@@ -159,10 +244,33 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		} else {
 			updatedAuction, err = k.PlaceReverseBidCollateral(ctx, auctionType, bidder, newAmount)
 		}
+	case types.DutchCollateralAuction:
+		updatedAuction, err = k.PlaceBidDutchCollateral(ctx, auctionType, bidder, newAmount)
 	default:
 		err = sdkerrors.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
 	}
 
+	return updatedAuction, err
+}
+
+// PlacePartialBid places a bid for a slice of an auction's lot at a price. Only collateral auctions accept partial bids.
+func (k Keeper) PlacePartialBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, lot sdk.Coin, price sdk.Dec) error {
+
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+
+	// validation common to all auctions
+	if ctx.BlockTime().After(auction.GetEndTime()) {
+		return sdkerrors.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
+	}
+
+	collateralAuction, ok := auction.(types.CollateralAuction)
+	if !ok {
+		return sdkerrors.Wrap(types.ErrPartialBidsNotSupported, auction.GetType())
+	}
+	updatedAuction, err := k.PlacePartialBidCollateral(ctx, collateralAuction, bidder, lot, price)
 	if err != nil {
 		return err
 	}
@@ -187,6 +295,13 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction types.SurplusAuction, b
 	if bid.Amount.LT(minNewBidAmt) {
 		return auction, sdkerrors.Wrapf(types.ErrBidTooSmall, "%s < %s%s", bid, minNewBidAmt, auction.Bid.Denom)
 	}
+	minReserveBidAmt, err := reserveMinBid(ctx, auction, auction.Lot.Amount)
+	if err != nil {
+		return auction, err
+	}
+	if bid.Amount.LT(minReserveBidAmt) {
+		return auction, sdkerrors.Wrapf(types.ErrBidBelowReserve, "%s < %s%s", bid, minReserveBidAmt, auction.Bid.Denom)
+	}
 
 	// New bidder pays back old bidder
 	// Catch edge cases of a bidder replacing their own bid, or the amount being zero (sending zero coins produces meaningless send events).
@@ -201,7 +316,7 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction types.SurplusAuction, b
 		}
 	}
 	// Increase in bid is burned
-	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, auction.Initiator, sdk.NewCoins(bid.Sub(auction.Bid)))
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, auction.Initiator, sdk.NewCoins(bid.Sub(auction.Bid)))
 	if err != nil {
 		return auction, err
 	}
@@ -213,6 +328,7 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction types.SurplusAuction, b
 	// Update Auction
 	auction.Bidder = bidder
 	auction.Bid = bid
+	k.recordBid(ctx, auction.ID, types.NewBidRecord(bidder, bid, auction.Lot, ctx.BlockHeight(), ctx.BlockTime()))
 	if !auction.HasReceivedBids {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
@@ -235,6 +351,9 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction types.SurplusAuction, b
 // PlaceForwardBidCollateral places a forward bid on a collateral auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.CollateralAuction, bidder sdk.AccAddress, bid sdk.Coin) (types.CollateralAuction, error) {
 	// Validate new bid
+	if len(auction.PartialBids) > 0 {
+		return auction, sdkerrors.Wrapf(types.ErrMixedBidTypes, "auction %d has partial bids", auction.ID)
+	}
 	if bid.Denom != auction.Bid.Denom {
 		return auction, sdkerrors.Wrapf(types.ErrInvalidBidDenom, "%s ≠ %s", bid.Denom, auction.Bid.Denom)
 	}
@@ -254,6 +373,14 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.Collate
 	if auction.MaxBid.IsLT(bid) {
 		return auction, sdkerrors.Wrapf(types.ErrBidTooLarge, "%s > %s", bid, auction.MaxBid)
 	}
+	minReserveBidAmt, err := reserveMinBid(ctx, auction, auction.Lot.Amount)
+	if err != nil {
+		return auction, err
+	}
+	minReserveBidAmt = sdk.MinInt(minReserveBidAmt, auction.MaxBid.Amount) // bids of MaxBid move the reserve onto the lot in the reverse phase
+	if bid.Amount.LT(minReserveBidAmt) {
+		return auction, sdkerrors.Wrapf(types.ErrBidBelowReserve, "%s < %s%s", bid, minReserveBidAmt, auction.Bid.Denom)
+	}
 
 	// New bidder pays back old bidder
 	// Catch edge cases of a bidder replacing their own bid, and the amount being zero (sending zero coins produces meaningless send events).
@@ -269,7 +396,7 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.Collate
 	}
 	// Increase in bid sent to auction initiator
 	bidIncrement := bid.Sub(auction.Bid)
-	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, auction.Initiator, sdk.NewCoins(bidIncrement))
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, auction.Initiator, sdk.NewCoins(bidIncrement))
 	if err != nil {
 		return auction, err
 	}
@@ -289,6 +416,7 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.Collate
 	// Update Auction
 	auction.Bidder = bidder
 	auction.Bid = bid
+	k.recordBid(ctx, auction.ID, types.NewBidRecord(bidder, bid, auction.Lot, ctx.BlockHeight(), ctx.BlockTime()))
 	if !auction.HasReceivedBids {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
@@ -308,6 +436,109 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.Collate
 	return auction, nil
 }
 
+// PlacePartialBidCollateral adds a bid for a slice of the lot to a collateral auction's order book, moving coins and returning the updated auction.
+// The bid coins are held until the auction closes. Bids that would no longer be filled are removed from the order book and refunded.
+func (k Keeper) PlacePartialBidCollateral(ctx sdk.Context, auction types.CollateralAuction, bidder sdk.AccAddress, lot sdk.Coin, price sdk.Dec) (types.CollateralAuction, error) {
+	// Validate new bid
+	if auction.Bid.IsPositive() {
+		return auction, sdkerrors.Wrapf(types.ErrMixedBidTypes, "auction %d has a bid for the whole lot", auction.ID)
+	}
+	if len(auction.ProxyBids) > 0 {
+		return auction, sdkerrors.Wrapf(types.ErrMixedBidTypes, "auction %d has proxy bids", auction.ID)
+	}
+	if lot.Denom != auction.Lot.Denom {
+		return auction, sdkerrors.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", lot.Denom, auction.Lot.Denom)
+	}
+	if !lot.IsPositive() {
+		return auction, sdkerrors.Wrapf(types.ErrLotTooSmall, "%s ≤ 0%s", lot, auction.Lot.Denom)
+	}
+	if lot.Amount.GT(auction.Lot.Amount) {
+		return auction, sdkerrors.Wrapf(types.ErrLotTooLarge, "%s > %s", lot, auction.Lot)
+	}
+	if price.IsNil() || !price.IsPositive() {
+		return auction, sdkerrors.Wrapf(types.ErrBidTooSmall, "price %s ≤ 0", price)
+	}
+	reserve, err := auction.GetReservePrice().CurrentPrice(ctx.BlockTime())
+	if err != nil {
+		return auction, err
+	}
+	if price.LT(reserve) {
+		return auction, sdkerrors.Wrapf(types.ErrBidBelowReserve, "price %s < %s", price, reserve)
+	}
+	// when the order book already sells the whole lot or raises the max bid, new bids must beat the lowest filled price by some %
+	fills := auction.PartialBidFills()
+	if minPrice, full := partialBidsMinNewPrice(auction, fills, k.GetParams(ctx).IncrementCollateral); full && price.LT(minPrice) {
+		return auction, sdkerrors.Wrapf(types.ErrBidTooSmall, "price %s < %s", price, minPrice)
+	}
+	bid := types.NewPartialBid(bidder, lot, price, auction.MaxBid.Denom)
+	if !bid.Escrow.IsPositive() {
+		return auction, sdkerrors.Wrapf(types.ErrBidTooSmall, "%s ≤ 0%s", bid.Escrow, auction.MaxBid.Denom)
+	}
+
+	// Bid coins held by the auction module until the auction closes
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(bid.Escrow))
+	if err != nil {
+		return auction, err
+	}
+
+	// Bids pushed out of the order book are refunded
+	auction.PartialBids = auction.PartialBids.Insert(bid)
+	var standingBids types.PartialBids
+	for i, fill := range auction.PartialBidFills() {
+		if fill.IsFilled() {
+			standingBids = append(standingBids, auction.PartialBids[i])
+			continue
+		}
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.PartialBids[i].Bidder, sdk.NewCoins(auction.PartialBids[i].Escrow))
+		if err != nil {
+			return auction, err
+		}
+	}
+	auction.PartialBids = standingBids
+
+	// Update Auction
+	k.recordBid(ctx, auction.ID, types.NewBidRecord(bidder, bid.Escrow, bid.Lot, ctx.BlockHeight(), ctx.BlockTime()))
+	if !auction.HasReceivedBids {
+		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.HasReceivedBids = true
+	}
+	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, bid.Escrow.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, bid.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, bid.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+
+	return auction, nil
+}
+
+// partialBidsMinNewPrice returns the minimum price of a new partial bid, and whether the order book is full.
+// A full order book sells the whole lot or raises the max bid, so new bids must beat the lowest filled price.
+func partialBidsMinNewPrice(auction types.CollateralAuction, fills []types.PartialBidFill, increment sdk.Dec) (sdk.Dec, bool) {
+	lotSold := sdk.ZeroInt()
+	bidRaised := sdk.ZeroInt()
+	lowestPrice := sdk.ZeroDec()
+	for i, fill := range fills {
+		if !fill.IsFilled() {
+			continue
+		}
+		lotSold = lotSold.Add(fill.Lot.Amount)
+		bidRaised = bidRaised.Add(fill.Cost.Amount)
+		lowestPrice = auction.PartialBids[i].Price
+	}
+	if lotSold.LT(auction.Lot.Amount) && bidRaised.LT(auction.MaxBid.Amount) {
+		return sdk.ZeroDec(), false
+	}
+	return lowestPrice.Add(lowestPrice.Mul(increment)), true
+}
+
 // PlaceReverseBidCollateral places a reverse bid on a collateral auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.CollateralAuction, bidder sdk.AccAddress, lot sdk.Coin) (types.CollateralAuction, error) {
 	// Validate new bid
@@ -329,6 +560,13 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.Collate
 	if lot.IsNegative() {
 		return auction, sdkerrors.Wrapf(types.ErrLotTooSmall, "%s < 0%s", lot, auction.Lot.Denom)
 	}
+	maxReserveLotAmt, found, err := reserveMaxLot(ctx, auction, auction.Bid.Amount)
+	if err != nil {
+		return auction, err
+	}
+	if found && lot.Amount.GT(maxReserveLotAmt) {
+		return auction, sdkerrors.Wrapf(types.ErrBidBelowReserve, "%s > %s%s", lot, maxReserveLotAmt, auction.Lot.Denom)
+	}
 
 	// New bidder pays back old bidder
 	// Catch edge cases of a bidder replacing their own bid
@@ -344,25 +582,16 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.Collate
 	}
 
 	// Decrease in lot is sent to weighted addresses (normally the CDP depositors)
-	// Note: splitting an integer amount across weighted buckets results in small errors.
-	lotPayouts, err := splitCoinIntoWeightedBuckets(auction.Lot.Sub(lot), auction.LotReturns.Weights)
+	lotReturned, err := k.payoutLotReturns(ctx, auction.Lot.Sub(lot), auction.LotReturns)
 	if err != nil {
 		return auction, err
 	}
-	for i, payout := range lotPayouts {
-		// if the payout amount is 0, don't send 0 coins
-		if !payout.IsPositive() {
-			continue
-		}
-		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.LotReturns.Addresses[i], sdk.NewCoins(payout))
-		if err != nil {
-			return auction, err
-		}
-	}
 
 	// Update Auction
 	auction.Bidder = bidder
 	auction.Lot = lot
+	auction.LotReturned = addLotReturns(auction.LotReturned, lotReturned)
+	k.recordBid(ctx, auction.ID, types.NewBidRecord(bidder, auction.Bid, lot, ctx.BlockHeight(), ctx.BlockTime()))
 	if !auction.HasReceivedBids {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
@@ -382,6 +611,98 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.Collate
 	return auction, nil
 }
 
+// PlaceBidDutchCollateral buys part of the lot of a dutch collateral auction at the current price, moving coins and returning the updated auction.
+// The amount bought is reduced if it is more than the remaining lot, or costs more than is left to raise.
+// Once the whole lot is sold or the max bid is raised the auction ends, and it is closed at the end of the block.
+func (k Keeper) PlaceBidDutchCollateral(ctx sdk.Context, auction types.DutchCollateralAuction, bidder sdk.AccAddress, lot sdk.Coin) (types.DutchCollateralAuction, error) {
+	// Validate new bid
+	if auction.IsComplete() {
+		return auction, sdkerrors.Wrapf(types.ErrAuctionHasExpired, "%d", auction.ID)
+	}
+	if lot.Denom != auction.Lot.Denom {
+		return auction, sdkerrors.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", lot.Denom, auction.Lot.Denom)
+	}
+	if !lot.IsPositive() {
+		return auction, sdkerrors.Wrapf(types.ErrLotTooSmall, "%s ≤ 0%s", lot, auction.Lot.Denom)
+	}
+
+	price, err := auction.CurrentPrice(ctx.BlockTime())
+	if err != nil {
+		return auction, err
+	}
+	if !price.IsPositive() {
+		return auction, sdkerrors.Wrapf(types.ErrBidTooSmall, "price %s ≤ 0", price)
+	}
+	reserve, err := auction.GetReservePrice().CurrentPrice(ctx.BlockTime())
+	if err != nil {
+		return auction, err
+	}
+	if price.LT(reserve) {
+		return auction, sdkerrors.Wrapf(types.ErrBidBelowReserve, "price %s < %s", price, reserve)
+	}
+	lotAmount := sdk.MinInt(lot.Amount, auction.Lot.Amount)
+	remainingBid := auction.MaxBid.Sub(auction.Bid)
+	cost := price.MulInt(lotAmount).Ceil().TruncateInt()
+	if cost.GT(remainingBid.Amount) {
+		// only sell enough of the lot to raise the max bid
+		cost = remainingBid.Amount
+		lotAmount = sdk.MinInt(lotAmount, sdk.NewDecFromInt(cost).Quo(price).Ceil().TruncateInt())
+	}
+	payment := sdk.NewCoin(auction.Bid.Denom, cost)
+	purchase := sdk.NewCoin(auction.Lot.Denom, lotAmount)
+
+	// Payment sent to auction initiator
+	if payment.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, auction.Initiator, sdk.NewCoins(payment))
+		if err != nil {
+			return auction, err
+		}
+	}
+	// Debt coins are sent to liquidator (until there is no CorrespondingDebt left). Amount sent is equal to payment (or whatever is left if < payment).
+	if auction.CorrespondingDebt.IsPositive() && payment.IsPositive() {
+
+		debtAmountToReturn := sdk.MinInt(payment.Amount, auction.CorrespondingDebt.Amount)
+		debtToReturn := sdk.NewCoin(auction.CorrespondingDebt.Denom, debtAmountToReturn)
+
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return auction, err
+		}
+		auction.CorrespondingDebt = auction.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ auction.CorrespondingDebt from the MinInt above
+	}
+	// Purchased lot sent to bidder
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(purchase))
+	if err != nil {
+		return auction, err
+	}
+
+	// Update Auction
+	auction.Bidder = bidder
+	auction.Bid = auction.Bid.Add(payment)
+	auction.Lot = auction.Lot.Sub(purchase)
+	auction.HasReceivedBids = true
+	k.recordBid(ctx, auction.ID, types.NewBidRecord(bidder, payment, purchase, ctx.BlockHeight(), ctx.BlockTime()))
+	if auction.IsComplete() {
+		// close the auction at the end of this block
+		auction.EndTime = ctx.BlockTime()
+		auction.MaxEndTime = ctx.BlockTime()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, payment.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, purchase.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+
+	return auction, nil
+}
+
 // PlaceBidDebt places a reverse bid on a debt auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction types.DebtAuction, bidder sdk.AccAddress, lot sdk.Coin) (types.DebtAuction, error) {
 	// Validate new bid
@@ -400,6 +721,13 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction types.DebtAuction, bidder
 	if lot.IsNegative() {
 		return auction, sdkerrors.Wrapf(types.ErrLotTooSmall, "%s ≤ %s%s", lot, sdk.ZeroInt(), auction.Lot.Denom)
 	}
+	maxReserveLotAmt, found, err := reserveMaxLot(ctx, auction, auction.Bid.Amount)
+	if err != nil {
+		return auction, err
+	}
+	if found && lot.Amount.GT(maxReserveLotAmt) {
+		return auction, sdkerrors.Wrapf(types.ErrBidBelowReserve, "%s > %s%s", lot, maxReserveLotAmt, auction.Lot.Denom)
+	}
 
 	// New bidder pays back old bidder
 	// Catch edge cases of a bidder replacing their own bid
@@ -429,6 +757,7 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction types.DebtAuction, bidder
 	// Update Auction
 	auction.Bidder = bidder
 	auction.Lot = lot
+	k.recordBid(ctx, auction.ID, types.NewBidRecord(bidder, auction.Bid, lot, ctx.BlockHeight(), ctx.BlockTime()))
 	if !auction.HasReceivedBids {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
@@ -460,14 +789,20 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 	}
 
 	// payout to the last bidder
-	var err error
+	var (
+		err        error
+		lotReturns types.LotReturns
+	)
 	switch auc := auction.(type) {
 	case types.SurplusAuction:
 		err = k.PayoutSurplusAuction(ctx, auc)
 	case types.DebtAuction:
 		err = k.PayoutDebtAuction(ctx, auc)
 	case types.CollateralAuction:
-		err = k.PayoutCollateralAuction(ctx, auc)
+		lotReturns, err = k.PayoutCollateralAuction(ctx, auc)
+		lotReturns = addLotReturns(auc.LotReturned, lotReturns)
+	case types.DutchCollateralAuction:
+		lotReturns, err = k.PayoutDutchCollateralAuction(ctx, auc)
 	default:
 		err = sdkerrors.Wrap(types.ErrUnrecognizedAuctionType, auc.GetType())
 	}
@@ -476,7 +811,13 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 		return err
 	}
 
+	if err := k.refundProxyBids(ctx, auction); err != nil {
+		return err
+	}
+
+	bids := k.GetBidRecords(ctx, auctionID)
 	k.DeleteAuction(ctx, auctionID)
+	k.SetAuctionRecord(ctx, types.NewAuctionRecord(auction, bids, lotReturns, ctx.BlockHeight(), ctx.BlockTime()))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -514,20 +855,112 @@ func (k Keeper) PayoutSurplusAuction(ctx sdk.Context, auction types.SurplusAucti
 	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.Bidder, sdk.NewCoins(auction.Lot))
 }
 
-// PayoutCollateralAuction pays out the proceeds for a collateral auction.
-func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, auction types.CollateralAuction) error {
+// PayoutCollateralAuction pays out the proceeds for a collateral auction, returning any lot sent to LotReturns.
+func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, auction types.CollateralAuction) (types.LotReturns, error) {
+	if len(auction.PartialBids) > 0 {
+		return k.PayoutPartialBidsCollateralAuction(ctx, auction)
+	}
+
 	// Send the tokens from the auction module account where they are being managed to the bidder who won the auction
 	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.Bidder, sdk.NewCoins(auction.Lot))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// if there is remaining debt after the auction, send it back to the initiating module for management
 	if !auction.CorrespondingDebt.IsPositive() {
-		return nil
+		return nil, nil
 	}
 
-	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
+	return nil, k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
+}
+
+// PayoutPartialBidsCollateralAuction settles a collateral auction's order book, filling bids from the highest price until the lot is sold or the max bid is raised.
+// Filled bids are paid to the initiator, unfilled bid coins are refunded and unsold lot is sent to LotReturns, which is returned.
+func (k Keeper) PayoutPartialBidsCollateralAuction(ctx sdk.Context, auction types.CollateralAuction) (types.LotReturns, error) {
+	for i, fill := range auction.PartialBidFills() {
+		bid := auction.PartialBids[i]
+		if fill.IsFilled() {
+			err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bid.Bidder, sdk.NewCoins(fill.Lot))
+			if err != nil {
+				return nil, err
+			}
+			err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(fill.Cost))
+			if err != nil {
+				return nil, err
+			}
+			auction.Lot = auction.Lot.Sub(fill.Lot)
+		}
+		refund := bid.Escrow.Sub(fill.Cost)
+		if refund.IsPositive() {
+			err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bid.Bidder, sdk.NewCoins(refund))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Unsold lot is sent to weighted addresses (normally the CDP depositors)
+	lotReturns, err := k.payoutLotReturns(ctx, auction.Lot, auction.LotReturns)
+	if err != nil {
+		return nil, err
+	}
+
+	// if there is remaining debt after the auction, send it back to the initiating module for management
+	if !auction.CorrespondingDebt.IsPositive() {
+		return lotReturns, nil
+	}
+	return lotReturns, k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
+}
+
+// PayoutDutchCollateralAuction returns any unsold lot of a dutch collateral auction to the lot owners, returning what was sent to them.
+// Bidders are paid as they buy, so there is nothing to pay out to them.
+func (k Keeper) PayoutDutchCollateralAuction(ctx sdk.Context, auction types.DutchCollateralAuction) (types.LotReturns, error) {
+	// Unsold lot is sent to weighted addresses (normally the CDP depositors)
+	lotReturns, err := k.payoutLotReturns(ctx, auction.Lot, auction.LotReturns)
+	if err != nil {
+		return nil, err
+	}
+
+	// if there is remaining debt after the auction, send it back to the initiating module for management
+	if !auction.CorrespondingDebt.IsPositive() {
+		return lotReturns, nil
+	}
+
+	return lotReturns, k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
+}
+
+// payoutLotReturns splits some lot between weighted addresses (normally the CDP depositors), returning what was sent to each.
+// Note: splitting an integer amount across weighted buckets results in small errors.
+func (k Keeper) payoutLotReturns(ctx sdk.Context, lot sdk.Coin, lotReturns types.WeightedAddresses) (types.LotReturns, error) {
+	if !lot.IsPositive() {
+		return nil, nil
+	}
+	lotPayouts, err := splitCoinIntoWeightedBuckets(lot, lotReturns.Weights)
+	if err != nil {
+		return nil, err
+	}
+	var returned types.LotReturns
+	for i, payout := range lotPayouts {
+		// if the payout amount is 0, don't send 0 coins
+		if !payout.IsPositive() {
+			continue
+		}
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lotReturns.Addresses[i], sdk.NewCoins(payout))
+		if err != nil {
+			return nil, err
+		}
+		returned = returned.Add(lotReturns.Addresses[i], payout)
+	}
+	return returned, nil
+}
+
+// addLotReturns combines two sets of lot returns.
+func addLotReturns(lotReturns, more types.LotReturns) types.LotReturns {
+	for _, lr := range more {
+		lotReturns = lotReturns.Add(lr.Address, lr.Lot)
+	}
+	return lotReturns
 }
 
 // CloseExpiredAuctions iterates over all the auctions stored by until the current
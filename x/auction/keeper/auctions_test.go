@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -257,6 +258,329 @@ func TestCollateralAuctionDebtRemaining(t *testing.T) {
 	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 100)))
 }
 
+func TestCollateralAuctionPartialBids(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(5)
+	buyers := addrs[:2]
+	returnAddrs := addrs[2:]
+	returnWeights := is(2, 1, 1)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	accs := authexported.GenesisAccounts{sellerAcc}
+	for _, addr := range addrs {
+		accs = append(accs, auth.NewBaseAccount(addr, cs(c("token1", 100), c("token2", 100)), nil, 0, 0))
+	}
+	tApp.InitializeFromGenesisStates(NewAuthGenStateFromAccs(accs))
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start auction
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	require.NoError(t, err)
+
+	// Place partial bids for slices of the lot
+	require.NoError(t, keeper.PlacePartialBid(ctx, auctionID, buyers[0], c("token1", 10), d("2")))
+	require.NoError(t, keeper.PlacePartialBid(ctx, auctionID, buyers[1], c("token1", 6), d("2.5")))
+	// Check bidders' coins are held by the auction module
+	tApp.CheckBalance(t, ctx, buyers[0], cs(c("token1", 100), c("token2", 80)))
+	tApp.CheckBalance(t, ctx, buyers[1], cs(c("token1", 100), c("token2", 85)))
+	tApp.CheckBalance(t, ctx, supply.NewModuleAddress(types.ModuleName), cs(c("token1", 20), c("token2", 35), c("debt", 40)))
+	// Check the order book is sorted by price
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	collateralAuction := auction.(types.CollateralAuction)
+	require.Equal(t, buyers[1], collateralAuction.PartialBids[0].Bidder)
+	require.Equal(t, buyers[0], collateralAuction.PartialBids[1].Bidder)
+
+	// A bid for the whole lot can't be placed alongside partial bids
+	err = keeper.PlaceBid(ctx, auctionID, returnAddrs[0], c("token2", 40))
+	require.True(t, errors.Is(err, types.ErrMixedBidTypes))
+
+	// Close auction at just after auction expiry
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	// Check bidders received their slices
+	tApp.CheckBalance(t, ctx, buyers[0], cs(c("token1", 110), c("token2", 80)))
+	tApp.CheckBalance(t, ctx, buyers[1], cs(c("token1", 106), c("token2", 85)))
+	// Check seller received the bids and all the debt
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 135), c("debt", 100)))
+	// Check return addresses received the unsold lot
+	tApp.CheckBalance(t, ctx, returnAddrs[0], cs(c("token1", 102), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[1], cs(c("token1", 101), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[2], cs(c("token1", 101), c("token2", 100)))
+}
+
+func TestCollateralAuctionPartialBidsMaxBid(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(6)
+	buyers := addrs[:3]
+	returnAddrs := addrs[3:]
+	returnWeights := is(4, 2, 1)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	accs := authexported.GenesisAccounts{sellerAcc}
+	for _, addr := range addrs {
+		accs = append(accs, auth.NewBaseAccount(addr, cs(c("token1", 100), c("token2", 100)), nil, 0, 0))
+	}
+	tApp.InitializeFromGenesisStates(NewAuthGenStateFromAccs(accs))
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start auction
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 30), returnAddrs, returnWeights, c("debt", 30))
+	require.NoError(t, err)
+
+	// Bid for the whole lot
+	require.NoError(t, keeper.PlacePartialBid(ctx, auctionID, buyers[0], c("token1", 20), d("1")))
+	// New bids must beat the lowest filled price by the increment once the lot is sold
+	err = keeper.PlacePartialBid(ctx, auctionID, buyers[1], c("token1", 10), d("1.02"))
+	require.True(t, errors.Is(err, types.ErrBidTooSmall))
+	// Outbid half the lot, raising the max bid
+	require.NoError(t, keeper.PlacePartialBid(ctx, auctionID, buyers[1], c("token1", 10), d("2")))
+	tApp.CheckBalance(t, ctx, buyers[0], cs(c("token1", 100), c("token2", 80)))
+	// Outbid again, pushing the first bid out of the order book
+	require.NoError(t, keeper.PlacePartialBid(ctx, auctionID, buyers[2], c("token1", 5), d("3")))
+	tApp.CheckBalance(t, ctx, buyers[0], cs(c("token1", 100), c("token2", 100)))
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Len(t, auction.(types.CollateralAuction).PartialBids, 2)
+
+	// Close auction
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	// Check the highest bid is filled, and the second only until the max bid is raised (15 / 2 rounded up), with the rest refunded
+	tApp.CheckBalance(t, ctx, buyers[2], cs(c("token1", 105), c("token2", 85)))
+	tApp.CheckBalance(t, ctx, buyers[1], cs(c("token1", 108), c("token2", 85)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 130), c("debt", 100)))
+	// Check return addresses received the unsold lot
+	tApp.CheckBalance(t, ctx, returnAddrs[0], cs(c("token1", 104), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[1], cs(c("token1", 102), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[2], cs(c("token1", 101), c("token2", 100)))
+}
+
+func TestPlacePartialBidInvalid(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	surplusID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2")
+	require.NoError(t, err)
+	collateralID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), addrs[1:], is(1), c("debt", 40))
+	require.NoError(t, err)
+
+	// Only collateral auctions accept partial bids
+	err = keeper.PlacePartialBid(ctx, surplusID, buyer, c("token1", 10), d("1"))
+	require.True(t, errors.Is(err, types.ErrPartialBidsNotSupported))
+	// Partial bids must be for part of the lot
+	err = keeper.PlacePartialBid(ctx, collateralID, buyer, c("token1", 21), d("1"))
+	require.True(t, errors.Is(err, types.ErrLotTooLarge))
+	err = keeper.PlacePartialBid(ctx, collateralID, buyer, c("token2", 10), d("1"))
+	require.True(t, errors.Is(err, types.ErrInvalidLotDenom))
+	// Partial bids can't be placed alongside a bid for the whole lot
+	require.NoError(t, keeper.PlaceBid(ctx, collateralID, buyer, c("token2", 10)))
+	err = keeper.PlacePartialBid(ctx, collateralID, buyer, c("token1", 10), d("1"))
+	require.True(t, errors.Is(err, types.ErrMixedBidTypes))
+}
+
+func TestDutchCollateralAuctionBasic(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	returnWeights := is(30, 20, 10)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[1], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[2], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start auction at an oracle price of 2 token2 per token1
+	auctionID, err := keeper.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40), sdk.NewDec(2))
+	require.NoError(t, err)
+	// Check seller's coins have decreased
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
+
+	// Buy part of the lot at the start price (2 * 1.2 markup = 2.4)
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 10)))
+	// Check bidder has paid and received the lot
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 110), c("token2", 76)))
+	// Check seller has received the payment and an equal amount of debt
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 124), c("debt", 84)))
+
+	// Buy the rest of the lot after the price has decayed for ten steps (2.4 * 0.99^10 ≈ 2.17)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(10 * types.DefaultDutchDecayCurve.Duration))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 15))) // more than the remaining lot
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 120), c("token2", 54)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 146), c("debt", 100)))
+
+	// Check the auction is complete and no more bids are accepted
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime(), auction.GetEndTime())
+	require.True(t, errors.Is(keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 1)), types.ErrAuctionHasExpired))
+
+	// Close auction in the block it completed
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	// Check return addresses have not received coins
+	for _, ra := range returnAddrs {
+		tApp.CheckBalance(t, ctx, ra, cs(c("token1", 100), c("token2", 100)))
+	}
+}
+
+func TestDutchCollateralAuctionMaxBidReached(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	returnWeights := is(4, 2, 1)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[1], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[2], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start auction
+	auctionID, err := keeper.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 30), returnAddrs, returnWeights, c("debt", 20), sdk.NewDec(2))
+	require.NoError(t, err)
+
+	// Try to buy the whole lot, which costs more than the max bid (20 * 2.4 = 48)
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 20)))
+	// Check bidder only paid the max bid, receiving 30 / 2.4 rounded up
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 113), c("token2", 70)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 130), c("debt", 100)))
+
+	// Close auction
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	// Check return addresses have received the unsold lot
+	tApp.CheckBalance(t, ctx, returnAddrs[0], cs(c("token1", 104), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[1], cs(c("token1", 102), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[2], cs(c("token1", 101), c("token2", 100)))
+}
+
+func TestDutchCollateralAuctionFloorAndEnd(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	returnWeights := is(1, 1, 1)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[1], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[2], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start auction at an oracle price of 2 token2 per token1
+	auctionID, err := keeper.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40), sdk.NewDec(2))
+	require.NoError(t, err)
+	endTime := ctx.BlockTime().Add(types.DefaultMaxAuctionDuration)
+
+	// Once the price has decayed past the floor, the lot is sold at the floor price (2 * 0.8 = 1.6)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(40 * time.Hour))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 5)))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 105), c("token2", 92)))
+
+	// No bids are accepted after the auction ends
+	ctx = ctx.WithBlockTime(endTime.Add(time.Second))
+	require.True(t, errors.Is(keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 1)), types.ErrAuctionHasExpired))
+
+	// The auction is closed at its end time, returning the unsold lot and the remaining debt
+	require.NoError(t, keeper.CloseExpiredAuctions(ctx))
+	_, found := keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	for _, ra := range returnAddrs {
+		tApp.CheckBalance(t, ctx, ra, cs(c("token1", 105), c("token2", 100)))
+	}
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 108), c("debt", 100)))
+}
+
+func TestStartDutchCollateralAuction(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{sellerAcc}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Zero oracle price is rejected
+	_, err := keeper.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 30), addrs, is(1), c("debt", 20), sdk.ZeroDec())
+	require.True(t, errors.Is(err, types.ErrInvalidStartPrice))
+
+	// Auction is started at the marked up price, with a floor below the oracle price, and ends after the max auction duration
+	id, err := keeper.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 30), addrs, is(1), c("debt", 20), sdk.NewDec(2))
+	require.NoError(t, err)
+	auction, found := keeper.GetAuction(ctx, id)
+	require.True(t, found)
+	dutchAuction, ok := auction.(types.DutchCollateralAuction)
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("2.4"), dutchAuction.StartPrice)
+	require.Equal(t, ctx.BlockTime(), dutchAuction.StartTime)
+	require.Equal(t, sdk.MustNewDecFromStr("1.6"), dutchAuction.FloorPrice)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultMaxAuctionDuration), dutchAuction.GetEndTime())
+	require.Equal(t, types.DescendingAuctionPhase, dutchAuction.GetPhase())
+}
+
 func TestStartSurplusAuction(t *testing.T) {
 	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
 	type args struct {
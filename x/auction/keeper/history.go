@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// recordBid stores a bid placed on an open auction after the bids already placed on it.
+// Bids are kept apart from the auction so that its size does not grow with every bid, and are added to its record when it closes.
+func (k Keeper) recordBid(ctx sdk.Context, auctionID uint64, bid types.BidRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidRecordKeyPrefix)
+	index := uint64(0)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetAuctionKey(auctionID))
+	if iterator.Valid() {
+		index = types.Uint64FromBytes(iterator.Key()[8:]) + 1
+	}
+	iterator.Close()
+	store.Set(types.GetBidRecordKey(auctionID, index), k.cdc.MustMarshalBinaryLengthPrefixed(bid))
+}
+
+// SetBidRecords stores the bids placed on an open auction, replacing any already stored.
+func (k Keeper) SetBidRecords(ctx sdk.Context, auctionID uint64, bids types.BidRecords) {
+	k.deleteBidRecords(ctx, auctionID)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidRecordKeyPrefix)
+	for i, bid := range bids {
+		store.Set(types.GetBidRecordKey(auctionID, uint64(i)), k.cdc.MustMarshalBinaryLengthPrefixed(bid))
+	}
+}
+
+// GetBidRecords returns the bids placed on an open auction, in the order they were placed.
+func (k Keeper) GetBidRecords(ctx sdk.Context, auctionID uint64) types.BidRecords {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidRecordKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAuctionKey(auctionID))
+
+	defer iterator.Close()
+	var bids types.BidRecords
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.BidRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &bid)
+		bids = append(bids, bid)
+	}
+	return bids
+}
+
+func (k Keeper) deleteBidRecords(ctx sdk.Context, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BidRecordKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAuctionKey(auctionID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAuctionRecord gets the record of a closed auction.
+func (k Keeper) GetAuctionRecord(ctx sdk.Context, auctionID uint64) (types.AuctionRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRecordKeyPrefix)
+	bz := store.Get(types.GetAuctionKey(auctionID))
+	if bz == nil {
+		return types.AuctionRecord{}, false
+	}
+	var record types.AuctionRecord
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return record, true
+}
+
+// SetAuctionRecord stores the record of a closed auction, updating the by time and by bidder indexes.
+func (k Keeper) SetAuctionRecord(ctx sdk.Context, record types.AuctionRecord) {
+	if existing, found := k.GetAuctionRecord(ctx, record.AuctionID); found {
+		k.removeFromAuctionRecordByTimeIndex(ctx, existing.CloseTime, existing.AuctionID)
+		k.removeFromAuctionRecordByBidderIndex(ctx, existing)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRecordKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(record)
+	store.Set(types.GetAuctionKey(record.AuctionID), bz)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRecordByTimeKeyPrefix)
+	indexStore.Set(types.GetAuctionRecordByTimeKey(record.CloseTime, record.AuctionID), types.Uint64ToBytes(record.AuctionID))
+
+	bidderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRecordByBidderKeyPrefix)
+	for _, bidder := range record.Bidders() {
+		bidderStore.Set(types.GetAuctionRecordByBidderKey(bidder, record.AuctionID), types.Uint64ToBytes(record.AuctionID))
+	}
+}
+
+// DeleteAuctionRecord removes the record of a closed auction, and its entries in the by time and by bidder indexes.
+func (k Keeper) DeleteAuctionRecord(ctx sdk.Context, auctionID uint64) {
+	record, found := k.GetAuctionRecord(ctx, auctionID)
+	if !found {
+		return
+	}
+	k.removeFromAuctionRecordByTimeIndex(ctx, record.CloseTime, auctionID)
+	k.removeFromAuctionRecordByBidderIndex(ctx, record)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRecordKeyPrefix)
+	store.Delete(types.GetAuctionKey(auctionID))
+}
+
+// PruneAuctionRecords removes the records of auctions that closed longer ago than the record retention param.
+func (k Keeper) PruneAuctionRecords(ctx sdk.Context) {
+	retention := k.GetParams(ctx).RecordRetention
+	if retention == 0 {
+		return
+	}
+
+	var auctionIDs []uint64
+	// the end of the range is exclusive, so records that closed exactly at the cutoff are pruned
+	k.IterateAuctionRecordsByTime(ctx, time.Time{}, ctx.BlockTime().Add(-retention).Add(time.Nanosecond), func(auctionID uint64) bool {
+		auctionIDs = append(auctionIDs, auctionID)
+		return false
+	})
+	for _, id := range auctionIDs {
+		k.DeleteAuctionRecord(ctx, id)
+	}
+}
+
+func (k Keeper) removeFromAuctionRecordByTimeIndex(ctx sdk.Context, closeTime time.Time, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRecordByTimeKeyPrefix)
+	store.Delete(types.GetAuctionRecordByTimeKey(closeTime, auctionID))
+}
+
+func (k Keeper) removeFromAuctionRecordByBidderIndex(ctx sdk.Context, record types.AuctionRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRecordByBidderKeyPrefix)
+	for _, bidder := range record.Bidders() {
+		store.Delete(types.GetAuctionRecordByBidderKey(bidder, record.AuctionID))
+	}
+}
+
+// IterateAuctionRecords provides an iterator over all auction records ordered by auction ID.
+// For each record, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateAuctionRecords(ctx sdk.Context, cb func(record types.AuctionRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AuctionRecordKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.AuctionRecord
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAuctionRecords returns all auction records ordered by auction ID.
+func (k Keeper) GetAuctionRecords(ctx sdk.Context) types.AuctionRecords {
+	records := types.AuctionRecords{}
+	k.IterateAuctionRecords(ctx, func(record types.AuctionRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// IterateAuctionRecordsByTime provides an iterator over the IDs of auctions that closed at or after a start time and before an end time, ordered by close time.
+// A zero start or end time leaves that end of the range open. For each auction, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateAuctionRecordsByTime(ctx sdk.Context, startTime, endTime time.Time, cb func(auctionID uint64) (stop bool)) {
+	var start, end []byte // nil iterates from the very start or to the very end of the prefix store
+	if !startTime.IsZero() {
+		start = sdk.FormatTimeBytes(startTime)
+	}
+	if !endTime.IsZero() {
+		end = sdk.FormatTimeBytes(endTime)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRecordByTimeKeyPrefix)
+	iterator := store.Iterator(start, end)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.Uint64FromBytes(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// IterateAuctionRecordsByBidder provides an iterator over the IDs of closed auctions an address bid on, ordered by auction ID.
+// For each auction, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateAuctionRecordsByBidder(ctx sdk.Context, bidder sdk.AccAddress, cb func(auctionID uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionRecordByBidderKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, bidder.Bytes())
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.Uint64FromBytes(iterator.Value())) {
+			break
+		}
+	}
+}
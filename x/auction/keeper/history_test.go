@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction/keeper"
+	"github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp"
+)
+
+func TestCloseAuctionRecordsHistory(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	buyer1, buyer2, returnAddrs := addrs[0], addrs[1], addrs[2:]
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(cdp.ModuleName, supply.Minter, supply.Burner)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 1000), c("debt", 1000))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer1, cs(c("token2", 1000)), nil, 0, 0),
+			auth.NewBaseAccount(buyer2, cs(c("token2", 1000)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)})
+	k := tApp.GetAuctionKeeper()
+
+	auctionID, err := k.StartCollateralAuction(ctx, cdp.ModuleName, c("token1", 100), c("token2", 100), returnAddrs, is(1, 1), c("debt", 100))
+	require.NoError(t, err)
+
+	// Bid through the forward phase into the reverse phase
+	require.NoError(t, k.PlaceBid(ctx, auctionID, buyer1, c("token2", 50)))
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, k.PlaceBid(ctx, auctionID, buyer2, c("token2", 100)))
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, k.PlaceBid(ctx, auctionID, buyer1, c("token1", 80)))
+
+	auction, found := k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	expectedBids := types.BidRecords{
+		types.NewBidRecord(buyer1, c("token2", 50), c("token1", 100), 1, startTime),
+		types.NewBidRecord(buyer2, c("token2", 100), c("token1", 100), 2, startTime.Add(time.Minute)),
+		types.NewBidRecord(buyer1, c("token2", 100), c("token1", 80), 3, startTime.Add(2*time.Minute)),
+	}
+	require.Equal(t, expectedBids, k.GetBidRecords(ctx, auctionID))
+
+	// Close the auction
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(auction.GetEndTime())
+	require.NoError(t, k.CloseAuction(ctx, auctionID))
+	_, found = k.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	require.Empty(t, k.GetBidRecords(ctx, auctionID))
+
+	record, found := k.GetAuctionRecord(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, auctionID, record.AuctionID)
+	require.Equal(t, types.CollateralAuctionType, record.AuctionType)
+	require.Equal(t, cdp.ModuleName, record.Initiator)
+	require.Equal(t, c("token1", 80), record.Lot)
+	require.Equal(t, c("token2", 100), record.Bid)
+	require.Equal(t, buyer1, record.Winner)
+	require.Equal(t, expectedBids, record.Bids)
+	require.Equal(t, types.LotReturns{
+		types.NewLotReturn(returnAddrs[0], c("token1", 10)),
+		types.NewLotReturn(returnAddrs[1], c("token1", 10)),
+	}, record.LotReturns)
+	require.Equal(t, int64(4), record.CloseHeight)
+	require.Equal(t, auction.GetEndTime(), record.CloseTime)
+	require.NoError(t, record.Validate())
+}
+
+func TestPruneAuctionRecords(t *testing.T) {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	firstCloseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstCloseTime})
+	k := tApp.GetAuctionKeeper()
+
+	for i, closeTime := range []time.Time{firstCloseTime, firstCloseTime.Add(24 * time.Hour), firstCloseTime.Add(48 * time.Hour)} {
+		k.SetAuctionRecord(ctx, types.AuctionRecord{
+			AuctionID:   uint64(i + 1),
+			AuctionType: types.SurplusAuctionType,
+			Initiator:   cdp.LiquidatorMacc,
+			Lot:         c("token1", 100),
+			Bid:         c("token2", 10),
+			CloseHeight: 10,
+			CloseTime:   closeTime,
+		})
+	}
+
+	// with the default zero retention nothing is pruned
+	ctx = ctx.WithBlockTime(firstCloseTime.Add(365 * 24 * time.Hour))
+	k.PruneAuctionRecords(ctx)
+	require.Len(t, k.GetAuctionRecords(ctx), 3)
+
+	// records closed at or before the retention cutoff are pruned, along with their entries in the time index
+	params := k.GetParams(ctx)
+	params.RecordRetention = 24 * time.Hour
+	k.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(firstCloseTime.Add(48 * time.Hour))
+	k.PruneAuctionRecords(ctx)
+
+	_, found := k.GetAuctionRecord(ctx, 1)
+	require.False(t, found)
+	_, found = k.GetAuctionRecord(ctx, 2)
+	require.False(t, found)
+	_, found = k.GetAuctionRecord(ctx, 3)
+	require.True(t, found)
+	var indexedIDs []uint64
+	k.IterateAuctionRecordsByTime(ctx, time.Time{}, time.Time{}, func(auctionID uint64) bool {
+		indexedIDs = append(indexedIDs, auctionID)
+		return false
+	})
+	require.Equal(t, []uint64{3}, indexedIDs)
+}
+
+func TestQueryAuctionRecords(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)})
+	k := tApp.GetAuctionKeeper()
+	querier := keeper.NewQuerier(k)
+
+	newRecord := func(id uint64, initiator string, bidder sdk.AccAddress, closeTime time.Time) types.AuctionRecord {
+		return types.AuctionRecord{
+			AuctionID:   id,
+			AuctionType: types.SurplusAuctionType,
+			Initiator:   initiator,
+			Lot:         c("token1", 100),
+			Bid:         c("token2", 10),
+			Winner:      bidder,
+			Bids:        types.BidRecords{types.NewBidRecord(bidder, c("token2", 10), c("token1", 100), 1, closeTime.Add(-time.Hour))},
+			CloseHeight: 10,
+			CloseTime:   closeTime,
+		}
+	}
+	t0 := ctx.BlockTime()
+	records := types.AuctionRecords{
+		newRecord(1, cdp.LiquidatorMacc, addrs[0], t0.Add(3*time.Hour)),
+		newRecord(2, cdp.LiquidatorMacc, addrs[1], t0.Add(1*time.Hour)),
+		newRecord(3, "hard", addrs[0], t0.Add(2*time.Hour)),
+	}
+	for _, r := range records {
+		k.SetAuctionRecord(ctx, r)
+	}
+	// Overwriting a record moves it in the time index
+	records[2].CloseTime = t0.Add(4 * time.Hour)
+	k.SetAuctionRecord(ctx, records[2])
+
+	testCases := []struct {
+		name        string
+		params      types.QueryAuctionRecordsParams
+		expectedIDs []uint64
+	}{
+		{"all, ordered by close time", types.NewQueryAuctionRecordsParams(1, 100, nil, "", time.Time{}, time.Time{}), []uint64{2, 1, 3}},
+		{"by bidder", types.NewQueryAuctionRecordsParams(1, 100, addrs[0], "", time.Time{}, time.Time{}), []uint64{1, 3}},
+		{"by initiator", types.NewQueryAuctionRecordsParams(1, 100, nil, cdp.LiquidatorMacc, time.Time{}, time.Time{}), []uint64{2, 1}},
+		{"by time range", types.NewQueryAuctionRecordsParams(1, 100, nil, "", t0.Add(time.Hour), t0.Add(4*time.Hour)), []uint64{2, 1}},
+		{"by open ended time range", types.NewQueryAuctionRecordsParams(1, 100, nil, "", t0.Add(2*time.Hour), time.Time{}), []uint64{1, 3}},
+		{"by bidder and initiator", types.NewQueryAuctionRecordsParams(1, 100, addrs[0], "hard", time.Time{}, time.Time{}), []uint64{3}},
+		{"paginated", types.NewQueryAuctionRecordsParams(2, 2, nil, "", time.Time{}, time.Time{}), []uint64{3}},
+		{"no matches", types.NewQueryAuctionRecordsParams(1, 100, addrs[1], "hard", time.Time{}, time.Time{}), []uint64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query := abci.RequestQuery{
+				Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctionRecords}, "/"),
+				Data: types.ModuleCdc.MustMarshalJSON(tc.params),
+			}
+			bz, err := querier(ctx, []string{types.QueryGetAuctionRecords}, query)
+			require.NoError(t, err)
+
+			var result types.AuctionRecords
+			require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &result))
+			ids := []uint64{}
+			for _, r := range result {
+				ids = append(ids, r.AuctionID)
+			}
+			require.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+
+	// Query a single record
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctionRecord}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAuctionParams(2)),
+	}
+	bz, err := querier(ctx, []string{types.QueryGetAuctionRecord}, query)
+	require.NoError(t, err)
+	var record types.AuctionRecord
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &record))
+	require.Equal(t, uint64(2), record.AuctionID)
+	require.Equal(t, addrs[1], record.Winner)
+
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQueryAuctionParams(4))
+	_, err = querier(ctx, []string{types.QueryGetAuctionRecord}, query)
+	require.Error(t, err)
+}
+
+func TestAuctionRecordsByBidderIndex(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)})
+	k := tApp.GetAuctionKeeper()
+	querier := keeper.NewQuerier(k)
+
+	newRecord := func(id uint64, closeTime time.Time, bidders ...sdk.AccAddress) types.AuctionRecord {
+		var bids types.BidRecords
+		for _, bidder := range bidders {
+			bids = append(bids, types.NewBidRecord(bidder, c("token2", 10), c("token1", 100), 1, closeTime.Add(-time.Hour)))
+		}
+		return types.AuctionRecord{
+			AuctionID:   id,
+			AuctionType: types.SurplusAuctionType,
+			Initiator:   cdp.LiquidatorMacc,
+			Lot:         c("token1", 100),
+			Bid:         c("token2", 10),
+			Winner:      bidders[len(bidders)-1],
+			Bids:        bids,
+			CloseHeight: 10,
+			CloseTime:   closeTime,
+		}
+	}
+	bidderIDs := func(bidder sdk.AccAddress) []uint64 {
+		ids := []uint64{}
+		k.IterateAuctionRecordsByBidder(ctx, bidder, func(auctionID uint64) bool {
+			ids = append(ids, auctionID)
+			return false
+		})
+		return ids
+	}
+	queryBidderIDs := func(bidder sdk.AccAddress) []uint64 {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctionRecords}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAuctionRecordsParams(1, 100, bidder, "", time.Time{}, time.Time{})),
+		}
+		bz, err := querier(ctx, []string{types.QueryGetAuctionRecords}, query)
+		require.NoError(t, err)
+		var result types.AuctionRecords
+		require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &result))
+		ids := []uint64{}
+		for _, r := range result {
+			ids = append(ids, r.AuctionID)
+		}
+		return ids
+	}
+
+	t0 := ctx.BlockTime()
+	// a bidder placing several bids on one auction is indexed once
+	k.SetAuctionRecord(ctx, newRecord(1, t0.Add(2*time.Hour), addrs[0], addrs[1], addrs[0]))
+	k.SetAuctionRecord(ctx, newRecord(2, t0.Add(time.Hour), addrs[0]))
+	require.Equal(t, []uint64{1, 2}, bidderIDs(addrs[0]))
+	require.Equal(t, []uint64{1}, bidderIDs(addrs[1]))
+	// the query orders the indexed records by close time
+	require.Equal(t, []uint64{2, 1}, queryBidderIDs(addrs[0]))
+
+	// overwriting a record replaces its entries in the index
+	k.SetAuctionRecord(ctx, newRecord(1, t0.Add(2*time.Hour), addrs[1]))
+	require.Equal(t, []uint64{2}, bidderIDs(addrs[0]))
+	require.Equal(t, []uint64{1}, bidderIDs(addrs[1]))
+
+	// deleting a record removes its entries from the index
+	k.DeleteAuctionRecord(ctx, 1)
+	require.Equal(t, []uint64{}, bidderIDs(addrs[1]))
+	require.Equal(t, []uint64{}, queryBidderIDs(addrs[1]))
+	require.Equal(t, []uint64{2}, queryBidderIDs(addrs[0]))
+}
@@ -11,6 +11,7 @@ import (
 func c(denom string, amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
 func cs(coins ...sdk.Coin) sdk.Coins        { return sdk.NewCoins(coins...) }
 func i(n int64) sdk.Int                     { return sdk.NewInt(n) }
+func d(str string) sdk.Dec                  { return sdk.MustNewDecFromStr(str) }
 func is(ns ...int64) (is []sdk.Int) {
 	for _, n := range ns {
 		is = append(is, sdk.NewInt(n))
@@ -19,6 +19,9 @@ type Keeper struct {
 	storeKey      sdk.StoreKey
 	cdc           *codec.Codec
 	paramSubspace subspace.Subspace
+	// priceReferences are keyed by the module name of auction initiators
+	// NOTE: the map is shared by copies of the keeper so modules holding one see references set later
+	priceReferences map[string]types.PriceReference
 }
 
 // NewKeeper returns a new auction keeper.
@@ -32,13 +35,22 @@ func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.Suppl
 	}
 
 	return Keeper{
-		supplyKeeper:  supplyKeeper,
-		storeKey:      storeKey,
-		cdc:           cdc,
-		paramSubspace: paramstore,
+		supplyKeeper:    supplyKeeper,
+		storeKey:        storeKey,
+		cdc:             cdc,
+		paramSubspace:   paramstore,
+		priceReferences: make(map[string]types.PriceReference),
 	}
 }
 
+// SetPriceReference sets the source of oracle prices used for the reserve prices of auctions started by a module.
+func (k Keeper) SetPriceReference(moduleName string, reference types.PriceReference) {
+	if _, found := k.priceReferences[moduleName]; found {
+		panic(fmt.Sprintf("price reference already set for module %s", moduleName))
+	}
+	k.priceReferences[moduleName] = reference
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -116,12 +128,13 @@ func (k Keeper) GetAuction(ctx sdk.Context, auctionID uint64) (types.Auction, bo
 	return auction, true
 }
 
-// DeleteAuction removes an auction from the store, and any indexes.
+// DeleteAuction removes an auction from the store, along with its bid records and any indexes.
 func (k Keeper) DeleteAuction(ctx sdk.Context, auctionID uint64) {
 	auction, found := k.GetAuction(ctx, auctionID)
 	if found {
 		k.removeFromByTimeIndex(ctx, auction.GetEndTime(), auctionID)
 	}
+	k.deleteBidRecords(ctx, auctionID)
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionKeyPrefix)
 	store.Delete(types.GetAuctionKey(auctionID))
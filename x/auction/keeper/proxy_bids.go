@@ -0,0 +1,370 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// PlaceProxyBid sets a bidder's proxy bid on an auction, replacing any they already have.
+// The coins needed to bid up to the max bid are held by the auction module, and if the bidder has been outbid a bid is placed for them straight away.
+// A min lot can be left empty to only bid in the forward phase.
+func (k Keeper) PlaceProxyBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, maxBid, minLot sdk.Coin) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+	if ctx.BlockTime().After(auction.GetEndTime()) {
+		return sdkerrors.Wrapf(types.ErrAuctionHasExpired, "%d", auctionID)
+	}
+	if ca, ok := auction.(types.CollateralAuction); ok && len(ca.PartialBids) > 0 {
+		return sdkerrors.Wrapf(types.ErrMixedBidTypes, "auction %d has partial bids", auctionID)
+	}
+
+	if minLot.Denom == "" {
+		minLot = auction.GetLot()
+	}
+	if err := k.validateProxyBid(ctx, auction, bidder, maxBid, minLot); err != nil {
+		return err
+	}
+
+	// Refund the escrow of any proxy bid being replaced
+	proxyBids := auction.GetProxyBids()
+	if existing, found := proxyBids.Get(bidder); found && existing.Escrow.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(existing.Escrow))
+		if err != nil {
+			return err
+		}
+	}
+	// Escrow enough to bid up to the max bid, less any bid the bidder has already paid
+	escrow := maxBid
+	if bidder.Equals(auction.GetBidder()) {
+		escrow = maxBid.Sub(auction.GetBid())
+	}
+	if escrow.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(escrow))
+		if err != nil {
+			return err
+		}
+	}
+	auction = auction.WithProxyBids(proxyBids.Set(types.NewProxyBid(bidder, maxBid, minLot, escrow)))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionProxyBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, maxBid.String()),
+			sdk.NewAttribute(types.AttributeKeyMinLot, minLot.String()),
+		),
+	)
+
+	auction, err := k.placeProxyBids(ctx, auction)
+	if err != nil {
+		return err
+	}
+	k.SetAuction(ctx, auction)
+
+	return nil
+}
+
+// validateProxyBid checks a proxy bid's limits can be bid with in the auction's current phase.
+func (k Keeper) validateProxyBid(ctx sdk.Context, auction types.Auction, bidder sdk.AccAddress, maxBid, minLot sdk.Coin) error {
+	rules, err := k.getBiddingRules(ctx, auction)
+	if err != nil {
+		return err
+	}
+	bid, lot := auction.GetBid(), auction.GetLot()
+	if maxBid.Denom != bid.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidBidDenom, "%s ≠ %s", maxBid.Denom, bid.Denom)
+	}
+	if minLot.Denom != lot.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidLotDenom, "%s ≠ %s", minLot.Denom, lot.Denom)
+	}
+	if minLot.IsNegative() {
+		return sdkerrors.Wrapf(types.ErrLotTooSmall, "%s < 0%s", minLot, lot.Denom)
+	}
+	isBidder := bidder.Equals(auction.GetBidder())
+
+	if rules.reverse {
+		// reverse bids always pay the full bid
+		if maxBid.IsLT(bid) {
+			return sdkerrors.Wrapf(types.ErrBidTooSmall, "%s < %s", maxBid, bid)
+		}
+		if bid.IsLT(maxBid) {
+			return sdkerrors.Wrapf(types.ErrBidTooLarge, "%s > %s", maxBid, bid)
+		}
+		if rules.hasReserveLot && minLot.Amount.GT(rules.reserveLot) {
+			return sdkerrors.Wrapf(types.ErrBidBelowReserve, "%s > %s%s", minLot, rules.reserveLot, lot.Denom)
+		}
+		maxNewLotAmt := rules.maxNextLot(lot.Amount)
+		if isBidder {
+			maxNewLotAmt = lot.Amount
+		}
+		if minLot.Amount.GT(maxNewLotAmt) {
+			return sdkerrors.Wrapf(types.ErrLotTooLarge, "%s > %s%s", minLot, maxNewLotAmt, lot.Denom)
+		}
+		return nil
+	}
+
+	minReserveBidAmt := rules.reserveBid
+	if rules.capped {
+		minReserveBidAmt = sdk.MinInt(minReserveBidAmt, rules.maxBid)
+	}
+	if maxBid.Amount.LT(minReserveBidAmt) {
+		return sdkerrors.Wrapf(types.ErrBidBelowReserve, "%s < %s%s", maxBid, minReserveBidAmt, bid.Denom)
+	}
+	minNewBidAmt := rules.minNextBid(bid.Amount)
+	if isBidder {
+		minNewBidAmt = bid.Amount
+	}
+	if maxBid.Amount.LT(minNewBidAmt) {
+		return sdkerrors.Wrapf(types.ErrBidTooSmall, "%s < %s%s", maxBid, minNewBidAmt, bid.Denom)
+	}
+	if rules.capped && maxBid.Amount.GT(rules.maxBid) {
+		return sdkerrors.Wrapf(types.ErrBidTooLarge, "%s > %s%s", maxBid, rules.maxBid, bid.Denom)
+	}
+	if minLot.Amount.GT(lot.Amount) {
+		return sdkerrors.Wrapf(types.ErrLotTooLarge, "%s > %s", minLot, lot)
+	}
+	// bidding down the lot in the reverse phase requires paying the full max bid
+	if minLot.Amount.LT(lot.Amount) && (!rules.capped || !maxBid.Amount.Equal(rules.maxBid)) {
+		return sdkerrors.Wrapf(types.ErrBidTooSmall, "proxy bids with a min lot must bid up to the auction max bid, %s", maxBid)
+	}
+	return nil
+}
+
+// placeProxyBids bids on behalf of proxy bidders until none of them can outbid the current bidder.
+// The proxy bid with the highest limit wins, bidding one increment past the limit of the next highest.
+func (k Keeper) placeProxyBids(ctx sdk.Context, auction types.Auction) (types.Auction, error) {
+	for {
+		rules, err := k.getBiddingRules(ctx, auction)
+		if err != nil {
+			return auction, err
+		}
+
+		var (
+			proxyBid types.ProxyBid
+			amount   sdk.Coin
+			found    bool
+		)
+		if rules.reverse {
+			proxyBid, amount, found = nextReverseProxyBid(auction, rules)
+		} else {
+			proxyBid, amount, found = nextForwardProxyBid(auction, rules)
+		}
+		if !found {
+			return auction, nil
+		}
+
+		auction, err = k.placeBidForProxy(ctx, auction, proxyBid, amount, rules.reverse)
+		if err != nil {
+			return auction, err
+		}
+	}
+}
+
+// nextForwardProxyBid returns the next bid to place for a proxy bidder in the forward phase, if any can outbid the current bidder.
+func nextForwardProxyBid(auction types.Auction, rules biddingRules) (types.ProxyBid, sdk.Coin, bool) {
+	bid := auction.GetBid()
+	minNewBidAmt := rules.minNextBid(bid.Amount)
+
+	var challenger types.ProxyBid
+	found := false
+	for _, pb := range auction.GetProxyBids() {
+		if pb.Bidder.Equals(auction.GetBidder()) || pb.MaxBid.Amount.LT(minNewBidAmt) {
+			continue
+		}
+		if !found || pb.MaxBid.Amount.GT(challenger.MaxBid.Amount) {
+			challenger = pb
+			found = true
+		}
+	}
+	if !found {
+		return types.ProxyBid{}, sdk.Coin{}, false
+	}
+
+	incumbent, hasIncumbent := auction.GetProxyBids().Get(auction.GetBidder())
+	if hasIncumbent && incumbent.MaxBid.Amount.GTE(challenger.MaxBid.Amount) {
+		// the current bidder keeps the lead, bidding just past the challenger's limit
+		amount := sdk.MinInt(incumbent.MaxBid.Amount, rules.minNextBid(challenger.MaxBid.Amount))
+		return incumbent, sdk.NewCoin(bid.Denom, amount), true
+	}
+
+	limit := bid.Amount
+	if hasIncumbent && incumbent.MaxBid.Amount.GTE(minNewBidAmt) {
+		limit = incumbent.MaxBid.Amount
+	}
+	amount := rules.minNextBid(limit)
+	if amount.GT(challenger.MaxBid.Amount) {
+		// the challenger can't beat the current bidder's limit by the increment, so the current bidder keeps the lead at its limit
+		return incumbent, sdk.NewCoin(bid.Denom, limit), true
+	}
+	return challenger, sdk.NewCoin(bid.Denom, amount), true
+}
+
+// nextReverseProxyBid returns the next bid to place for a proxy bidder in the reverse phase, if any can outbid the current bidder.
+func nextReverseProxyBid(auction types.Auction, rules biddingRules) (types.ProxyBid, sdk.Coin, bool) {
+	bid, lot := auction.GetBid(), auction.GetLot()
+	maxNewLotAmt := rules.maxNextLot(lot.Amount)
+
+	var challenger types.ProxyBid
+	found := false
+	for _, pb := range auction.GetProxyBids() {
+		// proxy bids that don't cover the full bid can't bid in the reverse phase
+		if pb.Bidder.Equals(auction.GetBidder()) || !pb.MaxBid.IsEqual(bid) || pb.MinLot.Amount.GT(maxNewLotAmt) {
+			continue
+		}
+		if !found || pb.MinLot.Amount.LT(challenger.MinLot.Amount) {
+			challenger = pb
+			found = true
+		}
+	}
+	if !found {
+		return types.ProxyBid{}, sdk.Coin{}, false
+	}
+
+	incumbent, hasIncumbent := auction.GetProxyBids().Get(auction.GetBidder())
+	if hasIncumbent && incumbent.MinLot.Amount.LTE(challenger.MinLot.Amount) {
+		// the current bidder keeps the lead, bidding just past the challenger's limit
+		amount := sdk.MaxInt(incumbent.MinLot.Amount, rules.maxNextLot(challenger.MinLot.Amount))
+		return incumbent, sdk.NewCoin(lot.Denom, amount), true
+	}
+
+	limit := lot.Amount
+	if hasIncumbent && incumbent.MinLot.Amount.LTE(maxNewLotAmt) {
+		limit = incumbent.MinLot.Amount
+	}
+	amount := rules.maxNextLot(limit)
+	if amount.LT(challenger.MinLot.Amount) {
+		// the challenger can't beat the current bidder's limit by the increment, so the current bidder keeps the lead at its limit
+		return incumbent, sdk.NewCoin(lot.Denom, limit), true
+	}
+	return challenger, sdk.NewCoin(lot.Denom, amount), true
+}
+
+// placeBidForProxy places a bid on behalf of a proxy bidder, paying for it out of their escrow.
+func (k Keeper) placeBidForProxy(ctx sdk.Context, auction types.Auction, proxyBid types.ProxyBid, amount sdk.Coin, reverse bool) (types.Auction, error) {
+	previousBidder, previousBid := auction.GetBidder(), auction.GetBid()
+
+	// New bidders pay back the previous bidder plus any increase in the bid, while the current bidder only pays any increase
+	cost := sdk.NewCoin(previousBid.Denom, sdk.ZeroInt())
+	switch {
+	case !proxyBid.Bidder.Equals(previousBidder) && reverse:
+		cost = previousBid
+	case !proxyBid.Bidder.Equals(previousBidder):
+		cost = amount
+	case !reverse:
+		cost = amount.Sub(previousBid)
+	}
+	if proxyBid.Escrow.IsLT(cost) {
+		return auction, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "proxy bid escrow %s < %s", proxyBid.Escrow, cost)
+	}
+
+	// Release the cost from escrow for the bidder to pay
+	if cost.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proxyBid.Bidder, sdk.NewCoins(cost))
+		if err != nil {
+			return auction, err
+		}
+	}
+	proxyBid.Escrow = proxyBid.Escrow.Sub(cost)
+	auction = auction.WithProxyBids(auction.GetProxyBids().Set(proxyBid))
+
+	auction, err := k.placeBid(ctx, auction, proxyBid.Bidder, amount)
+	if err != nil {
+		return auction, err
+	}
+	return k.escrowOutbidProxyRefund(ctx, auction, proxyBid.Bidder, previousBidder, previousBid)
+}
+
+// escrowOutbidProxyRefund moves the bid paid back to an outbid proxy bidder into their escrow, so it can be used to bid again.
+func (k Keeper) escrowOutbidProxyRefund(ctx sdk.Context, auction types.Auction, bidder, previousBidder sdk.AccAddress, previousBid sdk.Coin) (types.Auction, error) {
+	if bidder.Equals(previousBidder) || !previousBid.IsPositive() {
+		return auction, nil
+	}
+	proxyBid, found := auction.GetProxyBids().Get(previousBidder)
+	if !found {
+		return auction, nil
+	}
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, previousBidder, types.ModuleName, sdk.NewCoins(previousBid))
+	if err != nil {
+		return auction, err
+	}
+	proxyBid.Escrow = proxyBid.Escrow.Add(previousBid)
+	return auction.WithProxyBids(auction.GetProxyBids().Set(proxyBid)), nil
+}
+
+// refundProxyBids returns the remaining escrow of an auction's proxy bids to the bidders.
+func (k Keeper) refundProxyBids(ctx sdk.Context, auction types.Auction) error {
+	for _, pb := range auction.GetProxyBids() {
+		if !pb.Escrow.IsPositive() {
+			continue
+		}
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, pb.Bidder, sdk.NewCoins(pb.Escrow))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// biddingRules describes how new bids can be placed on an auction in its current phase.
+type biddingRules struct {
+	reverse   bool    // whether bids lower the lot rather than raise the bid
+	increment sdk.Dec // minimum fractional change in a new bid
+	capped    bool    // whether forward bids are limited to maxBid
+	maxBid    sdk.Int
+
+	reserveBid    sdk.Int // smallest forward bid that meets the reserve price
+	hasReserveLot bool    // whether reverse bids are limited by the reserve price
+	reserveLot    sdk.Int // largest reverse bid lot that meets the reserve price
+}
+
+// getBiddingRules returns the bidding rules for auctions that accept proxy bids.
+func (k Keeper) getBiddingRules(ctx sdk.Context, auction types.Auction) (biddingRules, error) {
+	params := k.GetParams(ctx)
+	var rules biddingRules
+	switch a := auction.(type) {
+	case types.SurplusAuction:
+		rules = biddingRules{increment: params.IncrementSurplus}
+	case types.DebtAuction:
+		rules = biddingRules{reverse: true, increment: params.IncrementDebt}
+	case types.CollateralAuction:
+		rules = biddingRules{reverse: a.IsReversePhase(), increment: params.IncrementCollateral, capped: true, maxBid: a.MaxBid.Amount}
+	default:
+		return biddingRules{}, sdkerrors.Wrap(types.ErrProxyBidsNotSupported, auction.GetType())
+	}
+	var err error
+	rules.reserveBid, err = reserveMinBid(ctx, auction, auction.GetLot().Amount)
+	if err != nil {
+		return biddingRules{}, err
+	}
+	rules.reserveLot, rules.hasReserveLot, err = reserveMaxLot(ctx, auction, auction.GetBid().Amount)
+	if err != nil {
+		return biddingRules{}, err
+	}
+	return rules, nil
+}
+
+// minNextBid returns the smallest bid that can replace a bid, some % greater than it and at least 1 larger, and meeting the reserve price.
+func (r biddingRules) minNextBid(bid sdk.Int) sdk.Int {
+	next := bid.Add(sdk.MaxInt(sdk.NewInt(1), sdk.NewDecFromInt(bid).Mul(r.increment).RoundInt()))
+	next = sdk.MaxInt(next, r.reserveBid)
+	if r.capped {
+		next = sdk.MinInt(next, r.maxBid) // allow new bids to hit the max bid even though it may be less than the increment %
+	}
+	return next
+}
+
+// maxNextLot returns the largest lot that can replace a lot, some % less than it and at least 1 smaller, and meeting the reserve price.
+func (r biddingRules) maxNextLot(lot sdk.Int) sdk.Int {
+	next := lot.Sub(sdk.MaxInt(sdk.NewInt(1), sdk.NewDecFromInt(lot).Mul(r.increment).RoundInt()))
+	if r.hasReserveLot {
+		next = sdk.MinInt(next, r.reserveLot)
+	}
+	return next
+}
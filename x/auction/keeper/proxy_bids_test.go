@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction/keeper"
+	"github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp"
+)
+
+func requireModuleAccountValid(t *testing.T, ctx sdk.Context, k keeper.Keeper) {
+	message, broken := keeper.ModuleAccountInvariants(k)(ctx)
+	require.False(t, broken, message)
+}
+
+func TestSurplusAuctionProxyBids(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer, secondBuyer := addrs[0], addrs[1]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner) // forward auctions burn proceeds
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(secondBuyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	k := tApp.GetAuctionKeeper()
+
+	// Start auction
+	auctionID, err := k.StartSurplusAuction(ctx, sellerModName, c("token1", 100), "token2")
+	require.NoError(t, err)
+
+	// Place a proxy bid, which bids straight away
+	require.NoError(t, k.PlaceProxyBid(ctx, auctionID, buyer, c("token2", 50), sdk.Coin{}))
+	auction, found := k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, buyer, auction.GetBidder())
+	require.Equal(t, c("token2", 1), auction.GetBid())
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 100), c("token2", 50)))
+	requireModuleAccountValid(t, ctx, k)
+
+	// Outbid the proxy bidder, who bids again by the minimum increment
+	require.NoError(t, k.PlaceBid(ctx, auctionID, secondBuyer, c("token2", 10)))
+	auction, found = k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, buyer, auction.GetBidder())
+	require.Equal(t, c("token2", 11), auction.GetBid())
+	tApp.CheckBalance(t, ctx, secondBuyer, cs(c("token1", 100), c("token2", 100)))
+	requireModuleAccountValid(t, ctx, k)
+
+	// A lower proxy bid is beaten by one increment past its limit
+	require.NoError(t, k.PlaceProxyBid(ctx, auctionID, secondBuyer, c("token2", 30), sdk.Coin{}))
+	auction, found = k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, buyer, auction.GetBidder())
+	require.Equal(t, c("token2", 32), auction.GetBid())
+	tApp.CheckBalance(t, ctx, secondBuyer, cs(c("token1", 100), c("token2", 70)))
+	tApp.CheckBalance(t, ctx, supply.NewModuleAddress(types.ModuleName), cs(c("token1", 100), c("token2", 48)))
+	requireModuleAccountValid(t, ctx, k)
+
+	// Close auction, refunding the remaining escrow
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, k.CloseAuction(ctx, auctionID))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 200), c("token2", 68)))
+	tApp.CheckBalance(t, ctx, secondBuyer, cs(c("token1", 100), c("token2", 100)))
+	requireModuleAccountValid(t, ctx, k)
+}
+
+func TestCollateralAuctionProxyBids(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	buyer, secondBuyer, returnAddr := addrs[0], addrs[1], addrs[2]
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	accs := authexported.GenesisAccounts{sellerAcc}
+	for _, addr := range addrs {
+		accs = append(accs, auth.NewBaseAccount(addr, cs(c("token1", 100), c("token2", 100)), nil, 0, 0))
+	}
+	tApp.InitializeFromGenesisStates(NewAuthGenStateFromAccs(accs))
+	ctx := tApp.NewContext(false, abci.Header{})
+	k := tApp.GetAuctionKeeper()
+
+	// Start auction
+	auctionID, err := k.StartCollateralAuction(ctx, sellerModName, c("token1", 100), c("token2", 50), []sdk.AccAddress{returnAddr}, is(1), c("debt", 50))
+	require.NoError(t, err)
+
+	// Place proxy bids that both continue into the reverse phase
+	require.NoError(t, k.PlaceProxyBid(ctx, auctionID, buyer, c("token2", 50), c("token1", 60)))
+	require.NoError(t, k.PlaceProxyBid(ctx, auctionID, secondBuyer, c("token2", 50), c("token1", 80)))
+
+	// Check the first proxy bid raised the max bid, then bid the lot down just past the second's limit
+	auction, found := k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, buyer, auction.GetBidder())
+	require.Equal(t, c("token2", 50), auction.GetBid())
+	require.Equal(t, c("token1", 76), auction.GetLot())
+	tApp.CheckBalance(t, ctx, returnAddr, cs(c("token1", 124), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token2", 150), c("debt", 100)))
+	requireModuleAccountValid(t, ctx, k)
+
+	// Close auction
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, k.CloseAuction(ctx, auctionID))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 176), c("token2", 50)))
+	tApp.CheckBalance(t, ctx, secondBuyer, cs(c("token1", 100), c("token2", 100)))
+	requireModuleAccountValid(t, ctx, k)
+}
+
+func TestDebtAuctionProxyBids(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer, secondBuyer := addrs[0], addrs[1]
+	initiatorModName := cdp.LiquidatorMacc
+	initiatorAddr := supply.NewModuleAddress(initiatorModName)
+
+	tApp := app.NewTestApp()
+	initiatorAcc := supply.NewEmptyModuleAccount(initiatorModName, supply.Minter) // reverse auctions mint payout
+	require.NoError(t, initiatorAcc.SetCoins(cs(c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(secondBuyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			initiatorAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	k := tApp.GetAuctionKeeper()
+
+	// Start auction
+	auctionID, err := k.StartDebtAuction(ctx, initiatorModName, c("token1", 20), c("token2", 100), c("debt", 20))
+	require.NoError(t, err)
+
+	// Place a proxy bid, which bids the lot down straight away
+	require.NoError(t, k.PlaceProxyBid(ctx, auctionID, buyer, c("token1", 20), c("token2", 50)))
+	auction, found := k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, buyer, auction.GetBidder())
+	require.Equal(t, c("token2", 95), auction.GetLot())
+	tApp.CheckBalance(t, ctx, initiatorAddr, cs(c("token1", 20), c("debt", 100)))
+
+	// Outbid the proxy bidder, who bids again
+	require.NoError(t, k.PlaceBid(ctx, auctionID, secondBuyer, c("token2", 80)))
+	auction, found = k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, buyer, auction.GetBidder())
+	require.Equal(t, c("token2", 76), auction.GetLot())
+	tApp.CheckBalance(t, ctx, secondBuyer, cs(c("token1", 100), c("token2", 100)))
+	requireModuleAccountValid(t, ctx, k)
+
+	// A proxy bid with a higher min lot is beaten by one increment past its limit
+	require.NoError(t, k.PlaceProxyBid(ctx, auctionID, secondBuyer, c("token1", 20), c("token2", 60)))
+	auction, found = k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, buyer, auction.GetBidder())
+	require.Equal(t, c("token2", 57), auction.GetLot())
+	requireModuleAccountValid(t, ctx, k)
+
+	// Close auction
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, k.CloseAuction(ctx, auctionID))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 80), c("token2", 157)))
+	tApp.CheckBalance(t, ctx, secondBuyer, cs(c("token1", 100), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, initiatorAddr, cs(c("token1", 20), c("debt", 100)))
+}
+
+func TestPlaceProxyBidInvalid(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	k := tApp.GetAuctionKeeper()
+
+	collateralID, err := k.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), addrs[1:], is(1), c("debt", 40))
+	require.NoError(t, err)
+	partialID, err := k.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), addrs[1:], is(1), c("debt", 40))
+	require.NoError(t, err)
+	dutchID, err := k.StartDutchCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), addrs[1:], is(1), c("debt", 20), d("2"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		auctionID uint64
+		maxBid    sdk.Coin
+		minLot    sdk.Coin
+		expectErr error
+	}{
+		{"auction not found", 100, c("token2", 10), sdk.Coin{}, types.ErrAuctionNotFound},
+		{"dutch auction", dutchID, c("token2", 10), sdk.Coin{}, types.ErrProxyBidsNotSupported},
+		{"wrong bid denom", collateralID, c("token1", 10), sdk.Coin{}, types.ErrInvalidBidDenom},
+		{"wrong lot denom", collateralID, c("token2", 10), c("token2", 10), types.ErrInvalidLotDenom},
+		{"max bid above auction max bid", collateralID, c("token2", 51), sdk.Coin{}, types.ErrBidTooLarge},
+		{"min lot above lot", collateralID, c("token2", 50), c("token1", 21), types.ErrLotTooLarge},
+		{"min lot without auction max bid", collateralID, c("token2", 49), c("token1", 10), types.ErrBidTooSmall},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := k.PlaceProxyBid(ctx, tc.auctionID, buyer, tc.maxBid, tc.minLot)
+			require.True(t, errors.Is(err, tc.expectErr), "expected %s, got %v", tc.expectErr, err)
+		})
+	}
+
+	// Proxy bids and partial bids can't be mixed
+	require.NoError(t, k.PlacePartialBid(ctx, partialID, buyer, c("token1", 10), d("1")))
+	err = k.PlaceProxyBid(ctx, partialID, buyer, c("token2", 10), sdk.Coin{})
+	require.True(t, errors.Is(err, types.ErrMixedBidTypes))
+	require.NoError(t, k.PlaceProxyBid(ctx, collateralID, buyer, c("token2", 10), sdk.Coin{}))
+	err = k.PlacePartialBid(ctx, collateralID, buyer, c("token1", 10), d("1"))
+	require.True(t, errors.Is(err, types.ErrMixedBidTypes))
+
+	// Proxy bids can't be placed on expired auctions
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration + 1))
+	err = k.PlaceProxyBid(ctx, collateralID, buyer, c("token2", 20), sdk.Coin{})
+	require.True(t, errors.Is(err, types.ErrAuctionHasExpired))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,6 +24,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryGetParams(ctx, req, keeper)
 		case types.QueryNextAuctionID:
 			return queryNextAuctionID(ctx, req, keeper)
+		case types.QueryGetAuctionRecord:
+			return queryAuctionRecord(ctx, req, keeper)
+		case types.QueryGetAuctionRecords:
+			return queryAuctionRecords(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -101,9 +107,16 @@ func filterAuctions(ctx sdk.Context, auctions types.Auctions, params types.Query
 
 		// match auction owner (if supplied)
 		if len(params.Owner) > 0 {
-			if cAuc, ok := auc.(types.CollateralAuction); ok {
+			var lotReturns *types.WeightedAddresses
+			switch cAuc := auc.(type) {
+			case types.CollateralAuction:
+				lotReturns = &cAuc.LotReturns
+			case types.DutchCollateralAuction:
+				lotReturns = &cAuc.LotReturns
+			}
+			if lotReturns != nil {
 				foundOwnerAddr := false
-				for _, addr := range cAuc.GetLotReturns().Addresses {
+				for _, addr := range lotReturns.Addresses {
 					if addr.Equals(params.Owner) {
 						foundOwnerAddr = true
 						break
@@ -149,3 +162,87 @@ func queryNextAuctionID(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	}
 	return bz, nil
 }
+
+func queryAuctionRecord(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryAuctionParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	record, found := keeper.GetAuctionRecord(ctx, requestParams.AuctionID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNotFound, "no record for auction %d", requestParams.AuctionID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, record)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryAuctionRecords returns the records of closed auctions, ordered by close time and filtered by bidder, initiator and time range.
+func queryAuctionRecords(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryAuctionRecordsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	records := types.AuctionRecords{}
+	if len(params.Bidder) > 0 {
+		// a bidder has few records compared to the whole history, so look them up through the bidder index
+		keeper.IterateAuctionRecordsByBidder(ctx, params.Bidder, func(auctionID uint64) bool {
+			record, found := keeper.GetAuctionRecord(ctx, auctionID)
+			if !found {
+				return false
+			}
+			if !params.StartTime.IsZero() && record.CloseTime.Before(params.StartTime) {
+				return false
+			}
+			if !params.EndTime.IsZero() && !record.CloseTime.Before(params.EndTime) {
+				return false
+			}
+			if len(params.Initiator) > 0 && record.Initiator != params.Initiator {
+				return false
+			}
+			records = append(records, record)
+			return false
+		})
+		// match the ordering of the by time index
+		sort.Slice(records, func(i, j int) bool {
+			if !records[i].CloseTime.Equal(records[j].CloseTime) {
+				return records[i].CloseTime.Before(records[j].CloseTime)
+			}
+			return records[i].AuctionID < records[j].AuctionID
+		})
+	} else {
+		keeper.IterateAuctionRecordsByTime(ctx, params.StartTime, params.EndTime, func(auctionID uint64) bool {
+			record, found := keeper.GetAuctionRecord(ctx, auctionID)
+			if !found {
+				return false
+			}
+			if len(params.Initiator) > 0 && record.Initiator != params.Initiator {
+				return false
+			}
+			records = append(records, record)
+			return false
+		})
+	}
+
+	start, end := client.Paginate(len(records), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		records = types.AuctionRecords{}
+	} else {
+		records = records[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// newReservePrice returns the reserve price of a new auction, starting at a fraction of the lot's oracle value.
+// Auctions have no reserve unless there is a reserve price param for their type and the initiating module has a price reference that can price the lot.
+func (k Keeper) newReservePrice(ctx sdk.Context, auctionType, initiator, lotDenom, bidDenom string) *types.ReservePrice {
+	param, found := k.GetParams(ctx).ReservePrices.Get(auctionType)
+	if !found {
+		return nil
+	}
+	reference, found := k.priceReferences[initiator]
+	if !found {
+		return nil
+	}
+	price, found := reference.GetAuctionReferencePrice(ctx, lotDenom, bidDenom)
+	if !found || !price.IsPositive() {
+		return nil
+	}
+	reservePrice := types.NewReservePrice(price.Mul(param.Fraction), ctx.BlockTime(), param.DecayCurve)
+	return &reservePrice
+}
+
+// reserveMinBid returns the smallest bid for a lot that meets an auction's current reserve price.
+func reserveMinBid(ctx sdk.Context, auction types.Auction, lot sdk.Int) (sdk.Int, error) {
+	reserve, err := auction.GetReservePrice().CurrentPrice(ctx.BlockTime())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return reserve.MulInt(lot).Ceil().TruncateInt(), nil
+}
+
+// reserveMaxLot returns the largest lot a bid can buy while meeting an auction's current reserve price.
+// It returns false if the auction has no reserve, or it has decayed to zero.
+func reserveMaxLot(ctx sdk.Context, auction types.Auction, bid sdk.Int) (sdk.Int, bool, error) {
+	reserve, err := auction.GetReservePrice().CurrentPrice(ctx.BlockTime())
+	if err != nil {
+		return sdk.Int{}, false, err
+	}
+	if !reserve.IsPositive() {
+		return sdk.Int{}, false, nil
+	}
+	return sdk.NewDecFromInt(bid).Quo(reserve).TruncateInt(), true, nil
+}
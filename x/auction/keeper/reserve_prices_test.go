@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction/keeper"
+	"github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp"
+)
+
+// fixedPriceReference prices the lot of every auction at the same price
+type fixedPriceReference struct {
+	price sdk.Dec
+}
+
+func (r fixedPriceReference) GetAuctionReferencePrice(_ sdk.Context, _, _ string) (sdk.Dec, bool) {
+	return r.price, true
+}
+
+// setupReservePrices creates an app where auctions started by the cdp module account have reserve prices of 80% of the reference price, decaying to zero over an hour.
+func setupReservePrices(t *testing.T, price sdk.Dec, addrs []sdk.AccAddress) (app.TestApp, sdk.Context, keeper.Keeper) {
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(cdp.ModuleName, supply.Minter, supply.Burner)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 1000), c("token2", 1000), c("debt", 1000))))
+	accs := authexported.GenesisAccounts{sellerAcc}
+	for _, addr := range addrs {
+		accs = append(accs, auth.NewBaseAccount(addr, cs(c("token1", 1000), c("token2", 1000)), nil, 0, 0))
+	}
+	tApp.InitializeFromGenesisStates(NewAuthGenStateFromAccs(accs))
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	k := tApp.GetAuctionKeeper()
+	k.SetPriceReference(cdp.ModuleName, fixedPriceReference{price})
+	params := k.GetParams(ctx)
+	for _, auctionType := range []string{types.SurplusAuctionType, types.DebtAuctionType, types.CollateralAuctionType, types.DutchCollateralAuctionType} {
+		params.ReservePrices = append(params.ReservePrices, types.NewReservePriceParam(auctionType, d("0.8"), types.NewLinearDecayCurve(time.Hour)))
+	}
+	k.SetParams(ctx, params)
+	return tApp, ctx, k
+}
+
+func TestSurplusAuctionReservePrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	buyer := addrs[0]
+	_, ctx, k := setupReservePrices(t, d("0.5"), addrs)
+
+	auctionID, err := k.StartSurplusAuction(ctx, cdp.ModuleName, c("token1", 100), "token2")
+	require.NoError(t, err)
+	auction, found := k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, types.NewReservePrice(d("0.4"), ctx.BlockTime(), types.NewLinearDecayCurve(time.Hour)), auction.GetReservePrice())
+
+	// Bids below the reserve are rejected
+	err = k.PlaceBid(ctx, auctionID, buyer, c("token2", 39))
+	require.True(t, errors.Is(err, types.ErrBidBelowReserve))
+	err = k.PlaceProxyBid(ctx, auctionID, buyer, c("token2", 39), sdk.Coin{})
+	require.True(t, errors.Is(err, types.ErrBidBelowReserve))
+
+	// After half the decay the reserve has halved
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	err = k.PlaceBid(ctx, auctionID, buyer, c("token2", 19))
+	require.True(t, errors.Is(err, types.ErrBidBelowReserve))
+	require.NoError(t, k.PlaceBid(ctx, auctionID, buyer, c("token2", 20)))
+}
+
+func TestSurplusAuctionReservePriceProxyBid(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	buyer := addrs[0]
+	_, ctx, k := setupReservePrices(t, d("0.5"), addrs)
+
+	auctionID, err := k.StartSurplusAuction(ctx, cdp.ModuleName, c("token1", 100), "token2")
+	require.NoError(t, err)
+
+	// Proxy bids open at the reserve
+	require.NoError(t, k.PlaceProxyBid(ctx, auctionID, buyer, c("token2", 50), sdk.Coin{}))
+	auction, found := k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, buyer, auction.GetBidder())
+	require.Equal(t, c("token2", 40), auction.GetBid())
+	requireModuleAccountValid(t, ctx, k)
+}
+
+func TestDebtAuctionReservePrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	buyer := addrs[0]
+	_, ctx, k := setupReservePrices(t, d("0.5"), addrs)
+
+	auctionID, err := k.StartDebtAuction(ctx, cdp.ModuleName, c("token1", 100), c("token2", 1000), c("debt", 100))
+	require.NoError(t, err)
+
+	// Lots larger than the bid buys at the reserve are rejected
+	err = k.PlaceBid(ctx, auctionID, buyer, c("token2", 251))
+	require.True(t, errors.Is(err, types.ErrBidBelowReserve))
+	err = k.PlaceProxyBid(ctx, auctionID, buyer, c("token1", 100), c("token2", 251))
+	require.True(t, errors.Is(err, types.ErrBidBelowReserve))
+	require.NoError(t, k.PlaceBid(ctx, auctionID, buyer, c("token2", 250)))
+}
+
+func TestCollateralAuctionReservePrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer, returnAddr := addrs[0], addrs[1]
+	_, ctx, k := setupReservePrices(t, d("2"), addrs)
+
+	auctionID, err := k.StartCollateralAuction(ctx, cdp.ModuleName, c("token1", 100), c("token2", 100), []sdk.AccAddress{returnAddr}, is(1), c("debt", 100))
+	require.NoError(t, err)
+
+	// The reserve is above the max bid, so forward bids must raise the max bid
+	err = k.PlaceBid(ctx, auctionID, buyer, c("token2", 50))
+	require.True(t, errors.Is(err, types.ErrBidBelowReserve))
+	require.NoError(t, k.PlaceBid(ctx, auctionID, buyer, c("token2", 100)))
+
+	// Reverse bids must then cut the lot to what the max bid buys at the reserve
+	err = k.PlaceBid(ctx, auctionID, buyer, c("token1", 80))
+	require.True(t, errors.Is(err, types.ErrBidBelowReserve))
+	require.NoError(t, k.PlaceBid(ctx, auctionID, buyer, c("token1", 62)))
+
+	// Partial bids must be priced at or above the reserve
+	partialID, err := k.StartCollateralAuction(ctx, cdp.ModuleName, c("token1", 100), c("token2", 100), []sdk.AccAddress{returnAddr}, is(1), c("debt", 100))
+	require.NoError(t, err)
+	err = k.PlacePartialBid(ctx, partialID, buyer, c("token1", 10), d("1.5"))
+	require.True(t, errors.Is(err, types.ErrBidBelowReserve))
+	require.NoError(t, k.PlacePartialBid(ctx, partialID, buyer, c("token1", 10), d("1.6")))
+	requireModuleAccountValid(t, ctx, k)
+}
+
+func TestDutchCollateralAuctionReservePrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer, returnAddr := addrs[0], addrs[1]
+	_, ctx, k := setupReservePrices(t, d("3"), addrs)
+	params := k.GetParams(ctx)
+	params.ReservePrices[3] = types.NewReservePriceParam(types.DutchCollateralAuctionType, d("1"), types.NewLinearDecayCurve(10*time.Hour))
+	k.SetParams(ctx, params)
+
+	// The auction starts at an oracle price of 2, below the reference price its reserve starts at
+	auctionID, err := k.StartDutchCollateralAuction(ctx, cdp.ModuleName, c("token1", 100), c("token2", 1000), []sdk.AccAddress{returnAddr}, is(1), c("debt", 100), d("2"))
+	require.NoError(t, err)
+	auction, found := k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, types.NewReservePrice(d("3"), ctx.BlockTime(), types.NewLinearDecayCurve(10*time.Hour)), auction.GetReservePrice())
+
+	// Bids are rejected while the current price is below the reserve
+	err = k.PlaceBid(ctx, auctionID, buyer, c("token1", 10))
+	require.True(t, errors.Is(err, types.ErrBidBelowReserve))
+
+	// Once the reserve has decayed below the floor price (2 * 0.8 = 1.6), bids are accepted
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(5 * time.Hour))
+	require.NoError(t, k.PlaceBid(ctx, auctionID, buyer, c("token1", 10)))
+	requireModuleAccountValid(t, ctx, k)
+}
+
+func TestNoReservePrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp, ctx, k := setupReservePrices(t, d("0.5"), addrs)
+
+	// Auction types without a reserve price param have no reserve
+	params := k.GetParams(ctx)
+	params.ReservePrices = types.ReservePriceParams{params.ReservePrices[1]}
+	k.SetParams(ctx, params)
+	auctionID, err := k.StartSurplusAuction(ctx, cdp.ModuleName, c("token1", 100), "token2")
+	require.NoError(t, err)
+	auction, found := k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.False(t, auction.GetReservePrice().IsSet())
+
+	// Modules without a price reference start auctions without a reserve
+	liquidatorAcc := tApp.GetSupplyKeeper().GetModuleAccount(ctx, cdp.LiquidatorMacc)
+	require.NoError(t, liquidatorAcc.SetCoins(cs(c("debt", 100))))
+	tApp.GetAccountKeeper().SetAccount(ctx, liquidatorAcc)
+	auctionID, err = k.StartDebtAuction(ctx, cdp.LiquidatorMacc, c("token1", 100), c("token2", 1000), c("debt", 100))
+	require.NoError(t, err)
+	auction, found = k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.False(t, auction.GetReservePrice().IsSet())
+}
@@ -21,7 +21,21 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &auctionB)
 		return fmt.Sprintf("%v\n%v", auctionA, auctionB)
 
+	case bytes.Equal(kvA.Key[:1], types.AuctionRecordKeyPrefix):
+		var recordA, recordB types.AuctionRecord
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &recordB)
+		return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+	case bytes.Equal(kvA.Key[:1], types.BidRecordKeyPrefix):
+		var bidA, bidB types.BidRecord
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &bidA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &bidB)
+		return fmt.Sprintf("%v\n%v", bidA, bidB)
+
 	case bytes.Equal(kvA.Key[:1], types.AuctionByTimeKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.AuctionRecordByTimeKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.AuctionRecordByBidderKeyPrefix),
 		bytes.Equal(kvA.Key[:1], types.NextAuctionIDKey):
 		auctionIDA := binary.BigEndian.Uint64(kvA.Value)
 		auctionIDB := binary.BigEndian.Uint64(kvB.Value)
@@ -27,10 +27,16 @@ func TestDecodeDistributionStore(t *testing.T) {
 
 	oneCoin := sdk.NewCoin("coin", sdk.OneInt())
 	auction := types.NewSurplusAuction("me", oneCoin, "coin", time.Now().UTC())
+	bid := types.NewBidRecord(sdk.AccAddress("bidder"), oneCoin, oneCoin, 4, time.Now().UTC())
+	record := types.NewAuctionRecord(auction, types.BidRecords{bid}, nil, 5, time.Now().UTC())
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.AuctionKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(&auction)},
 		kv.Pair{Key: types.AuctionByTimeKeyPrefix, Value: sdk.Uint64ToBigEndian(2)},
+		kv.Pair{Key: types.AuctionRecordKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(record)},
+		kv.Pair{Key: types.AuctionRecordByTimeKeyPrefix, Value: sdk.Uint64ToBigEndian(3)},
+		kv.Pair{Key: types.AuctionRecordByBidderKeyPrefix, Value: sdk.Uint64ToBigEndian(3)},
+		kv.Pair{Key: types.BidRecordKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(bid)},
 		kv.Pair{Key: types.NextAuctionIDKey, Value: sdk.Uint64ToBigEndian(10)},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
@@ -41,6 +47,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 	}{
 		{"Auction", fmt.Sprintf("%v\n%v", auction, auction)},
 		{"AuctionByTime", "2\n2"},
+		{"AuctionRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"AuctionRecordByTime", "3\n3"},
+		{"AuctionRecordByBidder", "3\n3"},
+		{"BidRecord", fmt.Sprintf("%v\n%v", bid, bid)},
 		{"NextAuctionI", "10\n10"},
 		{"other", ""},
 	}
@@ -51,6 +51,10 @@ func GenIncrementCollateral(r *rand.Rand) sdk.Dec {
 var GenIncrementDebt = GenIncrementCollateral
 var GenIncrementSurplus = GenIncrementCollateral
 
+func GenDutchStartMarkup(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(simulation.RandomDecAmount(r, sdk.OneDec()))
+}
+
 // RandomizedGenState generates a random GenesisState for auction
 func RandomizedGenState(simState *module.SimulationState) {
 
@@ -60,6 +64,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 		GenIncrementSurplus(simState.Rand),
 		GenIncrementDebt(simState.Rand),
 		GenIncrementCollateral(simState.Rand),
+		GenDutchStartMarkup(simState.Rand),
+		types.DefaultDutchDecayCurve,
+		types.DefaultDutchPriceFloor,
+		types.DefaultReservePrices,
+		types.DefaultRecordRetention,
 	)
 	if err := p.Validate(); err != nil {
 		panic(err)
@@ -68,6 +77,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		types.DefaultNextAuctionID,
 		p,
 		nil,
+		nil,
+		nil,
 	)
 
 	// Add auctions
@@ -210,6 +210,30 @@ func generateBidAmount(
 			return sdk.NewCoin(a.Bid.Denom, amt), nil // stable coin
 		}
 
+	case types.DutchCollateralAuction:
+		// Check auction can still receive new bids
+		if a.IsComplete() {
+			return sdk.Coin{}, errorCantReceiveBids
+		}
+		// Check the bidder has enough (stable coin) to buy some of the lot at the current price
+		maxLotAmt := a.Lot.Amount
+		price, err := a.CurrentPrice(blockTime)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		if price.IsPositive() {
+			maxLotAmt = sdk.MinInt(maxLotAmt, sdk.NewDecFromInt(bidderBalance.AmountOf(a.Bid.Denom)).Quo(price).TruncateInt())
+		}
+		if !maxLotAmt.IsPositive() {
+			return sdk.Coin{}, errorNotEnoughCoins
+		}
+		// Generate an amount of the lot to buy (collateral coin)
+		amt, err := RandIntInclusive(r, sdk.OneInt(), maxLotAmt)
+		if err != nil {
+			panic(err)
+		}
+		return sdk.NewCoin(a.Lot.Denom, amt), nil // collateral coin
+
 	default:
 		return sdk.Coin{}, fmt.Errorf("unknown auction type")
 	}
@@ -11,11 +11,13 @@ import (
 )
 
 const (
-	CollateralAuctionType = "collateral"
-	SurplusAuctionType    = "surplus"
-	DebtAuctionType       = "debt"
-	ForwardAuctionPhase   = "forward"
-	ReverseAuctionPhase   = "reverse"
+	CollateralAuctionType      = "collateral"
+	DutchCollateralAuctionType = "dutch_collateral"
+	SurplusAuctionType         = "surplus"
+	DebtAuctionType            = "debt"
+	ForwardAuctionPhase        = "forward"
+	ReverseAuctionPhase        = "reverse"
+	DescendingAuctionPhase     = "descending"
 )
 
 // DistantFuture is a very large time value to use as initial the ending time for auctions.
@@ -27,12 +29,15 @@ var DistantFuture = time.Date(9000, 1, 1, 0, 0, 0, 0, time.UTC)
 type Auction interface {
 	GetID() uint64
 	WithID(uint64) Auction
+	WithProxyBids(ProxyBids) Auction
 
 	GetInitiator() string
 	GetLot() sdk.Coin
 	GetBidder() sdk.AccAddress
 	GetBid() sdk.Coin
 	GetEndTime() time.Time
+	GetProxyBids() ProxyBids
+	GetReservePrice() ReservePrice
 
 	GetType() string
 	GetPhase() string
@@ -51,6 +56,8 @@ type BaseAuction struct {
 	HasReceivedBids bool           `json:"has_received_bids" yaml:"has_received_bids"` // Whether the auction has received any bids or not.
 	EndTime         time.Time      `json:"end_time" yaml:"end_time"`                   // Current auction closing time. Triggers at the end of the block with time ≥ EndTime.
 	MaxEndTime      time.Time      `json:"max_end_time" yaml:"max_end_time"`           // Maximum closing time. Auctions can close before this but never after.
+	ProxyBids       ProxyBids      `json:"proxy_bids" yaml:"proxy_bids"`               // Bids placed automatically on behalf of bidders, up to their limits.
+	ReservePrice    *ReservePrice  `json:"reserve_price" yaml:"reserve_price"`         // Lowest price bids can be placed at. Nil if the auction has no reserve.
 }
 
 // GetID is a getter for auction ID.
@@ -71,6 +78,17 @@ func (a BaseAuction) GetBid() sdk.Coin { return a.Bid }
 // GetEndTime is a getter for auction end time.
 func (a BaseAuction) GetEndTime() time.Time { return a.EndTime }
 
+// GetProxyBids is a getter for auction proxy bids.
+func (a BaseAuction) GetProxyBids() ProxyBids { return a.ProxyBids }
+
+// GetReservePrice is a getter for auction reserve price. It is unset if the auction has no reserve.
+func (a BaseAuction) GetReservePrice() ReservePrice {
+	if a.ReservePrice == nil {
+		return ReservePrice{}
+	}
+	return *a.ReservePrice
+}
+
 // GetType returns the auction type. Used to identify auctions in event attributes.
 func (a BaseAuction) GetType() string { return "base" }
 
@@ -96,6 +114,14 @@ func (a BaseAuction) Validate() error {
 	if a.EndTime.After(a.MaxEndTime) {
 		return fmt.Errorf("MaxEndTime < EndTime (%s < %s)", a.MaxEndTime, a.EndTime)
 	}
+	if err := a.ProxyBids.Validate(a.Bid.Denom, a.Lot.Denom); err != nil {
+		return fmt.Errorf("invalid proxy bids: %w", err)
+	}
+	if a.ReservePrice != nil {
+		if err := a.ReservePrice.Validate(); err != nil {
+			return fmt.Errorf("invalid reserve price: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -122,14 +148,20 @@ type SurplusAuction struct {
 // WithID returns an auction with the ID set.
 func (a SurplusAuction) WithID(id uint64) Auction { a.ID = id; return a }
 
+// WithProxyBids returns an auction with the proxy bids set.
+func (a SurplusAuction) WithProxyBids(proxyBids ProxyBids) Auction {
+	a.ProxyBids = proxyBids
+	return a
+}
+
 // GetType returns the auction type. Used to identify auctions in event attributes.
 func (a SurplusAuction) GetType() string { return SurplusAuctionType }
 
 // GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
 // It is used in genesis initialize the module account correctly.
 func (a SurplusAuction) GetModuleAccountCoins() sdk.Coins {
-	// a.Bid is paid out on bids, so is never stored in the module account
-	return sdk.NewCoins(a.Lot)
+	// a.Bid is paid out on bids, so is never stored in the module account, but proxy bid escrow is held until the auction closes
+	return sdk.NewCoins(a.Lot).Add(a.ProxyBids.TotalEscrow()...)
 }
 
 // GetPhase returns the direction of a surplus auction, which never changes.
@@ -161,6 +193,12 @@ type DebtAuction struct {
 // WithID returns an auction with the ID set.
 func (a DebtAuction) WithID(id uint64) Auction { a.ID = id; return a }
 
+// WithProxyBids returns an auction with the proxy bids set.
+func (a DebtAuction) WithProxyBids(proxyBids ProxyBids) Auction {
+	a.ProxyBids = proxyBids
+	return a
+}
+
 // GetType returns the auction type. Used to identify auctions in event attributes.
 func (a DebtAuction) GetType() string { return DebtAuctionType }
 
@@ -168,8 +206,8 @@ func (a DebtAuction) GetType() string { return DebtAuctionType }
 // It is used in genesis initialize the module account correctly.
 func (a DebtAuction) GetModuleAccountCoins() sdk.Coins {
 	// a.Lot is minted at auction close, so is never stored in the module account
-	// a.Bid is paid out on bids, so is never stored in the module account
-	return sdk.NewCoins(a.CorrespondingDebt)
+	// a.Bid is paid out on bids, so is never stored in the module account, but proxy bid escrow is held until the auction closes
+	return sdk.NewCoins(a.CorrespondingDebt).Add(a.ProxyBids.TotalEscrow()...)
 }
 
 // GetPhase returns the direction of a debt auction, which never changes.
@@ -215,19 +253,27 @@ type CollateralAuction struct {
 	CorrespondingDebt sdk.Coin          `json:"corresponding_debt" yaml:"corresponding_debt"`
 	MaxBid            sdk.Coin          `json:"max_bid" yaml:"max_bid"`
 	LotReturns        WeightedAddresses `json:"lot_returns" yaml:"lot_returns"`
+	PartialBids       PartialBids       `json:"partial_bids" yaml:"partial_bids"` // standing bids for slices of the lot, settled at close
+	LotReturned       LotReturns        `json:"lot_returned" yaml:"lot_returned"` // lot sent to LotReturns by reverse bids so far
 }
 
 // WithID returns an auction with the ID set.
 func (a CollateralAuction) WithID(id uint64) Auction { a.ID = id; return a }
 
+// WithProxyBids returns an auction with the proxy bids set.
+func (a CollateralAuction) WithProxyBids(proxyBids ProxyBids) Auction {
+	a.ProxyBids = proxyBids
+	return a
+}
+
 // GetType returns the auction type. Used to identify auctions in event attributes.
 func (a CollateralAuction) GetType() string { return CollateralAuctionType }
 
 // GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
 // It is used in genesis initialize the module account correctly.
 func (a CollateralAuction) GetModuleAccountCoins() sdk.Coins {
-	// a.Bid is paid out on bids, so is never stored in the module account
-	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt)...)
+	// a.Bid is paid out on bids, so is never stored in the module account, but partial bids and proxy bid escrow are held until the auction closes
+	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt)...).Add(a.PartialBids.TotalEscrow()...).Add(a.ProxyBids.TotalEscrow()...)
 }
 
 // IsReversePhase returns whether the auction has switched over to reverse phase or not.
@@ -260,9 +306,44 @@ func (a CollateralAuction) Validate() error {
 	if err := a.LotReturns.Validate(); err != nil {
 		return fmt.Errorf("invalid lot returns: %w", err)
 	}
+	if len(a.PartialBids) > 0 {
+		if a.Bid.IsPositive() {
+			return errors.New("auction cannot have both a bid for the whole lot and partial bids")
+		}
+		if len(a.ProxyBids) > 0 {
+			return errors.New("auction cannot have both proxy bids and partial bids")
+		}
+		if err := a.PartialBids.Validate(a.Lot.Denom, a.MaxBid.Denom); err != nil {
+			return fmt.Errorf("invalid partial bids: %w", err)
+		}
+	}
+	if err := a.LotReturned.Validate(); err != nil {
+		return fmt.Errorf("invalid lot returned: %w", err)
+	}
 	return a.BaseAuction.Validate()
 }
 
+// PartialBidFills returns how much of each partial bid is filled if the auction closes now, in the order of PartialBids.
+// Bids are filled in order of price, highest first, until the lot is sold or the max bid is raised.
+func (a CollateralAuction) PartialBidFills() []PartialBidFill {
+	fills := make([]PartialBidFill, len(a.PartialBids))
+	remainingLot := a.Lot.Amount
+	remainingBid := a.MaxBid.Amount
+	for i, pb := range a.PartialBids {
+		lot := sdk.MinInt(pb.Lot.Amount, remainingLot)
+		cost := sdk.MinInt(pb.Price.MulInt(lot).Ceil().TruncateInt(), pb.Escrow.Amount)
+		if cost.GT(remainingBid) {
+			// only fill enough of the bid to raise the max bid
+			cost = remainingBid
+			lot = sdk.MinInt(lot, sdk.NewDecFromInt(cost).Quo(pb.Price).Ceil().TruncateInt())
+		}
+		remainingLot = remainingLot.Sub(lot)
+		remainingBid = remainingBid.Sub(cost)
+		fills[i] = NewPartialBidFill(sdk.NewCoin(a.Lot.Denom, lot), sdk.NewCoin(a.MaxBid.Denom, cost))
+	}
+	return fills
+}
+
 func (a CollateralAuction) String() string {
 	return fmt.Sprintf(`Auction %d:
   Initiator:              %s
@@ -273,10 +354,11 @@ func (a CollateralAuction) String() string {
 	Max End Time:      			%s
 	Max Bid									%s
 	LotReturns						%s
-	Corresponding Debt %s`,
+	Corresponding Debt %s
+	Partial Bids       %s`,
 		a.GetID(), a.Initiator, a.Lot,
 		a.Bidder, a.Bid, a.GetEndTime().String(),
-		a.MaxEndTime.String(), a.MaxBid, a.LotReturns, a.CorrespondingDebt,
+		a.MaxEndTime.String(), a.MaxBid, a.LotReturns, a.CorrespondingDebt, a.PartialBids,
 	)
 }
 
@@ -299,6 +381,146 @@ func NewCollateralAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid
 	return auction
 }
 
+// DutchCollateralAuction is a descending price auction.
+// The price starts above the oracle price and decays over time along a DecayCurve, stopping at FloorPrice.
+// Bidders can buy any portion of the lot at the current price, until MaxBid has been raised, the lot is sold, or the auction reaches its end time.
+// Unsold Lot is sent to LotReturns, being divided among the addresses by weight.
+type DutchCollateralAuction struct {
+	BaseAuction `json:"base_auction" yaml:"base_auction"`
+
+	CorrespondingDebt sdk.Coin          `json:"corresponding_debt" yaml:"corresponding_debt"`
+	MaxBid            sdk.Coin          `json:"max_bid" yaml:"max_bid"` // Amount to raise, after which the remaining lot is returned.
+	LotReturns        WeightedAddresses `json:"lot_returns" yaml:"lot_returns"`
+	StartPrice        sdk.Dec           `json:"start_price" yaml:"start_price"` // Price of one unit of the lot, in units of the bid, when the auction started.
+	FloorPrice        sdk.Dec           `json:"floor_price" yaml:"floor_price"` // Lowest price the auction decays to.
+	StartTime         time.Time         `json:"start_time" yaml:"start_time"`
+	DecayCurve        DecayCurve        `json:"decay_curve" yaml:"decay_curve"`
+}
+
+// WithID returns an auction with the ID set.
+func (a DutchCollateralAuction) WithID(id uint64) Auction { a.ID = id; return a }
+
+// WithProxyBids returns an auction with the proxy bids set.
+func (a DutchCollateralAuction) WithProxyBids(proxyBids ProxyBids) Auction {
+	a.ProxyBids = proxyBids
+	return a
+}
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a DutchCollateralAuction) GetType() string { return DutchCollateralAuctionType }
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+func (a DutchCollateralAuction) GetModuleAccountCoins() sdk.Coins {
+	// a.Bid is paid out on bids, so is never stored in the module account
+	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt)...)
+}
+
+// GetPhase returns the direction of a dutch collateral auction, which never changes.
+func (a DutchCollateralAuction) GetPhase() string { return DescendingAuctionPhase }
+
+// GetLotReturns returns a dutch collateral auction's lot owners
+func (a DutchCollateralAuction) GetLotReturns() WeightedAddresses {
+	return a.LotReturns
+}
+
+// IsComplete returns whether the auction has sold all of its lot or raised its max bid.
+func (a DutchCollateralAuction) IsComplete() bool {
+	return !a.Lot.IsPositive() || a.Bid.IsGTE(a.MaxBid)
+}
+
+// CurrentPrice returns the price of one unit of the lot at a given time, which does not fall below the floor price.
+func (a DutchCollateralAuction) CurrentPrice(blockTime time.Time) (sdk.Dec, error) {
+	price, err := a.DecayCurve.Price(a.StartPrice, blockTime.Sub(a.StartTime))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return sdk.MaxDec(price, a.FloorPrice), nil
+}
+
+// Validate validates the DutchCollateralAuction fields values.
+func (a DutchCollateralAuction) Validate() error {
+	if !a.CorrespondingDebt.IsValid() {
+		return fmt.Errorf("invalid corresponding debt: %s", a.CorrespondingDebt)
+	}
+	if !a.MaxBid.IsValid() {
+		return fmt.Errorf("invalid max bid: %s", a.MaxBid)
+	}
+	if a.MaxBid.Denom != a.Bid.Denom {
+		return fmt.Errorf("max bid denom %s does not match bid denom %s", a.MaxBid.Denom, a.Bid.Denom)
+	}
+	if err := a.LotReturns.Validate(); err != nil {
+		return fmt.Errorf("invalid lot returns: %w", err)
+	}
+	if a.StartPrice.IsNil() || !a.StartPrice.IsPositive() {
+		return fmt.Errorf("start price must be positive: %s", a.StartPrice)
+	}
+	if a.FloorPrice.IsNil() || !a.FloorPrice.IsPositive() {
+		return fmt.Errorf("floor price must be positive: %s", a.FloorPrice)
+	}
+	if a.FloorPrice.GT(a.StartPrice) {
+		return fmt.Errorf("floor price %s cannot be greater than start price %s", a.FloorPrice, a.StartPrice)
+	}
+	if a.StartTime.Unix() <= 0 {
+		return errors.New("start time cannot be zero")
+	}
+	if err := a.DecayCurve.Validate(); err != nil {
+		return fmt.Errorf("invalid decay curve: %w", err)
+	}
+	if len(a.ProxyBids) > 0 {
+		return errors.New("dutch collateral auctions do not accept proxy bids")
+	}
+	return a.BaseAuction.Validate()
+}
+
+func (a DutchCollateralAuction) String() string {
+	return fmt.Sprintf(`Auction %d:
+  Initiator:          %s
+  Lot:                %s
+  Bidder:             %s
+  Bid:                %s
+  End Time:           %s
+  Max End Time:       %s
+  Max Bid:            %s
+  Lot Returns:        %s
+  Corresponding Debt: %s
+  Start Price:        %s
+  Floor Price:        %s
+  Start Time:         %s
+  Decay Curve:        %s`,
+		a.GetID(), a.Initiator, a.Lot,
+		a.Bidder, a.Bid, a.GetEndTime().String(),
+		a.MaxEndTime.String(), a.MaxBid, a.LotReturns, a.CorrespondingDebt,
+		a.StartPrice, a.FloorPrice, a.StartTime.String(), a.DecayCurve,
+	)
+}
+
+// NewDutchCollateralAuction returns a new dutch collateral auction.
+func NewDutchCollateralAuction(
+	seller string, lot, maxBid sdk.Coin, lotReturns WeightedAddresses, debt sdk.Coin,
+	startPrice, floorPrice sdk.Dec, startTime, endTime time.Time, decayCurve DecayCurve,
+) DutchCollateralAuction {
+	auction := DutchCollateralAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(maxBid.Denom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime},
+		CorrespondingDebt: debt,
+		MaxBid:            maxBid,
+		LotReturns:        lotReturns,
+		StartPrice:        startPrice,
+		FloorPrice:        floorPrice,
+		StartTime:         startTime,
+		DecayCurve:        decayCurve,
+	}
+	return auction
+}
+
 // WeightedAddresses is a type for storing some addresses and associated weights.
 type WeightedAddresses struct {
 	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
@@ -347,3 +569,461 @@ func (wa WeightedAddresses) Validate() error {
 
 	return nil
 }
+
+// PartialBid is a standing bid for a slice of a collateral auction's lot at a price.
+// The bid coins are held by the auction module until the auction closes or the bid is pushed out of the order book.
+type PartialBid struct {
+	Bidder sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Lot    sdk.Coin       `json:"lot" yaml:"lot"`       // amount of the lot bid for
+	Price  sdk.Dec        `json:"price" yaml:"price"`   // bid coins offered per unit of lot
+	Escrow sdk.Coin       `json:"escrow" yaml:"escrow"` // bid coins held for the bid, the cost of the whole slice
+}
+
+// NewPartialBid returns a new partial bid, escrowing the cost of the whole slice.
+func NewPartialBid(bidder sdk.AccAddress, lot sdk.Coin, price sdk.Dec, bidDenom string) PartialBid {
+	return PartialBid{
+		Bidder: bidder,
+		Lot:    lot,
+		Price:  price,
+		Escrow: sdk.NewCoin(bidDenom, price.MulInt(lot.Amount).Ceil().TruncateInt()),
+	}
+}
+
+// Validate performs a basic validation of the partial bid fields.
+func (pb PartialBid) Validate() error {
+	if pb.Bidder.Empty() {
+		return errors.New("bidder cannot be empty")
+	}
+	if !pb.Lot.IsValid() || !pb.Lot.IsPositive() {
+		return fmt.Errorf("lot must be positive: %s", pb.Lot)
+	}
+	if pb.Price.IsNil() || !pb.Price.IsPositive() {
+		return fmt.Errorf("price must be positive: %s", pb.Price)
+	}
+	if !pb.Escrow.IsValid() || !pb.Escrow.IsPositive() {
+		return fmt.Errorf("escrow must be positive: %s", pb.Escrow)
+	}
+	return nil
+}
+
+func (pb PartialBid) String() string {
+	return fmt.Sprintf("%s bids %s for %s at %s", pb.Bidder, pb.Escrow, pb.Lot, pb.Price)
+}
+
+// PartialBids is an order book of partial bids, sorted by price from highest to lowest, then by the order they were placed.
+type PartialBids []PartialBid
+
+// Insert adds a bid to the order book after any bids with the same or a higher price.
+func (pbs PartialBids) Insert(bid PartialBid) PartialBids {
+	i := 0
+	for i < len(pbs) && pbs[i].Price.GTE(bid.Price) {
+		i++
+	}
+	inserted := make(PartialBids, 0, len(pbs)+1)
+	inserted = append(inserted, pbs[:i]...)
+	inserted = append(inserted, bid)
+	return append(inserted, pbs[i:]...)
+}
+
+// TotalEscrow returns the total bid coins held for the bids.
+func (pbs PartialBids) TotalEscrow() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, pb := range pbs {
+		total = total.Add(pb.Escrow)
+	}
+	return total
+}
+
+// Validate checks each bid is valid, uses the auction's denoms and that the bids are sorted by price.
+func (pbs PartialBids) Validate(lotDenom, bidDenom string) error {
+	for i, pb := range pbs {
+		if err := pb.Validate(); err != nil {
+			return err
+		}
+		if pb.Lot.Denom != lotDenom {
+			return fmt.Errorf("lot denom %s does not match auction lot denom %s", pb.Lot.Denom, lotDenom)
+		}
+		if pb.Escrow.Denom != bidDenom {
+			return fmt.Errorf("escrow denom %s does not match auction bid denom %s", pb.Escrow.Denom, bidDenom)
+		}
+		if i > 0 && pb.Price.GT(pbs[i-1].Price) {
+			return fmt.Errorf("bids are not sorted by price: %s > %s", pb.Price, pbs[i-1].Price)
+		}
+	}
+	return nil
+}
+
+// PartialBidFill is the amount of a partial bid's lot bought and the bid coins paid for it.
+type PartialBidFill struct {
+	Lot  sdk.Coin `json:"lot" yaml:"lot"`
+	Cost sdk.Coin `json:"cost" yaml:"cost"`
+}
+
+// NewPartialBidFill returns a new PartialBidFill.
+func NewPartialBidFill(lot, cost sdk.Coin) PartialBidFill {
+	return PartialBidFill{
+		Lot:  lot,
+		Cost: cost,
+	}
+}
+
+// IsFilled returns whether any of the bid is filled.
+func (f PartialBidFill) IsFilled() bool {
+	return f.Lot.IsPositive()
+}
+
+// ProxyBid is a standing instruction to bid on a bidder's behalf whenever they are outbid.
+// In the forward phase bids are raised by the minimum increment up to MaxBid, and in the reverse phase the lot is bid down to MinLot.
+// The coins needed to place the bids are held by the auction module until the auction closes.
+type ProxyBid struct {
+	Bidder sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	MaxBid sdk.Coin       `json:"max_bid" yaml:"max_bid"` // highest bid to place in the forward phase
+	MinLot sdk.Coin       `json:"min_lot" yaml:"min_lot"` // smallest lot to bid for in the reverse phase
+	Escrow sdk.Coin       `json:"escrow" yaml:"escrow"`   // bid coins held to pay for bids
+}
+
+// NewProxyBid returns a new ProxyBid.
+func NewProxyBid(bidder sdk.AccAddress, maxBid, minLot, escrow sdk.Coin) ProxyBid {
+	return ProxyBid{
+		Bidder: bidder,
+		MaxBid: maxBid,
+		MinLot: minLot,
+		Escrow: escrow,
+	}
+}
+
+// Validate performs a basic validation of the proxy bid fields.
+func (pb ProxyBid) Validate() error {
+	if pb.Bidder.Empty() {
+		return errors.New("bidder cannot be empty")
+	}
+	if !pb.MaxBid.IsValid() || !pb.MaxBid.IsPositive() {
+		return fmt.Errorf("max bid must be positive: %s", pb.MaxBid)
+	}
+	if !pb.MinLot.IsValid() {
+		return fmt.Errorf("invalid min lot: %s", pb.MinLot)
+	}
+	if !pb.Escrow.IsValid() {
+		return fmt.Errorf("invalid escrow: %s", pb.Escrow)
+	}
+	return nil
+}
+
+func (pb ProxyBid) String() string {
+	return fmt.Sprintf("%s bids up to %s or down to %s, holding %s", pb.Bidder, pb.MaxBid, pb.MinLot, pb.Escrow)
+}
+
+// ProxyBids is a slice of proxy bids, in the order they were first placed.
+type ProxyBids []ProxyBid
+
+// Get returns the proxy bid placed by a bidder.
+func (pbs ProxyBids) Get(bidder sdk.AccAddress) (ProxyBid, bool) {
+	for _, pb := range pbs {
+		if pb.Bidder.Equals(bidder) {
+			return pb, true
+		}
+	}
+	return ProxyBid{}, false
+}
+
+// Set replaces the bidder's existing proxy bid, or adds it to the end if there is none.
+func (pbs ProxyBids) Set(bid ProxyBid) ProxyBids {
+	updated := make(ProxyBids, len(pbs))
+	copy(updated, pbs)
+	for i, pb := range updated {
+		if pb.Bidder.Equals(bid.Bidder) {
+			updated[i] = bid
+			return updated
+		}
+	}
+	return append(updated, bid)
+}
+
+// TotalEscrow returns the total bid coins held for the proxy bids.
+func (pbs ProxyBids) TotalEscrow() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, pb := range pbs {
+		total = total.Add(pb.Escrow)
+	}
+	return total
+}
+
+// Validate checks each proxy bid is valid, uses the auction's denoms and that no bidder has more than one proxy bid.
+func (pbs ProxyBids) Validate(bidDenom, lotDenom string) error {
+	bidders := make(map[string]bool, len(pbs))
+	for _, pb := range pbs {
+		if err := pb.Validate(); err != nil {
+			return err
+		}
+		if pb.MaxBid.Denom != bidDenom || pb.Escrow.Denom != bidDenom {
+			return fmt.Errorf("max bid %s and escrow %s must match auction bid denom %s", pb.MaxBid, pb.Escrow, bidDenom)
+		}
+		if pb.MinLot.Denom != lotDenom {
+			return fmt.Errorf("min lot denom %s does not match auction lot denom %s", pb.MinLot.Denom, lotDenom)
+		}
+		if bidders[pb.Bidder.String()] {
+			return fmt.Errorf("duplicate proxy bid for bidder %s", pb.Bidder)
+		}
+		bidders[pb.Bidder.String()] = true
+	}
+	return nil
+}
+
+// ReservePrice is the lowest price, in units of the bid per unit of the lot, that an auction accepts bids at.
+// It starts at StartPrice and falls over time along DecayCurve.
+type ReservePrice struct {
+	StartPrice sdk.Dec    `json:"start_price" yaml:"start_price"`
+	StartTime  time.Time  `json:"start_time" yaml:"start_time"`
+	DecayCurve DecayCurve `json:"decay_curve" yaml:"decay_curve"`
+}
+
+// NewReservePrice returns a new ReservePrice.
+func NewReservePrice(startPrice sdk.Dec, startTime time.Time, decayCurve DecayCurve) ReservePrice {
+	return ReservePrice{
+		StartPrice: startPrice,
+		StartTime:  startTime,
+		DecayCurve: decayCurve,
+	}
+}
+
+// IsSet returns whether there is a reserve. The reserve of auctions without one is unset, with a nil start price.
+func (r ReservePrice) IsSet() bool {
+	return !r.StartPrice.IsNil() && r.StartPrice.IsPositive()
+}
+
+// CurrentPrice returns the reserve price at a given time, or zero if there is no reserve.
+func (r ReservePrice) CurrentPrice(blockTime time.Time) (sdk.Dec, error) {
+	if !r.IsSet() {
+		return sdk.ZeroDec(), nil
+	}
+	return r.DecayCurve.Price(r.StartPrice, blockTime.Sub(r.StartTime))
+}
+
+// Validate checks the reserve has a positive start price, a start time and a valid decay curve.
+func (r ReservePrice) Validate() error {
+	if !r.IsSet() {
+		return fmt.Errorf("start price must be positive: %s", r.StartPrice)
+	}
+	if r.StartTime.Unix() <= 0 {
+		return errors.New("start time cannot be zero")
+	}
+	if err := r.DecayCurve.Validate(); err != nil {
+		return fmt.Errorf("invalid decay curve: %w", err)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (r ReservePrice) String() string {
+	return fmt.Sprintf("%s at %s, decay %s", r.StartPrice, r.StartTime, r.DecayCurve)
+}
+
+// BidRecord records a bid placed on an auction.
+type BidRecord struct {
+	Bidder sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Bid    sdk.Coin       `json:"bid" yaml:"bid"` // Coins bid. For dutch auctions and partial bids, the coins paid for this bid's lot.
+	Lot    sdk.Coin       `json:"lot" yaml:"lot"` // Lot bid for.
+	Height int64          `json:"height" yaml:"height"`
+	Time   time.Time      `json:"time" yaml:"time"`
+}
+
+// NewBidRecord returns a new BidRecord.
+func NewBidRecord(bidder sdk.AccAddress, bid, lot sdk.Coin, height int64, blockTime time.Time) BidRecord {
+	return BidRecord{
+		Bidder: bidder,
+		Bid:    bid,
+		Lot:    lot,
+		Height: height,
+		Time:   blockTime,
+	}
+}
+
+// Validate performs basic validation of bid record fields.
+func (br BidRecord) Validate() error {
+	if br.Bidder.Empty() {
+		return errors.New("bidder cannot be empty")
+	}
+	if !br.Bid.IsValid() {
+		return fmt.Errorf("invalid bid: %s", br.Bid)
+	}
+	if !br.Lot.IsValid() {
+		return fmt.Errorf("invalid lot: %s", br.Lot)
+	}
+	if br.Height < 0 {
+		return fmt.Errorf("height cannot be negative: %d", br.Height)
+	}
+	return nil
+}
+
+// BidRecords is a slice of bid records, in the order the bids were placed.
+type BidRecords []BidRecord
+
+// Validate validates each bid record.
+func (brs BidRecords) Validate() error {
+	for _, br := range brs {
+		if err := br.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AuctionBidHistory is the bids placed so far on an open auction, which are stored apart from the auction until it closes.
+type AuctionBidHistory struct {
+	AuctionID uint64     `json:"auction_id" yaml:"auction_id"`
+	Bids      BidRecords `json:"bids" yaml:"bids"`
+}
+
+// NewAuctionBidHistory returns a new AuctionBidHistory.
+func NewAuctionBidHistory(auctionID uint64, bids BidRecords) AuctionBidHistory {
+	return AuctionBidHistory{
+		AuctionID: auctionID,
+		Bids:      bids,
+	}
+}
+
+// Validate checks the history has bids and each of them is valid.
+func (h AuctionBidHistory) Validate() error {
+	if len(h.Bids) == 0 {
+		return fmt.Errorf("bid history of auction %d has no bids", h.AuctionID)
+	}
+	if err := h.Bids.Validate(); err != nil {
+		return fmt.Errorf("bid history of auction %d has an invalid bid record: %w", h.AuctionID, err)
+	}
+	return nil
+}
+
+// AuctionBidHistories is a slice of auction bid histories.
+type AuctionBidHistories []AuctionBidHistory
+
+// LotReturn records the lot sent to one of a collateral auction's lot return addresses.
+type LotReturn struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Lot     sdk.Coin       `json:"lot" yaml:"lot"`
+}
+
+// NewLotReturn returns a new LotReturn.
+func NewLotReturn(address sdk.AccAddress, lot sdk.Coin) LotReturn {
+	return LotReturn{
+		Address: address,
+		Lot:     lot,
+	}
+}
+
+// LotReturns is a slice of lot returns, at most one per address.
+type LotReturns []LotReturn
+
+// Add adds lot sent to an address, combining it with any lot already sent there.
+func (lrs LotReturns) Add(address sdk.AccAddress, lot sdk.Coin) LotReturns {
+	for i, lr := range lrs {
+		if lr.Address.Equals(address) {
+			lrs[i].Lot = lr.Lot.Add(lot)
+			return lrs
+		}
+	}
+	return append(lrs, NewLotReturn(address, lot))
+}
+
+// Validate checks each lot return has an address and a valid lot, and there is one per address.
+func (lrs LotReturns) Validate() error {
+	seenAddrs := make(map[string]bool)
+	for _, lr := range lrs {
+		if lr.Address.Empty() {
+			return errors.New("lot return address cannot be empty")
+		}
+		if !lr.Lot.IsValid() {
+			return fmt.Errorf("invalid lot return: %s", lr.Lot)
+		}
+		if seenAddrs[lr.Address.String()] {
+			return fmt.Errorf("duplicate lot return address: %s", lr.Address)
+		}
+		seenAddrs[lr.Address.String()] = true
+	}
+	return nil
+}
+
+// AuctionRecord is the history of a closed auction, kept after the auction is deleted.
+type AuctionRecord struct {
+	AuctionID   uint64         `json:"auction_id" yaml:"auction_id"`
+	AuctionType string         `json:"auction_type" yaml:"auction_type"`
+	Initiator   string         `json:"initiator" yaml:"initiator"`
+	Lot         sdk.Coin       `json:"lot" yaml:"lot"`       // Final lot. For dutch auctions, the lot left unsold.
+	Bid         sdk.Coin       `json:"bid" yaml:"bid"`       // Final bid. For dutch auctions, the total raised.
+	Winner      sdk.AccAddress `json:"winner" yaml:"winner"` // Last bidder. Empty for auctions won by partial bids, whose winners are among the bids.
+	Bids        BidRecords     `json:"bids" yaml:"bids"`
+	LotReturns  LotReturns     `json:"lot_returns" yaml:"lot_returns"` // Lot sent to the lot return addresses of collateral auctions.
+	CloseHeight int64          `json:"close_height" yaml:"close_height"`
+	CloseTime   time.Time      `json:"close_time" yaml:"close_time"`
+}
+
+// NewAuctionRecord returns the record of an auction closing with the bids placed on it.
+func NewAuctionRecord(auction Auction, bids BidRecords, lotReturns LotReturns, closeHeight int64, closeTime time.Time) AuctionRecord {
+	return AuctionRecord{
+		AuctionID:   auction.GetID(),
+		AuctionType: auction.GetType(),
+		Initiator:   auction.GetInitiator(),
+		Lot:         auction.GetLot(),
+		Bid:         auction.GetBid(),
+		Winner:      auction.GetBidder(),
+		Bids:        bids,
+		LotReturns:  lotReturns,
+		CloseHeight: closeHeight,
+		CloseTime:   closeTime,
+	}
+}
+
+// HasBidder returns whether an address bid on the auction.
+func (ar AuctionRecord) HasBidder(bidder sdk.AccAddress) bool {
+	for _, br := range ar.Bids {
+		if br.Bidder.Equals(bidder) {
+			return true
+		}
+	}
+	return false
+}
+
+// Bidders returns the addresses that bid on the auction, without duplicates, in the order of their first bid.
+func (ar AuctionRecord) Bidders() []sdk.AccAddress {
+	seen := make(map[string]bool, len(ar.Bids))
+	var bidders []sdk.AccAddress
+	for _, br := range ar.Bids {
+		if seen[br.Bidder.String()] {
+			continue
+		}
+		seen[br.Bidder.String()] = true
+		bidders = append(bidders, br.Bidder)
+	}
+	return bidders
+}
+
+// Validate performs basic validation of auction record fields.
+func (ar AuctionRecord) Validate() error {
+	if strings.TrimSpace(ar.Initiator) == "" {
+		return fmt.Errorf("auction record %d has a blank initiator", ar.AuctionID)
+	}
+	if strings.TrimSpace(ar.AuctionType) == "" {
+		return fmt.Errorf("auction record %d has a blank auction type", ar.AuctionID)
+	}
+	if !ar.Lot.IsValid() {
+		return fmt.Errorf("auction record %d has an invalid lot: %s", ar.AuctionID, ar.Lot)
+	}
+	if !ar.Bid.IsValid() {
+		return fmt.Errorf("auction record %d has an invalid bid: %s", ar.AuctionID, ar.Bid)
+	}
+	if err := ar.Bids.Validate(); err != nil {
+		return fmt.Errorf("auction record %d has an invalid bid record: %w", ar.AuctionID, err)
+	}
+	if err := ar.LotReturns.Validate(); err != nil {
+		return fmt.Errorf("auction record %d has invalid lot returns: %w", ar.AuctionID, err)
+	}
+	if ar.CloseHeight < 0 {
+		return fmt.Errorf("auction record %d has a negative close height", ar.AuctionID)
+	}
+	if ar.CloseTime.IsZero() {
+		return fmt.Errorf("auction record %d has no close time", ar.AuctionID)
+	}
+	return nil
+}
+
+// AuctionRecords is a slice of auction records.
+type AuctionRecords []AuctionRecord
@@ -467,3 +467,354 @@ func TestNewCollateralAuction(t *testing.T) {
 	require.Equal(t, collateralAuction.LotReturns, weightedAddresses)
 	require.Equal(t, collateralAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
 }
+
+func TestDutchCollateralAuctionValidate(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+
+	now := time.Now()
+	lotReturns, err := NewWeightedAddresses([]sdk.AccAddress{addr1}, []sdk.Int{sdk.NewInt(1)})
+	require.NoError(t, err)
+	validAuction := NewDutchCollateralAuction(
+		testAccAddress1, c("bnb", 10), c("kava", 20), lotReturns, c("debt", 15), d("2.5"), d("1.5"), now, now.Add(DefaultMaxAuctionDuration), DefaultDutchDecayCurve,
+	)
+	validAuction.ID = 1
+
+	tests := []struct {
+		msg     string
+		modify  func(a DutchCollateralAuction) DutchCollateralAuction
+		expPass bool
+	}{
+		{
+			"valid auction",
+			func(a DutchCollateralAuction) DutchCollateralAuction { return a },
+			true,
+		},
+		{
+			"max bid denom does not match bid",
+			func(a DutchCollateralAuction) DutchCollateralAuction {
+				a.MaxBid = c("usdx", 20)
+				return a
+			},
+			false,
+		},
+		{
+			"zero start price",
+			func(a DutchCollateralAuction) DutchCollateralAuction {
+				a.StartPrice = d("0")
+				return a
+			},
+			false,
+		},
+		{
+			"zero floor price",
+			func(a DutchCollateralAuction) DutchCollateralAuction {
+				a.FloorPrice = d("0")
+				return a
+			},
+			false,
+		},
+		{
+			"floor price above start price",
+			func(a DutchCollateralAuction) DutchCollateralAuction {
+				a.FloorPrice = d("3")
+				return a
+			},
+			false,
+		},
+		{
+			"zero start time",
+			func(a DutchCollateralAuction) DutchCollateralAuction {
+				a.StartTime = time.Time{}
+				return a
+			},
+			false,
+		},
+		{
+			"invalid decay curve",
+			func(a DutchCollateralAuction) DutchCollateralAuction {
+				a.DecayCurve = NewLinearDecayCurve(0)
+				return a
+			},
+			false,
+		},
+		{
+			"invalid lot returns",
+			func(a DutchCollateralAuction) DutchCollateralAuction {
+				a.LotReturns = WeightedAddresses{Addresses: []sdk.AccAddress{nil}, Weights: []sdk.Int{sdk.NewInt(1)}}
+				return a
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+
+		err := tc.modify(validAuction).Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestDutchCollateralAuctionCompletion(t *testing.T) {
+	now := time.Now()
+	auction := NewDutchCollateralAuction(
+		TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), c(TestBidDenom, TestBidAmount),
+		WeightedAddresses{}, c(TestDebtDenom, TestDebtAmount1), d("2"), d("0.5"), now, now.Add(2*time.Hour), NewLinearDecayCurve(time.Hour),
+	)
+
+	require.Equal(t, c(TestBidDenom, 0), auction.Bid)
+	require.Equal(t, now.Add(2*time.Hour), auction.GetEndTime())
+	price, err := auction.CurrentPrice(now.Add(30 * time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, d("1"), price)
+	// the price does not decay below the floor price
+	price, err = auction.CurrentPrice(now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, d("0.5"), price)
+	require.False(t, auction.IsComplete())
+
+	auction.DecayCurve.Type = "unknown"
+	_, err = auction.CurrentPrice(now.Add(time.Minute))
+	require.Error(t, err)
+	auction.DecayCurve = NewLinearDecayCurve(time.Hour)
+
+	auction.Bid = c(TestBidDenom, TestBidAmount)
+	require.True(t, auction.IsComplete())
+
+	auction.Bid = c(TestBidDenom, 0)
+	auction.Lot = c(TestLotDenom, 0)
+	require.True(t, auction.IsComplete())
+}
+
+func TestPartialBidsInsert(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	addr2, err := sdk.AccAddressFromBech32(testAccAddress2)
+	require.NoError(t, err)
+
+	bids := PartialBids{}
+	bids = bids.Insert(NewPartialBid(addr1, c(TestLotDenom, 10), d("1"), TestBidDenom))
+	bids = bids.Insert(NewPartialBid(addr2, c(TestLotDenom, 10), d("2"), TestBidDenom))
+	bids = bids.Insert(NewPartialBid(addr2, c(TestLotDenom, 5), d("1"), TestBidDenom))
+
+	require.Len(t, bids, 3)
+	require.Equal(t, d("2"), bids[0].Price)
+	// bids with equal prices keep the order they were placed in
+	require.Equal(t, addr1, bids[1].Bidder)
+	require.Equal(t, addr2, bids[2].Bidder)
+	require.NoError(t, bids.Validate(TestLotDenom, TestBidDenom))
+	require.Equal(t, sdk.NewCoins(c(TestBidDenom, 35)), bids.TotalEscrow())
+
+	require.Error(t, PartialBids{bids[1], bids[0]}.Validate(TestLotDenom, TestBidDenom))
+	require.Error(t, bids.Validate("otherdenom", TestBidDenom))
+	require.Error(t, bids.Validate(TestLotDenom, "otherdenom"))
+}
+
+func TestNewPartialBidEscrow(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+
+	// escrow is rounded up so the bidder always covers the full slice
+	bid := NewPartialBid(addr, c(TestLotDenom, 3), d("1.5"), TestBidDenom)
+	require.Equal(t, c(TestBidDenom, 5), bid.Escrow)
+	require.NoError(t, bid.Validate())
+}
+
+func TestCollateralAuctionPartialBidFills(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	addr2, err := sdk.AccAddressFromBech32(testAccAddress2)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		lot           int64
+		maxBid        int64
+		bids          PartialBids
+		expectedFills []PartialBidFill
+	}{
+		{
+			"lot not sold",
+			100,
+			1000,
+			PartialBids{
+				NewPartialBid(addr1, c(TestLotDenom, 20), d("3"), TestBidDenom),
+				NewPartialBid(addr2, c(TestLotDenom, 30), d("2"), TestBidDenom),
+			},
+			[]PartialBidFill{
+				NewPartialBidFill(c(TestLotDenom, 20), c(TestBidDenom, 60)),
+				NewPartialBidFill(c(TestLotDenom, 30), c(TestBidDenom, 60)),
+			},
+		},
+		{
+			"lot sold",
+			40,
+			1000,
+			PartialBids{
+				NewPartialBid(addr1, c(TestLotDenom, 20), d("3"), TestBidDenom),
+				NewPartialBid(addr2, c(TestLotDenom, 30), d("2"), TestBidDenom),
+				NewPartialBid(addr2, c(TestLotDenom, 10), d("1"), TestBidDenom),
+			},
+			[]PartialBidFill{
+				NewPartialBidFill(c(TestLotDenom, 20), c(TestBidDenom, 60)),
+				NewPartialBidFill(c(TestLotDenom, 20), c(TestBidDenom, 40)),
+				NewPartialBidFill(c(TestLotDenom, 0), c(TestBidDenom, 0)),
+			},
+		},
+		{
+			"max bid raised",
+			100,
+			90,
+			PartialBids{
+				NewPartialBid(addr1, c(TestLotDenom, 20), d("3"), TestBidDenom),
+				NewPartialBid(addr2, c(TestLotDenom, 30), d("2"), TestBidDenom),
+				NewPartialBid(addr2, c(TestLotDenom, 10), d("1"), TestBidDenom),
+			},
+			[]PartialBidFill{
+				NewPartialBidFill(c(TestLotDenom, 20), c(TestBidDenom, 60)),
+				NewPartialBidFill(c(TestLotDenom, 15), c(TestBidDenom, 30)),
+				NewPartialBidFill(c(TestLotDenom, 0), c(TestBidDenom, 0)),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			auction := NewCollateralAuction(
+				TestInitiatorModuleName, c(TestLotDenom, tc.lot), time.Now(), c(TestBidDenom, tc.maxBid),
+				WeightedAddresses{}, c(TestDebtDenom, TestDebtAmount1),
+			)
+			auction.PartialBids = tc.bids
+			fills := auction.PartialBidFills()
+			require.Len(t, fills, len(tc.expectedFills))
+			for i, fill := range fills {
+				require.True(t, tc.expectedFills[i].Lot.IsEqual(fill.Lot), "expected %s, got %s", tc.expectedFills[i].Lot, fill.Lot)
+				require.True(t, tc.expectedFills[i].Cost.IsEqual(fill.Cost), "expected %s, got %s", tc.expectedFills[i].Cost, fill.Cost)
+				require.Equal(t, tc.expectedFills[i].IsFilled(), fill.IsFilled())
+			}
+		})
+	}
+}
+
+func TestProxyBids(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	addr2, err := sdk.AccAddressFromBech32(testAccAddress2)
+	require.NoError(t, err)
+
+	bids := ProxyBids{}
+	bids = bids.Set(NewProxyBid(addr1, c(TestBidDenom, 20), c(TestLotDenom, 50), c(TestBidDenom, 20)))
+	bids = bids.Set(NewProxyBid(addr2, c(TestBidDenom, 15), c(TestLotDenom, 100), c(TestBidDenom, 15)))
+	// replacing a bid keeps its place
+	bids = bids.Set(NewProxyBid(addr1, c(TestBidDenom, 25), c(TestLotDenom, 40), c(TestBidDenom, 5)))
+
+	require.Len(t, bids, 2)
+	require.Equal(t, addr1, bids[0].Bidder)
+	bid, found := bids.Get(addr1)
+	require.True(t, found)
+	require.Equal(t, c(TestBidDenom, 25), bid.MaxBid)
+	require.Equal(t, sdk.NewCoins(c(TestBidDenom, 20)), bids.TotalEscrow())
+	require.NoError(t, bids.Validate(TestBidDenom, TestLotDenom))
+
+	require.Error(t, append(bids, bids[0]).Validate(TestBidDenom, TestLotDenom))
+	require.Error(t, bids.Validate("otherdenom", TestLotDenom))
+	require.Error(t, bids.Validate(TestBidDenom, "otherdenom"))
+	require.Error(t, ProxyBids{NewProxyBid(addr1, c(TestBidDenom, 0), c(TestLotDenom, 50), c(TestBidDenom, 0))}.Validate(TestBidDenom, TestLotDenom))
+}
+
+func TestReservePrice(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	reserve := NewReservePrice(d("2"), now, NewLinearDecayCurve(time.Hour))
+
+	require.True(t, reserve.IsSet())
+	requirePrice := func(expected sdk.Dec, reserve ReservePrice, blockTime time.Time) {
+		price, err := reserve.CurrentPrice(blockTime)
+		require.NoError(t, err)
+		require.Equal(t, expected, price)
+	}
+	requirePrice(d("2"), reserve, now)
+	requirePrice(d("1"), reserve, now.Add(30*time.Minute))
+	requirePrice(d("0"), reserve, now.Add(2*time.Hour))
+	require.NoError(t, reserve.Validate())
+
+	// auctions without a reserve have an unset reserve price
+	require.False(t, ReservePrice{}.IsSet())
+	requirePrice(d("0"), ReservePrice{}, now)
+	require.False(t, NewSurplusAuction(TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), TestBidDenom, now).GetReservePrice().IsSet())
+
+	require.Error(t, NewReservePrice(d("0"), now, NewLinearDecayCurve(time.Hour)).Validate())
+	require.Error(t, NewReservePrice(d("2"), time.Time{}, NewLinearDecayCurve(time.Hour)).Validate())
+	require.Error(t, NewReservePrice(d("2"), now, DecayCurve{}).Validate())
+
+	// dutch collateral auctions can have a reserve
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	lotReturns, err := NewWeightedAddresses([]sdk.AccAddress{addr1}, []sdk.Int{sdk.NewInt(1)})
+	require.NoError(t, err)
+	dutch := NewDutchCollateralAuction(
+		TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), c(TestBidDenom, TestBidAmount), lotReturns,
+		c(TestDebtDenom, TestDebtAmount1), d("2"), d("1"), now, now.Add(2*time.Hour), NewLinearDecayCurve(time.Hour),
+	)
+	dutch.ID = 1
+	dutch.ReservePrice = &reserve
+	require.NoError(t, dutch.Validate())
+	dutch.ReservePrice = &ReservePrice{}
+	require.Error(t, dutch.Validate())
+}
+
+func TestLotReturns(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	addr2, err := sdk.AccAddressFromBech32(testAccAddress2)
+	require.NoError(t, err)
+
+	var lotReturns LotReturns
+	lotReturns = lotReturns.Add(addr1, c(TestLotDenom, 10))
+	lotReturns = lotReturns.Add(addr2, c(TestLotDenom, 5))
+	// returns to the same address are combined
+	lotReturns = lotReturns.Add(addr1, c(TestLotDenom, 7))
+
+	require.Equal(t, LotReturns{NewLotReturn(addr1, c(TestLotDenom, 17)), NewLotReturn(addr2, c(TestLotDenom, 5))}, lotReturns)
+	require.NoError(t, lotReturns.Validate())
+
+	require.Error(t, append(lotReturns, NewLotReturn(addr2, c(TestLotDenom, 1))).Validate())
+	require.Error(t, LotReturns{NewLotReturn(nil, c(TestLotDenom, 1))}.Validate())
+}
+
+func TestAuctionRecord(t *testing.T) {
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	addr2, err := sdk.AccAddressFromBech32(testAccAddress2)
+	require.NoError(t, err)
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	auction := NewSurplusAuction(TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), TestBidDenom, now).WithID(TestAuctionID).(SurplusAuction)
+	auction.Bidder = addr1
+	auction.Bid = c(TestBidDenom, TestBidAmount)
+	bids := BidRecords{NewBidRecord(addr1, c(TestBidDenom, TestBidAmount), c(TestLotDenom, TestLotAmount), 5, now)}
+
+	record := NewAuctionRecord(auction, bids, nil, 10, now.Add(time.Hour))
+	require.Equal(t, uint64(TestAuctionID), record.AuctionID)
+	require.Equal(t, SurplusAuctionType, record.AuctionType)
+	require.Equal(t, TestInitiatorModuleName, record.Initiator)
+	require.Equal(t, addr1, record.Winner)
+	require.Equal(t, bids, record.Bids)
+	require.True(t, record.HasBidder(addr1))
+	require.False(t, record.HasBidder(addr2))
+	require.NoError(t, record.Validate())
+
+	invalid := record
+	invalid.Initiator = ""
+	require.Error(t, invalid.Validate())
+	invalid = record
+	invalid.CloseTime = time.Time{}
+	require.Error(t, invalid.Validate())
+	invalid = record
+	invalid.Bids = BidRecords{NewBidRecord(nil, c(TestBidDenom, TestBidAmount), c(TestLotDenom, TestLotAmount), 5, now)}
+	require.Error(t, invalid.Validate())
+}
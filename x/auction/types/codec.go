@@ -14,10 +14,13 @@ func init() {
 // RegisterCodec registers concrete types on the codec.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgPlaceBid{}, "auction/MsgPlaceBid", nil)
+	cdc.RegisterConcrete(MsgPlacePartialBid{}, "auction/MsgPlacePartialBid", nil)
+	cdc.RegisterConcrete(MsgPlaceProxyBid{}, "auction/MsgPlaceProxyBid", nil)
 
 	cdc.RegisterInterface((*GenesisAuction)(nil), nil)
 	cdc.RegisterInterface((*Auction)(nil), nil)
 	cdc.RegisterConcrete(SurplusAuction{}, "auction/SurplusAuction", nil)
 	cdc.RegisterConcrete(DebtAuction{}, "auction/DebtAuction", nil)
 	cdc.RegisterConcrete(CollateralAuction{}, "auction/CollateralAuction", nil)
+	cdc.RegisterConcrete(DutchCollateralAuction{}, "auction/DutchCollateralAuction", nil)
 }
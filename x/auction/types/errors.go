@@ -27,4 +27,14 @@ var (
 	ErrLotTooSmall = sdkerrors.Register(ModuleName, 11, "lot is not greater than auction's min new lot amount")
 	// ErrLotTooLarge error for when lot is not smaller than auction's max new lot amount
 	ErrLotTooLarge = sdkerrors.Register(ModuleName, 12, "lot is greater than auction's max new lot amount")
+	// ErrInvalidStartPrice error for when a dutch auction is started without a positive price
+	ErrInvalidStartPrice = sdkerrors.Register(ModuleName, 13, "auction start price must be positive")
+	// ErrMixedBidTypes error for when a bid for the whole lot and partial bids are placed on the same auction
+	ErrMixedBidTypes = sdkerrors.Register(ModuleName, 14, "auction cannot have both bids for the whole lot and partial bids")
+	// ErrPartialBidsNotSupported error for when a partial bid is placed on an auction type that does not accept them
+	ErrPartialBidsNotSupported = sdkerrors.Register(ModuleName, 15, "auction type does not accept partial bids")
+	// ErrProxyBidsNotSupported error for when a proxy bid is placed on an auction type that does not accept them
+	ErrProxyBidsNotSupported = sdkerrors.Register(ModuleName, 16, "auction type does not accept proxy bids")
+	// ErrBidBelowReserve error for when a bid is at a price below the auction's reserve price
+	ErrBidBelowReserve = sdkerrors.Register(ModuleName, 17, "bid is below the reserve price")
 )
@@ -2,9 +2,10 @@ package types
 
 // Events for the module
 const (
-	EventTypeAuctionStart = "auction_start"
-	EventTypeAuctionBid   = "auction_bid"
-	EventTypeAuctionClose = "auction_close"
+	EventTypeAuctionStart    = "auction_start"
+	EventTypeAuctionBid      = "auction_bid"
+	EventTypeAuctionProxyBid = "auction_proxy_bid"
+	EventTypeAuctionClose    = "auction_close"
 
 	AttributeValueCategory  = ModuleName
 	AttributeKeyAuctionID   = "auction_id"
@@ -12,7 +13,9 @@ const (
 	AttributeKeyBidder      = "bidder"
 	AttributeKeyLot         = "lot"
 	AttributeKeyMaxBid      = "max_bid"
+	AttributeKeyMinLot      = "min_lot"
 	AttributeKeyBid         = "bid"
 	AttributeKeyEndTime     = "end_time"
 	AttributeKeyCloseBlock  = "close_block"
+	AttributeKeyPrice       = "price"
 )
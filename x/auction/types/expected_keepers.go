@@ -17,3 +17,9 @@ type SupplyKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
+
+// PriceReference defines the expected interface of modules that start auctions and can price their lots
+type PriceReference interface {
+	// GetAuctionReferencePrice returns the oracle price of one unit of the lot denom in units of the bid denom
+	GetAuctionReferencePrice(ctx sdk.Context, lotDenom, bidDenom string) (sdk.Dec, bool)
+}
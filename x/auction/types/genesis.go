@@ -22,17 +22,21 @@ type GenesisAuctions []GenesisAuction
 
 // GenesisState is auction state that must be provided at chain genesis.
 type GenesisState struct {
-	NextAuctionID uint64          `json:"next_auction_id" yaml:"next_auction_id"`
-	Params        Params          `json:"params" yaml:"params"`
-	Auctions      GenesisAuctions `json:"auctions" yaml:"auctions"`
+	NextAuctionID uint64              `json:"next_auction_id" yaml:"next_auction_id"`
+	Params        Params              `json:"params" yaml:"params"`
+	Auctions      GenesisAuctions     `json:"auctions" yaml:"auctions"`
+	Bids          AuctionBidHistories `json:"bids" yaml:"bids"`
+	Records       AuctionRecords      `json:"records" yaml:"records"`
 }
 
 // NewGenesisState returns a new genesis state object for auctions module.
-func NewGenesisState(nextID uint64, ap Params, ga GenesisAuctions) GenesisState {
+func NewGenesisState(nextID uint64, ap Params, ga GenesisAuctions, bids AuctionBidHistories, records AuctionRecords) GenesisState {
 	return GenesisState{
 		NextAuctionID: nextID,
 		Params:        ap,
 		Auctions:      ga,
+		Bids:          bids,
+		Records:       records,
 	}
 }
 
@@ -42,6 +46,8 @@ func DefaultGenesisState() GenesisState {
 		DefaultNextAuctionID,
 		DefaultParams(),
 		GenesisAuctions{},
+		AuctionBidHistories{},
+		AuctionRecords{},
 	)
 }
 
@@ -79,5 +85,43 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("found auction ID ≥ the nextAuctionID (%d ≥ %d)", a.GetID(), gs.NextAuctionID)
 		}
 	}
+
+	bidIDs := map[uint64]bool{}
+	for _, h := range gs.Bids {
+
+		if err := h.Validate(); err != nil {
+			return fmt.Errorf("found invalid bid history: %w", err)
+		}
+
+		if bidIDs[h.AuctionID] {
+			return fmt.Errorf("found duplicate bid history auction ID (%d)", h.AuctionID)
+		}
+		bidIDs[h.AuctionID] = true
+
+		if !ids[h.AuctionID] {
+			return fmt.Errorf("found bid history for auction that is not open (%d)", h.AuctionID)
+		}
+	}
+
+	recordIDs := map[uint64]bool{}
+	for _, r := range gs.Records {
+
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("found invalid auction record: %w", err)
+		}
+
+		if recordIDs[r.AuctionID] {
+			return fmt.Errorf("found duplicate auction record ID (%d)", r.AuctionID)
+		}
+		recordIDs[r.AuctionID] = true
+
+		if ids[r.AuctionID] {
+			return fmt.Errorf("found record for open auction (%d)", r.AuctionID)
+		}
+
+		if r.AuctionID >= gs.NextAuctionID {
+			return fmt.Errorf("found auction record ID ≥ the nextAuctionID (%d ≥ %d)", r.AuctionID, gs.NextAuctionID)
+		}
+	}
 	return nil
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -32,7 +33,7 @@ func TestGenesisState_Validate(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			gs := NewGenesisState(tc.nextID, DefaultParams(), tc.auctions)
+			gs := NewGenesisState(tc.nextID, DefaultParams(), tc.auctions, AuctionBidHistories{}, AuctionRecords{})
 
 			err := gs.Validate()
 
@@ -45,3 +46,85 @@ func TestGenesisState_Validate(t *testing.T) {
 	}
 
 }
+
+func TestGenesisState_ValidateRecords(t *testing.T) {
+	record := AuctionRecord{
+		AuctionID:   5,
+		AuctionType: SurplusAuctionType,
+		Initiator:   TestInitiatorModuleName,
+		Lot:         c(TestLotDenom, TestLotAmount),
+		Bid:         c(TestBidDenom, TestBidAmount),
+		CloseHeight: 10,
+		CloseTime:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	invalidRecord := record
+	invalidRecord.CloseTime = time.Time{}
+
+	testCases := []struct {
+		name       string
+		nextID     uint64
+		auctions   GenesisAuctions
+		records    AuctionRecords
+		expectPass bool
+	}{
+		{"valid", 10, GenesisAuctions{}, AuctionRecords{record}, true},
+		{"invalid record", 10, GenesisAuctions{}, AuctionRecords{invalidRecord}, false},
+		{"record ID ≥ next ID", 5, GenesisAuctions{}, AuctionRecords{record}, false},
+		{"repeated record ID", 10, GenesisAuctions{}, AuctionRecords{record, record}, false},
+		{
+			"record for open auction",
+			10,
+			GenesisAuctions{NewSurplusAuction(TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), TestBidDenom, record.CloseTime).WithID(5).(GenesisAuction)},
+			AuctionRecords{record},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := NewGenesisState(tc.nextID, DefaultParams(), tc.auctions, AuctionBidHistories{}, tc.records)
+
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisState_ValidateBids(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	openAuction := NewSurplusAuction(TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), TestBidDenom, now).WithID(5).(GenesisAuction)
+	history := NewAuctionBidHistory(5, BidRecords{NewBidRecord(addr, c(TestBidDenom, TestBidAmount), c(TestLotDenom, TestLotAmount), 1, now)})
+
+	testCases := []struct {
+		name       string
+		bids       AuctionBidHistories
+		expectPass bool
+	}{
+		{"valid", AuctionBidHistories{history}, true},
+		{"no bids", AuctionBidHistories{NewAuctionBidHistory(5, BidRecords{})}, false},
+		{"invalid bid", AuctionBidHistories{NewAuctionBidHistory(5, BidRecords{NewBidRecord(nil, c(TestBidDenom, TestBidAmount), c(TestLotDenom, TestLotAmount), 1, now)})}, false},
+		{"repeated auction ID", AuctionBidHistories{history, history}, false},
+		{"auction not open", AuctionBidHistories{NewAuctionBidHistory(6, history.Bids)}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := NewGenesisState(10, DefaultParams(), GenesisAuctions{openAuction}, tc.bids, AuctionRecords{})
+
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -30,6 +30,11 @@ var (
 	AuctionByTimeKeyPrefix = []byte{0x01} // prefix for keys that are part of the auctionsByTime index
 
 	NextAuctionIDKey = []byte{0x02} // key for the next auction id
+
+	AuctionRecordKeyPrefix         = []byte{0x03} // prefix for keys that store the records of closed auctions
+	AuctionRecordByTimeKeyPrefix   = []byte{0x04} // prefix for keys that are part of the auction records by close time index
+	BidRecordKeyPrefix             = []byte{0x05} // prefix for keys that store the bids placed on open auctions
+	AuctionRecordByBidderKeyPrefix = []byte{0x06} // prefix for keys that are part of the auction records by bidder index
 )
 
 // GetAuctionKey returns the bytes of an auction key
@@ -42,6 +47,21 @@ func GetAuctionByTimeKey(endTime time.Time, auctionID uint64) []byte {
 	return append(sdk.FormatTimeBytes(endTime), Uint64ToBytes(auctionID)...)
 }
 
+// GetAuctionRecordByTimeKey returns the key for iterating auction records by close time
+func GetAuctionRecordByTimeKey(closeTime time.Time, auctionID uint64) []byte {
+	return append(sdk.FormatTimeBytes(closeTime), Uint64ToBytes(auctionID)...)
+}
+
+// GetAuctionRecordByBidderKey returns the key for iterating the records of auctions an address bid on
+func GetAuctionRecordByBidderKey(bidder sdk.AccAddress, auctionID uint64) []byte {
+	return append(append([]byte{}, bidder.Bytes()...), Uint64ToBytes(auctionID)...)
+}
+
+// GetBidRecordKey returns the key for a bid placed on an auction, ordered by auction ID then by the order the bids were placed
+func GetBidRecordKey(auctionID, index uint64) []byte {
+	return append(Uint64ToBytes(auctionID), Uint64ToBytes(index)...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func Uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -9,7 +9,11 @@ import (
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPlaceBid{}
+var (
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgPlacePartialBid{}
+	_ sdk.Msg = &MsgPlaceProxyBid{}
+)
 
 // MsgPlaceBid is the message type used to place a bid on any type of auction.
 type MsgPlaceBid struct {
@@ -69,3 +73,134 @@ func (msg MsgPlaceBid) String() string {
 	Amount: %s
 `, msg.AuctionID, msg.Bidder, msg.Amount)
 }
+
+// MsgPlacePartialBid is the message type used to bid for a slice of a collateral auction's lot at a price.
+type MsgPlacePartialBid struct {
+	AuctionID uint64         `json:"auction_id" yaml:"auction_id"`
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Lot       sdk.Coin       `json:"lot" yaml:"lot"`     // The slice of the lot bid for.
+	Price     sdk.Dec        `json:"price" yaml:"price"` // The bid coins offered per unit of lot.
+}
+
+// NewMsgPlacePartialBid returns a new MsgPlacePartialBid.
+func NewMsgPlacePartialBid(auctionID uint64, bidder sdk.AccAddress, lot sdk.Coin, price sdk.Dec) MsgPlacePartialBid {
+	return MsgPlacePartialBid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		Lot:       lot,
+		Price:     price,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlacePartialBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlacePartialBid) Type() string { return "place_partial_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgPlacePartialBid) ValidateBasic() error {
+	if msg.AuctionID == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty")
+	}
+	if len(msg.Bidder) != sdk.AddrLen {
+		return fmt.Errorf("the expected bidder address length is %d, actual length is %d", sdk.AddrLen, len(msg.Bidder))
+	}
+	if !msg.Lot.IsValid() || !msg.Lot.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "lot amount %s", msg.Lot)
+	}
+	if msg.Price.IsNil() || !msg.Price.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "price must be positive %s", msg.Price)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlacePartialBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlacePartialBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+func (msg MsgPlacePartialBid) String() string {
+	// String implements the Stringer interface
+	return fmt.Sprintf(`Place Partial Bid Message:
+	Auction ID:         %d
+	Bidder: %s
+	Lot: %s
+	Price: %s
+`, msg.AuctionID, msg.Bidder, msg.Lot, msg.Price)
+}
+
+// MsgPlaceProxyBid is the message type used to have bids placed automatically on an auction whenever the bidder is outbid.
+type MsgPlaceProxyBid struct {
+	AuctionID uint64         `json:"auction_id" yaml:"auction_id"`
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	MaxBid    sdk.Coin       `json:"max_bid" yaml:"max_bid"` // The highest bid to place in the forward phase.
+	MinLot    sdk.Coin       `json:"min_lot" yaml:"min_lot"` // The smallest lot to bid for in the reverse phase. Optional for forward auctions.
+}
+
+// NewMsgPlaceProxyBid returns a new MsgPlaceProxyBid.
+func NewMsgPlaceProxyBid(auctionID uint64, bidder sdk.AccAddress, maxBid, minLot sdk.Coin) MsgPlaceProxyBid {
+	return MsgPlaceProxyBid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		MaxBid:    maxBid,
+		MinLot:    minLot,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlaceProxyBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlaceProxyBid) Type() string { return "place_proxy_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgPlaceProxyBid) ValidateBasic() error {
+	if msg.AuctionID == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty")
+	}
+	if len(msg.Bidder) != sdk.AddrLen {
+		return fmt.Errorf("the expected bidder address length is %d, actual length is %d", sdk.AddrLen, len(msg.Bidder))
+	}
+	if !msg.MaxBid.IsValid() || !msg.MaxBid.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "max bid amount %s", msg.MaxBid)
+	}
+	// min lot can be left empty to only bid in the forward phase
+	if msg.MinLot.Denom != "" && !msg.MinLot.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "min lot amount %s", msg.MinLot)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlaceProxyBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlaceProxyBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+func (msg MsgPlaceProxyBid) String() string {
+	// String implements the Stringer interface
+	return fmt.Sprintf(`Place Proxy Bid Message:
+	Auction ID:         %d
+	Bidder: %s
+	Max Bid: %s
+	Min Lot: %s
+`, msg.AuctionID, msg.Bidder, msg.MaxBid, msg.MinLot)
+}
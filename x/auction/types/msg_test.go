@@ -57,3 +57,103 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgPlacePartialBid_ValidateBasic(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		msg        MsgPlacePartialBid
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgPlacePartialBid(1, addr, c("token", 10), d("1.5")),
+			true,
+		},
+		{
+			"zero id",
+			NewMsgPlacePartialBid(0, addr, c("token", 10), d("1.5")),
+			false,
+		},
+		{
+			"empty address",
+			NewMsgPlacePartialBid(1, nil, c("token", 10), d("1.5")),
+			false,
+		},
+		{
+			"zero lot",
+			NewMsgPlacePartialBid(1, addr, c("token", 0), d("1.5")),
+			false,
+		},
+		{
+			"zero price",
+			NewMsgPlacePartialBid(1, addr, c("token", 10), d("0")),
+			false,
+		},
+		{
+			"nil price",
+			NewMsgPlacePartialBid(1, addr, c("token", 10), sdk.Dec{}),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestMsgPlaceProxyBid_ValidateBasic(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		msg        MsgPlaceProxyBid
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgPlaceProxyBid(1, addr, c("token", 10), c("lot", 5)),
+			true,
+		},
+		{
+			"no min lot",
+			NewMsgPlaceProxyBid(1, addr, c("token", 10), sdk.Coin{}),
+			true,
+		},
+		{
+			"zero id",
+			NewMsgPlaceProxyBid(0, addr, c("token", 10), c("lot", 5)),
+			false,
+		},
+		{
+			"empty address",
+			NewMsgPlaceProxyBid(1, nil, c("token", 10), c("lot", 5)),
+			false,
+		},
+		{
+			"zero max bid",
+			NewMsgPlaceProxyBid(1, addr, c("token", 0), c("lot", 5)),
+			false,
+		},
+		{
+			"negative min lot",
+			NewMsgPlaceProxyBid(1, addr, c("token", 10), sdk.Coin{Denom: "lot", Amount: sdk.NewInt(-5)}),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
@@ -19,38 +19,66 @@ const (
 	DefaultMaxAuctionDuration time.Duration = 2 * 24 * time.Hour
 	// DefaultBidDuration how long an auction gets extended when someone bids
 	DefaultBidDuration time.Duration = 1 * time.Hour
+	// DefaultRecordRetention keeps the records of closed auctions forever
+	DefaultRecordRetention time.Duration = 0
+
+	LinearDecayCurve      = "linear"
+	ExponentialDecayCurve = "exponential"
 )
 
 var (
 	// DefaultIncrement is the smallest percent change a new bid must have from the old one
 	DefaultIncrement sdk.Dec = sdk.MustNewDecFromStr("0.05")
+	// DefaultDutchStartMarkup is the multiple of the oracle price that dutch auctions start at
+	DefaultDutchStartMarkup sdk.Dec = sdk.MustNewDecFromStr("1.2")
+	// DefaultDutchDecayCurve cuts the price of dutch auctions by 1% every 90 seconds
+	DefaultDutchDecayCurve = NewExponentialDecayCurve(90*time.Second, sdk.MustNewDecFromStr("0.01"))
+	// DefaultDutchPriceFloor stops the price of dutch auctions falling below 80% of the oracle price
+	DefaultDutchPriceFloor sdk.Dec = sdk.MustNewDecFromStr("0.8")
+	// DefaultReservePrices is empty, so bids are only limited by the increments
+	DefaultReservePrices ReservePriceParams
 	// ParamStoreKeyParams Param store key for auction params
 	KeyBidDuration         = []byte("BidDuration")
 	KeyMaxAuctionDuration  = []byte("MaxAuctionDuration")
 	KeyIncrementSurplus    = []byte("IncrementSurplus")
 	KeyIncrementDebt       = []byte("IncrementDebt")
 	KeyIncrementCollateral = []byte("IncrementCollateral")
+	KeyDutchStartMarkup    = []byte("DutchStartMarkup")
+	KeyDutchDecayCurve     = []byte("DutchDecayCurve")
+	KeyDutchPriceFloor     = []byte("DutchPriceFloor")
+	KeyReservePrices       = []byte("ReservePrices")
+	KeyRecordRetention     = []byte("RecordRetention")
 )
 
 var _ subspace.ParamSet = &Params{}
 
 // Params is the governance parameters for the auction module.
 type Params struct {
-	MaxAuctionDuration  time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
-	BidDuration         time.Duration `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
-	IncrementSurplus    sdk.Dec       `json:"increment_surplus" yaml:"increment_surplus"`       // percentage change (of auc.Bid) required for a new bid on a surplus auction
-	IncrementDebt       sdk.Dec       `json:"increment_debt" yaml:"increment_debt"`             // percentage change (of auc.Lot) required for a new bid on a debt auction
-	IncrementCollateral sdk.Dec       `json:"increment_collateral" yaml:"increment_collateral"` // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	MaxAuctionDuration  time.Duration      `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
+	BidDuration         time.Duration      `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
+	IncrementSurplus    sdk.Dec            `json:"increment_surplus" yaml:"increment_surplus"`       // percentage change (of auc.Bid) required for a new bid on a surplus auction
+	IncrementDebt       sdk.Dec            `json:"increment_debt" yaml:"increment_debt"`             // percentage change (of auc.Lot) required for a new bid on a debt auction
+	IncrementCollateral sdk.Dec            `json:"increment_collateral" yaml:"increment_collateral"` // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	DutchStartMarkup    sdk.Dec            `json:"dutch_start_markup" yaml:"dutch_start_markup"`     // multiple of the oracle price that dutch collateral auctions start at
+	DutchDecayCurve     DecayCurve         `json:"dutch_decay_curve" yaml:"dutch_decay_curve"`       // how the price of new dutch collateral auctions falls over time
+	DutchPriceFloor     sdk.Dec            `json:"dutch_price_floor" yaml:"dutch_price_floor"`       // fraction of the oracle price that the price of dutch collateral auctions does not fall below
+	ReservePrices       ReservePriceParams `json:"reserve_prices" yaml:"reserve_prices"`             // reserve prices of new auctions, by auction type
+	RecordRetention     time.Duration      `json:"record_retention" yaml:"record_retention"`         // how long the records of closed auctions are kept, zero to keep them forever
 }
 
 // NewParams returns a new Params object.
-func NewParams(maxAuctionDuration, bidDuration time.Duration, incrementSurplus, incrementDebt, incrementCollateral sdk.Dec) Params {
+func NewParams(maxAuctionDuration, bidDuration time.Duration, incrementSurplus, incrementDebt, incrementCollateral, dutchStartMarkup sdk.Dec, dutchDecayCurve DecayCurve, dutchPriceFloor sdk.Dec, reservePrices ReservePriceParams, recordRetention time.Duration) Params {
 	return Params{
 		MaxAuctionDuration:  maxAuctionDuration,
 		BidDuration:         bidDuration,
 		IncrementSurplus:    incrementSurplus,
 		IncrementDebt:       incrementDebt,
 		IncrementCollateral: incrementCollateral,
+		DutchStartMarkup:    dutchStartMarkup,
+		DutchDecayCurve:     dutchDecayCurve,
+		DutchPriceFloor:     dutchPriceFloor,
+		ReservePrices:       reservePrices,
+		RecordRetention:     recordRetention,
 	}
 }
 
@@ -62,6 +90,11 @@ func DefaultParams() Params {
 		DefaultIncrement,
 		DefaultIncrement,
 		DefaultIncrement,
+		DefaultDutchStartMarkup,
+		DefaultDutchDecayCurve,
+		DefaultDutchPriceFloor,
+		DefaultReservePrices,
+		DefaultRecordRetention,
 	)
 }
 
@@ -78,6 +111,11 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		params.NewParamSetPair(KeyIncrementSurplus, &p.IncrementSurplus, validateIncrementSurplusParam),
 		params.NewParamSetPair(KeyIncrementDebt, &p.IncrementDebt, validateIncrementDebtParam),
 		params.NewParamSetPair(KeyIncrementCollateral, &p.IncrementCollateral, validateIncrementCollateralParam),
+		params.NewParamSetPair(KeyDutchStartMarkup, &p.DutchStartMarkup, validateDutchStartMarkupParam),
+		params.NewParamSetPair(KeyDutchDecayCurve, &p.DutchDecayCurve, validateDutchDecayCurveParam),
+		params.NewParamSetPair(KeyDutchPriceFloor, &p.DutchPriceFloor, validateDutchPriceFloorParam),
+		params.NewParamSetPair(KeyReservePrices, &p.ReservePrices, validateReservePricesParam),
+		params.NewParamSetPair(KeyRecordRetention, &p.RecordRetention, validateRecordRetentionParam),
 	}
 }
 
@@ -95,8 +133,14 @@ func (p Params) String() string {
 	Bid Duration: %s
 	Increment Surplus: %s
 	Increment Debt: %s
-	Increment Collateral: %s`,
-		p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral)
+	Increment Collateral: %s
+	Dutch Start Markup: %s
+	Dutch Decay Curve: %s
+	Dutch Price Floor: %s
+	Reserve Prices: %s
+	Record Retention: %s`,
+		p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral,
+		p.DutchStartMarkup, p.DutchDecayCurve, p.DutchPriceFloor, p.ReservePrices, p.RecordRetention)
 }
 
 // Validate checks that the parameters have valid values.
@@ -121,7 +165,27 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateIncrementCollateralParam(p.IncrementCollateral)
+	if err := validateIncrementCollateralParam(p.IncrementCollateral); err != nil {
+		return err
+	}
+
+	if err := validateDutchStartMarkupParam(p.DutchStartMarkup); err != nil {
+		return err
+	}
+
+	if err := validateDutchDecayCurveParam(p.DutchDecayCurve); err != nil {
+		return err
+	}
+
+	if err := validateDutchPriceFloorParam(p.DutchPriceFloor); err != nil {
+		return err
+	}
+
+	if err := validateReservePricesParam(p.ReservePrices); err != nil {
+		return err
+	}
+
+	return validateRecordRetentionParam(p.RecordRetention)
 }
 
 func validateBidDurationParam(i interface{}) error {
@@ -200,3 +264,220 @@ func validateIncrementCollateralParam(i interface{}) error {
 
 	return nil
 }
+
+func validateDutchStartMarkupParam(i interface{}) error {
+	dutchStartMarkup, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if dutchStartMarkup == emptyDec || dutchStartMarkup.IsNil() {
+		return errors.New("dutch auction start markup cannot be nil or empty")
+	}
+
+	if dutchStartMarkup.LT(sdk.OneDec()) {
+		return fmt.Errorf("dutch auction start markup cannot be less than one %s", dutchStartMarkup)
+	}
+
+	return nil
+}
+
+func validateDutchDecayCurveParam(i interface{}) error {
+	dutchDecayCurve, ok := i.(DecayCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return dutchDecayCurve.Validate()
+}
+
+func validateDutchPriceFloorParam(i interface{}) error {
+	dutchPriceFloor, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if dutchPriceFloor == emptyDec || dutchPriceFloor.IsNil() {
+		return errors.New("dutch auction price floor cannot be nil or empty")
+	}
+
+	if !dutchPriceFloor.IsPositive() || dutchPriceFloor.GT(sdk.OneDec()) {
+		return fmt.Errorf("dutch auction price floor must be greater than zero and at most one %s", dutchPriceFloor)
+	}
+
+	return nil
+}
+
+func validateReservePricesParam(i interface{}) error {
+	reservePrices, ok := i.(ReservePriceParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return reservePrices.Validate()
+}
+
+func validateRecordRetentionParam(i interface{}) error {
+	recordRetention, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if recordRetention < 0 {
+		return fmt.Errorf("record retention cannot be negative %d", recordRetention)
+	}
+
+	return nil
+}
+
+// DecayCurve defines how the price of a dutch collateral auction falls over time.
+// Linear curves fall to zero after Duration. Exponential curves cut the price by Cut every Duration.
+type DecayCurve struct {
+	Type     string        `json:"type" yaml:"type"`
+	Duration time.Duration `json:"duration" yaml:"duration"`
+	Cut      sdk.Dec       `json:"cut" yaml:"cut"`
+}
+
+// NewLinearDecayCurve returns a curve that falls to zero at a constant rate over a duration.
+func NewLinearDecayCurve(duration time.Duration) DecayCurve {
+	return DecayCurve{
+		Type:     LinearDecayCurve,
+		Duration: duration,
+		Cut:      sdk.ZeroDec(),
+	}
+}
+
+// NewExponentialDecayCurve returns a curve that removes a fraction of the price each step.
+func NewExponentialDecayCurve(step time.Duration, cut sdk.Dec) DecayCurve {
+	return DecayCurve{
+		Type:     ExponentialDecayCurve,
+		Duration: step,
+		Cut:      cut,
+	}
+}
+
+// Price returns the price some time after the curve started at a start price.
+func (c DecayCurve) Price(startPrice sdk.Dec, elapsed time.Duration) (sdk.Dec, error) {
+	if elapsed <= 0 {
+		return startPrice, nil
+	}
+	switch c.Type {
+	case LinearDecayCurve:
+		if elapsed >= c.Duration {
+			return sdk.ZeroDec(), nil
+		}
+		remaining := sdk.NewDec(int64(c.Duration - elapsed)).QuoInt64(int64(c.Duration))
+		return startPrice.Mul(remaining), nil
+	case ExponentialDecayCurve:
+		steps := uint64(elapsed / c.Duration)
+		return startPrice.Mul(sdk.OneDec().Sub(c.Cut).Power(steps)), nil
+	default:
+		return sdk.Dec{}, fmt.Errorf("invalid decay curve type: %s", c.Type)
+	}
+}
+
+// Validate checks the curve has a known type and valid values for it.
+func (c DecayCurve) Validate() error {
+	if c.Duration <= 0 {
+		return fmt.Errorf("decay curve duration must be positive %d", c.Duration)
+	}
+	if c.Cut == emptyDec || c.Cut.IsNil() {
+		return errors.New("decay curve cut cannot be nil or empty")
+	}
+	switch c.Type {
+	case LinearDecayCurve:
+		if !c.Cut.IsZero() {
+			return fmt.Errorf("linear decay curve cut must be zero %s", c.Cut)
+		}
+	case ExponentialDecayCurve:
+		if !c.Cut.IsPositive() || c.Cut.GTE(sdk.OneDec()) {
+			return fmt.Errorf("exponential decay curve cut must be between zero and one %s", c.Cut)
+		}
+	default:
+		return fmt.Errorf("invalid decay curve type: %s", c.Type)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (c DecayCurve) String() string {
+	return fmt.Sprintf("%s (duration: %s, cut: %s)", c.Type, c.Duration, c.Cut)
+}
+
+// ReservePriceParam sets the reserve price of new auctions of one type as a fraction of the lot's oracle value when the auction starts.
+// The reserve then falls over time along DecayCurve.
+type ReservePriceParam struct {
+	AuctionType string     `json:"auction_type" yaml:"auction_type"`
+	Fraction    sdk.Dec    `json:"fraction" yaml:"fraction"`
+	DecayCurve  DecayCurve `json:"decay_curve" yaml:"decay_curve"`
+}
+
+// NewReservePriceParam returns a new ReservePriceParam.
+func NewReservePriceParam(auctionType string, fraction sdk.Dec, decayCurve DecayCurve) ReservePriceParam {
+	return ReservePriceParam{
+		AuctionType: auctionType,
+		Fraction:    fraction,
+		DecayCurve:  decayCurve,
+	}
+}
+
+// Validate checks the reserve is for an auction type that supports one and the fraction is between zero and one.
+func (p ReservePriceParam) Validate() error {
+	switch p.AuctionType {
+	case SurplusAuctionType, DebtAuctionType, CollateralAuctionType, DutchCollateralAuctionType:
+	default:
+		return fmt.Errorf("invalid reserve price auction type: %s", p.AuctionType)
+	}
+	if p.Fraction == emptyDec || p.Fraction.IsNil() {
+		return errors.New("reserve price fraction cannot be nil or empty")
+	}
+	if !p.Fraction.IsPositive() || p.Fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("reserve price fraction must be greater than zero and at most one %s", p.Fraction)
+	}
+	return p.DecayCurve.Validate()
+}
+
+// String implements fmt.Stringer
+func (p ReservePriceParam) String() string {
+	return fmt.Sprintf("%s: %s of oracle value, decay %s", p.AuctionType, p.Fraction, p.DecayCurve)
+}
+
+// ReservePriceParams is a slice of ReservePriceParam
+type ReservePriceParams []ReservePriceParam
+
+// Get returns the reserve price param for an auction type.
+func (ps ReservePriceParams) Get(auctionType string) (ReservePriceParam, bool) {
+	for _, p := range ps {
+		if p.AuctionType == auctionType {
+			return p, true
+		}
+	}
+	return ReservePriceParam{}, false
+}
+
+// Validate checks each param is valid and there is at most one per auction type.
+func (ps ReservePriceParams) Validate() error {
+	seenTypes := make(map[string]bool)
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if seenTypes[p.AuctionType] {
+			return fmt.Errorf("duplicate reserve price auction type: %s", p.AuctionType)
+		}
+		seenTypes[p.AuctionType] = true
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ps ReservePriceParams) String() string {
+	out := "["
+	for i, p := range ps {
+		if i > 0 {
+			out += ", "
+		}
+		out += p.String()
+	}
+	return out + "]"
+}
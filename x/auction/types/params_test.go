@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParams_Validate(t *testing.T) {
@@ -103,3 +105,129 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ValidateDutch(t *testing.T) {
+	testCases := []struct {
+		name      string
+		markup    sdk.Dec
+		curve     DecayCurve
+		floor     sdk.Dec
+		expectErr bool
+	}{
+		{"linear curve", d("1.5"), NewLinearDecayCurve(time.Hour), d("0.8"), false},
+		{"markup of one", d("1"), DefaultDutchDecayCurve, d("0.8"), false},
+		{"markup below one", d("0.9"), DefaultDutchDecayCurve, d("0.8"), true},
+		{"nil markup", sdk.Dec{}, DefaultDutchDecayCurve, d("0.8"), true},
+		{"empty curve", d("1.2"), DecayCurve{}, d("0.8"), true},
+		{"floor of one", d("1.2"), DefaultDutchDecayCurve, d("1"), false},
+		{"zero floor", d("1.2"), DefaultDutchDecayCurve, d("0"), true},
+		{"floor above one", d("1.2"), DefaultDutchDecayCurve, d("1.1"), true},
+		{"nil floor", d("1.2"), DefaultDutchDecayCurve, sdk.Dec{}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.DutchStartMarkup = tc.markup
+			params.DutchDecayCurve = tc.curve
+			params.DutchPriceFloor = tc.floor
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDecayCurve_Validate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		curve     DecayCurve
+		expectErr bool
+	}{
+		{"linear", NewLinearDecayCurve(time.Hour), false},
+		{"exponential", NewExponentialDecayCurve(time.Minute, d("0.05")), false},
+		{"zero duration", NewLinearDecayCurve(0), true},
+		{"linear with cut", DecayCurve{LinearDecayCurve, time.Hour, d("0.05")}, true},
+		{"exponential with zero cut", NewExponentialDecayCurve(time.Minute, d("0")), true},
+		{"exponential with cut of one", NewExponentialDecayCurve(time.Minute, d("1")), true},
+		{"nil cut", DecayCurve{ExponentialDecayCurve, time.Minute, sdk.Dec{}}, true},
+		{"unknown type", DecayCurve{"stepped", time.Minute, d("0.05")}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.curve.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDecayCurve_Price(t *testing.T) {
+	testCases := []struct {
+		name     string
+		curve    DecayCurve
+		elapsed  time.Duration
+		expected sdk.Dec
+	}{
+		{"linear at start", NewLinearDecayCurve(time.Hour), 0, d("10")},
+		{"linear part way", NewLinearDecayCurve(time.Hour), 15 * time.Minute, d("7.5")},
+		{"linear at end", NewLinearDecayCurve(time.Hour), time.Hour, d("0")},
+		{"linear after end", NewLinearDecayCurve(time.Hour), 2 * time.Hour, d("0")},
+		{"exponential at start", NewExponentialDecayCurve(time.Minute, d("0.1")), 0, d("10")},
+		{"exponential within first step", NewExponentialDecayCurve(time.Minute, d("0.1")), 59 * time.Second, d("10")},
+		{"exponential after two steps", NewExponentialDecayCurve(time.Minute, d("0.1")), 2 * time.Minute, d("8.1")},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := tc.curve.Price(d("10"), tc.elapsed)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, price)
+		})
+	}
+
+	_, err := DecayCurve{Type: "unknown", Duration: time.Hour, Cut: sdk.ZeroDec()}.Price(d("10"), time.Minute)
+	require.Error(t, err)
+}
+
+func TestParams_ValidateReservePrices(t *testing.T) {
+	curve := NewLinearDecayCurve(time.Hour)
+	testCases := []struct {
+		name          string
+		reservePrices ReservePriceParams
+		expectErr     bool
+	}{
+		{"none", nil, false},
+		{"each auction type", ReservePriceParams{
+			NewReservePriceParam(SurplusAuctionType, d("0.8"), curve),
+			NewReservePriceParam(DebtAuctionType, d("0.8"), curve),
+			NewReservePriceParam(CollateralAuctionType, d("1"), curve),
+			NewReservePriceParam(DutchCollateralAuctionType, d("0.8"), curve),
+		}, false},
+		{"unknown auction type", ReservePriceParams{NewReservePriceParam("sealed", d("0.8"), curve)}, true},
+		{"duplicate auction type", ReservePriceParams{
+			NewReservePriceParam(CollateralAuctionType, d("0.8"), curve),
+			NewReservePriceParam(CollateralAuctionType, d("0.5"), curve),
+		}, true},
+		{"zero fraction", ReservePriceParams{NewReservePriceParam(CollateralAuctionType, d("0"), curve)}, true},
+		{"fraction above one", ReservePriceParams{NewReservePriceParam(CollateralAuctionType, d("1.1"), curve)}, true},
+		{"nil fraction", ReservePriceParams{NewReservePriceParam(CollateralAuctionType, sdk.Dec{}, curve)}, true},
+		{"empty curve", ReservePriceParams{NewReservePriceParam(CollateralAuctionType, d("0.8"), DecayCurve{})}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.ReservePrices = tc.reservePrices
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
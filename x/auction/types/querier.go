@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,6 +15,10 @@ const (
 	QueryGetParams = "params"
 	// QueryNextAuctionID is the query path for querying the id of the next auction
 	QueryNextAuctionID = "next-auction-id"
+	// QueryGetAuctionRecord is the query path for querying the record of one closed auction
+	QueryGetAuctionRecord = "auction-record"
+	// QueryGetAuctionRecords is the query path for querying the records of closed auctions
+	QueryGetAuctionRecords = "auction-records"
 )
 
 // QueryAuctionParams params for query /auction/auction
@@ -49,6 +55,28 @@ func NewQueryAllAuctionParams(page, limit int, aucType, aucDenom, aucPhase strin
 	}
 }
 
+// QueryAuctionRecordsParams is the params for an auction records query
+type QueryAuctionRecordsParams struct {
+	Page      int            `json:"page" yaml:"page"`
+	Limit     int            `json:"limit" yaml:"limit"`
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Initiator string         `json:"initiator" yaml:"initiator"`
+	StartTime time.Time      `json:"start_time" yaml:"start_time"`
+	EndTime   time.Time      `json:"end_time" yaml:"end_time"`
+}
+
+// NewQueryAuctionRecordsParams creates a new QueryAuctionRecordsParams
+func NewQueryAuctionRecordsParams(page, limit int, bidder sdk.AccAddress, initiator string, startTime, endTime time.Time) QueryAuctionRecordsParams {
+	return QueryAuctionRecordsParams{
+		Page:      page,
+		Limit:     limit,
+		Bidder:    bidder,
+		Initiator: initiator,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+}
+
 // AuctionWithPhase augmented type for collateral auctions which includes auction phase for querying
 type AuctionWithPhase struct {
 	Auction Auction `json:"auction" yaml:"auction"`
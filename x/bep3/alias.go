@@ -24,6 +24,9 @@ const (
 	AttributeKeyTimestamp          = types.AttributeKeyTimestamp
 	AttributeKeySenderOtherChain   = types.AttributeKeySenderOtherChain
 	AttributeKeyExpireHeight       = types.AttributeKeyExpireHeight
+	AttributeKeyExpireTime         = types.AttributeKeyExpireTime
+	AttributeKeyHashAlgorithm      = types.AttributeKeyHashAlgorithm
+	AttributeKeyFee                = types.AttributeKeyFee
 	AttributeKeyAmount             = types.AttributeKeyAmount
 	AttributeKeyDirection          = types.AttributeKeyDirection
 	AttributeKeyClaimSender        = types.AttributeKeyClaimSender
@@ -37,10 +40,13 @@ const (
 	QuerierRoute                   = types.QuerierRoute
 	DefaultParamspace              = types.DefaultParamspace
 	DefaultLongtermStorageDuration = types.DefaultLongtermStorageDuration
+	MaxHTLCHeightSpan              = types.MaxHTLCHeightSpan
+	MaxHTLCTimeSpan                = types.MaxHTLCTimeSpan
 	CreateAtomicSwap               = types.CreateAtomicSwap
 	ClaimAtomicSwap                = types.ClaimAtomicSwap
 	RefundAtomicSwap               = types.RefundAtomicSwap
 	CalcSwapID                     = types.CalcSwapID
+	CreateHTLC                     = types.CreateHTLC
 	Int64Size                      = types.Int64Size
 	RandomNumberHashLength         = types.RandomNumberHashLength
 	RandomNumberLength             = types.RandomNumberLength
@@ -48,8 +54,11 @@ const (
 	MaxOtherChainAddrLength        = types.MaxOtherChainAddrLength
 	SwapIDLength                   = types.SwapIDLength
 	MaxExpectedIncomeLength        = types.MaxExpectedIncomeLength
+	HashLockLength                 = types.HashLockLength
 	QueryGetAssetSupply            = types.QueryGetAssetSupply
 	QueryGetAssetSupplies          = types.QueryGetAssetSupplies
+	QueryGetDeputySupplies         = types.QueryGetDeputySupplies
+	QueryGetDeputyFeeRevenue       = types.QueryGetDeputyFeeRevenue
 	QueryGetAtomicSwap             = types.QueryGetAtomicSwap
 	QueryGetAtomicSwaps            = types.QueryGetAtomicSwaps
 	QueryGetParams                 = types.QueryGetParams
@@ -60,6 +69,10 @@ const (
 	INVALID                        = types.INVALID
 	Incoming                       = types.Incoming
 	Outgoing                       = types.Outgoing
+	Generic                        = types.Generic
+	UnspecifiedHash                = types.UnspecifiedHash
+	SHA256Hash                     = types.SHA256Hash
+	Keccak256Hash                  = types.Keccak256Hash
 )
 
 var (
@@ -67,27 +80,39 @@ var (
 	NewKeeper                  = keeper.NewKeeper
 	NewQuerier                 = keeper.NewQuerier
 	NewAssetSupply             = types.NewAssetSupply
+	NewDeputySupply            = types.NewDeputySupply
+	NewDeputyParam             = types.NewDeputyParam
+	NewFeeSchedule             = types.NewFeeSchedule
+	NewFeeTier                 = types.NewFeeTier
 	RegisterCodec              = types.RegisterCodec
 	NewGenesisState            = types.NewGenesisState
 	DefaultGenesisState        = types.DefaultGenesisState
 	GenerateSecureRandomNumber = types.GenerateSecureRandomNumber
 	CalculateRandomHash        = types.CalculateRandomHash
 	CalculateSwapID            = types.CalculateSwapID
+	CalculateHashLock          = types.CalculateHashLock
 	GetAtomicSwapByHeightKey   = types.GetAtomicSwapByHeightKey
+	GetAtomicSwapByTimeKey     = types.GetAtomicSwapByTimeKey
+	GetDeputySupplyKey         = types.GetDeputySupplyKey
 	NewMsgCreateAtomicSwap     = types.NewMsgCreateAtomicSwap
 	NewMsgClaimAtomicSwap      = types.NewMsgClaimAtomicSwap
 	NewMsgRefundAtomicSwap     = types.NewMsgRefundAtomicSwap
+	NewMsgCreateHTLC           = types.NewMsgCreateHTLC
 	NewParams                  = types.NewParams
 	DefaultParams              = types.DefaultParams
 	NewAssetParam              = types.NewAssetParam
 	ParamKeyTable              = types.ParamKeyTable
 	NewQueryAssetSupply        = types.NewQueryAssetSupply
 	NewQueryAssetSupplies      = types.NewQueryAssetSupplies
+	NewQueryDeputySupplies     = types.NewQueryDeputySupplies
+	NewQueryDeputyFeeRevenue   = types.NewQueryDeputyFeeRevenue
 	NewQueryAtomicSwapByID     = types.NewQueryAtomicSwapByID
 	NewQueryAtomicSwaps        = types.NewQueryAtomicSwaps
 	NewAtomicSwap              = types.NewAtomicSwap
+	NewGenericAtomicSwap       = types.NewGenericAtomicSwap
 	NewSwapStatusFromString    = types.NewSwapStatusFromString
 	NewSwapDirectionFromString = types.NewSwapDirectionFromString
+	NewHashAlgorithmFromString = types.NewHashAlgorithmFromString
 	NewAugmentedAtomicSwap     = types.NewAugmentedAtomicSwap
 
 	// variable aliases
@@ -110,9 +135,13 @@ var (
 	ErrSwapNotClaimable             = types.ErrSwapNotClaimable
 	ErrInvalidAmount                = types.ErrInvalidAmount
 	ErrInvalidSwapAccount           = types.ErrInvalidSwapAccount
+	ErrInvalidExpireTime            = types.ErrInvalidExpireTime
+	ErrExceedsDeputySupplyLimit     = types.ErrExceedsDeputySupplyLimit
 	AtomicSwapKeyPrefix             = types.AtomicSwapKeyPrefix
 	AtomicSwapByBlockPrefix         = types.AtomicSwapByBlockPrefix
 	AtomicSwapLongtermStoragePrefix = types.AtomicSwapLongtermStoragePrefix
+	AtomicSwapByTimePrefix          = types.AtomicSwapByTimePrefix
+	DeputySupplyPrefix              = types.DeputySupplyPrefix
 	AtomicSwapCoinsAccAddr          = types.AtomicSwapCoinsAccAddr
 	KeyAssetParams                  = types.KeyAssetParams
 	DefaultBnbDeputyFixedFee        = types.DefaultBnbDeputyFixedFee
@@ -125,25 +154,36 @@ var (
 )
 
 type (
-	Keeper               = keeper.Keeper
-	AssetSupply          = types.AssetSupply
-	AssetSupplies        = types.AssetSupplies
-	GenesisState         = types.GenesisState
-	MsgCreateAtomicSwap  = types.MsgCreateAtomicSwap
-	MsgClaimAtomicSwap   = types.MsgClaimAtomicSwap
-	MsgRefundAtomicSwap  = types.MsgRefundAtomicSwap
-	Params               = types.Params
-	AssetParam           = types.AssetParam
-	AssetParams          = types.AssetParams
-	QueryAssetSupply     = types.QueryAssetSupply
-	QueryAssetSupplies   = types.QueryAssetSupplies
-	QueryAtomicSwapByID  = types.QueryAtomicSwapByID
-	QueryAtomicSwaps     = types.QueryAtomicSwaps
-	AtomicSwap           = types.AtomicSwap
-	AtomicSwaps          = types.AtomicSwaps
-	SwapStatus           = types.SwapStatus
-	SwapDirection        = types.SwapDirection
-	SupplyLimit          = types.SupplyLimit
-	AugmentedAtomicSwap  = types.AugmentedAtomicSwap
-	AugmentedAtomicSwaps = types.AugmentedAtomicSwaps
+	Keeper                = keeper.Keeper
+	AssetSupply           = types.AssetSupply
+	AssetSupplies         = types.AssetSupplies
+	DeputySupply          = types.DeputySupply
+	DeputySupplies        = types.DeputySupplies
+	DeputyParam           = types.DeputyParam
+	DeputyParams          = types.DeputyParams
+	FeeSchedule           = types.FeeSchedule
+	FeeTier               = types.FeeTier
+	FeeTiers              = types.FeeTiers
+	GenesisState          = types.GenesisState
+	MsgCreateAtomicSwap   = types.MsgCreateAtomicSwap
+	MsgClaimAtomicSwap    = types.MsgClaimAtomicSwap
+	MsgRefundAtomicSwap   = types.MsgRefundAtomicSwap
+	MsgCreateHTLC         = types.MsgCreateHTLC
+	Params                = types.Params
+	AssetParam            = types.AssetParam
+	AssetParams           = types.AssetParams
+	QueryAssetSupply      = types.QueryAssetSupply
+	QueryAssetSupplies    = types.QueryAssetSupplies
+	QueryDeputySupplies   = types.QueryDeputySupplies
+	QueryDeputyFeeRevenue = types.QueryDeputyFeeRevenue
+	QueryAtomicSwapByID   = types.QueryAtomicSwapByID
+	QueryAtomicSwaps      = types.QueryAtomicSwaps
+	AtomicSwap            = types.AtomicSwap
+	AtomicSwaps           = types.AtomicSwaps
+	SwapStatus            = types.SwapStatus
+	SwapDirection         = types.SwapDirection
+	HashAlgorithm         = types.HashAlgorithm
+	SupplyLimit           = types.SupplyLimit
+	AugmentedAtomicSwap   = types.AugmentedAtomicSwap
+	AugmentedAtomicSwaps  = types.AugmentedAtomicSwaps
 )
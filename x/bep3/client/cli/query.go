@@ -42,6 +42,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		QueryCalcRandomNumberHashCmd(queryRoute, cdc),
 		QueryGetAssetSupplyCmd(queryRoute, cdc),
 		QueryGetAssetSuppliesCmd(queryRoute, cdc),
+		QueryGetDeputySuppliesCmd(queryRoute, cdc),
+		QueryGetDeputyFeeRevenueCmd(queryRoute, cdc),
 		QueryGetAtomicSwapCmd(queryRoute, cdc),
 		QueryGetAtomicSwapsCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
@@ -179,6 +181,80 @@ func QueryGetAssetSuppliesCmd(queryRoute string, cdc *codec.Codec) *cobra.Comman
 	}
 }
 
+// QueryGetDeputySuppliesCmd queries the asset supplies relayed by each deputy, optionally for a single asset
+func QueryGetDeputySuppliesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "deputy-supplies [denom]",
+		Short:   "get a list of the asset supplies relayed by each deputy",
+		Example: "bep3 deputy-supplies bnb",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var denom string
+			if len(args) > 0 {
+				denom = args[0]
+			}
+
+			// Prepare query params
+			bz, err := cdc.MarshalJSON(types.NewQueryDeputySupplies(denom))
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetDeputySupplies), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var deputySupplies types.DeputySupplies
+			cdc.MustUnmarshalJSON(res, &deputySupplies)
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(deputySupplies)
+		},
+	}
+}
+
+// QueryGetDeputyFeeRevenueCmd queries the fees paid to a deputy by its fee schedules
+func QueryGetDeputyFeeRevenueCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "deputy-fee-revenue [deputy-address]",
+		Short:   "get the fees paid to a deputy by its fee schedules",
+		Example: "bep3 deputy-fee-revenue kava1r4v2zdhdalfj2ydazallqvrus9fkphmglhn6u6",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			deputy, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Prepare query params
+			bz, err := cdc.MarshalJSON(types.NewQueryDeputyFeeRevenue(deputy))
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetDeputyFeeRevenue), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var revenue sdk.Coins
+			cdc.MustUnmarshalJSON(res, &revenue)
+
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(revenue)
+		},
+	}
+}
+
 // QueryGetAtomicSwapCmd queries an AtomicSwap by swapID
 func QueryGetAtomicSwapCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -226,7 +302,7 @@ Example:
 $ kvcli q bep3 swaps --involve=kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
 $ kvcli q bep3 swaps --expiration=280
 $ kvcli q bep3 swaps --status=(Open|Completed|Expired)
-$ kvcli q bep3 swaps --direction=(Incoming|Outgoing)
+$ kvcli q bep3 swaps --direction=(Incoming|Outgoing|Generic)
 $ kvcli q bep3 swaps --page=2 --limit=100
 `,
 		),
@@ -306,7 +382,7 @@ $ kvcli q bep3 swaps --page=2 --limit=100
 	cmd.Flags().String(flagInvolve, "", "(optional) filter by atomic swaps that involve an address")
 	cmd.Flags().String(flagExpiration, "", "(optional) filter by atomic swaps that expire before a block height")
 	cmd.Flags().String(flagStatus, "", "(optional) filter by atomic swap status, status: open/completed/expired")
-	cmd.Flags().String(flagDirection, "", "(optional) filter by atomic swap direction, direction: incoming/outgoing")
+	cmd.Flags().String(flagDirection, "", "(optional) filter by atomic swap direction, direction: incoming/outgoing/generic")
 
 	return cmd
 }
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -23,6 +24,12 @@ import (
 	"github.com/kava-labs/kava/x/bep3/types"
 )
 
+// Flags for creating generic atomic swaps
+const (
+	flagHeightSpan = "height-span"
+	flagExpireTime = "expire-time"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	bep3TxCmd := &cobra.Command{
@@ -37,6 +44,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdCreateAtomicSwap(cdc),
 		GetCmdClaimAtomicSwap(cdc),
 		GetCmdRefundAtomicSwap(cdc),
+		GetCmdCreateHTLC(cdc),
 	)...)
 
 	return bep3TxCmd
@@ -113,6 +121,77 @@ func GetCmdCreateAtomicSwap(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdCreateHTLC cli command for creating generic atomic swaps between two users
+func GetCmdCreateHTLC(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-htlc [to] [coins] [hash-algorithm] [hash-lock]",
+		Short: "create a new generic atomic swap with another user, locked by either a height span or an expire time",
+		Long: strings.TrimSpace(`Create a generic hashed timelock swap with another user, without a deputy.
+The hash algorithm is sha256 or keccak256. Pass "new" as the hash lock to generate a random preimage, which is printed.
+Exactly one of --height-span and --expire-time (unix seconds) must be set.`),
+		Example: fmt.Sprintf(`%[1]s tx %[2]s create-htlc kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj 100ukava sha256 new --height-span 270 --from accA
+%[1]s tx %[2]s create-htlc kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj 100ukava keccak256 6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af --expire-time 1609459200 --from accA`,
+			version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			from := cliCtx.GetFromAddress()
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			hashAlgorithm := types.NewHashAlgorithmFromString(args[2])
+			if !hashAlgorithm.IsValid() {
+				return fmt.Errorf("invalid hash algorithm %s, must be sha256 or keccak256", args[2])
+			}
+
+			var hashLock []byte
+			if strings.Compare(args[3], "new") == 0 {
+				// Generate cryptographically strong pseudo-random preimage
+				preimage, err := types.GenerateSecureRandomNumber()
+				if err != nil {
+					return err
+				}
+				hashLock = types.CalculateHashLock(preimage, hashAlgorithm)
+
+				// Print preimage and hash lock to user's console
+				fmt.Printf("\nPreimage: %s\n", hex.EncodeToString(preimage))
+				fmt.Printf("Hash lock: %s\n\n", hex.EncodeToString(hashLock))
+			} else {
+				hashLock, err = hex.DecodeString(args[3])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCreateHTLC(
+				from, to, hashLock, hashAlgorithm, coins,
+				viper.GetUint64(flagHeightSpan), viper.GetInt64(flagExpireTime),
+			)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(flagHeightSpan, 0, "number of blocks the swap is locked for")
+	cmd.Flags().Int64(flagExpireTime, 0, "unix time in seconds the swap is locked until")
+	return cmd
+}
+
 // GetCmdClaimAtomicSwap cli command for claiming an atomic swap
 func GetCmdClaimAtomicSwap(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/kava-labs/kava/x/bep3/types"
 )
 
 // REST Variable names
@@ -39,6 +41,18 @@ type PostCreateSwapReq struct {
 	CrossChain          bool             `json:"cross_chain" yaml:"cross_chain"`
 }
 
+// PostCreateHTLCReq defines the properties of a generic swap create request's body
+type PostCreateHTLCReq struct {
+	BaseReq       rest.BaseReq        `json:"base_req" yaml:"base_req"`
+	From          sdk.AccAddress      `json:"from" yaml:"from"`
+	To            sdk.AccAddress      `json:"to" yaml:"to"`
+	HashLock      tmbytes.HexBytes    `json:"hash_lock" yaml:"hash_lock"`
+	HashAlgorithm types.HashAlgorithm `json:"hash_algorithm" yaml:"hash_algorithm"`
+	Amount        sdk.Coins           `json:"amount" yaml:"amount"`
+	HeightSpan    uint64              `json:"height_span" yaml:"height_span"`
+	ExpireTime    int64               `json:"expire_time" yaml:"expire_time"`
+}
+
 // PostClaimSwapReq defines the properties of a swap claim request's body
 type PostClaimSwapReq struct {
 	BaseReq      rest.BaseReq     `json:"base_req" yaml:"base_req"`
@@ -26,6 +26,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	for _, supply := range gs.Supplies {
 		keeper.SetAssetSupply(ctx, supply, supply.GetDenom())
 	}
+	for _, supply := range gs.DeputySupplies {
+		// Deputies' incoming and outgoing supplies are rebuilt from the atomic swaps below
+		zero := sdk.NewCoin(supply.GetDenom(), sdk.ZeroInt())
+		keeper.SetDeputySupply(ctx, NewDeputySupply(supply.DeputyAddress, zero, zero, supply.CurrentSupply, supply.FeeRevenue))
+	}
 
 	var incomingSupplies sdk.Coins
 	var outgoingSupplies sdk.Coins
@@ -34,10 +39,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 			panic(fmt.Sprintf("invalid swap %s", swap.GetSwapID()))
 		}
 
-		// Atomic swap assets must be both supported and active
-		err := keeper.ValidateLiveAsset(ctx, swap.Amount[0])
-		if err != nil {
-			panic(err)
+		// Atomic swap assets must be both supported and active, except in generic swaps which do not affect supplies
+		if !swap.IsGeneric() {
+			err := keeper.ValidateLiveAsset(ctx, swap.Amount[0])
+			if err != nil {
+				panic(err)
+			}
 		}
 
 		keeper.SetAtomicSwap(ctx, swap)
@@ -51,8 +58,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 				// This index expires unclaimed swaps
 				keeper.InsertIntoByBlockIndex(ctx, swap)
 				incomingSupplies = incomingSupplies.Add(swap.Amount...)
+				addSwapToDeputySupply(ctx, keeper, swap)
 			case Expired:
 				incomingSupplies = incomingSupplies.Add(swap.Amount...)
+				addSwapToDeputySupply(ctx, keeper, swap)
 			case Completed:
 				// This index stores swaps until deletion
 				keeper.InsertIntoLongtermStorage(ctx, swap)
@@ -64,8 +73,21 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 			case Open:
 				keeper.InsertIntoByBlockIndex(ctx, swap)
 				outgoingSupplies = outgoingSupplies.Add(swap.Amount...)
+				addSwapToDeputySupply(ctx, keeper, swap)
 			case Expired:
 				outgoingSupplies = outgoingSupplies.Add(swap.Amount...)
+				addSwapToDeputySupply(ctx, keeper, swap)
+			case Completed:
+				keeper.InsertIntoLongtermStorage(ctx, swap)
+			default:
+				panic(fmt.Sprintf("swap %s has invalid status %s", swap.GetSwapID(), swap.Status.String()))
+			}
+		case Generic:
+			switch swap.Status {
+			case Open:
+				// Time locked swaps are expired by the byTime index rather than the block index
+				keeper.InsertIntoExpiryIndex(ctx, swap)
+			case Expired:
 			case Completed:
 				keeper.InsertIntoLongtermStorage(ctx, swap)
 			default:
@@ -118,5 +140,23 @@ func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
 	if !found {
 		previousBlockTime = DefaultPreviousBlockTime
 	}
-	return NewGenesisState(params, swaps, supplies, previousBlockTime)
+	deputySupplies := k.GetAllDeputySupplies(ctx)
+	return NewGenesisState(params, swaps, supplies, previousBlockTime, deputySupplies)
+}
+
+// addSwapToDeputySupply adds the amount of an incoming or outgoing atomic swap to the supply relayed by its deputy
+func addSwapToDeputySupply(ctx sdk.Context, keeper Keeper, swap AtomicSwap) {
+	coin := swap.Amount[0]
+	deputySupply, found := keeper.GetDeputySupply(ctx, coin.Denom, swap.GetDeputy())
+	if !found {
+		zero := sdk.NewCoin(coin.Denom, sdk.ZeroInt())
+		deputySupply = NewDeputySupply(swap.GetDeputy(), zero, zero, zero, zero)
+	}
+	switch swap.Direction {
+	case Incoming:
+		deputySupply.IncomingSupply = deputySupply.IncomingSupply.Add(coin)
+	case Outgoing:
+		deputySupply.OutgoingSupply = deputySupply.OutgoingSupply.Add(coin)
+	}
+	keeper.SetDeputySupply(ctx, deputySupply)
 }
@@ -226,6 +226,22 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 			},
 			expectPass: false,
 		},
+		{
+			name: "generic atomic swaps of any asset",
+			genState: func() app.GenesisState {
+				gs := baseGenState(suite.addrs[0])
+				_, addrs := app.GeneratePrivKeyAddressPairs(2)
+				preimage, _ := bep3.GenerateSecureRandomNumber()
+				heightLocked := bep3.NewGenericAtomicSwap(cs(c("fake", 500000)), bep3.CalculateHashLock(preimage, bep3.SHA256Hash),
+					bep3.SHA256Hash, uint64(360), 0, ts(0), addrs[0], addrs[1])
+				timeLocked := bep3.NewGenericAtomicSwap(cs(c("bnb", 5000)), bep3.CalculateHashLock(preimage, bep3.Keccak256Hash),
+					bep3.Keccak256Hash, 0, ts(60), ts(0), addrs[0], addrs[1])
+
+				gs.AtomicSwaps = bep3.AtomicSwaps{heightLocked, timeLocked}
+				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)}
+			},
+			expectPass: true,
+		},
 		{
 			name: "atomic swap status is invalid",
 			genState: func() app.GenesisState {
@@ -298,6 +314,31 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestDeputySupplies() {
+	gs := baseGenState(suite.addrs[0])
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	var swaps bep3.AtomicSwaps
+	var supplies bep3.AssetSupplies
+	for i := 0; i < 2; i++ {
+		swap, supply := loadSwapAndSupply(addrs[i], i)
+		swaps = append(swaps, swap)
+		supplies = append(supplies, supply)
+	}
+	gs.AtomicSwaps = swaps
+	gs.Supplies = supplies
+	// A deputy's incoming and outgoing supplies are rebuilt from its atomic swaps, its current supply and fee revenue are imported
+	gs.DeputySupplies = bep3.DeputySupplies{bep3.NewDeputySupply(addrs[0], c("bnb", 1), c("bnb", 1), c("bnb", 100), c("bnb", 10))}
+	suite.app.InitializeFromGenesisStates(app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)})
+	ctx := suite.app.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	expectedSupplies := bep3.DeputySupplies{
+		bep3.NewDeputySupply(addrs[0], c("bnb", 50000), c("bnb", 0), c("bnb", 100), c("bnb", 10)),
+		bep3.NewDeputySupply(addrs[1], c("inc", 50000), c("inc", 0), c("inc", 0), c("inc", 0)),
+	}
+	suite.ElementsMatch(expectedSupplies, suite.keeper.GetAllDeputySupplies(ctx))
+	suite.ElementsMatch(expectedSupplies, bep3.ExportGenesis(ctx, suite.keeper).DeputySupplies)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
@@ -16,6 +16,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgClaimAtomicSwap(ctx, k, msg)
 		case MsgRefundAtomicSwap:
 			return handleMsgRefundAtomicSwap(ctx, k, msg)
+		case MsgCreateHTLC:
+			return handleMsgCreateHTLC(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -84,3 +86,24 @@ func handleMsgRefundAtomicSwap(ctx sdk.Context, k Keeper, msg MsgRefundAtomicSwa
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+// handleMsgCreateHTLC handles requests to create a new generic AtomicSwap between two users
+func handleMsgCreateHTLC(ctx sdk.Context, k Keeper, msg MsgCreateHTLC) (*sdk.Result, error) {
+	err := k.CreateHTLC(ctx, msg.HashLock, msg.HashAlgorithm, msg.HeightSpan, msg.ExpireTime,
+		msg.From, msg.To, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
@@ -9,8 +9,8 @@ import (
 	"github.com/kava-labs/kava/x/bep3/types"
 )
 
-// IncrementCurrentAssetSupply increments an asset's supply by the coin
-func (k Keeper) IncrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin) error {
+// IncrementCurrentAssetSupply increments an asset's supply, and the supply relayed by the deputy, by the coin
+func (k Keeper) IncrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin, deputy sdk.AccAddress) error {
 	supply, found := k.GetAssetSupply(ctx, coin.Denom)
 	if !found {
 		return sdkerrors.Wrap(types.ErrAssetNotSupported, coin.Denom)
@@ -37,11 +37,15 @@ func (k Keeper) IncrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin) erro
 
 	supply.CurrentSupply = supply.CurrentSupply.Add(coin)
 	k.SetAssetSupply(ctx, supply, coin.Denom)
+
+	deputySupply := k.getDeputySupplyOrEmpty(ctx, coin.Denom, deputy)
+	deputySupply.CurrentSupply = deputySupply.CurrentSupply.Add(coin)
+	k.SetDeputySupply(ctx, deputySupply)
 	return nil
 }
 
-// DecrementCurrentAssetSupply decrement an asset's supply by the coin
-func (k Keeper) DecrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin) error {
+// DecrementCurrentAssetSupply decrement an asset's supply, and the supply relayed by the deputy, by the coin
+func (k Keeper) DecrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin, deputy sdk.AccAddress) error {
 	supply, found := k.GetAssetSupply(ctx, coin.Denom)
 	if !found {
 		return sdkerrors.Wrap(types.ErrAssetNotSupported, coin.Denom)
@@ -55,11 +59,20 @@ func (k Keeper) DecrementCurrentAssetSupply(ctx sdk.Context, coin sdk.Coin) erro
 
 	supply.CurrentSupply = supply.CurrentSupply.Sub(coin)
 	k.SetAssetSupply(ctx, supply, coin.Denom)
+
+	// Coins relayed onto Kava by one deputy can leave through another, so a deputy's current supply stops at 0
+	deputySupply := k.getDeputySupplyOrEmpty(ctx, coin.Denom, deputy)
+	if deputySupply.CurrentSupply.IsLT(coin) {
+		deputySupply.CurrentSupply = sdk.NewCoin(coin.Denom, sdk.ZeroInt())
+	} else {
+		deputySupply.CurrentSupply = deputySupply.CurrentSupply.Sub(coin)
+	}
+	k.SetDeputySupply(ctx, deputySupply)
 	return nil
 }
 
-// IncrementIncomingAssetSupply increments an asset's incoming supply
-func (k Keeper) IncrementIncomingAssetSupply(ctx sdk.Context, coin sdk.Coin) error {
+// IncrementIncomingAssetSupply increments an asset's incoming supply, and the incoming supply relayed by the deputy
+func (k Keeper) IncrementIncomingAssetSupply(ctx sdk.Context, coin sdk.Coin, deputy sdk.AccAddress) error {
 	supply, found := k.GetAssetSupply(ctx, coin.Denom)
 	if !found {
 		return sdkerrors.Wrap(types.ErrAssetNotSupported, coin.Denom)
@@ -85,13 +98,30 @@ func (k Keeper) IncrementIncomingAssetSupply(ctx sdk.Context, coin sdk.Coin) err
 		}
 	}
 
+	// 	Result of the deputy's (current + incoming + amount) must be under the deputy's limit, if it has one
+	deputySupply := k.getDeputySupplyOrEmpty(ctx, coin.Denom, deputy)
+	asset, err := k.GetAsset(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if deputyParam, found := asset.GetDeputy(deputy); found && deputyParam.HasSupplyLimit() {
+		deputyTotalSupply := deputySupply.CurrentSupply.Add(deputySupply.IncomingSupply)
+		deputySupplyLimit := sdk.NewCoin(coin.Denom, deputyParam.SupplyLimit)
+		if deputySupplyLimit.IsLT(deputyTotalSupply.Add(coin)) {
+			return sdkerrors.Wrapf(types.ErrExceedsDeputySupplyLimit, "increase %s, deputy %s supply %s, limit %s", coin, deputy, deputyTotalSupply, deputySupplyLimit)
+		}
+	}
+
 	supply.IncomingSupply = supply.IncomingSupply.Add(coin)
 	k.SetAssetSupply(ctx, supply, coin.Denom)
+
+	deputySupply.IncomingSupply = deputySupply.IncomingSupply.Add(coin)
+	k.SetDeputySupply(ctx, deputySupply)
 	return nil
 }
 
-// DecrementIncomingAssetSupply decrements an asset's incoming supply
-func (k Keeper) DecrementIncomingAssetSupply(ctx sdk.Context, coin sdk.Coin) error {
+// DecrementIncomingAssetSupply decrements an asset's incoming supply, and the incoming supply relayed by the deputy
+func (k Keeper) DecrementIncomingAssetSupply(ctx sdk.Context, coin sdk.Coin, deputy sdk.AccAddress) error {
 	supply, found := k.GetAssetSupply(ctx, coin.Denom)
 	if !found {
 		return sdkerrors.Wrap(types.ErrAssetNotSupported, coin.Denom)
@@ -102,14 +132,21 @@ func (k Keeper) DecrementIncomingAssetSupply(ctx sdk.Context, coin sdk.Coin) err
 	if supply.IncomingSupply.Amount.Sub(coin.Amount).IsNegative() {
 		return sdkerrors.Wrapf(types.ErrInvalidIncomingSupply, "decrease %s, incoming supply %s", coin, supply.IncomingSupply)
 	}
+	deputySupply := k.getDeputySupplyOrEmpty(ctx, coin.Denom, deputy)
+	if deputySupply.IncomingSupply.Amount.Sub(coin.Amount).IsNegative() {
+		return sdkerrors.Wrapf(types.ErrInvalidIncomingSupply, "decrease %s, deputy %s incoming supply %s", coin, deputy, deputySupply.IncomingSupply)
+	}
 
 	supply.IncomingSupply = supply.IncomingSupply.Sub(coin)
 	k.SetAssetSupply(ctx, supply, coin.Denom)
+
+	deputySupply.IncomingSupply = deputySupply.IncomingSupply.Sub(coin)
+	k.SetDeputySupply(ctx, deputySupply)
 	return nil
 }
 
-// IncrementOutgoingAssetSupply increments an asset's outgoing supply
-func (k Keeper) IncrementOutgoingAssetSupply(ctx sdk.Context, coin sdk.Coin) error {
+// IncrementOutgoingAssetSupply increments an asset's outgoing supply, and the outgoing supply relayed by the deputy
+func (k Keeper) IncrementOutgoingAssetSupply(ctx sdk.Context, coin sdk.Coin, deputy sdk.AccAddress) error {
 	supply, found := k.GetAssetSupply(ctx, coin.Denom)
 	if !found {
 		return sdkerrors.Wrap(types.ErrAssetNotSupported, coin.Denom)
@@ -123,11 +160,15 @@ func (k Keeper) IncrementOutgoingAssetSupply(ctx sdk.Context, coin sdk.Coin) err
 
 	supply.OutgoingSupply = supply.OutgoingSupply.Add(coin)
 	k.SetAssetSupply(ctx, supply, coin.Denom)
+
+	deputySupply := k.getDeputySupplyOrEmpty(ctx, coin.Denom, deputy)
+	deputySupply.OutgoingSupply = deputySupply.OutgoingSupply.Add(coin)
+	k.SetDeputySupply(ctx, deputySupply)
 	return nil
 }
 
-// DecrementOutgoingAssetSupply decrements an asset's outgoing supply
-func (k Keeper) DecrementOutgoingAssetSupply(ctx sdk.Context, coin sdk.Coin) error {
+// DecrementOutgoingAssetSupply decrements an asset's outgoing supply, and the outgoing supply relayed by the deputy
+func (k Keeper) DecrementOutgoingAssetSupply(ctx sdk.Context, coin sdk.Coin, deputy sdk.AccAddress) error {
 	supply, found := k.GetAssetSupply(ctx, coin.Denom)
 	if !found {
 		return sdkerrors.Wrap(types.ErrAssetNotSupported, coin.Denom)
@@ -138,12 +179,29 @@ func (k Keeper) DecrementOutgoingAssetSupply(ctx sdk.Context, coin sdk.Coin) err
 	if supply.OutgoingSupply.Amount.Sub(coin.Amount).IsNegative() {
 		return sdkerrors.Wrapf(types.ErrInvalidOutgoingSupply, "decrease %s, outgoing supply %s", coin, supply.OutgoingSupply)
 	}
+	deputySupply := k.getDeputySupplyOrEmpty(ctx, coin.Denom, deputy)
+	if deputySupply.OutgoingSupply.Amount.Sub(coin.Amount).IsNegative() {
+		return sdkerrors.Wrapf(types.ErrInvalidOutgoingSupply, "decrease %s, deputy %s outgoing supply %s", coin, deputy, deputySupply.OutgoingSupply)
+	}
 
 	supply.OutgoingSupply = supply.OutgoingSupply.Sub(coin)
 	k.SetAssetSupply(ctx, supply, coin.Denom)
+
+	deputySupply.OutgoingSupply = deputySupply.OutgoingSupply.Sub(coin)
+	k.SetDeputySupply(ctx, deputySupply)
 	return nil
 }
 
+// getDeputySupplyOrEmpty returns the supply of an asset relayed by a deputy, or an empty supply if the deputy has relayed none
+func (k Keeper) getDeputySupplyOrEmpty(ctx sdk.Context, denom string, deputy sdk.AccAddress) types.DeputySupply {
+	deputySupply, found := k.GetDeputySupply(ctx, denom, deputy)
+	if !found {
+		zero := sdk.NewCoin(denom, sdk.ZeroInt())
+		deputySupply = types.NewDeputySupply(deputy, zero, zero, zero, zero)
+	}
+	return deputySupply
+}
+
 // CreateNewAssetSupply creates a new AssetSupply in the store for the input denom
 func (k Keeper) CreateNewAssetSupply(ctx sdk.Context, denom string) types.AssetSupply {
 	supply := types.NewAssetSupply(
@@ -23,6 +23,7 @@ type AssetTestSuite struct {
 	keeper keeper.Keeper
 	app    app.TestApp
 	ctx    sdk.Context
+	deputy sdk.AccAddress
 }
 
 func (suite *AssetTestSuite) SetupTest() {
@@ -51,9 +52,14 @@ func (suite *AssetTestSuite) SetupTest() {
 	keeper.SetAssetSupply(ctx, supply, supply.IncomingSupply.Denom)
 	keeper.SetPreviousBlockTime(ctx, ctx.BlockTime())
 
+	// Set the deputy's supplies to match the asset supplies
+	keeper.SetDeputySupply(ctx, types.NewDeputySupply(deputy, c("bnb", 5), c("bnb", 5), c("bnb", 40), c("bnb", 0)))
+	keeper.SetDeputySupply(ctx, types.NewDeputySupply(deputy, c("inc", 10), c("inc", 5), c("inc", 5), c("inc", 0)))
+
 	suite.app = tApp
 	suite.ctx = ctx
 	suite.keeper = keeper
+	suite.deputy = deputy
 	return
 }
 
@@ -101,7 +107,7 @@ func (suite *AssetTestSuite) TestIncrementCurrentAssetSupply() {
 		suite.Run(tc.name, func() {
 
 			preSupply, found := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
-			err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, tc.args.coin)
+			err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, tc.args.coin, suite.deputy)
 			postSupply, _ := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
 
 			if tc.expectPass {
@@ -161,7 +167,7 @@ func (suite *AssetTestSuite) TestIncrementTimeLimitedCurrentAssetSupply() {
 	for _, tc := range testCases {
 		suite.SetupTest()
 		suite.Run(tc.name, func() {
-			err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, tc.args.coin)
+			err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, tc.args.coin, suite.deputy)
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
 				supply, _ := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
@@ -218,7 +224,7 @@ func (suite *AssetTestSuite) TestDecrementCurrentAssetSupply() {
 		suite.Run(tc.name, func() {
 
 			preSupply, found := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
-			err := suite.keeper.DecrementCurrentAssetSupply(suite.ctx, tc.args.coin)
+			err := suite.keeper.DecrementCurrentAssetSupply(suite.ctx, tc.args.coin, suite.deputy)
 			postSupply, _ := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
 
 			if tc.expectPass {
@@ -276,7 +282,7 @@ func (suite *AssetTestSuite) TestIncrementIncomingAssetSupply() {
 		suite.SetupTest()
 		suite.Run(tc.name, func() {
 			preSupply, found := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
-			err := suite.keeper.IncrementIncomingAssetSupply(suite.ctx, tc.args.coin)
+			err := suite.keeper.IncrementIncomingAssetSupply(suite.ctx, tc.args.coin, suite.deputy)
 			postSupply, _ := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
 
 			if tc.expectPass {
@@ -336,7 +342,7 @@ func (suite *AssetTestSuite) TestIncrementTimeLimitedIncomingAssetSupply() {
 	for _, tc := range testCases {
 		suite.SetupTest()
 		suite.Run(tc.name, func() {
-			err := suite.keeper.IncrementIncomingAssetSupply(suite.ctx, tc.args.coin)
+			err := suite.keeper.IncrementIncomingAssetSupply(suite.ctx, tc.args.coin, suite.deputy)
 			if tc.errArgs.expectPass {
 				suite.Require().NoError(err)
 				supply, _ := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
@@ -393,7 +399,7 @@ func (suite *AssetTestSuite) TestDecrementIncomingAssetSupply() {
 		suite.Run(tc.name, func() {
 
 			preSupply, found := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
-			err := suite.keeper.DecrementIncomingAssetSupply(suite.ctx, tc.args.coin)
+			err := suite.keeper.DecrementIncomingAssetSupply(suite.ctx, tc.args.coin, suite.deputy)
 			postSupply, _ := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
 
 			if tc.expectPass {
@@ -452,7 +458,7 @@ func (suite *AssetTestSuite) TestIncrementOutgoingAssetSupply() {
 		suite.Run(tc.name, func() {
 
 			preSupply, found := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
-			err := suite.keeper.IncrementOutgoingAssetSupply(suite.ctx, tc.args.coin)
+			err := suite.keeper.IncrementOutgoingAssetSupply(suite.ctx, tc.args.coin, suite.deputy)
 			postSupply, _ := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
 
 			if tc.expectPass {
@@ -510,7 +516,7 @@ func (suite *AssetTestSuite) TestDecrementOutgoingAssetSupply() {
 		suite.SetupTest()
 		suite.Run(tc.name, func() {
 			preSupply, found := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
-			err := suite.keeper.DecrementOutgoingAssetSupply(suite.ctx, tc.args.coin)
+			err := suite.keeper.DecrementOutgoingAssetSupply(suite.ctx, tc.args.coin, suite.deputy)
 			postSupply, _ := suite.keeper.GetAssetSupply(suite.ctx, tc.args.coin.Denom)
 
 			if tc.expectPass {
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+// SettleSwapFee pays the fee escrowed for an outgoing swap to its deputy, returning part of the fee to the swap's sender
+func (k Keeper) SettleSwapFee(ctx sdk.Context, swap types.AtomicSwap, returned sdk.Coins) error {
+	fee := swap.Fee[0]
+	returnedFee := sdk.NewCoin(fee.Denom, sdk.MinInt(returned.AmountOf(fee.Denom), fee.Amount))
+	deputyFee := fee.Sub(returnedFee)
+
+	if returnedFee.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, swap.Sender, sdk.NewCoins(returnedFee))
+		if err != nil {
+			return err
+		}
+	}
+	if deputyFee.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, swap.GetDeputy(), sdk.NewCoins(deputyFee))
+		if err != nil {
+			return err
+		}
+		deputySupply := k.getDeputySupplyOrEmpty(ctx, fee.Denom, swap.GetDeputy())
+		deputySupply.FeeRevenue = deputySupply.FeeRevenue.Add(deputyFee)
+		k.SetDeputySupply(ctx, deputySupply)
+	}
+	return nil
+}
+
+// GetDeputyFeeRevenue returns the total fees paid to a deputy by its fee schedules, across all assets
+func (k Keeper) GetDeputyFeeRevenue(ctx sdk.Context, deputy sdk.AccAddress) sdk.Coins {
+	revenue := sdk.NewCoins()
+	k.IterateDeputySupplies(ctx, func(supply types.DeputySupply) bool {
+		if supply.DeputyAddress.Equals(deputy) {
+			revenue = revenue.Add(supply.FeeRevenue)
+		}
+		return false
+	})
+	return revenue
+}
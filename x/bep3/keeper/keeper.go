@@ -152,6 +152,60 @@ func (k Keeper) IterateAtomicSwapsByBlock(ctx sdk.Context, inclusiveCutoffTime u
 	}
 }
 
+// ------------------------------------------
+//			Atomic Swap Time Index
+// ------------------------------------------
+
+// InsertIntoByTimeIndex adds a time locked swap's ID and expiration time into the byTime index.
+func (k Keeper) InsertIntoByTimeIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByTimePrefix)
+	store.Set(types.GetAtomicSwapByTimeKey(atomicSwap.ExpireTime, atomicSwap.GetSwapID()), atomicSwap.GetSwapID())
+}
+
+// RemoveFromByTimeIndex removes an AtomicSwap from the byTime index.
+func (k Keeper) RemoveFromByTimeIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByTimePrefix)
+	store.Delete(types.GetAtomicSwapByTimeKey(atomicSwap.ExpireTime, atomicSwap.GetSwapID()))
+}
+
+// IterateAtomicSwapsByTime provides an iterator over time locked AtomicSwaps ordered by AtomicSwap expiration time
+// For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsByTime(ctx sdk.Context, inclusiveCutoffTime int64, cb func(swapID []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByTimePrefix)
+	iterator := store.Iterator(
+		nil, // start at the very start of the prefix store
+		sdk.PrefixEndBytes(sdk.Uint64ToBigEndian(uint64(inclusiveCutoffTime))), // end of range
+	)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+
+		id := iterator.Value()
+
+		if cb(id) {
+			break
+		}
+	}
+}
+
+// InsertIntoExpiryIndex adds an open swap into the index it expires from, byTime for time locked swaps and byBlock otherwise.
+func (k Keeper) InsertIntoExpiryIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	if atomicSwap.IsTimeLocked() {
+		k.InsertIntoByTimeIndex(ctx, atomicSwap)
+		return
+	}
+	k.InsertIntoByBlockIndex(ctx, atomicSwap)
+}
+
+// RemoveFromExpiryIndex removes a swap from the index it expires from.
+func (k Keeper) RemoveFromExpiryIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	if atomicSwap.IsTimeLocked() {
+		k.RemoveFromByTimeIndex(ctx, atomicSwap)
+		return
+	}
+	k.RemoveFromByBlockIndex(ctx, atomicSwap)
+}
+
 // ------------------------------------------
 //		Atomic Swap Longterm Storage Index
 // ------------------------------------------
@@ -238,6 +292,52 @@ func (k Keeper) GetAllAssetSupplies(ctx sdk.Context) (supplies types.AssetSuppli
 	return
 }
 
+// ------------------------------------------
+//				Deputy Supplies
+// ------------------------------------------
+
+// GetDeputySupply gets the supply of an asset relayed by a deputy from the store.
+func (k Keeper) GetDeputySupply(ctx sdk.Context, denom string, deputy sdk.AccAddress) (types.DeputySupply, bool) {
+	var deputySupply types.DeputySupply
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputySupplyPrefix)
+	bz := store.Get(types.GetDeputySupplyKey(denom, deputy))
+	if bz == nil {
+		return types.DeputySupply{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &deputySupply)
+	return deputySupply, true
+}
+
+// SetDeputySupply updates the supply of an asset relayed by a deputy
+func (k Keeper) SetDeputySupply(ctx sdk.Context, supply types.DeputySupply) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputySupplyPrefix)
+	store.Set(types.GetDeputySupplyKey(supply.GetDenom(), supply.DeputyAddress), k.cdc.MustMarshalBinaryBare(supply))
+}
+
+// IterateDeputySupplies provides an iterator over all stored DeputySupplies.
+func (k Keeper) IterateDeputySupplies(ctx sdk.Context, cb func(supply types.DeputySupply) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.DeputySupplyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var supply types.DeputySupply
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &supply)
+
+		if cb(supply) {
+			break
+		}
+	}
+}
+
+// GetAllDeputySupplies returns all deputy supplies from the store
+func (k Keeper) GetAllDeputySupplies(ctx sdk.Context) (supplies types.DeputySupplies) {
+	k.IterateDeputySupplies(ctx, func(supply types.DeputySupply) bool {
+		supplies = append(supplies, supply)
+		return false
+	})
+	return
+}
+
 // GetPreviousBlockTime get the blocktime for the previous block
 func (k Keeper) GetPreviousBlockTime(ctx sdk.Context) (blockTime time.Time, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousBlockTimeKey)
@@ -54,7 +54,7 @@ func (k Keeper) GetAssets(ctx sdk.Context) (types.AssetParams, bool) {
 //				Asset-specific getters
 // ------------------------------------------
 
-// GetDeputyAddress returns the deputy address for the input denom
+// GetDeputyAddress returns the primary deputy address for the input denom
 func (k Keeper) GetDeputyAddress(ctx sdk.Context, denom string) (sdk.AccAddress, error) {
 	asset, err := k.GetAsset(ctx, denom)
 	if err != nil {
@@ -63,7 +63,7 @@ func (k Keeper) GetDeputyAddress(ctx sdk.Context, denom string) (sdk.AccAddress,
 	return asset.DeputyAddress, nil
 }
 
-// GetFixedFee returns the fixed fee for incoming swaps
+// GetFixedFee returns the primary deputy's fixed fee for incoming swaps
 func (k Keeper) GetFixedFee(ctx sdk.Context, denom string) (sdk.Int, error) {
 	asset, err := k.GetAsset(ctx, denom)
 	if err != nil {
@@ -72,6 +72,15 @@ func (k Keeper) GetFixedFee(ctx sdk.Context, denom string) (sdk.Int, error) {
 	return asset.FixedFee, nil
 }
 
+// GetDeputies returns all deputies for the input denom
+func (k Keeper) GetDeputies(ctx sdk.Context, denom string) (types.DeputyParams, error) {
+	asset, err := k.GetAsset(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	return asset.GetDeputies(), nil
+}
+
 // GetMinSwapAmount returns the minimum swap amount
 func (k Keeper) GetMinSwapAmount(ctx sdk.Context, denom string) (sdk.Int, error) {
 	asset, err := k.GetAsset(ctx, denom)
@@ -155,12 +164,14 @@ func (k Keeper) GetAuthorizedAddresses(ctx sdk.Context) []sdk.AccAddress {
 	uniqueAddresses := map[string]bool{}
 
 	for _, ap := range assetParams {
-		a := ap.DeputyAddress
-		// de-dup addresses
-		if _, found := uniqueAddresses[a.String()]; !found {
-			addresses = append(addresses, a)
+		for _, deputy := range ap.GetDeputies() {
+			a := deputy.Address
+			// de-dup addresses
+			if _, found := uniqueAddresses[a.String()]; !found {
+				addresses = append(addresses, a)
+			}
+			uniqueAddresses[a.String()] = true
 		}
-		uniqueAddresses[a.String()] = true
 	}
 	return addresses
 }
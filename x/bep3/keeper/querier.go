@@ -19,6 +19,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAssetSupply(ctx, req, keeper)
 		case types.QueryGetAssetSupplies:
 			return queryAssetSupplies(ctx, req, keeper)
+		case types.QueryGetDeputySupplies:
+			return queryDeputySupplies(ctx, req, keeper)
+		case types.QueryGetDeputyFeeRevenue:
+			return queryDeputyFeeRevenue(ctx, req, keeper)
 		case types.QueryGetAtomicSwap:
 			return queryAtomicSwap(ctx, req, keeper)
 		case types.QueryGetAtomicSwaps:
@@ -67,6 +71,50 @@ func queryAssetSupplies(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	return bz, nil
 }
 
+func queryDeputySupplies(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	// Decode request
+	var requestParams types.QueryDeputySupplies
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	supplies := types.DeputySupplies{}
+	keeper.IterateDeputySupplies(ctx, func(supply types.DeputySupply) bool {
+		if requestParams.Denom == "" || supply.GetDenom() == requestParams.Denom {
+			supplies = append(supplies, supply)
+		}
+		return false
+	})
+
+	// Encode results
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, supplies)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDeputyFeeRevenue(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	// Decode request
+	var requestParams types.QueryDeputyFeeRevenue
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	revenue := keeper.GetDeputyFeeRevenue(ctx, requestParams.Deputy)
+
+	// Encode results
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, revenue)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryAtomicSwap(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	// Decode request
 	var requestParams types.QueryAtomicSwapByID
@@ -145,9 +193,9 @@ func filterAtomicSwaps(ctx sdk.Context, swaps types.AtomicSwaps, params types.Qu
 			matchInvolve = s.Sender.Equals(params.Involve) || s.Recipient.Equals(params.Involve)
 		}
 
-		// match expiration block limit (if supplied)
+		// match expiration block limit (if supplied), time locked swaps have no expiration block
 		if params.Expiration > 0 {
-			matchExpiration = s.ExpireHeight <= params.Expiration
+			matchExpiration = !s.IsTimeLocked() && s.ExpireHeight <= params.Expiration
 		}
 
 		// match status (if supplied/valid)
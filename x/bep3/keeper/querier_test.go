@@ -150,6 +150,28 @@ func (suite *QuerierTestSuite) TestQueryAssetSupplies() {
 	suite.Equal(supplies, storeSupplies)
 }
 
+func (suite *QuerierTestSuite) TestQueryDeputyFeeRevenue() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	deputy := suite.addrs[10]
+	supply, _ := suite.keeper.GetDeputySupply(ctx, "bnb", deputy)
+	supply.FeeRevenue = c("bnb", 500)
+	suite.keeper.SetDeputySupply(ctx, supply)
+
+	// Set up request query
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetDeputyFeeRevenue}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryDeputyFeeRevenue(deputy)),
+	}
+
+	bz, err := suite.querier(ctx, []string{types.QueryGetDeputyFeeRevenue}, query)
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var revenue sdk.Coins
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &revenue))
+	suite.Equal(cs(c("bnb", 500)), revenue)
+}
+
 func (suite *QuerierTestSuite) TestQueryAtomicSwaps() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	// Set up request query
@@ -53,14 +53,19 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 		return sdkerrors.Wrap(types.ErrInvalidTimestamp, fmt.Sprintf("block time: %s, timestamp: %s", ctx.BlockTime().String(), time.Unix(timestamp, 0).UTC().String()))
 	}
 
+	// Incoming swaps are sent by one of the asset's deputies, outgoing swaps are sent to the deputy chosen by the user
 	var direction types.SwapDirection
-	if sender.Equals(asset.DeputyAddress) {
-		if recipient.Equals(asset.DeputyAddress) {
-			return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "deputy cannot be both sender and receiver: %s", asset.DeputyAddress)
+	var fee, feeRefund, quickClaimDiscount sdk.Coins
+	var quickClaimBlocks uint64
+	deputy, senderIsDeputy := asset.GetDeputy(sender)
+	if senderIsDeputy {
+		if _, found := asset.GetDeputy(recipient); found {
+			return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "deputy cannot be both sender and receiver: %s", recipient)
 		}
 		direction = types.Incoming
 	} else {
-		if !recipient.Equals(asset.DeputyAddress) {
+		deputy, found = asset.GetDeputy(recipient)
+		if !found {
 			return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "deputy must be recipient for outgoing account: %s", recipient)
 		}
 		direction = types.Outgoing
@@ -76,23 +81,32 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 			k.accountKeeper.SetAccount(ctx, newAcc)
 		}
 		// Incoming swaps have already had their fees collected by the deputy during the relay process.
-		err = k.IncrementIncomingAssetSupply(ctx, amount[0])
+		err = k.IncrementIncomingAssetSupply(ctx, amount[0], deputy.Address)
 	case types.Outgoing:
 
 		// Outgoing swaps must have a height span within the accepted range
 		if heightSpan < asset.MinBlockLock || heightSpan > asset.MaxBlockLock {
 			return sdkerrors.Wrapf(types.ErrInvalidHeightSpan, "height span %d outside range [%d, %d]", heightSpan, asset.MinBlockLock, asset.MaxBlockLock)
 		}
-		// Amount in outgoing swaps must be able to pay the deputy's fixed fee.
-		if amount[0].Amount.LTE(asset.FixedFee.Add(asset.MinSwapAmount)) {
+		// Amount in outgoing swaps must be able to pay the deputy's fixed fee, unless the deputy charges its fee by a fee schedule.
+		if deputy.FeeSchedule.IsEmpty() && amount[0].Amount.LTE(deputy.FixedFee.Add(asset.MinSwapAmount)) {
 			return sdkerrors.Wrap(types.ErrInsufficientAmount, amount[0].String())
 		}
-		err = k.IncrementOutgoingAssetSupply(ctx, amount[0])
+		err = k.IncrementOutgoingAssetSupply(ctx, amount[0], deputy.Address)
 		if err != nil {
 			return err
 		}
+		// Fee schedules are charged in addition to the swap amount, and held by the module until the swap is closed
+		feeAmount := deputy.FeeSchedule.CalculateFee(amount[0].Amount)
+		if feeAmount.IsPositive() {
+			fee = sdk.NewCoins(sdk.NewCoin(amount[0].Denom, feeAmount))
+			// The parts of the fee returned to the sender are fixed now, so the swap settles on the schedule it was created under
+			feeRefund = sdk.NewCoins(sdk.NewCoin(amount[0].Denom, deputy.FeeSchedule.CalculateRefund(feeAmount)))
+			quickClaimDiscount = sdk.NewCoins(sdk.NewCoin(amount[0].Denom, deputy.FeeSchedule.CalculateQuickClaimDiscount(feeAmount)))
+			quickClaimBlocks = deputy.FeeSchedule.QuickClaimBlocks
+		}
 		// Transfer coins to module - only needed for outgoing swaps
-		err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+		err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount.Add(fee...))
 	default:
 		err = fmt.Errorf("invalid swap direction: %s", direction.String())
 	}
@@ -104,6 +118,11 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 	expireHeight := uint64(ctx.BlockHeight()) + heightSpan
 	atomicSwap := types.NewAtomicSwap(amount, randomNumberHash, expireHeight, timestamp, sender,
 		recipient, senderOtherChain, recipientOtherChain, 0, types.Open, crossChain, direction)
+	atomicSwap.Fee = fee
+	atomicSwap.CreatedBlock = ctx.BlockHeight()
+	atomicSwap.FeeRefund = feeRefund
+	atomicSwap.QuickClaimBlocks = quickClaimBlocks
+	atomicSwap.QuickClaimDiscount = quickClaimDiscount
 
 	// Insert the atomic swap under both keys
 	k.SetAtomicSwap(ctx, atomicSwap)
@@ -122,6 +141,88 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 			sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", atomicSwap.ExpireHeight)),
 			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, atomicSwap.Fee.String()),
+		),
+	)
+
+	return nil
+}
+
+// CreateHTLC creates a new generic atomic swap between two users without a deputy.
+// The swap is locked until either heightSpan blocks have passed or the unix time expireTime, whichever is set.
+func (k Keeper) CreateHTLC(ctx sdk.Context, hashLock []byte, hashAlgorithm types.HashAlgorithm, heightSpan uint64,
+	expireTime int64, sender sdk.AccAddress, recipient sdk.AccAddress, amount sdk.Coins) error {
+	// Confirm that this is not a duplicate swap
+	swapID := types.CalculateSwapID(hashLock, sender, "")
+	_, found := k.GetAtomicSwap(ctx, swapID)
+	if found {
+		return sdkerrors.Wrap(types.ErrAtomicSwapAlreadyExists, hex.EncodeToString(swapID))
+	}
+
+	// Cannot send coins to a module account
+	if k.Maccs[recipient.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account", recipient)
+	}
+	if sender.Equals(recipient) {
+		return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "sender cannot be recipient: %s", sender)
+	}
+
+	// Swaps must be locked by exactly one of height and time, within the accepted range
+	var expireHeight uint64
+	switch {
+	case heightSpan > 0 && expireTime == 0:
+		if heightSpan > types.MaxHTLCHeightSpan {
+			return sdkerrors.Wrapf(types.ErrInvalidHeightSpan, "height span %d outside range [1, %d]", heightSpan, types.MaxHTLCHeightSpan)
+		}
+		expireHeight = uint64(ctx.BlockHeight()) + heightSpan
+	case heightSpan == 0 && expireTime > 0:
+		now := ctx.BlockTime().Unix()
+		if expireTime <= now || expireTime > now+types.MaxHTLCTimeSpan {
+			return sdkerrors.Wrapf(types.ErrInvalidExpireTime, "block time: %s, expire time: %s",
+				ctx.BlockTime().String(), time.Unix(expireTime, 0).UTC().String())
+		}
+	default:
+		return sdkerrors.Wrap(types.ErrInvalidExpireTime, "swap must be locked by exactly one of height span and expire time")
+	}
+
+	atomicSwap := types.NewGenericAtomicSwap(amount, hashLock, hashAlgorithm, expireHeight, expireTime,
+		ctx.BlockTime().Unix(), sender, recipient)
+	if err := atomicSwap.Validate(); err != nil {
+		return err
+	}
+
+	// If recipient's account doesn't exist, register it in state so that the address can send
+	// a claim swap tx without needing to be registered in state by receiving a coin transfer.
+	recipientAcc := k.accountKeeper.GetAccount(ctx, recipient)
+	if recipientAcc == nil {
+		newAcc := k.accountKeeper.NewAccountWithAddress(ctx, recipient)
+		k.accountKeeper.SetAccount(ctx, newAcc)
+	}
+
+	// Generic swaps do not change asset supplies, the module holds the coins until the swap is claimed or refunded
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	// Store the details of the swap, inserting it under both keys
+	k.SetAtomicSwap(ctx, atomicSwap)
+	k.InsertIntoExpiryIndex(ctx, atomicSwap)
+
+	// Emit 'create_atomic_swap' event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateAtomicSwap,
+			sdk.NewAttribute(types.AttributeKeySender, atomicSwap.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, atomicSwap.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
+			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
+			sdk.NewAttribute(types.AttributeKeyHashAlgorithm, atomicSwap.HashAlgorithm.String()),
+			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", atomicSwap.Timestamp)),
+			sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", atomicSwap.ExpireHeight)),
+			sdk.NewAttribute(types.AttributeKeyExpireTime, fmt.Sprintf("%d", atomicSwap.ExpireTime)),
+			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
 		),
 	)
 
@@ -141,7 +242,12 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 	}
 
 	//  Calculate hashed secret using submitted number
-	hashedSubmittedNumber := types.CalculateRandomHash(randomNumber, atomicSwap.Timestamp)
+	var hashedSubmittedNumber []byte
+	if atomicSwap.IsGeneric() {
+		hashedSubmittedNumber = types.CalculateHashLock(randomNumber, atomicSwap.HashAlgorithm)
+	} else {
+		hashedSubmittedNumber = types.CalculateRandomHash(randomNumber, atomicSwap.Timestamp)
+	}
 	hashedSecret := types.CalculateSwapID(hashedSubmittedNumber, atomicSwap.Sender, atomicSwap.SenderOtherChain)
 
 	// Confirm that secret unlocks the atomic swap
@@ -152,11 +258,11 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 	var err error
 	switch atomicSwap.Direction {
 	case types.Incoming:
-		err = k.DecrementIncomingAssetSupply(ctx, atomicSwap.Amount[0])
+		err = k.DecrementIncomingAssetSupply(ctx, atomicSwap.Amount[0], atomicSwap.GetDeputy())
 		if err != nil {
 			return err
 		}
-		err = k.IncrementCurrentAssetSupply(ctx, atomicSwap.Amount[0])
+		err = k.IncrementCurrentAssetSupply(ctx, atomicSwap.Amount[0], atomicSwap.GetDeputy())
 		if err != nil {
 			return err
 		}
@@ -171,11 +277,11 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 			return err
 		}
 	case types.Outgoing:
-		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0])
+		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0], atomicSwap.GetDeputy())
 		if err != nil {
 			return err
 		}
-		err = k.DecrementCurrentAssetSupply(ctx, atomicSwap.Amount[0])
+		err = k.DecrementCurrentAssetSupply(ctx, atomicSwap.Amount[0], atomicSwap.GetDeputy())
 		if err != nil {
 			return err
 		}
@@ -184,6 +290,23 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 		if err != nil {
 			return err
 		}
+		// Pay the deputy's fee, discounted for the sender if the swap was claimed quickly
+		if !atomicSwap.Fee.Empty() {
+			discount := sdk.NewCoins()
+			if atomicSwap.IsQuickClaim(ctx.BlockHeight()) {
+				discount = atomicSwap.QuickClaimDiscount
+			}
+			err = k.SettleSwapFee(ctx, atomicSwap, discount)
+			if err != nil {
+				return err
+			}
+		}
+	case types.Generic:
+		// generic case - escrowed coins are sent to the recipient
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Recipient, atomicSwap.Amount)
+		if err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("invalid swap direction: %s", atomicSwap.Direction.String())
 	}
@@ -193,8 +316,8 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 	atomicSwap.ClosedBlock = ctx.BlockHeight()
 	k.SetAtomicSwap(ctx, atomicSwap)
 
-	// Remove from byBlock index and transition to longterm storage
-	k.RemoveFromByBlockIndex(ctx, atomicSwap)
+	// Remove from byBlock or byTime index and transition to longterm storage
+	k.RemoveFromExpiryIndex(ctx, atomicSwap)
 	k.InsertIntoLongtermStorage(ctx, atomicSwap)
 
 	// Emit 'claim_atomic_swap' event
@@ -226,14 +349,24 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 	var err error
 	switch atomicSwap.Direction {
 	case types.Incoming:
-		err = k.DecrementIncomingAssetSupply(ctx, atomicSwap.Amount[0])
+		err = k.DecrementIncomingAssetSupply(ctx, atomicSwap.Amount[0], atomicSwap.GetDeputy())
 	case types.Outgoing:
-		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0])
+		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0], atomicSwap.GetDeputy())
 		if err != nil {
 			return err
 		}
 		// Refund coins to original swap sender for outgoing swaps
 		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Sender, atomicSwap.Amount)
+		if err != nil {
+			return err
+		}
+		// Pay the deputy's fee, refunding part of it to the sender
+		if !atomicSwap.Fee.Empty() {
+			err = k.SettleSwapFee(ctx, atomicSwap, atomicSwap.FeeRefund)
+		}
+	case types.Generic:
+		// Refund escrowed coins to original swap sender
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Sender, atomicSwap.Amount)
 	default:
 		err = fmt.Errorf("invalid swap direction: %s", atomicSwap.Direction.String())
 	}
@@ -281,6 +414,19 @@ func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 		expiredSwapIDs = append(expiredSwapIDs, hex.EncodeToString(atomicSwap.GetSwapID()))
 		return false
 	})
+	k.IterateAtomicSwapsByTime(ctx, ctx.BlockTime().Unix(), func(id []byte) bool {
+		atomicSwap, found := k.GetAtomicSwap(ctx, id)
+		if !found {
+			// NOTE: shouldn't happen. Continue to next item.
+			return false
+		}
+		// Expire the uncompleted time locked swap and update both indexes
+		atomicSwap.Status = types.Expired
+		k.RemoveFromByTimeIndex(ctx, atomicSwap)
+		k.SetAtomicSwap(ctx, atomicSwap)
+		expiredSwapIDs = append(expiredSwapIDs, hex.EncodeToString(atomicSwap.GetSwapID()))
+		return false
+	})
 
 	// Emit 'swaps_expired' event
 	ctx.EventManager().EmitEvent(
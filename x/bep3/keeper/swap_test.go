@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -405,7 +406,7 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 			// Increment current asset supply to support outgoing swaps
 			suite.ctx = suite.ctx.WithBlockTime(tc.blockTime)
 			if tc.args.direction == types.Outgoing {
-				err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, tc.args.coins[0])
+				err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, tc.args.coins[0], suite.deputy)
 				suite.Nil(err)
 			}
 
@@ -471,6 +472,7 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 						Status:              types.Open,
 						CrossChain:          tc.args.crossChain,
 						Direction:           tc.args.direction,
+						CreatedBlock:        suite.ctx.BlockHeight(),
 					}
 				suite.Equal(expectedSwap, actualSwap)
 			} else {
@@ -594,7 +596,7 @@ func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 			if tc.args.direction == types.Outgoing {
 				sender = suite.addrs[6]
 				expectedRecipient = suite.deputy
-				err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, tc.args.coins[0])
+				err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, tc.args.coins[0], suite.deputy)
 				suite.Nil(err)
 			}
 
@@ -751,7 +753,7 @@ func (suite *AtomicSwapTestSuite) TestRefundAtomicSwap() {
 			if tc.args.direction == types.Outgoing {
 				sender = suite.addrs[6]
 				expectedRecipient = suite.deputy
-				err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, expectedRefundAmount[0])
+				err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, expectedRefundAmount[0], suite.deputy)
 				suite.Nil(err)
 			}
 
@@ -834,6 +836,186 @@ func (suite *AtomicSwapTestSuite) TestRefundAtomicSwap() {
 	}
 }
 
+func (suite *AtomicSwapTestSuite) TestGenericAtomicSwap() {
+	suite.SetupTest()
+	ak := suite.app.GetAccountKeeper()
+	sender, recipient := suite.addrs[1], suite.addrs[2]
+	amount := cs(c(OTHER_DENOM, 50000))
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) sdk.Int {
+		return ak.GetAccount(ctx, addr).GetCoins().AmountOf(OTHER_DENOM)
+	}
+	preimage, _ := types.GenerateSecureRandomNumber()
+
+	// Swaps must be locked by exactly one of height and time, within the accepted range
+	sha256Lock := types.CalculateHashLock(preimage, types.SHA256Hash)
+	err := suite.keeper.CreateHTLC(suite.ctx, sha256Lock, types.SHA256Hash, 0, 0, sender, recipient, amount)
+	suite.True(errors.Is(err, types.ErrInvalidExpireTime))
+	err = suite.keeper.CreateHTLC(suite.ctx, sha256Lock, types.SHA256Hash, types.MaxHTLCHeightSpan+1, 0, sender, recipient, amount)
+	suite.True(errors.Is(err, types.ErrInvalidHeightSpan))
+	err = suite.keeper.CreateHTLC(suite.ctx, sha256Lock, types.SHA256Hash, 0, suite.ctx.BlockTime().Unix(), sender, recipient, amount)
+	suite.True(errors.Is(err, types.ErrInvalidExpireTime))
+	err = suite.keeper.CreateHTLC(suite.ctx, sha256Lock, types.SHA256Hash, 0, suite.ctx.BlockTime().Unix()+types.MaxHTLCTimeSpan+1, sender, recipient, amount)
+	suite.True(errors.Is(err, types.ErrInvalidExpireTime))
+	err = suite.keeper.CreateHTLC(suite.ctx, sha256Lock, types.SHA256Hash, 100, 0, sender, suite.randMacc, amount)
+	suite.Error(err)
+
+	// A height locked SHA-256 swap escrows the sender's coins until the recipient claims them
+	senderBalance, recipientBalance := balance(suite.ctx, sender), balance(suite.ctx, recipient)
+	suite.NoError(suite.keeper.CreateHTLC(suite.ctx, sha256Lock, types.SHA256Hash, 100, 0, sender, recipient, amount))
+	suite.Equal(senderBalance.Sub(amount[0].Amount), balance(suite.ctx, sender))
+	swapID := types.CalculateSwapID(sha256Lock, sender, "")
+	swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.True(found)
+	suite.Equal(types.Generic, swap.Direction)
+	suite.Equal(uint64(suite.ctx.BlockHeight())+100, swap.ExpireHeight)
+
+	err = suite.keeper.CreateHTLC(suite.ctx, sha256Lock, types.SHA256Hash, 100, 0, sender, recipient, amount)
+	suite.True(errors.Is(err, types.ErrAtomicSwapAlreadyExists))
+	otherPreimage, _ := types.GenerateSecureRandomNumber()
+	err = suite.keeper.ClaimAtomicSwap(suite.ctx, recipient, swapID, otherPreimage)
+	suite.True(errors.Is(err, types.ErrInvalidClaimSecret))
+	suite.NoError(suite.keeper.ClaimAtomicSwap(suite.ctx, recipient, swapID, preimage))
+	suite.Equal(recipientBalance.Add(amount[0].Amount), balance(suite.ctx, recipient))
+	swap, _ = suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Equal(types.Completed, swap.Status)
+
+	// A time locked Keccak-256 swap expires at its expire time and can then be refunded to the sender
+	keccakLock := types.CalculateHashLock(preimage, types.Keccak256Hash)
+	expireTime := suite.ctx.BlockTime().Add(time.Hour).Unix()
+	senderBalance, recipientBalance = balance(suite.ctx, sender), balance(suite.ctx, recipient)
+	suite.NoError(suite.keeper.CreateHTLC(suite.ctx, keccakLock, types.Keccak256Hash, 0, expireTime, sender, recipient, amount))
+	swapID = types.CalculateSwapID(keccakLock, sender, "")
+
+	err = suite.keeper.RefundAtomicSwap(suite.ctx, sender, swapID)
+	suite.True(errors.Is(err, types.ErrSwapNotRefundable))
+	// the height based index does not expire time locked swaps
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + int64(types.MaxHTLCHeightSpan)).WithBlockTime(time.Unix(expireTime-1, 0))
+	bep3.BeginBlocker(ctx, suite.keeper)
+	swap, _ = suite.keeper.GetAtomicSwap(ctx, swapID)
+	suite.Equal(types.Open, swap.Status)
+
+	ctx = ctx.WithBlockTime(time.Unix(expireTime, 0))
+	bep3.BeginBlocker(ctx, suite.keeper)
+	swap, _ = suite.keeper.GetAtomicSwap(ctx, swapID)
+	suite.Equal(types.Expired, swap.Status)
+	err = suite.keeper.ClaimAtomicSwap(ctx, recipient, swapID, preimage)
+	suite.True(errors.Is(err, types.ErrSwapNotClaimable))
+	suite.NoError(suite.keeper.RefundAtomicSwap(ctx, sender, swapID))
+	suite.Equal(senderBalance, balance(ctx, sender))
+	suite.Equal(recipientBalance, balance(ctx, recipient))
+}
+
+func (suite *AtomicSwapTestSuite) TestMultipleDeputies() {
+	suite.SetupTest()
+	primary, secondary := suite.deputy, suite.addrs[1]
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AssetParams[0].Deputies = types.DeputyParams{types.NewDeputyParam(secondary, sdk.NewInt(5000), sdk.NewInt(100000))}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	createSwap := func(i int, sender, recipient sdk.AccAddress, amount int64) error {
+		return suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i], types.DefaultMinBlockLock,
+			sender, recipient, TestSenderOtherChain, TestRecipientOtherChain, cs(c(BNB_DENOM, amount)), true)
+	}
+	deputySupply := func(deputy sdk.AccAddress) types.DeputySupply {
+		supply, _ := suite.keeper.GetDeputySupply(suite.ctx, BNB_DENOM, deputy)
+		return supply
+	}
+
+	// Every deputy is authorized, and deputies cannot swap with each other
+	suite.ElementsMatch([]sdk.AccAddress{primary, secondary}, suite.keeper.GetAuthorizedAddresses(suite.ctx))
+	err := createSwap(0, primary, secondary, 50000)
+	suite.True(errors.Is(err, types.ErrInvalidSwapAccount))
+
+	// Incoming swaps relayed by a deputy count towards its own supply limit
+	suite.NoError(createSwap(1, secondary, suite.addrs[5], 60000))
+	err = createSwap(2, secondary, suite.addrs[5], 40001)
+	suite.True(errors.Is(err, types.ErrExceedsDeputySupplyLimit))
+	// the primary deputy has no supply limit
+	suite.NoError(createSwap(3, primary, suite.addrs[5], 40001))
+	suite.Equal(c(BNB_DENOM, 60000), deputySupply(secondary).IncomingSupply)
+	suite.Equal(c(BNB_DENOM, 40001), deputySupply(primary).IncomingSupply)
+
+	// Claiming moves the swap amount into the relaying deputy's current supply
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[1], secondary, TestSenderOtherChain)
+	suite.NoError(suite.keeper.ClaimAtomicSwap(suite.ctx, suite.addrs[5], swapID, suite.randomNumbers[1]))
+	suite.Equal(c(BNB_DENOM, 0), deputySupply(secondary).IncomingSupply)
+	suite.Equal(c(BNB_DENOM, 60000), deputySupply(secondary).CurrentSupply)
+
+	// Outgoing swaps are sent to the deputy chosen by the user, and must be able to pay that deputy's fee
+	err = createSwap(4, suite.addrs[5], secondary, 5001)
+	suite.True(errors.Is(err, types.ErrInsufficientAmount))
+	suite.NoError(createSwap(4, suite.addrs[5], secondary, 20000))
+	suite.Equal(c(BNB_DENOM, 20000), deputySupply(secondary).OutgoingSupply)
+	suite.Equal(c(BNB_DENOM, 0), deputySupply(primary).OutgoingSupply)
+}
+
+func (suite *AtomicSwapTestSuite) TestFeeSchedule() {
+	suite.SetupTest()
+	ak := suite.app.GetAccountKeeper()
+	deputy := suite.deputy
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AssetParams[0].FeeSchedule = types.NewFeeSchedule(
+		types.FeeTiers{types.NewFeeTier(i(0), i(100)), types.NewFeeTier(i(100000), i(500))},
+		sdk.MustNewDecFromStr("0.01"), i(200), 10, sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.8"),
+	)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	createSwap := func(ctx sdk.Context, i int, sender, recipient sdk.AccAddress, amount int64) error {
+		return suite.keeper.CreateAtomicSwap(ctx, suite.randomNumberHashes[i], suite.timestamps[i], types.DefaultMinBlockLock,
+			sender, recipient, TestSenderOtherChain, TestRecipientOtherChain, cs(c(BNB_DENOM, amount)), true)
+	}
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) sdk.Int {
+		return ak.GetAccount(ctx, addr).GetCoins().AmountOf(BNB_DENOM)
+	}
+
+	// Incoming swaps are not charged a fee
+	suite.NoError(createSwap(suite.ctx, 0, deputy, suite.addrs[5], 100000))
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], deputy, TestSenderOtherChain)
+	swap, _ := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.True(swap.Fee.Empty())
+	suite.NoError(suite.keeper.ClaimAtomicSwap(suite.ctx, suite.addrs[5], swapID, suite.randomNumbers[0]))
+
+	// Outgoing swaps are charged the schedule's fee in addition to the swap amount
+	sender := suite.addrs[6]
+	senderBalance, deputyBalance := balance(suite.ctx, sender), balance(suite.ctx, deputy)
+	suite.NoError(createSwap(suite.ctx, 1, sender, deputy, 50000))
+	swapID = types.CalculateSwapID(suite.randomNumberHashes[1], sender, TestSenderOtherChain)
+	swap, _ = suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Equal(cs(c(BNB_DENOM, 600)), swap.Fee)
+	suite.Equal(suite.ctx.BlockHeight(), swap.CreatedBlock)
+	suite.Equal(cs(c(BNB_DENOM, 480)), swap.FeeRefund)
+	suite.Equal(uint64(10), swap.QuickClaimBlocks)
+	suite.Equal(cs(c(BNB_DENOM, 300)), swap.QuickClaimDiscount)
+	suite.Equal(senderBalance.Sub(i(50600)), balance(suite.ctx, sender))
+
+	// A quickly claimed swap returns the discount to the sender and pays the rest to the deputy
+	claimCtx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 10)
+	suite.NoError(suite.keeper.ClaimAtomicSwap(claimCtx, deputy, swapID, suite.randomNumbers[1]))
+	suite.Equal(senderBalance.Sub(i(50300)), balance(claimCtx, sender))
+	suite.Equal(deputyBalance.Add(i(300)), balance(claimCtx, deputy))
+	suite.Equal(cs(c(BNB_DENOM, 300)), suite.keeper.GetDeputyFeeRevenue(claimCtx, deputy))
+
+	// Swaps too small to pay the deputy's fixed fee can be created when the deputy has a fee schedule,
+	// and are charged at least the minimum fee
+	senderBalance = balance(suite.ctx, sender)
+	suite.NoError(createSwap(suite.ctx, 2, sender, deputy, 500))
+	swapID = types.CalculateSwapID(suite.randomNumberHashes[2], sender, TestSenderOtherChain)
+	swap, _ = suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Equal(cs(c(BNB_DENOM, 200)), swap.Fee)
+
+	// Swaps settle on the schedule they were created under, even if the deputy's schedule is later removed
+	params = suite.keeper.GetParams(suite.ctx)
+	params.AssetParams[0].FeeSchedule = types.FeeSchedule{}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// A refunded swap returns the swap amount and the schedule's refund rate of the fee to the sender
+	refundCtx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 400)
+	bep3.BeginBlocker(refundCtx, suite.keeper)
+	suite.NoError(suite.keeper.RefundAtomicSwap(refundCtx, sender, swapID))
+	suite.Equal(senderBalance.Sub(i(40)), balance(refundCtx, sender))
+	suite.Equal(cs(c(BNB_DENOM, 340)), suite.keeper.GetDeputyFeeRevenue(refundCtx, deputy))
+}
+
 func TestAtomicSwapTestSuite(t *testing.T) {
 	suite.Run(t, new(AtomicSwapTestSuite))
 }
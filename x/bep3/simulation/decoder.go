@@ -23,7 +23,8 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		return fmt.Sprintf("%v\n%v", swapA, swapB)
 
 	case bytes.Equal(kvA.Key[:1], types.AtomicSwapByBlockPrefix),
-		bytes.Equal(kvA.Key[:1], types.AtomicSwapLongtermStoragePrefix):
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapLongtermStoragePrefix),
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapByTimePrefix):
 		var bytesA tmbytes.HexBytes = kvA.Value
 		var bytesB tmbytes.HexBytes = kvA.Value
 		return fmt.Sprintf("%s\n%s", bytesA.String(), bytesB.String())
@@ -32,6 +33,11 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &supplyA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &supplyB)
 		return fmt.Sprintf("%s\n%s", supplyA, supplyB)
+	case bytes.Equal(kvA.Key[:1], types.DeputySupplyPrefix):
+		var supplyA, supplyB types.DeputySupply
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &supplyA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &supplyB)
+		return fmt.Sprintf("%s\n%s", supplyA, supplyB)
 	case bytes.Equal(kvA.Key[:1], types.PreviousBlockTimeKey):
 		var timeA, timeB time.Time
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &timeA)
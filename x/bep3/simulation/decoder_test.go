@@ -30,11 +30,13 @@ func TestDecodeBep3Store(t *testing.T) {
 	oneCoin := sdk.NewCoin("coin", sdk.OneInt())
 	swap := types.NewAtomicSwap(sdk.Coins{oneCoin}, nil, 10, 100, nil, nil, "otherChainSender", "otherChainRec", 200, types.Completed, true, types.Outgoing)
 	supply := types.AssetSupply{IncomingSupply: oneCoin, OutgoingSupply: oneCoin, CurrentSupply: oneCoin, TimeLimitedCurrentSupply: oneCoin, TimeElapsed: time.Duration(0)}
+	deputySupply := types.NewDeputySupply(sdk.AccAddress("deputy"), oneCoin, oneCoin, oneCoin, oneCoin)
 	bz := tmbytes.HexBytes([]byte{1, 2})
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.AtomicSwapKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(swap)},
 		kv.Pair{Key: types.AssetSupplyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(supply)},
+		kv.Pair{Key: types.DeputySupplyPrefix, Value: cdc.MustMarshalBinaryBare(deputySupply)},
 		kv.Pair{Key: types.AtomicSwapByBlockPrefix, Value: bz},
 		kv.Pair{Key: types.AtomicSwapByBlockPrefix, Value: bz},
 		kv.Pair{Key: types.PreviousBlockTimeKey, Value: cdc.MustMarshalBinaryLengthPrefixed(prevBlockTime)},
@@ -47,6 +49,7 @@ func TestDecodeBep3Store(t *testing.T) {
 	}{
 		{"AtomicSwap", fmt.Sprintf("%v\n%v", swap, swap)},
 		{"AssetSupply", fmt.Sprintf("%v\n%v", supply, supply)},
+		{"DeputySupply", fmt.Sprintf("%v\n%v", deputySupply, deputySupply)},
 		{"AtomicSwapByBlock", fmt.Sprintf("%s\n%s", bz, bz)},
 		{"AtomicSwapLongtermStorage", fmt.Sprintf("%s\n%s", bz, bz)},
 		{"PreviousBlockTime", fmt.Sprintf("%s\n%s", prevBlockTime, prevBlockTime)},
@@ -65,8 +65,8 @@ func SimulateMsgCreateAtomicSwap(ak types.AccountKeeper, k keeper.Keeper) simula
 			}
 			if supply.CurrentSupply.Amount.IsPositive() {
 				authAcc := ak.GetAccount(ctx, simAcc.Address)
-				// deputy cannot be sender of outgoing swap
-				if authAcc.GetAddress().Equals(asset.DeputyAddress) {
+				// deputies cannot be sender of outgoing swap
+				if _, isDeputy := asset.GetDeputy(authAcc.GetAddress()); isDeputy {
 					return false
 				}
 				// Search for an account that holds coins received by an atomic swap
@@ -96,8 +96,8 @@ func SimulateMsgCreateAtomicSwap(ak types.AccountKeeper, k keeper.Keeper) simula
 			asset = assets[r.Intn(len(assets))]
 			var eligibleAccs []simulation.Account
 			for _, simAcc := range accs {
-				// don't allow recipient of incoming swap to be the deputy
-				if simAcc.Address.Equals(asset.DeputyAddress) {
+				// don't allow recipient of incoming swap to be a deputy
+				if _, isDeputy := asset.GetDeputy(simAcc.Address); isDeputy {
 					continue
 				}
 				eligibleAccs = append(eligibleAccs, simAcc)
@@ -132,7 +132,7 @@ func SimulateMsgCreateAtomicSwap(ak types.AccountKeeper, k keeper.Keeper) simula
 			return noOpMsg, nil, fmt.Errorf("no asset supply found for %s", asset.Denom)
 		}
 		// The maximum amount for outgoing swaps is limited by the asset's current supply
-		if recipient.Address.Equals(asset.DeputyAddress) {
+		if _, isDeputy := asset.GetDeputy(recipient.Address); isDeputy {
 
 			if maximumAmount.GT(assetSupply.CurrentSupply.Amount.Sub(assetSupply.OutgoingSupply.Amount)) {
 				maximumAmount = assetSupply.CurrentSupply.Amount.Sub(assetSupply.OutgoingSupply.Amount)
@@ -227,20 +227,20 @@ func operationClaimAtomicSwap(ak types.AccountKeeper, k keeper.Keeper, swapID []
 		cacheCtx, _ := ctx.CacheContext()
 		switch swap.Direction {
 		case types.Incoming:
-			err := k.DecrementIncomingAssetSupply(cacheCtx, swap.Amount[0])
+			err := k.DecrementIncomingAssetSupply(cacheCtx, swap.Amount[0], swap.GetDeputy())
 			if err != nil {
 				return simulation.NewOperationMsgBasic(types.ModuleName, fmt.Sprintf("no-operation (could not claim - unable to decrement incoming asset supply %s)", swap.Amount[0].Denom), "", false, nil), nil, nil
 			}
-			err = k.IncrementCurrentAssetSupply(cacheCtx, swap.Amount[0])
+			err = k.IncrementCurrentAssetSupply(cacheCtx, swap.Amount[0], swap.GetDeputy())
 			if err != nil {
 				return simulation.NewOperationMsgBasic(types.ModuleName, fmt.Sprintf("no-operation (could not claim - unable to increment current asset supply %s)", swap.Amount[0].Denom), "", false, nil), nil, nil
 			}
 		case types.Outgoing:
-			err := k.DecrementOutgoingAssetSupply(cacheCtx, swap.Amount[0])
+			err := k.DecrementOutgoingAssetSupply(cacheCtx, swap.Amount[0], swap.GetDeputy())
 			if err != nil {
 				return simulation.NewOperationMsgBasic(types.ModuleName, fmt.Sprintf("no-operation (could not claim - unable to decrement outgoing asset supply %s)", swap.Amount[0].Denom), "", false, nil), nil, nil
 			}
-			err = k.DecrementCurrentAssetSupply(cacheCtx, swap.Amount[0])
+			err = k.DecrementCurrentAssetSupply(cacheCtx, swap.Amount[0], swap.GetDeputy())
 			if err != nil {
 				return simulation.NewOperationMsgBasic(types.ModuleName, fmt.Sprintf("no-operation (could not claim - unable to decrement current asset supply %s)", swap.Amount[0].Denom), "", false, nil), nil, nil
 			}
@@ -294,11 +294,11 @@ func operationRefundAtomicSwap(ak types.AccountKeeper, k keeper.Keeper, swapID [
 		cacheCtx, _ := ctx.CacheContext()
 		switch swap.Direction {
 		case types.Incoming:
-			if err := k.DecrementIncomingAssetSupply(cacheCtx, swap.Amount[0]); err != nil {
+			if err := k.DecrementIncomingAssetSupply(cacheCtx, swap.Amount[0], swap.GetDeputy()); err != nil {
 				return simulation.NewOperationMsgBasic(types.ModuleName, fmt.Sprintf("no-operation (could not refund - unable to decrement incoming asset supply %s)", swap.Amount[0].Denom), "", false, nil), nil, nil
 			}
 		case types.Outgoing:
-			if err := k.DecrementOutgoingAssetSupply(cacheCtx, swap.Amount[0]); err != nil {
+			if err := k.DecrementOutgoingAssetSupply(cacheCtx, swap.Amount[0], swap.GetDeputy()); err != nil {
 				return simulation.NewOperationMsgBasic(types.ModuleName, fmt.Sprintf("no-operation (could not refund - unable to decrement outgoing asset supply %s)", swap.Amount[0].Denom), "", false, nil), nil, nil
 			}
 		}
@@ -45,4 +45,8 @@ var (
 	ErrInvalidSwapAccount = sdkerrors.Register(ModuleName, 19, "atomic swap has invalid account")
 	// ErrExceedsTimeBasedSupplyLimit error for when the proposed supply increase would put the supply above limit for the current time period
 	ErrExceedsTimeBasedSupplyLimit = sdkerrors.Register(ModuleName, 20, "asset supply over limit for current time period")
+	// ErrInvalidExpireTime error for when a generic swap's expire time is outside of the acceptable range
+	ErrInvalidExpireTime = sdkerrors.Register(ModuleName, 21, "expire time is outside acceptable range")
+	// ErrExceedsDeputySupplyLimit error for when the proposed supply increase would put a deputy's supply over its limit
+	ErrExceedsDeputySupplyLimit = sdkerrors.Register(ModuleName, 22, "deputy supply over limit")
 )
@@ -22,4 +22,7 @@ const (
 	AttributeKeyRefundSender     = "refund_sender"
 	AttributeKeyAtomicSwapIDs    = "atomic_swap_ids"
 	AttributeExpirationBlock     = "expiration_block"
+	AttributeKeyExpireTime       = "expire_time"
+	AttributeKeyHashAlgorithm    = "hash_algorithm"
+	AttributeKeyFee              = "fee"
 )
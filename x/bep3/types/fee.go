@@ -0,0 +1,183 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeSchedule determines the fee a deputy charges on outgoing swaps. The fee is paid by the sender in addition to the
+// swap amount when the swap is created, and is partially returned to the sender if the swap is claimed quickly or refunded.
+type FeeSchedule struct {
+	Tiers              FeeTiers `json:"tiers" yaml:"tiers"`                               // flat fees charged on swaps of at least each tier's amount
+	Rate               sdk.Dec  `json:"rate" yaml:"rate"`                                 // fraction of the swap amount charged in addition to the tier fee
+	MinimumFee         sdk.Int  `json:"minimum_fee" yaml:"minimum_fee"`                   // the least fee charged on any swap
+	QuickClaimBlocks   uint64   `json:"quick_claim_blocks" yaml:"quick_claim_blocks"`     // swaps claimed within this many blocks of being created are discounted
+	QuickClaimDiscount sdk.Dec  `json:"quick_claim_discount" yaml:"quick_claim_discount"` // fraction of the fee returned to the sender of a quickly claimed swap
+	RefundRate         sdk.Dec  `json:"refund_rate" yaml:"refund_rate"`                   // fraction of the fee returned to the sender of a refunded swap
+}
+
+// NewFeeSchedule returns a new FeeSchedule
+func NewFeeSchedule(tiers FeeTiers, rate sdk.Dec, minimumFee sdk.Int, quickClaimBlocks uint64,
+	quickClaimDiscount sdk.Dec, refundRate sdk.Dec) FeeSchedule {
+	return FeeSchedule{
+		Tiers:              tiers,
+		Rate:               rate,
+		MinimumFee:         minimumFee,
+		QuickClaimBlocks:   quickClaimBlocks,
+		QuickClaimDiscount: quickClaimDiscount,
+		RefundRate:         refundRate,
+	}
+}
+
+// IsEmpty returns true if the schedule charges no fee
+func (fs FeeSchedule) IsEmpty() bool {
+	return len(fs.Tiers) == 0 && decOrZero(fs.Rate).IsZero() && intOrZero(fs.MinimumFee).IsZero()
+}
+
+// CalculateFee returns the fee charged on a swap amount, the fee of the highest tier the amount reaches
+// plus the rate of the amount, and no less than the minimum fee
+func (fs FeeSchedule) CalculateFee(amount sdk.Int) sdk.Int {
+	fee := sdk.ZeroInt()
+	for _, tier := range fs.Tiers {
+		if amount.GTE(tier.MinAmount) {
+			fee = tier.Fee
+		}
+	}
+	fee = fee.Add(decOrZero(fs.Rate).MulInt(amount).TruncateInt())
+	return sdk.MaxInt(fee, intOrZero(fs.MinimumFee))
+}
+
+// CalculateQuickClaimDiscount returns the part of a fee returned to the sender of a quickly claimed swap
+func (fs FeeSchedule) CalculateQuickClaimDiscount(fee sdk.Int) sdk.Int {
+	return decOrZero(fs.QuickClaimDiscount).MulInt(fee).TruncateInt()
+}
+
+// CalculateRefund returns the part of a fee returned to the sender of a refunded swap
+func (fs FeeSchedule) CalculateRefund(fee sdk.Int) sdk.Int {
+	return decOrZero(fs.RefundRate).MulInt(fee).TruncateInt()
+}
+
+// Validate performs a basic check of a FeeSchedule
+func (fs FeeSchedule) Validate() error {
+	if err := fs.Tiers.Validate(); err != nil {
+		return err
+	}
+	if err := validateFraction("fee rate", fs.Rate); err != nil {
+		return err
+	}
+	if intOrZero(fs.MinimumFee).IsNegative() {
+		return fmt.Errorf("minimum fee cannot be negative: %s", fs.MinimumFee)
+	}
+	if err := validateFraction("quick claim discount", fs.QuickClaimDiscount); err != nil {
+		return err
+	}
+	return validateFraction("refund rate", fs.RefundRate)
+}
+
+// Equal returns true if two fee schedules are the same, treating unset values as zero
+func (fs FeeSchedule) Equal(fs2 FeeSchedule) bool {
+	return fs.Tiers.Equal(fs2.Tiers) &&
+		decOrZero(fs.Rate).Equal(decOrZero(fs2.Rate)) &&
+		intOrZero(fs.MinimumFee).Equal(intOrZero(fs2.MinimumFee)) &&
+		fs.QuickClaimBlocks == fs2.QuickClaimBlocks &&
+		decOrZero(fs.QuickClaimDiscount).Equal(decOrZero(fs2.QuickClaimDiscount)) &&
+		decOrZero(fs.RefundRate).Equal(decOrZero(fs2.RefundRate))
+}
+
+// String implements fmt.Stringer
+func (fs FeeSchedule) String() string {
+	return fmt.Sprintf(`Fee Schedule:
+		Tiers: %s
+		Rate: %s
+		Minimum Fee: %s
+		Quick Claim Blocks: %d
+		Quick Claim Discount: %s
+		Refund Rate: %s`,
+		fs.Tiers, fs.Rate, fs.MinimumFee, fs.QuickClaimBlocks, fs.QuickClaimDiscount, fs.RefundRate)
+}
+
+// FeeTier is a flat fee charged on swaps of at least a minimum amount
+type FeeTier struct {
+	MinAmount sdk.Int `json:"min_amount" yaml:"min_amount"`
+	Fee       sdk.Int `json:"fee" yaml:"fee"`
+}
+
+// NewFeeTier returns a new FeeTier
+func NewFeeTier(minAmount sdk.Int, fee sdk.Int) FeeTier {
+	return FeeTier{
+		MinAmount: minAmount,
+		Fee:       fee,
+	}
+}
+
+// String implements fmt.Stringer
+func (ft FeeTier) String() string {
+	return fmt.Sprintf("%s from %s", ft.Fee, ft.MinAmount)
+}
+
+// FeeTiers array of FeeTier, ordered by increasing minimum amount
+type FeeTiers []FeeTier
+
+// Validate checks each tier has a non-negative minimum amount and fee, and that tiers are in increasing order of minimum amount
+func (fts FeeTiers) Validate() error {
+	for i, tier := range fts {
+		if tier.MinAmount.IsNil() || tier.MinAmount.IsNegative() {
+			return fmt.Errorf("fee tier minimum amount cannot be negative: %s", tier.MinAmount)
+		}
+		if tier.Fee.IsNil() || tier.Fee.IsNegative() {
+			return fmt.Errorf("fee tier fee cannot be negative: %s", tier.Fee)
+		}
+		if i > 0 && tier.MinAmount.LTE(fts[i-1].MinAmount) {
+			return fmt.Errorf("fee tiers must be in increasing order of minimum amount: %s <= %s", tier.MinAmount, fts[i-1].MinAmount)
+		}
+	}
+	return nil
+}
+
+// Equal returns true if two sets of fee tiers are the same, in the same order
+func (fts FeeTiers) Equal(fts2 FeeTiers) bool {
+	if len(fts) != len(fts2) {
+		return false
+	}
+	for i := range fts {
+		if !equalOrNil(fts[i].MinAmount, fts2[i].MinAmount) || !equalOrNil(fts[i].Fee, fts2[i].Fee) {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements fmt.Stringer
+func (fts FeeTiers) String() string {
+	out := ""
+	for _, ft := range fts {
+		out += fmt.Sprintf("\n\t\t\t%s", ft)
+	}
+	return out
+}
+
+// validateFraction checks an optional value is between 0 and 1
+func validateFraction(name string, d sdk.Dec) error {
+	d = decOrZero(d)
+	if d.IsNegative() || d.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s must be between 0 and 1: %s", name, d)
+	}
+	return nil
+}
+
+// decOrZero returns zero for an unset dec
+func decOrZero(d sdk.Dec) sdk.Dec {
+	if d.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return d
+}
+
+// intOrZero returns zero for an unset int
+func intOrZero(i sdk.Int) sdk.Int {
+	if i.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return i
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeScheduleCalculateFee(t *testing.T) {
+	schedule := NewFeeSchedule(
+		FeeTiers{NewFeeTier(sdk.NewInt(1000), sdk.NewInt(100)), NewFeeTier(sdk.NewInt(100000), sdk.NewInt(500))},
+		sdk.MustNewDecFromStr("0.01"), sdk.NewInt(150), 10, sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.8"),
+	)
+	testCases := []struct {
+		msg         string
+		amount      sdk.Int
+		expectedFee sdk.Int
+	}{
+		{"below lowest tier is charged minimum fee", sdk.NewInt(500), sdk.NewInt(150)},
+		{"lowest tier", sdk.NewInt(10000), sdk.NewInt(200)},
+		{"highest tier", sdk.NewInt(100000), sdk.NewInt(1500)},
+		{"rate is truncated", sdk.NewInt(100099), sdk.NewInt(1500)},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expectedFee, schedule.CalculateFee(tc.amount), tc.msg)
+	}
+
+	require.True(t, FeeSchedule{}.IsEmpty())
+	require.Equal(t, sdk.ZeroInt(), FeeSchedule{}.CalculateFee(sdk.NewInt(100000)))
+}
+
+func TestFeeScheduleDiscountAndRefund(t *testing.T) {
+	schedule := NewFeeSchedule(nil, sdk.ZeroDec(), sdk.NewInt(100), 10, sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.8"))
+
+	swap := AtomicSwap{CreatedBlock: 100, QuickClaimBlocks: schedule.QuickClaimBlocks}
+	require.True(t, swap.IsQuickClaim(100))
+	require.True(t, swap.IsQuickClaim(110))
+	require.False(t, swap.IsQuickClaim(111))
+	require.False(t, swap.IsQuickClaim(99))
+	require.False(t, AtomicSwap{CreatedBlock: 100}.IsQuickClaim(100))
+
+	require.Equal(t, sdk.NewInt(50), schedule.CalculateQuickClaimDiscount(sdk.NewInt(101)))
+	require.Equal(t, sdk.NewInt(80), schedule.CalculateRefund(sdk.NewInt(101)))
+	require.Equal(t, sdk.ZeroInt(), FeeSchedule{}.CalculateRefund(sdk.NewInt(101)))
+}
+
+func TestFeeScheduleValidate(t *testing.T) {
+	tiers := FeeTiers{NewFeeTier(sdk.ZeroInt(), sdk.NewInt(100)), NewFeeTier(sdk.NewInt(1000), sdk.NewInt(50))}
+	testCases := []struct {
+		msg      string
+		schedule FeeSchedule
+		expPass  bool
+	}{
+		{"empty", FeeSchedule{}, true},
+		{"valid", NewFeeSchedule(tiers, sdk.MustNewDecFromStr("0.01"), sdk.NewInt(10), 5, sdk.OneDec(), sdk.ZeroDec()), true},
+		{"negative tier amount", FeeSchedule{Tiers: FeeTiers{NewFeeTier(sdk.NewInt(-1), sdk.NewInt(100))}}, false},
+		{"negative tier fee", FeeSchedule{Tiers: FeeTiers{NewFeeTier(sdk.ZeroInt(), sdk.NewInt(-1))}}, false},
+		{"unordered tiers", FeeSchedule{Tiers: FeeTiers{tiers[1], tiers[0]}}, false},
+		{"duplicate tiers", FeeSchedule{Tiers: FeeTiers{tiers[0], tiers[0]}}, false},
+		{"negative rate", FeeSchedule{Rate: sdk.MustNewDecFromStr("-0.01")}, false},
+		{"negative minimum fee", FeeSchedule{MinimumFee: sdk.NewInt(-1)}, false},
+		{"quick claim discount above one", FeeSchedule{QuickClaimDiscount: sdk.MustNewDecFromStr("1.01")}, false},
+		{"refund rate above one", FeeSchedule{RefundRate: sdk.MustNewDecFromStr("1.01")}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.schedule.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
@@ -9,19 +9,21 @@ import (
 
 // GenesisState - all bep3 state that must be provided at genesis
 type GenesisState struct {
-	Params            Params        `json:"params" yaml:"params"`
-	AtomicSwaps       AtomicSwaps   `json:"atomic_swaps" yaml:"atomic_swaps"`
-	Supplies          AssetSupplies `json:"supplies" yaml:"supplies"`
-	PreviousBlockTime time.Time     `json:"previous_block_time" yaml:"previous_block_time"`
+	Params            Params         `json:"params" yaml:"params"`
+	AtomicSwaps       AtomicSwaps    `json:"atomic_swaps" yaml:"atomic_swaps"`
+	Supplies          AssetSupplies  `json:"supplies" yaml:"supplies"`
+	PreviousBlockTime time.Time      `json:"previous_block_time" yaml:"previous_block_time"`
+	DeputySupplies    DeputySupplies `json:"deputy_supplies" yaml:"deputy_supplies"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, swaps AtomicSwaps, supplies AssetSupplies, previousBlockTime time.Time) GenesisState {
+func NewGenesisState(params Params, swaps AtomicSwaps, supplies AssetSupplies, previousBlockTime time.Time, deputySupplies DeputySupplies) GenesisState {
 	return GenesisState{
 		Params:            params,
 		AtomicSwaps:       swaps,
 		Supplies:          supplies,
 		PreviousBlockTime: previousBlockTime,
+		DeputySupplies:    deputySupplies,
 	}
 }
 
@@ -32,6 +34,7 @@ func DefaultGenesisState() GenesisState {
 		AtomicSwaps{},
 		AssetSupplies{},
 		DefaultPreviousBlockTime,
+		DeputySupplies{},
 	)
 }
 
@@ -76,5 +79,17 @@ func (gs GenesisState) Validate() error {
 		}
 		supplyDenoms[supply.GetDenom()] = true
 	}
+
+	deputySupplies := map[string]bool{}
+	for _, supply := range gs.DeputySupplies {
+		if err := supply.Validate(); err != nil {
+			return err
+		}
+		key := string(GetDeputySupplyKey(supply.GetDenom(), supply.DeputyAddress))
+		if deputySupplies[key] {
+			return fmt.Errorf("found duplicate deputy supply for denom %s and deputy %s", supply.GetDenom(), supply.DeputyAddress)
+		}
+		deputySupplies[key] = true
+	}
 	return nil
 }
@@ -34,7 +34,10 @@ func (suite *GenesisTestSuite) TestValidate() {
 		swaps             types.AtomicSwaps
 		supplies          types.AssetSupplies
 		previousBlockTime time.Time
+		deputySupplies    types.DeputySupplies
 	}
+	coin := sdk.NewCoin("kava", sdk.OneInt())
+	deputySupply := types.NewDeputySupply(suite.swaps[0].Sender, coin, coin, coin, coin)
 	testCases := []struct {
 		name       string
 		args       args
@@ -74,6 +77,34 @@ func (suite *GenesisTestSuite) TestValidate() {
 			},
 			false,
 		},
+		{
+			"with deputy supplies",
+			args{
+				swaps:             types.AtomicSwaps{},
+				supplies:          suite.supplies,
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				deputySupplies:    types.DeputySupplies{deputySupply},
+			},
+			true,
+		},
+		{
+			"invalid deputy supply",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				deputySupplies:    types.DeputySupplies{types.NewDeputySupply(nil, coin, coin, coin, coin)},
+			},
+			false,
+		},
+		{
+			"duplicate deputy supplies",
+			args{
+				swaps:             types.AtomicSwaps{},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+				deputySupplies:    types.DeputySupplies{deputySupply, deputySupply},
+			},
+			false,
+		},
 		{
 			"duplicate swaps",
 			args{
@@ -105,7 +136,7 @@ func (suite *GenesisTestSuite) TestValidate() {
 			if tc.name == "default" {
 				gs = types.DefaultGenesisState()
 			} else {
-				gs = types.NewGenesisState(types.DefaultParams(), tc.args.swaps, tc.args.supplies, tc.args.previousBlockTime)
+				gs = types.NewGenesisState(types.DefaultParams(), tc.args.swaps, tc.args.supplies, tc.args.previousBlockTime, tc.args.deputySupplies)
 			}
 
 			err := gs.Validate()
@@ -2,9 +2,12 @@ package types
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/binary"
 	"strings"
 
+	"golang.org/x/crypto/sha3"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -35,3 +38,18 @@ func CalculateSwapID(randomNumberHash []byte, sender sdk.AccAddress, senderOther
 	data = append(data, []byte(senderOtherChain)...)
 	return tmhash.Sum(data)
 }
+
+// CalculateHashLock calculates the hash a generic swap is locked with from its preimage, or nil if the algorithm is not valid for generic swaps
+func CalculateHashLock(preimage []byte, algorithm HashAlgorithm) []byte {
+	switch algorithm {
+	case SHA256Hash:
+		hash := sha256.Sum256(preimage)
+		return hash[:]
+	case Keccak256Hash:
+		hasher := sha3.NewLegacyKeccak256()
+		hasher.Write(preimage)
+		return hasher.Sum(nil)
+	default:
+		return nil
+	}
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -46,6 +47,13 @@ func (suite *HashTestSuite) TestCalculateRandomHash() {
 	suite.Equal(32, len(hash))
 }
 
+func (suite *HashTestSuite) TestCalculateHashLock() {
+	// hashes of the empty preimage
+	suite.Equal("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", hex.EncodeToString(types.CalculateHashLock([]byte{}, types.SHA256Hash)))
+	suite.Equal("c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470", hex.EncodeToString(types.CalculateHashLock([]byte{}, types.Keccak256Hash)))
+	suite.Nil(types.CalculateHashLock([]byte{}, types.UnspecifiedHash))
+}
+
 func (suite *HashTestSuite) TestCalculateSwapID() {
 	randomNumber, _ := types.GenerateSecureRandomNumber()
 	hash := types.CalculateRandomHash(randomNumber[:], suite.timestamps[3])
@@ -24,6 +24,12 @@ const (
 
 	// DefaultLongtermStorageDuration is 1 week (assuming a block time of 7 seconds)
 	DefaultLongtermStorageDuration uint64 = 86400
+
+	// MaxHTLCHeightSpan is the longest a generic swap can be locked by height, 1 week (assuming a block time of 7 seconds)
+	MaxHTLCHeightSpan uint64 = 86400
+
+	// MaxHTLCTimeSpan is the longest a generic swap can be locked by time, in seconds
+	MaxHTLCTimeSpan int64 = 7 * 24 * 60 * 60
 )
 
 // Key prefixes
@@ -36,9 +42,21 @@ var (
 	AtomicSwapLongtermStoragePrefix = []byte{0x02} // prefix for keys of the AtomicSwapLongtermStorage index
 	AssetSupplyPrefix               = []byte{0x03}
 	PreviousBlockTimeKey            = []byte{0x04}
+	AtomicSwapByTimePrefix          = []byte{0x05} // prefix for keys of the AtomicSwapsByTime index, which expires time locked generic swaps
+	DeputySupplyPrefix              = []byte{0x06} // prefix for keys that store the supply of each asset relayed by each deputy
 )
 
 // GetAtomicSwapByHeightKey is used by the AtomicSwapByBlock index and AtomicSwapLongtermStorage index
 func GetAtomicSwapByHeightKey(height uint64, swapID []byte) []byte {
 	return append(sdk.Uint64ToBigEndian(height), swapID...)
 }
+
+// GetAtomicSwapByTimeKey is used by the AtomicSwapByTime index
+func GetAtomicSwapByTimeKey(expireTime int64, swapID []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expireTime)), swapID...)
+}
+
+// GetDeputySupplyKey is used by the deputy supply store, keying supplies by denom then deputy
+func GetDeputySupplyKey(denom string, deputy sdk.AccAddress) []byte {
+	return append([]byte(denom), deputy...)
+}
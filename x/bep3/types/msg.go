@@ -17,6 +17,7 @@ const (
 	ClaimAtomicSwap  = "claimAtomicSwap"
 	RefundAtomicSwap = "refundAtomicSwap"
 	CalcSwapID       = "calcSwapID"
+	CreateHTLC       = "createHTLC"
 
 	Int64Size               = 8
 	RandomNumberHashLength  = 32
@@ -25,6 +26,7 @@ const (
 	MaxOtherChainAddrLength = 64
 	SwapIDLength            = 32
 	MaxExpectedIncomeLength = 64
+	HashLockLength          = 32
 )
 
 // ensure Msg interface compliance at compile time
@@ -32,6 +34,7 @@ var (
 	_                      sdk.Msg = &MsgCreateAtomicSwap{}
 	_                      sdk.Msg = &MsgClaimAtomicSwap{}
 	_                      sdk.Msg = &MsgRefundAtomicSwap{}
+	_                      sdk.Msg = &MsgCreateHTLC{}
 	AtomicSwapCoinsAccAddr         = sdk.AccAddress(crypto.AddressHash([]byte("KavaAtomicSwapCoins")))
 	// kava prefix address:  [INSERT BEP3-DEPUTY ADDRESS]
 	// tkava prefix address: [INSERT BEP3-DEPUTY ADDRESS]
@@ -135,6 +138,98 @@ func (msg MsgCreateAtomicSwap) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
+// MsgCreateHTLC creates a generic hashed timelock swap between two users without a deputy.
+// The swap is locked until either a number of blocks have passed or a unix time, but not both.
+type MsgCreateHTLC struct {
+	From          sdk.AccAddress   `json:"from"  yaml:"from"`
+	To            sdk.AccAddress   `json:"to"  yaml:"to"`
+	HashLock      tmbytes.HexBytes `json:"hash_lock"  yaml:"hash_lock"`
+	HashAlgorithm HashAlgorithm    `json:"hash_algorithm"  yaml:"hash_algorithm"`
+	Amount        sdk.Coins        `json:"amount"  yaml:"amount"`
+	HeightSpan    uint64           `json:"height_span"  yaml:"height_span"`
+	ExpireTime    int64            `json:"expire_time"  yaml:"expire_time"`
+}
+
+// NewMsgCreateHTLC initializes a new MsgCreateHTLC
+func NewMsgCreateHTLC(from sdk.AccAddress, to sdk.AccAddress, hashLock tmbytes.HexBytes, hashAlgorithm HashAlgorithm,
+	amount sdk.Coins, heightSpan uint64, expireTime int64) MsgCreateHTLC {
+	return MsgCreateHTLC{
+		From:          from,
+		To:            to,
+		HashLock:      hashLock,
+		HashAlgorithm: hashAlgorithm,
+		Amount:        amount,
+		HeightSpan:    heightSpan,
+		ExpireTime:    expireTime,
+	}
+}
+
+// Route establishes the route for the MsgCreateHTLC
+func (msg MsgCreateHTLC) Route() string { return RouterKey }
+
+// Type is the name of MsgCreateHTLC
+func (msg MsgCreateHTLC) Type() string { return CreateHTLC }
+
+// String prints the MsgCreateHTLC
+func (msg MsgCreateHTLC) String() string {
+	return fmt.Sprintf("HTLC{%v#%v#%v#%v#%v#%v#%v}",
+		msg.From, msg.To, msg.HashLock, msg.HashAlgorithm, msg.Amount, msg.HeightSpan, msg.ExpireTime)
+}
+
+// GetInvolvedAddresses gets the addresses involved in a MsgCreateHTLC
+func (msg MsgCreateHTLC) GetInvolvedAddresses() []sdk.AccAddress {
+	return append(msg.GetSigners(), AtomicSwapCoinsAccAddr)
+}
+
+// GetSigners gets the signers of a MsgCreateHTLC
+func (msg MsgCreateHTLC) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic validates the MsgCreateHTLC
+func (msg MsgCreateHTLC) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.From) != AddrByteCount {
+		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(msg.From))
+	}
+	if msg.To.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient address cannot be empty")
+	}
+	if len(msg.To) != AddrByteCount {
+		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(msg.To))
+	}
+	if msg.From.Equals(msg.To) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and recipient cannot be the same")
+	}
+	if len(msg.HashLock) != HashLockLength {
+		return fmt.Errorf("the length of hash lock should be %d", HashLockLength)
+	}
+	if !msg.HashAlgorithm.IsValid() {
+		return fmt.Errorf("invalid hash algorithm: %s", msg.HashAlgorithm)
+	}
+	if len(msg.Amount) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount cannot be empty")
+	}
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if msg.ExpireTime < 0 {
+		return errors.New("expire time cannot be negative")
+	}
+	if (msg.HeightSpan == 0) == (msg.ExpireTime == 0) {
+		return errors.New("exactly one of height span and expire time must be set")
+	}
+	return nil
+}
+
+// GetSignBytes gets the sign bytes of a MsgCreateHTLC
+func (msg MsgCreateHTLC) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
 // MsgClaimAtomicSwap defines a AtomicSwap claim
 type MsgClaimAtomicSwap struct {
 	From         sdk.AccAddress   `json:"from"  yaml:"from"`
@@ -66,6 +66,48 @@ func TestMsgCreateAtomicSwap(t *testing.T) {
 	}
 }
 
+func TestMsgCreateHTLC(t *testing.T) {
+	hashLock := types.CalculateHashLock(randomNumberBytes, types.SHA256Hash)
+	tests := []struct {
+		description   string
+		from          sdk.AccAddress
+		to            sdk.AccAddress
+		hashLock      tmbytes.HexBytes
+		hashAlgorithm types.HashAlgorithm
+		amount        sdk.Coins
+		heightSpan    uint64
+		expireTime    int64
+		expectPass    bool
+	}{
+		{"height locked", kavaAddrs[0], kavaAddrs[1], hashLock, types.SHA256Hash, coinsSingle, 500, 0, true},
+		{"time locked", kavaAddrs[0], kavaAddrs[1], hashLock, types.Keccak256Hash, coinsSingle, 0, timestampInt64, true},
+		{"height and time locked", kavaAddrs[0], kavaAddrs[1], hashLock, types.SHA256Hash, coinsSingle, 500, timestampInt64, false},
+		{"not locked", kavaAddrs[0], kavaAddrs[1], hashLock, types.SHA256Hash, coinsSingle, 0, 0, false},
+		{"negative expire time", kavaAddrs[0], kavaAddrs[1], hashLock, types.SHA256Hash, coinsSingle, 0, -1, false},
+		{"unspecified hash algorithm", kavaAddrs[0], kavaAddrs[1], hashLock, types.UnspecifiedHash, coinsSingle, 500, 0, false},
+		{"invalid hash lock length", kavaAddrs[0], kavaAddrs[1], hashLock[:20], types.SHA256Hash, coinsSingle, 500, 0, false},
+		{"sender is recipient", kavaAddrs[0], kavaAddrs[0], hashLock, types.SHA256Hash, coinsSingle, 500, 0, false},
+		{"invalid amount", kavaAddrs[0], kavaAddrs[1], hashLock, types.SHA256Hash, coinsZero, 500, 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgCreateHTLC(
+			tc.from,
+			tc.to,
+			tc.hashLock,
+			tc.hashAlgorithm,
+			tc.amount,
+			tc.heightSpan,
+			tc.expireTime,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgClaimAtomicSwap(t *testing.T) {
 	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
 
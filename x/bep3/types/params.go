@@ -63,6 +63,8 @@ type AssetParam struct {
 	MaxSwapAmount sdk.Int        `json:"max_swap_amount" yaml:"max_swap_amount"` // Maximum swap amount
 	MinBlockLock  uint64         `json:"min_block_lock" yaml:"min_block_lock"`   // Minimum swap block lock
 	MaxBlockLock  uint64         `json:"max_block_lock" yaml:"max_block_lock"`   // Maximum swap block lock
+	Deputies      DeputyParams   `json:"deputies" yaml:"deputies"`               // relayer processes in addition to the deputy address, which share the asset's swaps
+	FeeSchedule   FeeSchedule    `json:"fee_schedule" yaml:"fee_schedule"`       // the fee charged on chain by the relayer process at the deputy address for outgoing swaps
 }
 
 // NewAssetParam returns a new AssetParam
@@ -97,9 +99,32 @@ func (ap AssetParam) String() string {
 	Min Swap Amount: %s
 	Max Swap Amount: %s
 	Min Block Lock: %d
-	Max Block Lock: %d`,
+	Max Block Lock: %d
+	Deputies: %s
+	%s`,
 		ap.Denom, ap.CoinID, ap.SupplyLimit, ap.Active, ap.DeputyAddress, ap.FixedFee,
-		ap.MinSwapAmount, ap.MaxSwapAmount, ap.MinBlockLock, ap.MaxBlockLock)
+		ap.MinSwapAmount, ap.MaxSwapAmount, ap.MinBlockLock, ap.MaxBlockLock, ap.Deputies, ap.FeeSchedule)
+}
+
+// GetDeputies returns all of an asset's deputies, starting with the deputy address if it is set
+func (ap AssetParam) GetDeputies() DeputyParams {
+	var deputies DeputyParams
+	if !ap.DeputyAddress.Empty() {
+		deputy := NewDeputyParam(ap.DeputyAddress, ap.FixedFee, sdk.ZeroInt())
+		deputy.FeeSchedule = ap.FeeSchedule
+		deputies = append(deputies, deputy)
+	}
+	return append(deputies, ap.Deputies...)
+}
+
+// GetDeputy returns the asset's deputy with an address, if there is one
+func (ap AssetParam) GetDeputy(addr sdk.AccAddress) (DeputyParam, bool) {
+	for _, deputy := range ap.GetDeputies() {
+		if deputy.Address.Equals(addr) {
+			return deputy, true
+		}
+	}
+	return DeputyParam{}, false
 }
 
 // AssetParams array of AssetParam
@@ -114,6 +139,94 @@ func (aps AssetParams) String() string {
 	return out
 }
 
+// DeputyParam is a relayer process that creates incoming swaps and receives outgoing swaps for an asset
+type DeputyParam struct {
+	Address     sdk.AccAddress `json:"address" yaml:"address"`           // the address of the relayer process
+	FixedFee    sdk.Int        `json:"fixed_fee" yaml:"fixed_fee"`       // the fixed fee charged by the relayer process for outgoing swaps
+	SupplyLimit sdk.Int        `json:"supply_limit" yaml:"supply_limit"` // the most of the asset the relayer process can have on Kava at once, zero for no limit beyond the asset's
+	FeeSchedule FeeSchedule    `json:"fee_schedule" yaml:"fee_schedule"` // the fee charged on chain by the relayer process for outgoing swaps
+}
+
+// NewDeputyParam returns a new DeputyParam
+func NewDeputyParam(address sdk.AccAddress, fixedFee sdk.Int, supplyLimit sdk.Int) DeputyParam {
+	return DeputyParam{
+		Address:     address,
+		FixedFee:    fixedFee,
+		SupplyLimit: supplyLimit,
+	}
+}
+
+// HasSupplyLimit returns true if the deputy's supply is limited separately from the asset's
+func (dp DeputyParam) HasSupplyLimit() bool {
+	return !dp.SupplyLimit.IsNil() && dp.SupplyLimit.IsPositive()
+}
+
+// Validate performs a basic check of a DeputyParam
+func (dp DeputyParam) Validate() error {
+	if dp.Address.Empty() {
+		return fmt.Errorf("deputy address cannot be empty")
+	}
+	if len(dp.Address.Bytes()) != sdk.AddrLen {
+		return fmt.Errorf("deputy address %s invalid bytes length got %d, want %d", dp.Address, len(dp.Address.Bytes()), sdk.AddrLen)
+	}
+	if dp.FixedFee.IsNil() || dp.FixedFee.IsNegative() {
+		return fmt.Errorf("deputy %s cannot have a negative fixed fee %s", dp.Address, dp.FixedFee)
+	}
+	if !dp.SupplyLimit.IsNil() && dp.SupplyLimit.IsNegative() {
+		return fmt.Errorf("deputy %s has invalid (negative) supply limit: %s", dp.Address, dp.SupplyLimit)
+	}
+	if err := dp.FeeSchedule.Validate(); err != nil {
+		return fmt.Errorf("deputy %s has invalid fee schedule: %w", dp.Address, err)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (dp DeputyParam) String() string {
+	return fmt.Sprintf(`Deputy:
+		Address: %s
+		Fixed Fee: %s
+		Supply Limit: %s
+		%s`,
+		dp.Address, dp.FixedFee, dp.SupplyLimit, dp.FeeSchedule)
+}
+
+// DeputyParams array of DeputyParam
+type DeputyParams []DeputyParam
+
+// String implements fmt.Stringer
+func (dps DeputyParams) String() string {
+	out := "\n"
+	for _, dp := range dps {
+		out += fmt.Sprintf("\t%s\n", dp)
+	}
+	return out
+}
+
+// Equal returns true if two sets of deputies are the same, in the same order
+func (dps DeputyParams) Equal(dps2 DeputyParams) bool {
+	if len(dps) != len(dps2) {
+		return false
+	}
+	for i := range dps {
+		if !dps[i].Address.Equals(dps2[i].Address) ||
+			!equalOrNil(dps[i].FixedFee, dps2[i].FixedFee) ||
+			!equalOrNil(dps[i].SupplyLimit, dps2[i].SupplyLimit) ||
+			!dps[i].FeeSchedule.Equal(dps2[i].FeeSchedule) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalOrNil returns true if two ints are equal or both nil
+func equalOrNil(a, b sdk.Int) bool {
+	if a.IsNil() || b.IsNil() {
+		return a.IsNil() && b.IsNil()
+	}
+	return a.Equal(b)
+}
+
 // SupplyLimit parameters that control the absolute and time-based limits for an assets's supply
 type SupplyLimit struct {
 	Limit          sdk.Int       `json:"limit" yaml:"limit"`                       // the absolute supply limit for an asset
@@ -190,16 +303,34 @@ func validateAssetParams(i interface{}) error {
 
 		coinDenoms[asset.Denom] = true
 
-		if asset.DeputyAddress.Empty() {
+		// the deputy address is optional if the asset has other deputies
+		if asset.DeputyAddress.Empty() && len(asset.Deputies) == 0 {
 			return fmt.Errorf("deputy address cannot be empty for %s", asset.Denom)
 		}
 
-		if len(asset.DeputyAddress.Bytes()) != sdk.AddrLen {
-			return fmt.Errorf("%s deputy address invalid bytes length got %d, want %d", asset.Denom, len(asset.DeputyAddress.Bytes()), sdk.AddrLen)
+		if !asset.DeputyAddress.Empty() {
+			if len(asset.DeputyAddress.Bytes()) != sdk.AddrLen {
+				return fmt.Errorf("%s deputy address invalid bytes length got %d, want %d", asset.Denom, len(asset.DeputyAddress.Bytes()), sdk.AddrLen)
+			}
+
+			if asset.FixedFee.IsNegative() {
+				return fmt.Errorf("asset %s cannot have a negative fixed fee %s", asset.Denom, asset.FixedFee)
+			}
+		}
+
+		if err := asset.FeeSchedule.Validate(); err != nil {
+			return fmt.Errorf("asset %s has invalid fee schedule: %w", asset.Denom, err)
 		}
 
-		if asset.FixedFee.IsNegative() {
-			return fmt.Errorf("asset %s cannot have a negative fixed fee %s", asset.Denom, asset.FixedFee)
+		deputyAddresses := make(map[string]bool)
+		for _, deputy := range asset.GetDeputies() {
+			if err := deputy.Validate(); err != nil {
+				return fmt.Errorf("asset %s: %w", asset.Denom, err)
+			}
+			if deputyAddresses[deputy.Address.String()] {
+				return fmt.Errorf("asset %s cannot have duplicate deputy %s", asset.Denom, deputy.Address)
+			}
+			deputyAddresses[deputy.Address.String()] = true
 		}
 
 		if asset.MinBlockLock > asset.MaxBlockLock {
@@ -45,6 +45,21 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 		assetParams types.AssetParams
 	}
 
+	_, deputies := app.GeneratePrivKeyAddressPairs(2)
+	withDeputies := func(deputyAddress sdk.AccAddress, deputies ...types.DeputyParam) types.AssetParam {
+		asset := types.NewAssetParam(
+			"bnb", 714, suite.supply[0], true,
+			deputyAddress, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+			types.DefaultMinBlockLock, types.DefaultMaxBlockLock)
+		asset.Deputies = deputies
+		return asset
+	}
+	withFeeSchedule := func(feeSchedule types.FeeSchedule) types.AssetParam {
+		asset := withDeputies(suite.addr)
+		asset.FeeSchedule = feeSchedule
+		return asset
+	}
+
 	testCases := []struct {
 		name        string
 		args        args
@@ -238,6 +253,90 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 			expectPass:  false,
 			expectedErr: "duplicate denom",
 		},
+		{
+			name: "valid multiple deputies",
+			args: args{
+				assetParams: types.AssetParams{withDeputies(suite.addr,
+					types.NewDeputyParam(deputies[1], sdk.NewInt(2000), sdk.NewInt(1000000000)))},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "valid deputies without deputy address",
+			args: args{
+				assetParams: types.AssetParams{withDeputies(nil,
+					types.NewDeputyParam(deputies[1], sdk.NewInt(2000), sdk.ZeroInt()))},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "no deputies",
+			args: args{
+				assetParams: types.AssetParams{withDeputies(nil)},
+			},
+			expectPass:  false,
+			expectedErr: "deputy address cannot be empty",
+		},
+		{
+			name: "duplicate deputy",
+			args: args{
+				assetParams: types.AssetParams{withDeputies(suite.addr,
+					types.NewDeputyParam(suite.addr, sdk.NewInt(2000), sdk.ZeroInt()))},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate deputy",
+		},
+		{
+			name: "negative deputy fixed fee",
+			args: args{
+				assetParams: types.AssetParams{withDeputies(suite.addr,
+					types.NewDeputyParam(deputies[1], sdk.NewInt(-1), sdk.ZeroInt()))},
+			},
+			expectPass:  false,
+			expectedErr: "negative fixed fee",
+		},
+		{
+			name: "negative deputy supply limit",
+			args: args{
+				assetParams: types.AssetParams{withDeputies(suite.addr,
+					types.NewDeputyParam(deputies[1], sdk.NewInt(2000), sdk.NewInt(-1)))},
+			},
+			expectPass:  false,
+			expectedErr: "negative) supply limit",
+		},
+		{
+			name: "valid fee schedule",
+			args: args{
+				assetParams: types.AssetParams{withFeeSchedule(types.NewFeeSchedule(
+					types.FeeTiers{types.NewFeeTier(sdk.ZeroInt(), sdk.NewInt(100)), types.NewFeeTier(sdk.NewInt(100000), sdk.NewInt(500))},
+					sdk.MustNewDecFromStr("0.001"), sdk.NewInt(200), 10, sdk.MustNewDecFromStr("0.5"), sdk.OneDec()))},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid fee schedule rate",
+			args: args{
+				assetParams: types.AssetParams{withFeeSchedule(types.NewFeeSchedule(
+					nil, sdk.MustNewDecFromStr("1.5"), sdk.ZeroInt(), 0, sdk.ZeroDec(), sdk.ZeroDec()))},
+			},
+			expectPass:  false,
+			expectedErr: "invalid fee schedule",
+		},
+		{
+			name: "invalid deputy fee schedule",
+			args: args{
+				assetParams: types.AssetParams{withDeputies(suite.addr, func() types.DeputyParam {
+					deputy := types.NewDeputyParam(deputies[1], sdk.NewInt(2000), sdk.ZeroInt())
+					deputy.FeeSchedule = types.NewFeeSchedule(nil, sdk.ZeroDec(), sdk.NewInt(-1), 0, sdk.ZeroDec(), sdk.ZeroDec())
+					return deputy
+				}())},
+			},
+			expectPass:  false,
+			expectedErr: "invalid fee schedule",
+		},
 	}
 
 	for _, tc := range testCases {
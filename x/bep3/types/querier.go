@@ -10,6 +10,10 @@ const (
 	QueryGetAssetSupply = "supply"
 	// QueryGetAssetSupplies command for getting a list of asset supplies
 	QueryGetAssetSupplies = "supplies"
+	// QueryGetDeputySupplies command for getting a list of the asset supplies relayed by each deputy
+	QueryGetDeputySupplies = "deputy-supplies"
+	// QueryGetDeputyFeeRevenue command for getting the fees paid to a deputy
+	QueryGetDeputyFeeRevenue = "deputy-fee-revenue"
 	// QueryGetAtomicSwap command for getting info about an atomic swap
 	QueryGetAtomicSwap = "swap"
 	// QueryGetAtomicSwaps command for getting a list of atomic swaps
@@ -44,6 +48,30 @@ func NewQueryAssetSupplies(page int, limit int) QueryAssetSupplies {
 	}
 }
 
+// QueryDeputySupplies contains the params for a DeputySupplies query, an empty denom returns the supplies of all assets
+type QueryDeputySupplies struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryDeputySupplies creates a new QueryDeputySupplies
+func NewQueryDeputySupplies(denom string) QueryDeputySupplies {
+	return QueryDeputySupplies{
+		Denom: denom,
+	}
+}
+
+// QueryDeputyFeeRevenue contains the params for query 'custom/bep3/deputy-fee-revenue'
+type QueryDeputyFeeRevenue struct {
+	Deputy sdk.AccAddress `json:"deputy" yaml:"deputy"`
+}
+
+// NewQueryDeputyFeeRevenue creates a new QueryDeputyFeeRevenue
+func NewQueryDeputyFeeRevenue(deputy sdk.AccAddress) QueryDeputyFeeRevenue {
+	return QueryDeputyFeeRevenue{
+		Deputy: deputy,
+	}
+}
+
 // QueryAtomicSwapByID contains the params for query 'custom/bep3/swap'
 type QueryAtomicSwapByID struct {
 	SwapID tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
@@ -83,3 +83,68 @@ func (a AssetSupply) GetDenom() string {
 
 // AssetSupplies is a slice of AssetSupply
 type AssetSupplies []AssetSupply
+
+// DeputySupply contains information about the supply of an asset relayed by one of its deputies
+type DeputySupply struct {
+	DeputyAddress  sdk.AccAddress `json:"deputy_address"  yaml:"deputy_address"`
+	IncomingSupply sdk.Coin       `json:"incoming_supply"  yaml:"incoming_supply"`
+	OutgoingSupply sdk.Coin       `json:"outgoing_supply"  yaml:"outgoing_supply"`
+	CurrentSupply  sdk.Coin       `json:"current_supply"  yaml:"current_supply"`
+	FeeRevenue     sdk.Coin       `json:"fee_revenue"  yaml:"fee_revenue"` // total fees paid to the deputy by its fee schedule
+}
+
+// NewDeputySupply initializes a new DeputySupply
+func NewDeputySupply(deputyAddress sdk.AccAddress, incomingSupply, outgoingSupply, currentSupply, feeRevenue sdk.Coin) DeputySupply {
+	return DeputySupply{
+		DeputyAddress:  deputyAddress,
+		IncomingSupply: incomingSupply,
+		OutgoingSupply: outgoingSupply,
+		CurrentSupply:  currentSupply,
+		FeeRevenue:     feeRevenue,
+	}
+}
+
+// Validate performs a basic validation of a deputy supply fields.
+func (d DeputySupply) Validate() error {
+	if d.DeputyAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "deputy address cannot be empty")
+	}
+	if !d.IncomingSupply.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "incoming supply %s", d.IncomingSupply)
+	}
+	if !d.OutgoingSupply.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "outgoing supply %s", d.OutgoingSupply)
+	}
+	if !d.CurrentSupply.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "current supply %s", d.CurrentSupply)
+	}
+	if !d.FeeRevenue.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "fee revenue %s", d.FeeRevenue)
+	}
+	denom := d.CurrentSupply.Denom
+	if (d.IncomingSupply.Denom != denom) || (d.OutgoingSupply.Denom != denom) || (d.FeeRevenue.Denom != denom) {
+		return fmt.Errorf("deputy supply denoms do not match %s %s %s %s", d.CurrentSupply.Denom, d.IncomingSupply.Denom, d.OutgoingSupply.Denom, d.FeeRevenue.Denom)
+	}
+	return nil
+}
+
+// String implements stringer
+func (d DeputySupply) String() string {
+	return fmt.Sprintf(`
+	deputy supply:
+		Deputy address:     %s
+		Incoming supply:    %s
+		Outgoing supply:    %s
+		Current supply:     %s
+		Fee revenue:        %s
+		`,
+		d.DeputyAddress, d.IncomingSupply, d.OutgoingSupply, d.CurrentSupply, d.FeeRevenue)
+}
+
+// GetDenom getter method for the denom of the deputy supply
+func (d DeputySupply) GetDenom() string {
+	return d.CurrentSupply.Denom
+}
+
+// DeputySupplies is a slice of DeputySupply
+type DeputySupplies []DeputySupply
@@ -27,6 +27,13 @@ type AtomicSwap struct {
 	Status              SwapStatus       `json:"status"  yaml:"status"`
 	CrossChain          bool             `json:"cross_chain"  yaml:"cross_chain"`
 	Direction           SwapDirection    `json:"direction"  yaml:"direction"`
+	ExpireTime          int64            `json:"expire_time"  yaml:"expire_time"`                   // unix time a time locked generic swap expires at, zero for height locked swaps
+	HashAlgorithm       HashAlgorithm    `json:"hash_algorithm"  yaml:"hash_algorithm"`             // hash of a generic swap's preimage, unspecified for deputy swaps
+	Fee                 sdk.Coins        `json:"fee"  yaml:"fee"`                                   // fee escrowed for the deputy of an outgoing swap by its fee schedule
+	CreatedBlock        int64            `json:"created_block"  yaml:"created_block"`               // height the swap was created at, used to discount quickly claimed swaps
+	FeeRefund           sdk.Coins        `json:"fee_refund"  yaml:"fee_refund"`                     // part of the fee returned to the sender if the swap is refunded
+	QuickClaimBlocks    uint64           `json:"quick_claim_blocks"  yaml:"quick_claim_blocks"`     // the fee is discounted if the swap is claimed within this many blocks of being created
+	QuickClaimDiscount  sdk.Coins        `json:"quick_claim_discount"  yaml:"quick_claim_discount"` // part of the fee returned to the sender if the swap is claimed quickly
 }
 
 // NewAtomicSwap returns a new AtomicSwap
@@ -49,6 +56,53 @@ func NewAtomicSwap(amount sdk.Coins, randomNumberHash tmbytes.HexBytes, expireHe
 	}
 }
 
+// NewGenericAtomicSwap returns a new open AtomicSwap between two users without a deputy, locked until either a height or a unix time
+func NewGenericAtomicSwap(amount sdk.Coins, hashLock tmbytes.HexBytes, hashAlgorithm HashAlgorithm, expireHeight uint64,
+	expireTime int64, timestamp int64, sender, recipient sdk.AccAddress) AtomicSwap {
+	return AtomicSwap{
+		Amount:           amount,
+		RandomNumberHash: hashLock,
+		ExpireHeight:     expireHeight,
+		Timestamp:        timestamp,
+		Sender:           sender,
+		Recipient:        recipient,
+		Status:           Open,
+		CrossChain:       true,
+		Direction:        Generic,
+		ExpireTime:       expireTime,
+		HashAlgorithm:    hashAlgorithm,
+	}
+}
+
+// IsGeneric returns true if the swap is between two users without a deputy
+func (a AtomicSwap) IsGeneric() bool {
+	return a.Direction == Generic
+}
+
+// GetDeputy returns the deputy relaying the swap, the sender of incoming swaps or the recipient of outgoing swaps.
+// Generic swaps have no deputy.
+func (a AtomicSwap) GetDeputy() sdk.AccAddress {
+	switch a.Direction {
+	case Incoming:
+		return a.Sender
+	case Outgoing:
+		return a.Recipient
+	default:
+		return nil
+	}
+}
+
+// IsQuickClaim returns true if claiming the swap at a height discounts its fee
+func (a AtomicSwap) IsQuickClaim(blockHeight int64) bool {
+	blocksOpen := blockHeight - a.CreatedBlock
+	return a.QuickClaimBlocks > 0 && blocksOpen >= 0 && uint64(blocksOpen) <= a.QuickClaimBlocks
+}
+
+// IsTimeLocked returns true if the swap expires at a unix time rather than a height
+func (a AtomicSwap) IsTimeLocked() bool {
+	return a.ExpireTime > 0
+}
+
 // GetSwapID calculates the ID of an atomic swap
 func (a AtomicSwap) GetSwapID() tmbytes.HexBytes {
 	return CalculateSwapID(a.RandomNumberHash, a.Sender, a.SenderOtherChain)
@@ -70,8 +124,26 @@ func (a AtomicSwap) Validate() error {
 	if len(a.RandomNumberHash) != RandomNumberHashLength {
 		return fmt.Errorf("the length of random number hash should be %d", RandomNumberHashLength)
 	}
-	if a.ExpireHeight == 0 {
-		return errors.New("expire height cannot be 0")
+	if a.IsGeneric() {
+		if (a.ExpireHeight == 0) == (a.ExpireTime == 0) {
+			return errors.New("generic swap must have exactly one of an expire height and an expire time")
+		}
+		if a.ExpireTime < 0 {
+			return errors.New("expire time cannot be negative")
+		}
+		if !a.HashAlgorithm.IsValid() {
+			return fmt.Errorf("invalid hash algorithm: %s", a.HashAlgorithm)
+		}
+	} else {
+		if a.ExpireHeight == 0 {
+			return errors.New("expire height cannot be 0")
+		}
+		if a.ExpireTime != 0 {
+			return errors.New("only generic swaps can have an expire time")
+		}
+		if a.HashAlgorithm != UnspecifiedHash {
+			return errors.New("only generic swaps can have a hash algorithm")
+		}
 	}
 	if a.Timestamp == 0 {
 		return errors.New("timestamp cannot be 0")
@@ -88,11 +160,11 @@ func (a AtomicSwap) Validate() error {
 	if len(a.Recipient) != AddrByteCount {
 		return fmt.Errorf("the expected address length is %d, actual length is %d", AddrByteCount, len(a.Recipient))
 	}
-	// NOTE: These adresses may not have a bech32 prefix.
-	if strings.TrimSpace(a.SenderOtherChain) == "" {
+	// NOTE: These adresses may not have a bech32 prefix. Generic swaps have no deputy, so need no addresses on the other chain.
+	if !a.IsGeneric() && strings.TrimSpace(a.SenderOtherChain) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender other chain cannot be blank")
 	}
-	if strings.TrimSpace(a.RecipientOtherChain) == "" {
+	if !a.IsGeneric() && strings.TrimSpace(a.RecipientOtherChain) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient other chain cannot be blank")
 	}
 	if a.Status == Completed && a.ClosedBlock == 0 {
@@ -101,9 +173,28 @@ func (a AtomicSwap) Validate() error {
 	if a.Status == NULL || a.Status > 3 {
 		return errors.New("invalid swap status")
 	}
-	if a.Direction == INVALID || a.Direction > 2 {
+	if !a.Direction.IsValid() {
 		return errors.New("invalid swap direction")
 	}
+	if !a.Fee.Empty() {
+		if a.Direction != Outgoing {
+			return errors.New("only outgoing swaps can have a fee")
+		}
+		if !a.Fee.IsValid() || len(a.Fee) != 1 || a.Fee[0].Denom != a.Amount[0].Denom {
+			return fmt.Errorf("invalid fee: %s", a.Fee)
+		}
+	}
+	for _, returned := range []sdk.Coins{a.FeeRefund, a.QuickClaimDiscount} {
+		if returned.Empty() {
+			continue
+		}
+		if !returned.IsValid() || !a.Fee.IsAllGTE(returned) {
+			return fmt.Errorf("fee returned %s must be part of fee %s", returned, a.Fee)
+		}
+	}
+	if a.CreatedBlock < 0 {
+		return errors.New("created block cannot be negative")
+	}
 	return nil
 }
 
@@ -122,12 +213,20 @@ func (a AtomicSwap) String() string {
 		"\n    Recipient other chain:    %s"+
 		"\n    Closed block:             %d"+
 		"\n    Cross chain:              %t"+
-		"\n    Direction:                %s",
+		"\n    Direction:                %s"+
+		"\n    Expire time:              %d"+
+		"\n    Hash algorithm:           %s"+
+		"\n    Fee:                      %s"+
+		"\n    Created block:            %d"+
+		"\n    Fee refund:               %s"+
+		"\n    Quick claim blocks:       %d"+
+		"\n    Quick claim discount:     %s",
 		a.GetSwapID(), a.Status.String(), a.Amount.String(),
 		hex.EncodeToString(a.RandomNumberHash), a.ExpireHeight,
 		a.Timestamp, a.Sender.String(), a.Recipient.String(),
 		a.SenderOtherChain, a.RecipientOtherChain, a.ClosedBlock,
-		a.CrossChain, a.Direction)
+		a.CrossChain, a.Direction, a.ExpireTime, a.HashAlgorithm,
+		a.Fee, a.CreatedBlock, a.FeeRefund, a.QuickClaimBlocks, a.QuickClaimDiscount)
 }
 
 // AtomicSwaps is a slice of AtomicSwap
@@ -214,6 +313,7 @@ const (
 	INVALID  SwapDirection = 0x00
 	Incoming SwapDirection = 0x01
 	Outgoing SwapDirection = 0x02
+	Generic  SwapDirection = 0x03 // swaps between two users without a deputy
 )
 
 // NewSwapDirectionFromString converts string to SwapDirection type
@@ -223,6 +323,8 @@ func NewSwapDirectionFromString(str string) SwapDirection {
 		return Incoming
 	case "Outgoing", "outgoing", "out", "O", "o":
 		return Outgoing
+	case "Generic", "generic", "G", "g":
+		return Generic
 	default:
 		return INVALID
 	}
@@ -235,6 +337,8 @@ func (direction SwapDirection) String() string {
 		return "Incoming"
 	case Outgoing:
 		return "Outgoing"
+	case Generic:
+		return "Generic"
 	default:
 		return "INVALID"
 	}
@@ -259,7 +363,67 @@ func (direction *SwapDirection) UnmarshalJSON(data []byte) error {
 // IsValid returns true if the swap direction is valid and false otherwise.
 func (direction SwapDirection) IsValid() bool {
 	if direction == Incoming ||
-		direction == Outgoing {
+		direction == Outgoing ||
+		direction == Generic {
+		return true
+	}
+	return false
+}
+
+// HashAlgorithm is the hash function a generic AtomicSwap's preimage is hashed with
+type HashAlgorithm byte
+
+// hash algorithms
+const (
+	UnspecifiedHash HashAlgorithm = 0x00 // deputy swaps hash the random number with the swap's timestamp, see CalculateRandomHash
+	SHA256Hash      HashAlgorithm = 0x01
+	Keccak256Hash   HashAlgorithm = 0x02 // used by Ethereum style HTLC contracts
+)
+
+// NewHashAlgorithmFromString converts string to HashAlgorithm type
+func NewHashAlgorithmFromString(str string) HashAlgorithm {
+	switch str {
+	case "SHA256", "sha256", "SHA-256", "sha-256":
+		return SHA256Hash
+	case "Keccak256", "keccak256", "Keccak-256", "keccak-256":
+		return Keccak256Hash
+	default:
+		return UnspecifiedHash
+	}
+}
+
+// String returns the string representation of a HashAlgorithm
+func (algorithm HashAlgorithm) String() string {
+	switch algorithm {
+	case SHA256Hash:
+		return "sha256"
+	case Keccak256Hash:
+		return "keccak256"
+	default:
+		return "unspecified"
+	}
+}
+
+// MarshalJSON marshals the HashAlgorithm
+func (algorithm HashAlgorithm) MarshalJSON() ([]byte, error) {
+	return json.Marshal(algorithm.String())
+}
+
+// UnmarshalJSON unmarshals the HashAlgorithm
+func (algorithm *HashAlgorithm) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	*algorithm = NewHashAlgorithmFromString(s)
+	return nil
+}
+
+// IsValid returns true if the hash algorithm can be used by a generic swap and false otherwise.
+func (algorithm HashAlgorithm) IsValid() bool {
+	if algorithm == SHA256Hash ||
+		algorithm == Keccak256Hash {
 		return true
 	}
 	return false
@@ -282,6 +446,13 @@ type AugmentedAtomicSwap struct {
 	Status              SwapStatus       `json:"status"  yaml:"status"`
 	CrossChain          bool             `json:"cross_chain"  yaml:"cross_chain"`
 	Direction           SwapDirection    `json:"direction"  yaml:"direction"`
+	ExpireTime          int64            `json:"expire_time"  yaml:"expire_time"`
+	HashAlgorithm       HashAlgorithm    `json:"hash_algorithm"  yaml:"hash_algorithm"`
+	Fee                 sdk.Coins        `json:"fee"  yaml:"fee"`
+	CreatedBlock        int64            `json:"created_block"  yaml:"created_block"`
+	FeeRefund           sdk.Coins        `json:"fee_refund"  yaml:"fee_refund"`
+	QuickClaimBlocks    uint64           `json:"quick_claim_blocks"  yaml:"quick_claim_blocks"`
+	QuickClaimDiscount  sdk.Coins        `json:"quick_claim_discount"  yaml:"quick_claim_discount"`
 }
 
 func NewAugmentedAtomicSwap(swap AtomicSwap) AugmentedAtomicSwap {
@@ -299,6 +470,13 @@ func NewAugmentedAtomicSwap(swap AtomicSwap) AugmentedAtomicSwap {
 		Status:              swap.Status,
 		CrossChain:          swap.CrossChain,
 		Direction:           swap.Direction,
+		ExpireTime:          swap.ExpireTime,
+		HashAlgorithm:       swap.HashAlgorithm,
+		Fee:                 swap.Fee,
+		CreatedBlock:        swap.CreatedBlock,
+		FeeRefund:           swap.FeeRefund,
+		QuickClaimBlocks:    swap.QuickClaimBlocks,
+		QuickClaimDiscount:  swap.QuickClaimDiscount,
 	}
 }
 
@@ -231,6 +231,73 @@ func (suite *AtomicSwapTestSuite) TestNewAtomicSwap() {
 			},
 			false,
 		},
+		{
+			"valid generic height locked swap",
+			types.NewGenericAtomicSwap(cs(c("ukava", 50000)), suite.randomNumberHashes[0], types.SHA256Hash,
+				360, 0, suite.timestamps[0], suite.addrs[0], suite.addrs[5]),
+			true,
+		},
+		{
+			"valid generic time locked swap",
+			types.NewGenericAtomicSwap(cs(c("ukava", 50000)), suite.randomNumberHashes[0], types.Keccak256Hash,
+				0, suite.timestamps[1], suite.timestamps[0], suite.addrs[0], suite.addrs[5]),
+			true,
+		},
+		{
+			"generic swap locked by height and time",
+			types.NewGenericAtomicSwap(cs(c("ukava", 50000)), suite.randomNumberHashes[0], types.SHA256Hash,
+				360, suite.timestamps[1], suite.timestamps[0], suite.addrs[0], suite.addrs[5]),
+			false,
+		},
+		{
+			"generic swap without lock",
+			types.NewGenericAtomicSwap(cs(c("ukava", 50000)), suite.randomNumberHashes[0], types.SHA256Hash,
+				0, 0, suite.timestamps[0], suite.addrs[0], suite.addrs[5]),
+			false,
+		},
+		{
+			"generic swap without hash algorithm",
+			types.NewGenericAtomicSwap(cs(c("ukava", 50000)), suite.randomNumberHashes[0], types.UnspecifiedHash,
+				360, 0, suite.timestamps[0], suite.addrs[0], suite.addrs[5]),
+			false,
+		},
+		{
+			"fee refund larger than fee",
+			types.AtomicSwap{
+				Amount:              cs(c("bnb", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireHeight:        360,
+				Timestamp:           suite.timestamps[0],
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				RecipientOtherChain: "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7",
+				SenderOtherChain:    "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7",
+				Status:              types.Open,
+				CrossChain:          true,
+				Direction:           types.Outgoing,
+				Fee:                 cs(c("bnb", 100)),
+				FeeRefund:           cs(c("bnb", 101)),
+			},
+			false,
+		},
+		{
+			"deputy swap with expire time",
+			types.AtomicSwap{
+				Amount:              cs(c("bnb", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireHeight:        360,
+				Timestamp:           suite.timestamps[0],
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				RecipientOtherChain: "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7",
+				SenderOtherChain:    "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7",
+				Status:              types.Open,
+				CrossChain:          true,
+				Direction:           types.Incoming,
+				ExpireTime:          suite.timestamps[1],
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
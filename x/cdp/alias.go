@@ -13,6 +13,8 @@ const (
 	AttributeKeyError               = types.AttributeKeyError
 	AttributeValueCategory          = types.AttributeValueCategory
 	DefaultParamspace               = types.DefaultParamspace
+	DutchAuctionType                = types.DutchAuctionType
+	EnglishAuctionType              = types.EnglishAuctionType
 	EventTypeBeginBlockerFatal      = types.EventTypeBeginBlockerFatal
 	EventTypeCdpClose               = types.EventTypeCdpClose
 	EventTypeCdpDeposit             = types.EventTypeCdpDeposit
@@ -23,6 +25,7 @@ const (
 	EventTypeCreateCdp              = types.EventTypeCreateCdp
 	LiquidatorMacc                  = types.LiquidatorMacc
 	ModuleName                      = types.ModuleName
+	ProposalTypeLiquidationSweep    = types.ProposalTypeLiquidationSweep
 	QuerierRoute                    = types.QuerierRoute
 	QueryGetAccounts                = types.QueryGetAccounts
 	QueryGetCdp                     = types.QueryGetCdp
@@ -65,6 +68,7 @@ var (
 	NewGenesisAccumulationTime         = types.NewGenesisAccumulationTime
 	NewGenesisState                    = types.NewGenesisState
 	NewGenesisTotalPrincipal           = types.NewGenesisTotalPrincipal
+	NewLiquidationSweepProposal        = types.NewLiquidationSweepProposal
 	NewMsgCreateCDP                    = types.NewMsgCreateCDP
 	NewMsgDeposit                      = types.NewMsgDeposit
 	NewMsgDrawDebt                     = types.NewMsgDrawDebt
@@ -128,6 +132,7 @@ var (
 	ErrInvalidDebtRequest      = types.ErrInvalidDebtRequest
 	ErrInvalidDeposit          = types.ErrInvalidDeposit
 	ErrInvalidPayment          = types.ErrInvalidPayment
+	ErrInvalidPubProposal      = types.ErrInvalidPubProposal
 	ErrInvalidWithdrawAmount   = types.ErrInvalidWithdrawAmount
 	ErrLoadingAugmentedCDP     = types.ErrLoadingAugmentedCDP
 	ErrNotLiquidatable         = types.ErrNotLiquidatable
@@ -169,6 +174,7 @@ type (
 	GenesisState                    = types.GenesisState
 	GenesisTotalPrincipal           = types.GenesisTotalPrincipal
 	GenesisTotalPrincipals          = types.GenesisTotalPrincipals
+	LiquidationSweepProposal        = types.LiquidationSweepProposal
 	MsgCreateCDP                    = types.MsgCreateCDP
 	MsgDeposit                      = types.MsgDeposit
 	MsgDrawDebt                     = types.MsgDrawDebt
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/kava-labs/kava/x/cdp/types"
 )
@@ -71,10 +72,10 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 		penalty := k.ApplyLiquidationPenalty(ctx, collateralType, debtAmount)
 
-		_, err := k.auctionKeeper.StartCollateralAuction(
-			ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, auctionSize),
-			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), []sdk.AccAddress{returnAddr},
-			[]sdk.Int{auctionSize}, sdk.NewCoin(debtDenom, debtAmount),
+		err := k.startCollateralAuction(
+			ctx, collateralType, sdk.NewCoin(collateral.Denom, auctionSize),
+			sdk.NewCoin(principalDenom, debtAmount.Add(penalty)), returnAddr,
+			sdk.NewCoin(debtDenom, debtAmount),
 		)
 
 		if err != nil {
@@ -97,12 +98,39 @@ func (k Keeper) CreateAuctionsFromDeposit(
 
 	penalty := k.ApplyLiquidationPenalty(ctx, collateralType, lastAuctionDebt)
 
-	_, err := k.auctionKeeper.StartCollateralAuction(
-		ctx, types.LiquidatorMacc, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
-		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), []sdk.AccAddress{returnAddr},
-		[]sdk.Int{lastAuctionCollateral}, sdk.NewCoin(debtDenom, lastAuctionDebt),
+	return k.startCollateralAuction(
+		ctx, collateralType, sdk.NewCoin(collateral.Denom, lastAuctionCollateral),
+		sdk.NewCoin(principalDenom, lastAuctionDebt.Add(penalty)), returnAddr,
+		sdk.NewCoin(debtDenom, lastAuctionDebt),
 	)
+}
+
+// startCollateralAuction starts an auction of the type set in the collateral type's params, selling the lot to raise the max bid
+func (k Keeper) startCollateralAuction(ctx sdk.Context, collateralType string, lot, maxBid sdk.Coin, returnAddr sdk.AccAddress, debt sdk.Coin) error {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return sdkerrors.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+
+	if cp.AuctionType != types.DutchAuctionType {
+		_, err := k.auctionKeeper.StartCollateralAuction(
+			ctx, types.LiquidatorMacc, lot, maxBid, []sdk.AccAddress{returnAddr}, []sdk.Int{lot.Amount}, debt,
+		)
+		return err
+	}
+
+	// dutch auctions start from the liquidation price of the collateral, in units of the principal
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil {
+		return err
+	}
+	collateralUnit := k.convertCollateralToBaseUnits(ctx, sdk.NewCoin(lot.Denom, sdk.OneInt()), collateralType)
+	principalUnit := k.convertDebtToBaseUnits(ctx, sdk.NewCoin(maxBid.Denom, sdk.OneInt()))
+	oraclePrice := price.Price.Mul(collateralUnit).Quo(principalUnit)
 
+	_, err = k.auctionKeeper.StartDutchCollateralAuction(
+		ctx, types.LiquidatorMacc, lot, maxBid, []sdk.AccAddress{returnAddr}, []sdk.Int{lot.Amount}, debt, oraclePrice,
+	)
 	return err
 }
 
@@ -170,3 +198,36 @@ func (k Keeper) RunSurplusAndDebtAuctions(ctx sdk.Context) error {
 	_, err := k.auctionKeeper.StartSurplusAuction(ctx, types.LiquidatorMacc, surplusLot, k.GetGovDenom(ctx))
 	return err
 }
+
+// GetAuctionReferencePrice returns the oracle price of one unit of a liquidator auction's lot, in units of its bid.
+// Collateral is priced with its liquidation market and the debt asset is assumed to be at its peg.
+// It returns false if either denom can't be priced.
+func (k Keeper) GetAuctionReferencePrice(ctx sdk.Context, lotDenom, bidDenom string) (sdk.Dec, bool) {
+	lotPrice, found := k.getBaseUnitPrice(ctx, lotDenom)
+	if !found {
+		return sdk.Dec{}, false
+	}
+	bidPrice, found := k.getBaseUnitPrice(ctx, bidDenom)
+	if !found || !bidPrice.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	return lotPrice.Quo(bidPrice), true
+}
+
+// getBaseUnitPrice returns the price of one unit of the debt denom or a collateral denom, in units of the debt's reference asset.
+func (k Keeper) getBaseUnitPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	if dp, found := k.GetDebtParam(ctx, denom); found {
+		return sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64()), true
+	}
+	for _, cp := range k.GetParams(ctx).CollateralParams {
+		if cp.Denom != denom {
+			continue
+		}
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+		if err != nil {
+			return sdk.Dec{}, false
+		}
+		return price.Price.Mul(sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64())), true
+	}
+	return sdk.Dec{}, false
+}
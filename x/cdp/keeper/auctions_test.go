@@ -152,6 +152,20 @@ func (suite *AuctionTestSuite) TestGetTotalDebt() {
 	suite.Require().Equal(sdk.NewInt(250e6), suite.keeper.GetTotalDebt(suite.ctx, types.LiquidatorMacc))
 }
 
+func (suite *AuctionTestSuite) TestGetAuctionReferencePrice() {
+	// btc is priced at 8000 usd and has 8 decimals, usdx has 6
+	price, found := suite.keeper.GetAuctionReferencePrice(suite.ctx, "btc", "usdx")
+	suite.Require().True(found)
+	suite.Equal(d("80"), price)
+
+	price, found = suite.keeper.GetAuctionReferencePrice(suite.ctx, "usdx", "btc")
+	suite.Require().True(found)
+	suite.Equal(d("0.0125"), price)
+
+	_, found = suite.keeper.GetAuctionReferencePrice(suite.ctx, "ukava", "usdx")
+	suite.False(found)
+}
+
 func TestAuctionTestSuite(t *testing.T) {
 	suite.Run(t, new(AuctionTestSuite))
 }
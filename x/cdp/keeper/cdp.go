@@ -571,7 +571,7 @@ func (k Keeper) SetMarketStatus(ctx sdk.Context, marketID string, up bool) {
 	return
 }
 
-// GetMarketStatus returns true if the market has a price, otherwise false
+// GetMarketStatus returns true if the market has a healthy price, otherwise false
 func (k Keeper) GetMarketStatus(ctx sdk.Context, marketID string) (up bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PricefeedStatusKeyPrefix)
 	bz := store.Get([]byte(marketID))
@@ -579,10 +579,10 @@ func (k Keeper) GetMarketStatus(ctx sdk.Context, marketID string) (up bool) {
 	return up
 }
 
-// UpdatePricefeedStatus determines if the price of an asset is available and updates the global status of the market
+// UpdatePricefeedStatus determines if the price of an asset is available and healthy and updates the global status of the market
 func (k Keeper) UpdatePricefeedStatus(ctx sdk.Context, marketID string) (ok bool) {
 	_, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
-	if err != nil {
+	if err != nil || !k.pricefeedKeeper.IsMarketHealthy(ctx, marketID) {
 		k.SetMarketStatus(ctx, marketID, false)
 		return false
 	}
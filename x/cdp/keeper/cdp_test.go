@@ -15,6 +15,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 type CdpTestSuite struct {
@@ -107,6 +108,34 @@ func (suite *CdpTestSuite) TestAddCdp() {
 	suite.Require().True(errors.Is(err, types.ErrCdpAlreadyExists))
 }
 
+func (suite *CdpTestSuite) TestUnhealthyPricefeedStatus() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.NewAccountWithAddress(suite.ctx, addrs[0])
+	acc.SetCoins(cs(c("xrp", 200000000)))
+	ak.SetAccount(suite.ctx, acc)
+
+	// A tripped price guard takes the market down even though it has a price
+	pk := suite.app.GetPriceFeedKeeper()
+	trip := pricefeedtypes.NewGuardTrip("xrp:usd", pricefeedtypes.GuardTripDeviation, 1, suite.ctx.BlockTime(), d("0.5"), d("0.25"))
+	pk.SetGuardTrip(suite.ctx, trip)
+	_, err := pk.GetCurrentPrice(suite.ctx, "xrp:usd")
+	suite.NoError(err)
+	ok := suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd")
+	suite.False(ok)
+	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 100000000), c("usdx", 10000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrPricefeedDown))
+
+	// The market comes back up once it recovers
+	trip.RecoveredHeight = 2
+	trip.RecoveredTime = suite.ctx.BlockTime()
+	pk.SetGuardTrip(suite.ctx, trip)
+	ok = suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd")
+	suite.True(ok)
+	err = suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 100000000), c("usdx", 10000000), "xrp-a")
+	suite.NoError(err)
+}
+
 func (suite *CdpTestSuite) TestGetSetCollateralTypeByte() {
 	_, found := suite.keeper.GetCollateralTypePrefix(suite.ctx, "lol-a")
 	suite.False(found)
@@ -111,6 +111,28 @@ func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType s
 	return nil
 }
 
+// SweepLiquidations seizes collateral from every CDP of the input collateral type that is below the liquidation ratio,
+// without the per block limit applied by the begin blocker
+func (k Keeper) SweepLiquidations(ctx sdk.Context, collateralType string) error {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return sdkerrors.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	err := k.AccumulateInterest(ctx, cp.Type)
+	if err != nil {
+		return err
+	}
+	count := sdk.NewInt(int64(len(k.GetAllCdpsByCollateralType(ctx, cp.Type))))
+	if count.IsZero() {
+		return nil
+	}
+	err = k.SynchronizeInterestForRiskyCDPs(ctx, count, sdk.MaxSortableDec, cp.Type)
+	if err != nil {
+		return err
+	}
+	return k.LiquidateCdps(ctx, cp.LiquidationMarketID, cp.Type, cp.LiquidationRatio, count)
+}
+
 // ApplyLiquidationPenalty multiplies the input debt amount by the liquidation penalty
 func (k Keeper) ApplyLiquidationPenalty(ctx sdk.Context, collateralType string, debt sdk.Int) sdk.Int {
 	penalty := k.getLiquidationPenalty(ctx, collateralType)
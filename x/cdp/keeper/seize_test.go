@@ -180,6 +180,31 @@ func (suite *SeizeTestSuite) TestSeizeCollateralMultiDeposit() {
 	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
 }
 
+func (suite *SeizeTestSuite) TestSeizeCollateralDutchAuction() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	for i := range params.CollateralParams {
+		if params.CollateralParams[i].Type == "xrp-a" {
+			params.CollateralParams[i].AuctionType = types.DutchAuctionType
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(2))
+	suite.True(found)
+	err := suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.NoError(err)
+
+	auctionKeeper := suite.app.GetAuctionKeeper()
+	a, found := auctionKeeper.GetAuction(suite.ctx, auction.DefaultNextAuctionID)
+	suite.True(found)
+	dutchAuction, ok := a.(auction.DutchCollateralAuction)
+	suite.Require().True(ok)
+	suite.Equal("xrp", dutchAuction.Lot.Denom)
+	// xrp and usdx have the same conversion factor, so the start price is the xrp price with the default markup
+	suite.Equal(d("0.25").Mul(auction.DefaultDutchStartMarkup), dutchAuction.StartPrice)
+}
+
 func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.createCdps()
 	sk := suite.app.GetSupplyKeeper()
@@ -196,6 +221,24 @@ func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.Equal(10, xrpLiquidations)
 }
 
+func (suite *SeizeTestSuite) TestSweepLiquidations() {
+	suite.createCdps()
+	sk := suite.app.GetSupplyKeeper()
+	acc := sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	originalXrpCollateral := acc.GetCoins().AmountOf("xrp")
+	suite.setPrice(d("0.2"), "xrp:usd")
+	err := suite.keeper.SweepLiquidations(suite.ctx, "xrp-a")
+	suite.NoError(err)
+	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
+	finalXrpCollateral := acc.GetCoins().AmountOf("xrp")
+	seizedXrpCollateral := originalXrpCollateral.Sub(finalXrpCollateral)
+	xrpLiquidations := int(seizedXrpCollateral.Quo(i(10000000000)).Int64())
+	suite.Equal(len(suite.liquidations.xrp), xrpLiquidations)
+
+	err = suite.keeper.SweepLiquidations(suite.ctx, "lol-a")
+	suite.Require().True(errors.Is(err, types.ErrCollateralNotSupported))
+}
+
 func (suite *SeizeTestSuite) TestApplyLiquidationPenalty() {
 	penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, "xrp-a", i(1000))
 	suite.Equal(i(50), penalty)
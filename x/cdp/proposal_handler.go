@@ -0,0 +1,27 @@
+package cdp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewProposalHandler creates a handler for cdp proposals
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case LiquidationSweepProposal:
+			return handleLiquidationSweepProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
+		}
+	}
+}
+
+func handleLiquidationSweepProposal(ctx sdk.Context, k Keeper, p LiquidationSweepProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, err.Error())
+	}
+	return k.SweepLiquidations(ctx, p.CollateralType)
+}
@@ -51,4 +51,6 @@ var (
 	ErrInsufficientBalance = sdkerrors.Register(ModuleName, 22, "insufficient balance")
 	// ErrNotLiquidatable error for when an cdp is not liquidatable
 	ErrNotLiquidatable = sdkerrors.Register(ModuleName, 23, "cdp collateral ratio not below liquidation ratio")
+	// ErrInvalidPubProposal error for when a governance proposal is invalid
+	ErrInvalidPubProposal = sdkerrors.Register(ModuleName, 24, "invalid pubproposal")
 )
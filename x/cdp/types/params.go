@@ -9,6 +9,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
+// Auction types that collateral can be sold with when cdps are liquidated
+const (
+	EnglishAuctionType = "english"
+	DutchAuctionType   = "dutch"
+)
+
 // Parameter keys
 var (
 	KeyGlobalDebtLimit      = []byte("GlobalDebtLimit")
@@ -110,12 +116,14 @@ type CollateralParam struct {
 	KeeperRewardPercentage           sdk.Dec  `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"`                       // the percentage of a CDPs collateral that gets rewarded to a keeper that liquidates the position
 	CheckCollateralizationIndexCount sdk.Int  `json:"check_collateralization_index_count" yaml:"check_collateralization_index_count"` // the number of cdps that will be checked for liquidation in the begin blocker
 	ConversionFactor                 sdk.Int  `json:"conversion_factor" yaml:"conversion_factor"`                                     // factor for converting internal units to one base unit of collateral
+	AuctionType                      string   `json:"auction_type" yaml:"auction_type"`                                               // type of auction liquidated collateral is sold in, english (the default if blank) or dutch
 }
 
 // NewCollateralParam returns a new CollateralParam
 func NewCollateralParam(
 	denom, ctype string, liqRatio sdk.Dec, debtLimit sdk.Coin, stabilityFee sdk.Dec, auctionSize sdk.Int,
-	liqPenalty sdk.Dec, prefix byte, spotMarketID, liquidationMarketID string, keeperReward sdk.Dec, checkIndexCount sdk.Int, conversionFactor sdk.Int) CollateralParam {
+	liqPenalty sdk.Dec, prefix byte, spotMarketID, liquidationMarketID string, keeperReward sdk.Dec, checkIndexCount sdk.Int, conversionFactor sdk.Int,
+	auctionType string) CollateralParam {
 	return CollateralParam{
 		Denom:                            denom,
 		Type:                             ctype,
@@ -130,6 +138,7 @@ func NewCollateralParam(
 		KeeperRewardPercentage:           keeperReward,
 		CheckCollateralizationIndexCount: checkIndexCount,
 		ConversionFactor:                 conversionFactor,
+		AuctionType:                      auctionType,
 	}
 }
 
@@ -148,10 +157,11 @@ func (cp CollateralParam) String() string {
 	Liquidation Market ID: %s
 	Keeper Reward Percentage: %s
 	Check Collateralization Count: %s
-	Conversion Factor: %s`,
+	Conversion Factor: %s
+	Auction Type: %s`,
 		cp.Denom, cp.Type, cp.LiquidationRatio, cp.StabilityFee, cp.LiquidationPenalty,
 		cp.DebtLimit, cp.AuctionSize, cp.Prefix, cp.SpotMarketID, cp.LiquidationMarketID,
-		cp.KeeperRewardPercentage, cp.CheckCollateralizationIndexCount, cp.ConversionFactor)
+		cp.KeeperRewardPercentage, cp.CheckCollateralizationIndexCount, cp.ConversionFactor, cp.AuctionType)
 }
 
 // CollateralParams array of CollateralParam
@@ -377,6 +387,9 @@ func validateCollateralParams(i interface{}) error {
 		if cp.CheckCollateralizationIndexCount.IsNegative() {
 			return fmt.Errorf("keeper reward percentage should be positive, is %s for %s", cp.CheckCollateralizationIndexCount, cp.Denom)
 		}
+		if cp.AuctionType != "" && cp.AuctionType != EnglishAuctionType && cp.AuctionType != DutchAuctionType {
+			return fmt.Errorf("auction type should be %s or %s, is %s for %s", EnglishAuctionType, DutchAuctionType, cp.AuctionType, cp.Type)
+		}
 	}
 
 	return nil
@@ -94,6 +94,84 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				contains:   "",
 			},
 		},
+		{
+			name: "valid single-collateral dutch auction",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 4000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdk.NewInt(50000000000),
+						Prefix:                           0x20,
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdk.NewInt(8),
+						CheckCollateralizationIndexCount: sdk.NewInt(10),
+						AuctionType:                      types.DutchAuctionType,
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdx",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdk.NewInt(6),
+					DebtFloor:        sdk.NewInt(10000000),
+				},
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			name: "invalid collateral auction type",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 4000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 2000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdk.NewInt(50000000000),
+						Prefix:                           0x20,
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdk.NewInt(8),
+						CheckCollateralizationIndexCount: sdk.NewInt(10),
+						AuctionType:                      "sealed",
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdx",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdk.NewInt(6),
+					DebtFloor:        sdk.NewInt(10000000),
+				},
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "auction type should be",
+			},
+		},
 		{
 			name: "invalid single-collateral mismatched debt denoms",
 			args: args{
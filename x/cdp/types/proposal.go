@@ -0,0 +1,63 @@
+package types
+
+import (
+	"errors"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeLiquidationSweep is the type of proposals that liquidate all risky cdps of a collateral type
+	ProposalTypeLiquidationSweep = "LiquidationSweep"
+)
+
+// ensure proposal types fulfill the gov Content interface.
+var _ govtypes.Content = LiquidationSweepProposal{}
+
+// LiquidationSweepProposal is a proposal for liquidating every cdp of a collateral type that is below its liquidation ratio.
+type LiquidationSweepProposal struct {
+	Title          string `json:"title" yaml:"title"`
+	Description    string `json:"description" yaml:"description"`
+	CollateralType string `json:"collateral_type" yaml:"collateral_type"`
+}
+
+// NewLiquidationSweepProposal returns a new LiquidationSweepProposal
+func NewLiquidationSweepProposal(title, description, collateralType string) LiquidationSweepProposal {
+	return LiquidationSweepProposal{
+		Title:          title,
+		Description:    description,
+		CollateralType: collateralType,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (lsp LiquidationSweepProposal) GetTitle() string { return lsp.Title }
+
+// GetDescription returns the description of the proposal.
+func (lsp LiquidationSweepProposal) GetDescription() string { return lsp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (lsp LiquidationSweepProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (lsp LiquidationSweepProposal) ProposalType() string { return ProposalTypeLiquidationSweep }
+
+// ValidateBasic runs basic stateless validity checks
+func (lsp LiquidationSweepProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(lsp); err != nil {
+		return err
+	}
+	if strings.TrimSpace(lsp.CollateralType) == "" {
+		return errors.New("collateral type cannot be blank")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (lsp LiquidationSweepProposal) String() string {
+	bz, _ := yaml.Marshal(lsp)
+	return string(bz)
+}
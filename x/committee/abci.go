@@ -8,7 +8,12 @@ import (
 
 // BeginBlocker runs at the start of every block.
 func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k Keeper) {
+	// enact queued proposals whose enactment delay has passed
+	k.EnactQueuedProposals(ctx)
 	// enact proposals ignoring their expiry time - they could have received enough votes last block before expiring this block
 	k.EnactPassedProposals(ctx)
+	k.CloseRejectedProposals(ctx)
 	k.CloseExpiredProposals(ctx)
+	// remove closed proposals that are older than the archive retention
+	k.PruneArchivedProposals(ctx)
 }
@@ -45,6 +45,7 @@ func (suite *ModuleTestSuite) TestBeginBlock_ClosesExpired() {
 		Permissions:      []committee.Permission{committee.GodPermission{}},
 		VoteThreshold:    d("0.8"),
 		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
 	}
 	suite.keeper.SetCommittee(suite.ctx, normalCom)
 
@@ -80,6 +81,7 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassed() {
 		Permissions:      []committee.Permission{committee.GodPermission{}},
 		VoteThreshold:    d("0.8"),
 		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
 	}
 	suite.keeper.SetCommittee(suite.ctx, normalCom)
 
@@ -109,9 +111,9 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassed() {
 	suite.NoError(err)
 
 	// add enough votes to make the first proposal pass, but not the second
-	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[0]))
-	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[1]))
-	suite.NoError(suite.keeper.AddVote(suite.ctx, id2, suite.addresses[0]))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[0], committee.Yes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[1], committee.Yes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id2, suite.addresses[0], committee.Yes))
 
 	// Run BeginBlocker
 	suite.NotPanics(func() {
@@ -127,6 +129,246 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassed() {
 	suite.True(found, "expected non passed proposal to be not closed")
 }
 
+func (suite *ModuleTestSuite) TestBeginBlock_EnactsQueuedAfterDelay() {
+	suite.app.InitializeFromGenesisStates()
+
+	// setup committee with an enactment delay
+	delayedCom := committee.Committee{
+		ID:               12,
+		Members:          suite.addresses[:2],
+		Permissions:      []committee.Permission{committee.GodPermission{}},
+		VoteThreshold:    d("0.8"),
+		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
+		EnactmentDelay:   time.Hour * 24,
+	}
+	suite.keeper.SetCommittee(suite.ctx, delayedCom)
+
+	previousCDPDebtThreshold := suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold
+	newDebtThreshold := previousCDPDebtThreshold.Add(i(1000000))
+	pprop := params.NewParameterChangeProposal("Title 1", "A description of this proposal.",
+		[]params.ParamChange{{
+			Subspace: cdptypes.ModuleName,
+			Key:      string(cdp.KeyDebtThreshold),
+			Value:    string(cdp.ModuleCdc.MustMarshalJSON(newDebtThreshold)),
+		}},
+	)
+	id, err := suite.keeper.SubmitProposal(suite.ctx, delayedCom.Members[0], delayedCom.ID, pprop)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[0], committee.Yes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[1], committee.Yes))
+
+	// Run BeginBlocker, the passed proposal is queued rather than enacted
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	suite.Equal(previousCDPDebtThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold)
+	_, found := suite.keeper.GetProposal(suite.ctx, id)
+	suite.False(found, "expected passed proposal to be closed")
+	queuedProposal, found := suite.keeper.GetQueuedProposal(suite.ctx, id)
+	suite.True(found, "expected passed proposal to be queued")
+	suite.Equal(suite.ctx.BlockTime().Add(delayedCom.EnactmentDelay), queuedProposal.EnactmentTime)
+	archivedProposal, found := suite.keeper.GetArchivedProposal(suite.ctx, id)
+	suite.True(found, "expected queued proposal to be archived")
+	suite.Equal(committee.AttributeValueProposalQueued, archivedProposal.Outcome)
+
+	// Run BeginBlocker before the delay has passed
+	almostDelayLaterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(delayedCom.EnactmentDelay - time.Second))
+	suite.NotPanics(func() {
+		committee.BeginBlocker(almostDelayLaterCtx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	suite.Equal(previousCDPDebtThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold)
+
+	// Run BeginBlocker once the delay has passed
+	delayLaterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(delayedCom.EnactmentDelay))
+	suite.NotPanics(func() {
+		committee.BeginBlocker(delayLaterCtx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	suite.Equal(newDebtThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold)
+	_, found = suite.keeper.GetQueuedProposal(suite.ctx, id)
+	suite.False(found, "expected queued proposal to be enacted and removed")
+	archivedProposal, found = suite.keeper.GetArchivedProposal(suite.ctx, id)
+	suite.True(found)
+	suite.Equal(committee.AttributeValueProposalPassed, archivedProposal.Outcome)
+	suite.Empty(archivedProposal.EnactmentError)
+}
+
+func (suite *ModuleTestSuite) TestBeginBlock_GuardianVetoesQueued() {
+	suite.app.InitializeFromGenesisStates()
+
+	// setup a committee with an enactment delay and a guardian committee that can veto its proposals
+	delayedCom := committee.Committee{
+		ID:               12,
+		Members:          suite.addresses[:2],
+		Permissions:      []committee.Permission{committee.GodPermission{}},
+		VoteThreshold:    d("0.8"),
+		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
+		EnactmentDelay:   time.Hour * 24,
+	}
+	suite.keeper.SetCommittee(suite.ctx, delayedCom)
+	guardianCom := committee.Committee{
+		ID:               13,
+		Members:          suite.addresses[2:4],
+		Permissions:      []committee.Permission{committee.NewVetoPermission([]uint64{delayedCom.ID})},
+		VoteThreshold:    d("0.5"),
+		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
+	}
+	suite.keeper.SetCommittee(suite.ctx, guardianCom)
+
+	previousCDPDebtThreshold := suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold
+	pprop := params.NewParameterChangeProposal("Title 1", "A description of this proposal.",
+		[]params.ParamChange{{
+			Subspace: cdptypes.ModuleName,
+			Key:      string(cdp.KeyDebtThreshold),
+			Value:    string(cdp.ModuleCdc.MustMarshalJSON(previousCDPDebtThreshold.Add(i(1000000)))),
+		}},
+	)
+	id, err := suite.keeper.SubmitProposal(suite.ctx, delayedCom.Members[0], delayedCom.ID, pprop)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[0], committee.Yes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[1], committee.Yes))
+
+	// the guardian cannot veto a proposal before it is queued
+	vetoProp := committee.NewVetoProposal("Veto", "A description of this veto.", id)
+	_, err = suite.keeper.SubmitProposal(suite.ctx, guardianCom.Members[0], guardianCom.ID, vetoProp)
+	suite.Error(err)
+
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	_, found := suite.keeper.GetQueuedProposal(suite.ctx, id)
+	suite.True(found, "expected passed proposal to be queued")
+
+	// the guardian vetoes the queued proposal
+	vetoID, err := suite.keeper.SubmitProposal(suite.ctx, guardianCom.Members[0], guardianCom.ID, vetoProp)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, vetoID, suite.addresses[2], committee.Yes))
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	_, found = suite.keeper.GetQueuedProposal(suite.ctx, id)
+	suite.False(found, "expected queued proposal to be vetoed")
+	archivedProposal, found := suite.keeper.GetArchivedProposal(suite.ctx, id)
+	suite.True(found)
+	suite.Equal(committee.AttributeValueProposalVetoed, archivedProposal.Outcome)
+
+	// the vetoed proposal is never enacted
+	delayLaterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(delayedCom.EnactmentDelay))
+	suite.NotPanics(func() {
+		committee.BeginBlocker(delayLaterCtx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	suite.Equal(previousCDPDebtThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold)
+}
+
+func (suite *ModuleTestSuite) TestBeginBlock_DelayedGuardianVetoesImmediately() {
+	suite.app.InitializeFromGenesisStates()
+
+	// setup a committee with an enactment delay and a guardian committee that also has an enactment delay
+	delayedCom := committee.Committee{
+		ID:               12,
+		Members:          suite.addresses[:2],
+		Permissions:      []committee.Permission{committee.GodPermission{}},
+		VoteThreshold:    d("0.8"),
+		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
+		EnactmentDelay:   time.Hour * 24,
+	}
+	suite.keeper.SetCommittee(suite.ctx, delayedCom)
+	guardianCom := committee.Committee{
+		ID:               13,
+		Members:          suite.addresses[2:4],
+		Permissions:      []committee.Permission{committee.NewVetoPermission([]uint64{delayedCom.ID}), committee.GodPermission{}},
+		VoteThreshold:    d("0.5"),
+		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
+		EnactmentDelay:   time.Hour * 24,
+	}
+	suite.keeper.SetCommittee(suite.ctx, guardianCom)
+
+	// queue two proposals from the delayed committee
+	previousCDPParams := suite.app.GetCDPKeeper().GetParams(suite.ctx)
+	pprop := params.NewParameterChangeProposal("Title 1", "A description of this proposal.",
+		[]params.ParamChange{{
+			Subspace: cdptypes.ModuleName,
+			Key:      string(cdp.KeyDebtThreshold),
+			Value:    string(cdp.ModuleCdc.MustMarshalJSON(previousCDPParams.DebtAuctionThreshold.Add(i(1000000)))),
+		}},
+	)
+	var queuedIDs []uint64
+	for j := 0; j < 2; j++ {
+		id, err := suite.keeper.SubmitProposal(suite.ctx, delayedCom.Members[0], delayedCom.ID, pprop)
+		suite.NoError(err)
+		suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[0], committee.Yes))
+		suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[1], committee.Yes))
+		queuedIDs = append(queuedIDs, id)
+	}
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	for _, id := range queuedIDs {
+		_, found := suite.keeper.GetQueuedProposal(suite.ctx, id)
+		suite.True(found, "expected passed proposal to be queued")
+	}
+
+	// the guardian vetoes one proposal on its own and the other within a batch that also changes a param
+	newSurplusThreshold := previousCDPParams.SurplusAuctionThreshold.Add(i(1000000))
+	surplusProp := params.NewParameterChangeProposal("Title 2", "A description of this proposal.",
+		[]params.ParamChange{{
+			Subspace: cdptypes.ModuleName,
+			Key:      string(cdp.KeySurplusThreshold),
+			Value:    string(cdp.ModuleCdc.MustMarshalJSON(newSurplusThreshold)),
+		}},
+	)
+	vetoID, err := suite.keeper.SubmitProposal(suite.ctx, guardianCom.Members[0], guardianCom.ID,
+		committee.NewVetoProposal("Veto", "A description of this veto.", queuedIDs[0]),
+	)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, vetoID, suite.addresses[2], committee.Yes))
+	batchID, err := suite.keeper.SubmitProposal(suite.ctx, guardianCom.Members[0], guardianCom.ID,
+		committee.NewBatchProposal("Batch", "A description of this batch.", []committee.PubProposal{
+			committee.NewVetoProposal("Veto", "A description of this veto.", queuedIDs[1]),
+			surplusProp,
+		}),
+	)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, batchID, suite.addresses[2], committee.Yes))
+
+	// the vetoes are enacted in the block the guardian proposals pass, despite the guardian's enactment delay
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	for _, id := range queuedIDs {
+		_, found := suite.keeper.GetQueuedProposal(suite.ctx, id)
+		suite.False(found, "expected queued proposal to be vetoed")
+		archivedProposal, found := suite.keeper.GetArchivedProposal(suite.ctx, id)
+		suite.True(found)
+		suite.Equal(committee.AttributeValueProposalVetoed, archivedProposal.Outcome)
+	}
+	_, found := suite.keeper.GetQueuedProposal(suite.ctx, vetoID)
+	suite.False(found, "expected veto proposal to not be queued")
+	archivedProposal, found := suite.keeper.GetArchivedProposal(suite.ctx, vetoID)
+	suite.True(found)
+	suite.Equal(committee.AttributeValueProposalPassed, archivedProposal.Outcome)
+
+	// the rest of the batch waits for the guardian's enactment delay
+	queuedBatch, found := suite.keeper.GetQueuedProposal(suite.ctx, batchID)
+	suite.True(found, "expected the rest of the batch to be queued")
+	suite.Equal(committee.NewBatchProposal("Batch", "A description of this batch.", []committee.PubProposal{surplusProp}), queuedBatch.PubProposal)
+	suite.Equal(previousCDPParams.SurplusAuctionThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).SurplusAuctionThreshold)
+
+	delayLaterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(guardianCom.EnactmentDelay))
+	suite.NotPanics(func() {
+		committee.BeginBlocker(delayLaterCtx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	suite.Equal(newSurplusThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).SurplusAuctionThreshold)
+	suite.Equal(previousCDPParams.DebtAuctionThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold)
+	archivedProposal, found = suite.keeper.GetArchivedProposal(suite.ctx, batchID)
+	suite.True(found)
+	suite.Equal(committee.AttributeValueProposalPassed, archivedProposal.Outcome)
+}
+
 func (suite *ModuleTestSuite) TestBeginBlock_DoesntEnactFailed() {
 	suite.app.InitializeFromGenesisStates()
 
@@ -137,6 +379,7 @@ func (suite *ModuleTestSuite) TestBeginBlock_DoesntEnactFailed() {
 		Permissions:      []committee.Permission{committee.SoftwareUpgradePermission{}},
 		VoteThreshold:    d("1.0"),
 		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
 	}
 	firstBlockTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
 	ctx := suite.ctx.WithBlockTime(firstBlockTime)
@@ -154,7 +397,7 @@ func (suite *ModuleTestSuite) TestBeginBlock_DoesntEnactFailed() {
 	suite.NoError(err)
 
 	// add enough votes to make the proposal pass
-	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0]))
+	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0], committee.Yes))
 
 	// Run BeginBlocker 10 seconds later (5 seconds after upgrade expires)
 	tenSecLaterCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Second * 10))
@@ -186,6 +429,7 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassedUpgrade() {
 		Permissions:      []committee.Permission{committee.SoftwareUpgradePermission{}},
 		VoteThreshold:    d("1.0"),
 		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
 	}
 	firstBlockTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
 	ctx := suite.ctx.WithBlockTime(firstBlockTime)
@@ -203,7 +447,7 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassedUpgrade() {
 	suite.NoError(err)
 
 	// add enough votes to make the proposal pass
-	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0]))
+	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0], committee.Yes))
 
 	// Run BeginBlocker
 	fiveSecLaterCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Second * 5))
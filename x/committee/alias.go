@@ -9,118 +9,195 @@ import (
 )
 
 const (
+	Abstain                         = types.Abstain
 	AttributeKeyCommitteeID         = types.AttributeKeyCommitteeID
+	AttributeKeyEnactmentTime       = types.AttributeKeyEnactmentTime
 	AttributeKeyProposalCloseStatus = types.AttributeKeyProposalCloseStatus
 	AttributeKeyProposalID          = types.AttributeKeyProposalID
+	AttributeKeyVoteType            = types.AttributeKeyVoteType
 	AttributeKeyVoter               = types.AttributeKeyVoter
 	AttributeValueCategory          = types.AttributeValueCategory
 	AttributeValueProposalFailed    = types.AttributeValueProposalFailed
 	AttributeValueProposalPassed    = types.AttributeValueProposalPassed
+	AttributeValueProposalQueued    = types.AttributeValueProposalQueued
+	AttributeValueProposalRejected  = types.AttributeValueProposalRejected
 	AttributeValueProposalTimeout   = types.AttributeValueProposalTimeout
+	AttributeValueProposalVetoed    = types.AttributeValueProposalVetoed
 	DefaultNextProposalID           = types.DefaultNextProposalID
 	DefaultParamspace               = types.DefaultParamspace
 	EventTypeProposalClose          = types.EventTypeProposalClose
+	EventTypeProposalEnact          = types.EventTypeProposalEnact
+	EventTypeProposalQueue          = types.EventTypeProposalQueue
 	EventTypeProposalSubmit         = types.EventTypeProposalSubmit
+	EventTypeProposalVeto           = types.EventTypeProposalVeto
 	EventTypeProposalVote           = types.EventTypeProposalVote
 	MaxCommitteeDescriptionLength   = types.MaxCommitteeDescriptionLength
+	MemberCommitteeType             = types.MemberCommitteeType
 	ModuleName                      = types.ModuleName
+	No                              = types.No
+	NullVoteType                    = types.NullVoteType
+	ProposalTypeBatch               = types.ProposalTypeBatch
 	ProposalTypeCommitteeChange     = types.ProposalTypeCommitteeChange
 	ProposalTypeCommitteeDelete     = types.ProposalTypeCommitteeDelete
+	ProposalTypeVeto                = types.ProposalTypeVeto
 	QuerierRoute                    = types.QuerierRoute
+	QueryArchivedProposal           = types.QueryArchivedProposal
+	QueryArchivedProposals          = types.QueryArchivedProposals
 	QueryCommittee                  = types.QueryCommittee
 	QueryCommittees                 = types.QueryCommittees
 	QueryNextProposalID             = types.QueryNextProposalID
+	QueryParams                     = types.QueryParams
 	QueryProposal                   = types.QueryProposal
 	QueryProposals                  = types.QueryProposals
+	QueryQueuedProposal             = types.QueryQueuedProposal
+	QueryQueuedProposals            = types.QueryQueuedProposals
 	QueryRawParams                  = types.QueryRawParams
 	QueryTally                      = types.QueryTally
 	QueryVote                       = types.QueryVote
 	QueryVotes                      = types.QueryVotes
 	RouterKey                       = types.RouterKey
+	StakeCommitteeType              = types.StakeCommitteeType
 	StoreKey                        = types.StoreKey
+	TokenCommitteeType              = types.TokenCommitteeType
 	TypeMsgSubmitProposal           = types.TypeMsgSubmitProposal
 	TypeMsgVote                     = types.TypeMsgVote
+	Yes                             = types.Yes
 )
 
 var (
 	// function aliases
-	NewKeeper                   = keeper.NewKeeper
-	NewQuerier                  = keeper.NewQuerier
-	RegisterInvariants          = keeper.RegisterInvariants
-	ValidCommitteesInvariant    = keeper.ValidCommitteesInvariant
-	ValidProposalsInvariant     = keeper.ValidProposalsInvariant
-	ValidVotesInvariant         = keeper.ValidVotesInvariant
-	DefaultGenesisState         = types.DefaultGenesisState
-	GetKeyFromID                = types.GetKeyFromID
-	GetVoteKey                  = types.GetVoteKey
-	NewAllowedCollateralParam   = types.NewAllowedCollateralParam
-	NewAllowedMoneyMarket       = types.NewAllowedMoneyMarket
-	NewCommittee                = types.NewCommittee
-	NewCommitteeChangeProposal  = types.NewCommitteeChangeProposal
-	NewCommitteeDeleteProposal  = types.NewCommitteeDeleteProposal
-	NewGenesisState             = types.NewGenesisState
-	NewMsgSubmitProposal        = types.NewMsgSubmitProposal
-	NewMsgVote                  = types.NewMsgVote
-	NewProposal                 = types.NewProposal
-	NewQueryCommitteeParams     = types.NewQueryCommitteeParams
-	NewQueryProposalParams      = types.NewQueryProposalParams
-	NewQueryRawParamsParams     = types.NewQueryRawParamsParams
-	NewQueryVoteParams          = types.NewQueryVoteParams
-	NewVote                     = types.NewVote
-	RegisterCodec               = types.RegisterCodec
-	RegisterPermissionTypeCodec = types.RegisterPermissionTypeCodec
-	RegisterProposalTypeCodec   = types.RegisterProposalTypeCodec
-	Uint64FromBytes             = types.Uint64FromBytes
+	NewKeeper                       = keeper.NewKeeper
+	NewQuerier                      = keeper.NewQuerier
+	RegisterInvariants              = keeper.RegisterInvariants
+	ValidCommitteesInvariant        = keeper.ValidCommitteesInvariant
+	ValidProposalsInvariant         = keeper.ValidProposalsInvariant
+	ValidVotesInvariant             = keeper.ValidVotesInvariant
+	VoteEscrowInvariant             = keeper.VoteEscrowInvariant
+	CommitteeTypeFromString         = types.CommitteeTypeFromString
+	DefaultGenesisState             = types.DefaultGenesisState
+	DefaultParams                   = types.DefaultParams
+	GetArchivedProposalByTimeKey    = types.GetArchivedProposalByTimeKey
+	GetKeyFromID                    = types.GetKeyFromID
+	GetParamChangeTimeKey           = types.GetParamChangeTimeKey
+	GetVoteKey                      = types.GetVoteKey
+	IsValidProposalOutcome          = types.IsValidProposalOutcome
+	NewAllowedCollateralParam       = types.NewAllowedCollateralParam
+	NewAllowedMoneyMarket           = types.NewAllowedMoneyMarket
+	NewArchivedProposal             = types.NewArchivedProposal
+	NewBatchProposal                = types.NewBatchProposal
+	NewBoundedParamChangePermission = types.NewBoundedParamChangePermission
+	NewCommittee                    = types.NewCommittee
+	NewCommitteeChangeProposal      = types.NewCommitteeChangeProposal
+	NewCommitteeDeleteProposal      = types.NewCommitteeDeleteProposal
+	NewGenesisState                 = types.NewGenesisState
+	NewMemberWeight                 = types.NewMemberWeight
+	NewMsgSubmitProposal            = types.NewMsgSubmitProposal
+	NewMsgVote                      = types.NewMsgVote
+	NewParamBound                   = types.NewParamBound
+	NewParamChangeTime              = types.NewParamChangeTime
+	NewParams                       = types.NewParams
+	NewProposal                     = types.NewProposal
+	NewProposalTally                = types.NewProposalTally
+	NewQueryArchivedProposalsParams = types.NewQueryArchivedProposalsParams
+	NewQueryCommitteeParams         = types.NewQueryCommitteeParams
+	NewQueryProposalParams          = types.NewQueryProposalParams
+	NewQueryRawParamsParams         = types.NewQueryRawParamsParams
+	NewQueryVoteParams              = types.NewQueryVoteParams
+	NewQueuedProposal               = types.NewQueuedProposal
+	NewStakeCommittee               = types.NewStakeCommittee
+	NewTokenCommittee               = types.NewTokenCommittee
+	NewVetoPermission               = types.NewVetoPermission
+	NewVetoProposal                 = types.NewVetoProposal
+	NewVote                         = types.NewVote
+	NewVotingPowerSnapshot          = types.NewVotingPowerSnapshot
+	ParamKeyTable                   = types.ParamKeyTable
+	RegisterCodec                   = types.RegisterCodec
+	RegisterPermissionTypeCodec     = types.RegisterPermissionTypeCodec
+	RegisterProposalTypeCodec       = types.RegisterProposalTypeCodec
+	Uint64FromBytes                 = types.Uint64FromBytes
+	VoteTypeFromString              = types.VoteTypeFromString
 
 	// variable aliases
-	ProposalHandler            = client.ProposalHandler
-	CommitteeKeyPrefix         = types.CommitteeKeyPrefix
-	ErrInvalidCommittee        = types.ErrInvalidCommittee
-	ErrInvalidGenesis          = types.ErrInvalidGenesis
-	ErrInvalidPubProposal      = types.ErrInvalidPubProposal
-	ErrNoProposalHandlerExists = types.ErrNoProposalHandlerExists
-	ErrProposalExpired         = types.ErrProposalExpired
-	ErrUnknownCommittee        = types.ErrUnknownCommittee
-	ErrUnknownProposal         = types.ErrUnknownProposal
-	ErrUnknownSubspace         = types.ErrUnknownSubspace
-	ErrUnknownVote             = types.ErrUnknownVote
-	ModuleCdc                  = types.ModuleCdc
-	NextProposalIDKey          = types.NextProposalIDKey
-	ProposalKeyPrefix          = types.ProposalKeyPrefix
-	VoteKeyPrefix              = types.VoteKeyPrefix
+	ProposalHandler                 = client.ProposalHandler
+	ArchivedProposalByTimeKeyPrefix = types.ArchivedProposalByTimeKeyPrefix
+	ArchivedProposalKeyPrefix       = types.ArchivedProposalKeyPrefix
+	CommitteeKeyPrefix              = types.CommitteeKeyPrefix
+	DefaultArchiveRetention         = types.DefaultArchiveRetention
+	ErrInvalidCommittee             = types.ErrInvalidCommittee
+	ErrInvalidGenesis               = types.ErrInvalidGenesis
+	ErrInvalidPubProposal           = types.ErrInvalidPubProposal
+	ErrInvalidVoteType              = types.ErrInvalidVoteType
+	ErrNoProposalHandlerExists      = types.ErrNoProposalHandlerExists
+	ErrNoVotingPower                = types.ErrNoVotingPower
+	ErrProposalExpired              = types.ErrProposalExpired
+	ErrUnknownArchivedProposal      = types.ErrUnknownArchivedProposal
+	ErrUnknownCommittee             = types.ErrUnknownCommittee
+	ErrUnknownProposal              = types.ErrUnknownProposal
+	ErrUnknownQueuedProposal        = types.ErrUnknownQueuedProposal
+	ErrUnknownSubspace              = types.ErrUnknownSubspace
+	ErrUnknownVote                  = types.ErrUnknownVote
+	KeyArchiveRetention             = types.KeyArchiveRetention
+	ModuleCdc                       = types.ModuleCdc
+	NextProposalIDKey               = types.NextProposalIDKey
+	ParamChangeTimeKeyPrefix        = types.ParamChangeTimeKeyPrefix
+	ProposalKeyPrefix               = types.ProposalKeyPrefix
+	QueuedProposalKeyPrefix         = types.QueuedProposalKeyPrefix
+	VoteKeyPrefix                   = types.VoteKeyPrefix
+	VotingPowerSnapshotKeyPrefix    = types.VotingPowerSnapshotKeyPrefix
 )
 
 type (
-	Keeper                      = keeper.Keeper
-	AllowedAssetParam           = types.AllowedAssetParam
-	AllowedAssetParams          = types.AllowedAssetParams
-	AllowedCollateralParam      = types.AllowedCollateralParam
-	AllowedCollateralParams     = types.AllowedCollateralParams
-	AllowedDebtParam            = types.AllowedDebtParam
-	AllowedMarket               = types.AllowedMarket
-	AllowedMarkets              = types.AllowedMarkets
-	AllowedMoneyMarket          = types.AllowedMoneyMarket
-	AllowedMoneyMarkets         = types.AllowedMoneyMarkets
-	AllowedParam                = types.AllowedParam
-	AllowedParams               = types.AllowedParams
-	Committee                   = types.Committee
-	CommitteeChangeProposal     = types.CommitteeChangeProposal
-	CommitteeDeleteProposal     = types.CommitteeDeleteProposal
-	GenesisState                = types.GenesisState
-	GodPermission               = types.GodPermission
-	MsgSubmitProposal           = types.MsgSubmitProposal
-	MsgVote                     = types.MsgVote
-	ParamKeeper                 = types.ParamKeeper
-	Permission                  = types.Permission
-	Proposal                    = types.Proposal
-	PubProposal                 = types.PubProposal
-	QueryCommitteeParams        = types.QueryCommitteeParams
-	QueryProposalParams         = types.QueryProposalParams
-	QueryRawParamsParams        = types.QueryRawParamsParams
-	QueryVoteParams             = types.QueryVoteParams
-	SimpleParamChangePermission = types.SimpleParamChangePermission
-	SoftwareUpgradePermission   = types.SoftwareUpgradePermission
-	SubParamChangePermission    = types.SubParamChangePermission
-	TextPermission              = types.TextPermission
-	Vote                        = types.Vote
+	Keeper                       = keeper.Keeper
+	AccountKeeper                = types.AccountKeeper
+	AllowedAssetParam            = types.AllowedAssetParam
+	AllowedAssetParams           = types.AllowedAssetParams
+	AllowedCollateralParam       = types.AllowedCollateralParam
+	AllowedCollateralParams      = types.AllowedCollateralParams
+	AllowedDebtParam             = types.AllowedDebtParam
+	AllowedMarket                = types.AllowedMarket
+	AllowedMarkets               = types.AllowedMarkets
+	AllowedMoneyMarket           = types.AllowedMoneyMarket
+	AllowedMoneyMarkets          = types.AllowedMoneyMarkets
+	AllowedParam                 = types.AllowedParam
+	AllowedParams                = types.AllowedParams
+	ArchivedProposal             = types.ArchivedProposal
+	BatchProposal                = types.BatchProposal
+	BoundedParamChangePermission = types.BoundedParamChangePermission
+	Committee                    = types.Committee
+	CommitteeChangeProposal      = types.CommitteeChangeProposal
+	CommitteeDeleteProposal      = types.CommitteeDeleteProposal
+	CommitteeType                = types.CommitteeType
+	GenesisState                 = types.GenesisState
+	GodPermission                = types.GodPermission
+	MemberWeight                 = types.MemberWeight
+	MsgSubmitProposal            = types.MsgSubmitProposal
+	MsgVote                      = types.MsgVote
+	ParamBound                   = types.ParamBound
+	ParamBounds                  = types.ParamBounds
+	ParamChangeHistory           = types.ParamChangeHistory
+	ParamChangeTime              = types.ParamChangeTime
+	ParamKeeper                  = types.ParamKeeper
+	Params                       = types.Params
+	Permission                   = types.Permission
+	Proposal                     = types.Proposal
+	ProposalQueue                = types.ProposalQueue
+	ProposalTally                = types.ProposalTally
+	PubProposal                  = types.PubProposal
+	QueryArchivedProposalsParams = types.QueryArchivedProposalsParams
+	QueryCommitteeParams         = types.QueryCommitteeParams
+	QueryProposalParams          = types.QueryProposalParams
+	QueryRawParamsParams         = types.QueryRawParamsParams
+	QueryVoteParams              = types.QueryVoteParams
+	QueuedProposal               = types.QueuedProposal
+	SimpleParamChangePermission  = types.SimpleParamChangePermission
+	SoftwareUpgradePermission    = types.SoftwareUpgradePermission
+	StakingKeeper                = types.StakingKeeper
+	SubParamChangePermission     = types.SubParamChangePermission
+	SupplyKeeper                 = types.SupplyKeeper
+	TextPermission               = types.TextPermission
+	VetoPermission               = types.VetoPermission
+	VetoProposal                 = types.VetoProposal
+	Vote                         = types.Vote
+	VoteType                     = types.VoteType
+	VotingPowerSnapshot          = types.VotingPowerSnapshot
 )
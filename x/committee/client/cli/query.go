@@ -3,8 +3,10 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -16,6 +18,12 @@ import (
 	"github.com/kava-labs/kava/x/committee/types"
 )
 
+// Query flags
+const (
+	flagCommittee = "committee"
+	flagOutcome   = "outcome"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -33,12 +41,17 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		// proposals
 		GetCmdQueryProposal(queryRoute, cdc),
 		GetCmdQueryProposals(queryRoute, cdc),
+		GetCmdQueryQueuedProposal(queryRoute, cdc),
+		GetCmdQueryQueuedProposals(queryRoute, cdc),
+		GetCmdQueryArchivedProposal(queryRoute, cdc),
+		GetCmdQueryArchivedProposals(queryRoute, cdc),
 		// votes
 		GetCmdQueryVotes(queryRoute, cdc),
 		// other
 		GetCmdQueryProposer(queryRoute, cdc),
 		GetCmdQueryTally(queryRoute, cdc),
-		GetCmdQueryRawParams(queryRoute, cdc))...)
+		GetCmdQueryRawParams(queryRoute, cdc),
+		GetCmdQueryParams(queryRoute, cdc))...)
 
 	return queryCmd
 }
@@ -179,6 +192,156 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryQueuedProposal implements the query queued proposal command.
+func GetCmdQueryQueuedProposal(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "queued-proposal [proposal-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query details of a single passed proposal waiting to be enacted",
+		Example: fmt.Sprintf("%s query %s queued-proposal 2", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint", args[0])
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryProposalParams(proposalID))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryQueuedProposal), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print result
+			queuedProposal := types.QueuedProposal{}
+			if err = cdc.UnmarshalJSON(res, &queuedProposal); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(queuedProposal)
+		},
+	}
+}
+
+// GetCmdQueryQueuedProposals implements a query queued proposals command.
+func GetCmdQueryQueuedProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "queued-proposals",
+		Args:    cobra.NoArgs,
+		Short:   "Query all passed proposals waiting to be enacted",
+		Example: fmt.Sprintf("%s query %s queued-proposals", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryQueuedProposals), nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print result
+			queuedProposals := []types.QueuedProposal{}
+			if err = cdc.UnmarshalJSON(res, &queuedProposals); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(queuedProposals)
+		},
+	}
+}
+
+// GetCmdQueryArchivedProposal implements the query archived proposal command.
+func GetCmdQueryArchivedProposal(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "archived-proposal [proposal-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the outcome, final tally and votes of a single closed proposal",
+		Example: fmt.Sprintf("%s query %s archived-proposal 2", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint", args[0])
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryProposalParams(proposalID))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryArchivedProposal), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print result
+			archivedProposal := types.ArchivedProposal{}
+			if err = cdc.UnmarshalJSON(res, &archivedProposal); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(archivedProposal)
+		},
+	}
+}
+
+// GetCmdQueryArchivedProposals implements a query archived proposals command.
+func GetCmdQueryArchivedProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archived-proposals",
+		Args:  cobra.NoArgs,
+		Short: "Query closed proposals, optionally filtered by committee and outcome",
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s query %s archived-proposals", version.ClientName, types.ModuleName),
+			fmt.Sprintf("%s query %s archived-proposals --committee 1 --outcome proposal_passed", version.ClientName, types.ModuleName),
+		}, "\n"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			outcome := viper.GetString(flagOutcome)
+			if outcome != "" && !types.IsValidProposalOutcome(outcome) {
+				return fmt.Errorf("invalid outcome %s", outcome)
+			}
+			params := types.NewQueryArchivedProposalsParams(
+				viper.GetInt(flags.FlagPage),
+				viper.GetInt(flags.FlagLimit),
+				viper.GetUint64(flagCommittee),
+				outcome,
+			)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryArchivedProposals), bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print result
+			archivedProposals := []types.ArchivedProposal{}
+			if err = cdc.UnmarshalJSON(res, &archivedProposals); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(archivedProposals)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit (max 100)")
+	cmd.Flags().Uint64(flagCommittee, 0, "(optional) filter for proposals by committee id")
+	cmd.Flags().String(flagOutcome, "", "(optional) filter for proposals by outcome, eg proposal_passed, proposal_failed, proposal_rejected, proposal_timeout, proposal_queued, proposal_vetoed")
+	return cmd
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
@@ -319,3 +482,30 @@ func GetCmdQueryRawParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "params",
+		Args:    cobra.NoArgs,
+		Short:   fmt.Sprintf("Query the current %s parameters", types.ModuleName),
+		Example: fmt.Sprintf("%s query %s params", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print result
+			var params types.Params
+			if err = cdc.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
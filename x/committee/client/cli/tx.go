@@ -100,11 +100,11 @@ For example:
 // GetCmdVote returns the command to vote on a proposal.
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:     "vote [proposal-id]",
-		Args:    cobra.ExactArgs(1),
+		Use:     "vote [proposal-id] [vote-type]",
+		Args:    cobra.ExactArgs(2),
 		Short:   "Vote for an active proposal",
-		Long:    "Submit a yes vote for the proposal with id [proposal-id].",
-		Example: fmt.Sprintf("%s tx %s vote 2", version.ClientName, types.ModuleName),
+		Long:    "Submit a [yes/no/abstain] vote for the proposal with id [proposal-id].",
+		Example: fmt.Sprintf("%s tx %s vote 2 yes", version.ClientName, types.ModuleName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -119,8 +119,13 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
 			}
 
+			voteType, err := types.VoteTypeFromString(args[1])
+			if err != nil {
+				return err
+			}
+
 			// Build vote message and run basic validation
-			msg := types.NewMsgVote(from, proposalID)
+			msg := types.NewMsgVote(from, proposalID, voteType)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -136,7 +141,7 @@ func GetGovCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "committee [proposal-file] [deposit]",
 		Short: "Submit a governance proposal to change a committee.",
-		Long: fmt.Sprintf(`Submit a governance proposal to create, alter, or delete a committee.
+		Long: fmt.Sprintf(`Submit a governance proposal to create, alter, or delete a committee, or to veto a queued committee proposal.
 
 The proposal file must be the json encoded form of the proposal type you want to submit.
 For example, to create or update a committee:
@@ -144,7 +149,10 @@ For example, to create or update a committee:
 
 and to delete a committee:
 %s
-`, MustGetExampleCommitteeChangeProposal(cdc), MustGetExampleCommitteeDeleteProposal(cdc)),
+
+and to veto a queued committee proposal:
+%s
+`, MustGetExampleCommitteeChangeProposal(cdc), MustGetExampleCommitteeDeleteProposal(cdc), MustGetExampleVetoProposal(cdc)),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -203,6 +211,9 @@ func MustGetExampleCommitteeChangeProposal(cdc *codec.Codec) string {
 			},
 			sdk.MustNewDecFromStr("0.8"),
 			time.Hour*24*7,
+			[]types.MemberWeight{},
+			sdk.MustNewDecFromStr("0.5"),
+			0,
 		),
 	)
 	exampleChangeProposalBz, err := cdc.MarshalJSONIndent(exampleChangeProposal, "", "  ")
@@ -226,6 +237,20 @@ func MustGetExampleCommitteeDeleteProposal(cdc *codec.Codec) string {
 	return string(exampleDeleteProposalBz)
 }
 
+// MustGetExampleVetoProposal is a helper function to return an example json proposal
+func MustGetExampleVetoProposal(cdc *codec.Codec) string {
+	exampleVetoProposal := types.NewVetoProposal(
+		"A Title",
+		"A description of this proposal.",
+		1,
+	)
+	exampleVetoProposalBz, err := cdc.MarshalJSONIndent(exampleVetoProposal, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return string(exampleVetoProposalBz)
+}
+
 // MustGetExampleParameterChangeProposal is a helper function to return an example json proposal
 func MustGetExampleParameterChangeProposal(cdc *codec.Codec) string {
 	exampleParameterChangeProposal := params.NewParameterChangeProposal(
@@ -10,6 +10,7 @@ import (
 const (
 	RestProposalID  = "proposal-id"
 	RestCommitteeID = "committee-id"
+	RestOutcome     = "outcome"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
@@ -68,8 +68,9 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 // PostVoteReq defines the properties of a vote request's body.
 type PostVoteReq struct {
-	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
-	Voter   sdk.AccAddress `json:"voter" yaml:"voter"`
+	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Voter    sdk.AccAddress `json:"voter" yaml:"voter"`
+	VoteType types.VoteType `json:"vote_type" yaml:"vote_type"`
 }
 
 func postVoteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -97,7 +98,7 @@ func postVoteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// Create and return a StdTx
-		msg := types.NewMsgVote(req.Voter, proposalID)
+		msg := types.NewMsgVote(req.Voter, proposalID, req.VoteType)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
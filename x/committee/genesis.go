@@ -14,6 +14,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
 	}
 
+	keeper.SetParams(ctx, gs.Params)
 	keeper.SetNextProposalID(ctx, gs.NextProposalID)
 
 	for _, com := range gs.Committees {
@@ -25,6 +26,18 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 	for _, v := range gs.Votes {
 		keeper.SetVote(ctx, v)
 	}
+	for _, vps := range gs.VotingPowerSnapshots {
+		keeper.SetVotingPowerSnapshot(ctx, vps)
+	}
+	for _, pct := range gs.ParamChangeTimes {
+		keeper.SetParamChangeTime(ctx, pct)
+	}
+	for _, qp := range gs.QueuedProposals {
+		keeper.SetQueuedProposal(ctx, qp)
+	}
+	for _, ap := range gs.ArchivedProposals {
+		keeper.SetArchivedProposal(ctx, ap)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -37,11 +50,21 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	committees := keeper.GetCommittees(ctx)
 	proposals := keeper.GetProposals(ctx)
 	votes := keeper.GetVotes(ctx)
+	snapshots := keeper.GetVotingPowerSnapshots(ctx)
+	paramChangeTimes := keeper.GetParamChangeTimes(ctx)
+	queuedProposals := keeper.GetQueuedProposals(ctx)
+	params := keeper.GetParams(ctx)
+	archivedProposals := keeper.GetArchivedProposals(ctx)
 
 	return types.NewGenesisState(
 		nextID,
 		committees,
 		proposals,
 		votes,
+		snapshots,
+		paramChangeTimes,
+		queuedProposals,
+		params,
+		archivedProposals,
 	)
 }
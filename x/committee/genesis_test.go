@@ -40,6 +40,11 @@ func (suite *GenesisTestSuite) TestGenesis() {
 				[]types.Committee{},
 				[]types.Proposal{{ID: 1, CommitteeID: 57}},
 				[]types.Vote{},
+				[]types.VotingPowerSnapshot{},
+				[]types.ParamChangeTime{},
+				[]types.QueuedProposal{},
+				types.DefaultParams(),
+				[]types.ArchivedProposal{},
 			),
 			expectPass: false,
 		},
@@ -56,10 +56,16 @@ func (suite *HandlerTestSuite) SetupTest() {
 				Permissions:      []types.Permission{types.GodPermission{}},
 				VoteThreshold:    d("0.5"),
 				ProposalDuration: time.Hour * 24 * 7,
+				Quorum:           d("0"),
 			},
 		},
 		[]types.Proposal{},
 		[]types.Vote{},
+		[]types.VotingPowerSnapshot{},
+		[]types.ParamChangeTime{},
+		[]types.QueuedProposal{},
+		types.DefaultParams(),
+		[]types.ArchivedProposal{},
 	)
 	suite.communityPoolAmt = cs(c("ukava", 1000))
 	suite.app.InitializeFromGenesisStates(
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// GetArchivedProposal gets a closed proposal from the archive.
+func (k Keeper) GetArchivedProposal(ctx sdk.Context, proposalID uint64) (types.ArchivedProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ArchivedProposalKeyPrefix)
+	bz := store.Get(types.GetKeyFromID(proposalID))
+	if bz == nil {
+		return types.ArchivedProposal{}, false
+	}
+	var archivedProposal types.ArchivedProposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &archivedProposal)
+	return archivedProposal, true
+}
+
+// SetArchivedProposal puts a closed proposal into the archive, updating the by time index.
+func (k Keeper) SetArchivedProposal(ctx sdk.Context, archivedProposal types.ArchivedProposal) {
+	if existing, found := k.GetArchivedProposal(ctx, archivedProposal.ID); found {
+		k.removeFromArchiveByTimeIndex(ctx, existing.CloseTime, existing.ID)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ArchivedProposalKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(archivedProposal)
+	store.Set(types.GetKeyFromID(archivedProposal.ID), bz)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ArchivedProposalByTimeKeyPrefix)
+	indexStore.Set(types.GetArchivedProposalByTimeKey(archivedProposal.CloseTime, archivedProposal.ID), types.GetKeyFromID(archivedProposal.ID))
+}
+
+// DeleteArchivedProposal removes a closed proposal from the archive and the by time index.
+func (k Keeper) DeleteArchivedProposal(ctx sdk.Context, proposalID uint64) {
+	archivedProposal, found := k.GetArchivedProposal(ctx, proposalID)
+	if found {
+		k.removeFromArchiveByTimeIndex(ctx, archivedProposal.CloseTime, proposalID)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ArchivedProposalKeyPrefix)
+	store.Delete(types.GetKeyFromID(proposalID))
+}
+
+func (k Keeper) removeFromArchiveByTimeIndex(ctx sdk.Context, closeTime time.Time, proposalID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ArchivedProposalByTimeKeyPrefix)
+	store.Delete(types.GetArchivedProposalByTimeKey(closeTime, proposalID))
+}
+
+// IterateArchivedProposals provides an iterator over all archived proposals ordered by ID.
+// For each archived proposal, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateArchivedProposals(ctx sdk.Context, cb func(archivedProposal types.ArchivedProposal) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ArchivedProposalKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var archivedProposal types.ArchivedProposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &archivedProposal)
+		if cb(archivedProposal) {
+			break
+		}
+	}
+}
+
+// GetArchivedProposals returns all archived proposals ordered by ID.
+func (k Keeper) GetArchivedProposals(ctx sdk.Context) []types.ArchivedProposal {
+	results := []types.ArchivedProposal{}
+	k.IterateArchivedProposals(ctx, func(ap types.ArchivedProposal) bool {
+		results = append(results, ap)
+		return false
+	})
+	return results
+}
+
+// IterateArchivedProposalsByTime provides an iterator over the IDs of archived proposals closed at or before a cutoff time, ordered by close time.
+// For each archived proposal, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateArchivedProposalsByTime(ctx sdk.Context, inclusiveCutoffTime time.Time, cb func(proposalID uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ArchivedProposalByTimeKeyPrefix)
+	iterator := store.Iterator(
+		nil, // start at the very start of the prefix store
+		sdk.PrefixEndBytes(sdk.FormatTimeBytes(inclusiveCutoffTime)), // include any keys with times equal to inclusiveCutoffTime
+	)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.Uint64FromBytes(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// ArchiveProposal records a proposal that is closing, along with its final tally and votes.
+// It must be called before the proposal and its votes are deleted.
+func (k Keeper) ArchiveProposal(ctx sdk.Context, proposal types.Proposal, tally types.ProposalTally, outcome string, enactmentErr error) {
+	enactmentError := ""
+	if enactmentErr != nil {
+		enactmentError = enactmentErr.Error()
+	}
+	k.SetArchivedProposal(ctx, types.NewArchivedProposal(
+		proposal,
+		tally,
+		outcome,
+		enactmentError,
+		k.GetVotesByProposal(ctx, proposal.ID),
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+	))
+}
+
+// updateArchivedProposalOutcome sets the final outcome of an archived proposal that was queued for enactment.
+func (k Keeper) updateArchivedProposalOutcome(ctx sdk.Context, proposalID uint64, outcome string, enactmentErr error) {
+	archivedProposal, found := k.GetArchivedProposal(ctx, proposalID)
+	if !found {
+		// the archived proposal may have been pruned while it was queued
+		return
+	}
+	archivedProposal.Outcome = outcome
+	archivedProposal.EnactmentError = ""
+	if enactmentErr != nil {
+		archivedProposal.EnactmentError = enactmentErr.Error()
+	}
+	k.SetArchivedProposal(ctx, archivedProposal)
+}
+
+// PruneArchivedProposals removes archived proposals that closed longer ago than the archive retention param.
+func (k Keeper) PruneArchivedProposals(ctx sdk.Context) {
+	retention := k.GetParams(ctx).ArchiveRetention
+	if retention == 0 {
+		return
+	}
+
+	var proposalIDs []uint64
+	k.IterateArchivedProposalsByTime(ctx, ctx.BlockTime().Add(-retention), func(proposalID uint64) bool {
+		proposalIDs = append(proposalIDs, proposalID)
+		return false
+	})
+	for _, id := range proposalIDs {
+		k.DeleteArchivedProposal(ctx, id)
+	}
+}
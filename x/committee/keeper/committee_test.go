@@ -11,7 +11,10 @@ import (
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/kava-labs/kava/app"
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/committee/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	issuancetypes "github.com/kava-labs/kava/x/issuance/types"
 )
 
 type TypesTestSuite struct {
@@ -160,6 +163,101 @@ func (suite *TypesTestSuite) TestCommittee_HasPermissionsFor() {
 			pubProposal:          UnregisteredPubProposal{govtypes.TextProposal{Title: "A Title", Description: "A description."}},
 			expectHasPermissions: false,
 		},
+		{
+			name: "batch with all contents allowed",
+			permissions: []types.Permission{
+				types.SimpleParamChangePermission{
+					AllowedParams: types.AllowedParams{
+						{
+							Subspace: "cdp",
+							Key:      "DebtThreshold",
+						},
+					}},
+				types.TextPermission{},
+			},
+			pubProposal: types.NewBatchProposal(
+				"A Title",
+				"A description of this proposal.",
+				[]types.PubProposal{
+					paramstypes.NewParameterChangeProposal(
+						"A Title",
+						"A description of this proposal.",
+						[]paramstypes.ParamChange{
+							{
+								Subspace: "cdp",
+								Key:      "DebtThreshold",
+
+								Value: `{"denom": "usdx", "amount": "1000000"}`,
+							},
+						},
+					),
+					govtypes.NewTextProposal("A Proposal Title", "A description of this proposal"),
+				},
+			),
+			expectHasPermissions: true,
+		},
+		{
+			name: "batch with a content not allowed",
+			permissions: []types.Permission{
+				types.TextPermission{},
+			},
+			pubProposal: types.NewBatchProposal(
+				"A Title",
+				"A description of this proposal.",
+				[]types.PubProposal{
+					govtypes.NewTextProposal("A Proposal Title", "A description of this proposal"),
+					paramstypes.NewParameterChangeProposal(
+						"A Title",
+						"A description of this proposal.",
+						[]paramstypes.ParamChange{
+							{
+								Subspace: "cdp",
+								Key:      "DebtThreshold",
+
+								Value: `{"denom": "usdx", "amount": "1000000"}`,
+							},
+						},
+					),
+				},
+			),
+			expectHasPermissions: false,
+		},
+		{
+			name:                 "money market pause allowed",
+			permissions:          []types.Permission{types.NewMoneyMarketPausePermission([]string{"bnb"})},
+			pubProposal:          hardtypes.NewMoneyMarketPauseProposal("A Title", "A description of this proposal.", "bnb", true),
+			expectHasPermissions: true,
+		},
+		{
+			name:                 "money market pause for denom not allowed",
+			permissions:          []types.Permission{types.NewMoneyMarketPausePermission([]string{"bnb"})},
+			pubProposal:          hardtypes.NewMoneyMarketPauseProposal("A Title", "A description of this proposal.", "btcb", true),
+			expectHasPermissions: false,
+		},
+		{
+			name:                 "seize coins allowed",
+			permissions:          []types.Permission{types.NewSeizeCoinsPermission([]string{"usdtoken"})},
+			pubProposal:          issuancetypes.NewSeizeCoinsProposal("A Title", "A description of this proposal.", "usdtoken"),
+			expectHasPermissions: true,
+		},
+		{
+			name:                 "seize coins with wrong permission",
+			permissions:          []types.Permission{types.NewMoneyMarketPausePermission([]string{"usdtoken"})},
+			pubProposal:          issuancetypes.NewSeizeCoinsProposal("A Title", "A description of this proposal.", "usdtoken"),
+			expectHasPermissions: false,
+		},
+		{
+			name:                 "liquidation sweep allowed",
+			permissions:          []types.Permission{types.NewLiquidationSweepPermission([]string{"bnb-a"})},
+			pubProposal:          cdptypes.NewLiquidationSweepProposal("A Title", "A description of this proposal.", "bnb-a"),
+			expectHasPermissions: true,
+		},
+		{
+			name:                 "liquidation sweep for collateral type not allowed",
+			permissions:          []types.Permission{types.NewLiquidationSweepPermission([]string{"bnb-a"})},
+			pubProposal:          cdptypes.NewLiquidationSweepProposal("A Title", "A description of this proposal.", "btc-a"),
+			expectHasPermissions: false,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -174,6 +272,9 @@ func (suite *TypesTestSuite) TestCommittee_HasPermissionsFor() {
 				tc.permissions,
 				d("0.5"),
 				24*time.Hour,
+				nil,
+				d("0"),
+				0,
 			)
 			suite.Equal(
 				tc.expectHasPermissions,
@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ValidProposalsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "valid-votes",
 		ValidVotesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vote-escrow",
+		VoteEscrowInvariant(k))
 }
 
 // ValidCommitteesInvariant verifies that all committees in the store are independently valid
@@ -118,10 +120,14 @@ func ValidVotesInvariant(k Keeper) sdk.Invariant {
 				validationErr = fmt.Errorf("vote's proposal has no committee %d", proposal.CommitteeID)
 				return true
 			}
-			if !com.HasMember(vote.Voter) {
+			if com.IsMemberCommittee() && !com.HasMember(vote.Voter) {
 				validationErr = fmt.Errorf("voter is not a member of committee %+v", com)
 				return true
 			}
+			if _, found := k.GetVotingPowerSnapshot(ctx, vote.ProposalID, vote.Voter); !com.IsMemberCommittee() && !found {
+				validationErr = fmt.Errorf("vote has no voting power snapshot in %s committee %d", com.Type, com.ID)
+				return true
+			}
 
 			return false
 		})
@@ -138,3 +144,30 @@ func ValidVotesInvariant(k Keeper) sdk.Invariant {
 		return invariantMessage, broken
 	}
 }
+
+// VoteEscrowInvariant verifies that the module account holds at least the tokens escrowed by token committee voters
+func VoteEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+
+		totalEscrow := sdk.NewCoins()
+		k.IterateVotingPowerSnapshots(ctx, func(snapshot types.VotingPowerSnapshot) bool {
+			totalEscrow = totalEscrow.Add(snapshot.Escrow...)
+			return false
+		})
+
+		balance := sdk.NewCoins()
+		if macc := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName); macc != nil {
+			balance = macc.GetCoins()
+		}
+
+		broken := !balance.IsAllGTE(totalEscrow)
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"vote escrow",
+			fmt.Sprintf(
+				"\tmodule account balance %s is less than escrowed vote tokens %s\n",
+				balance, totalEscrow),
+		)
+		return invariantMessage, broken
+	}
+}
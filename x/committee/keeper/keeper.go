@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -8,30 +9,45 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/kava-labs/kava/x/committee/types"
 )
 
 type Keeper struct {
-	cdc      *codec.Codec
-	storeKey sdk.StoreKey
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	paramSubspace params.Subspace
 
 	ParamKeeper types.ParamKeeper // TODO ideally don't export, only sims need it exported
 
+	accountKeeper types.AccountKeeper
+	supplyKeeper  types.SupplyKeeper
+	stakingKeeper types.StakingKeeper
+
 	// Proposal router
 	router govtypes.Router
 }
 
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router govtypes.Router, paramKeeper types.ParamKeeper) Keeper {
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramstore params.Subspace, router govtypes.Router, paramKeeper types.ParamKeeper,
+	ak types.AccountKeeper, sk types.SupplyKeeper, stk types.StakingKeeper) Keeper {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
 	// Logic in the keeper methods assume the set of gov handlers is fixed.
 	// So the gov router must be sealed so no handlers can be added or removed after the keeper is created.
 	router.Seal()
 
 	return Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		ParamKeeper: paramKeeper,
-		router:      router,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramstore,
+		ParamKeeper:   paramKeeper,
+		accountKeeper: ak,
+		supplyKeeper:  sk,
+		stakingKeeper: stk,
+		router:        router,
 	}
 }
 
@@ -205,13 +221,21 @@ func (k Keeper) GetProposalsByCommittee(ctx sdk.Context, committeeID uint64) []t
 	return results
 }
 
-// DeleteProposalAndVotes removes a proposal and its associated votes.
+// DeleteProposalAndVotes removes a proposal and its associated votes, returning any tokens escrowed by voters.
 func (k Keeper) DeleteProposalAndVotes(ctx sdk.Context, proposalID uint64) {
 	votes := k.GetVotesByProposal(ctx, proposalID)
 	k.DeleteProposal(ctx, proposalID)
 	for _, v := range votes {
 		k.DeleteVote(ctx, v.ProposalID, v.Voter)
 	}
+	for _, vps := range k.GetVotingPowerSnapshotsByProposal(ctx, proposalID) {
+		if !vps.Escrow.Empty() {
+			if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, vps.Voter, vps.Escrow); err != nil {
+				panic(fmt.Sprintf("failed to return escrowed vote tokens: %s", err))
+			}
+		}
+		k.DeleteVotingPowerSnapshot(ctx, vps.ProposalID, vps.Voter)
+	}
 }
 
 // ------------------------------------------
@@ -283,3 +307,73 @@ func (k Keeper) GetVotesByProposal(ctx sdk.Context, proposalID uint64) []types.V
 
 	return results
 }
+
+// ------------------------------------------
+//				Voting Power Snapshots
+// ------------------------------------------
+
+// GetVotingPowerSnapshot gets a voting power snapshot from the store.
+func (k Keeper) GetVotingPowerSnapshot(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (types.VotingPowerSnapshot, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingPowerSnapshotKeyPrefix)
+	bz := store.Get(types.GetVoteKey(proposalID, voter))
+	if bz == nil {
+		return types.VotingPowerSnapshot{}, false
+	}
+	var snapshot types.VotingPowerSnapshot
+	k.cdc.MustUnmarshalBinaryBare(bz, &snapshot)
+	return snapshot, true
+}
+
+// SetVotingPowerSnapshot puts a voting power snapshot into the store.
+func (k Keeper) SetVotingPowerSnapshot(ctx sdk.Context, snapshot types.VotingPowerSnapshot) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingPowerSnapshotKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(snapshot)
+	store.Set(types.GetVoteKey(snapshot.ProposalID, snapshot.Voter), bz)
+}
+
+// DeleteVotingPowerSnapshot removes a voting power snapshot from the store.
+func (k Keeper) DeleteVotingPowerSnapshot(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingPowerSnapshotKeyPrefix)
+	store.Delete(types.GetVoteKey(proposalID, voter))
+}
+
+// IterateVotingPowerSnapshots provides an iterator over all stored voting power snapshots.
+// For each snapshot, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateVotingPowerSnapshots(ctx sdk.Context, cb func(snapshot types.VotingPowerSnapshot) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VotingPowerSnapshotKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.VotingPowerSnapshot
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &snapshot)
+
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetVotingPowerSnapshots returns all stored voting power snapshots.
+func (k Keeper) GetVotingPowerSnapshots(ctx sdk.Context) []types.VotingPowerSnapshot {
+	results := []types.VotingPowerSnapshot{}
+	k.IterateVotingPowerSnapshots(ctx, func(snapshot types.VotingPowerSnapshot) bool {
+		results = append(results, snapshot)
+		return false
+	})
+	return results
+}
+
+// GetVotingPowerSnapshotsByProposal returns all voting power snapshots for one proposal.
+func (k Keeper) GetVotingPowerSnapshotsByProposal(ctx sdk.Context, proposalID uint64) []types.VotingPowerSnapshot {
+	results := []types.VotingPowerSnapshot{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), append(types.VotingPowerSnapshotKeyPrefix, types.GetKeyFromID(proposalID)...))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.VotingPowerSnapshot
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &snapshot)
+		results = append(results, snapshot)
+	}
+
+	return results
+}
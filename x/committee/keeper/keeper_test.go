@@ -42,6 +42,7 @@ func (suite *KeeperTestSuite) TestGetSetDeleteCommittee() {
 		Permissions:      []types.Permission{types.GodPermission{}},
 		VoteThreshold:    d("0.667"),
 		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
 	}
 
 	// write and read from store
@@ -90,6 +91,7 @@ func (suite *KeeperTestSuite) TestGetSetDeleteVote() {
 	vote := types.Vote{
 		ProposalID: 12,
 		Voter:      suite.addresses[0],
+		VoteType:   types.Yes,
 	}
 
 	// write and read from store
@@ -108,6 +110,80 @@ func (suite *KeeperTestSuite) TestGetSetDeleteVote() {
 	suite.False(found)
 }
 
+func (suite *KeeperTestSuite) TestGetSetDeleteArchivedProposal() {
+	// test setup
+	closeTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	archivedProposal := types.NewArchivedProposal(
+		types.Proposal{
+			ID:          12,
+			CommitteeID: 1,
+			PubProposal: gov.NewTextProposal("A Title", "A description of this proposal."),
+			Deadline:    closeTime.Add(time.Hour),
+		},
+		types.NewProposalTally(12, d("1"), d("0"), d("0"), d("3"), d("0.5"), d("0")),
+		types.AttributeValueProposalRejected,
+		"",
+		[]types.Vote{{ProposalID: 12, Voter: suite.addresses[0], VoteType: types.No}},
+		10,
+		closeTime,
+	)
+
+	// write and read from store
+	suite.keeper.SetArchivedProposal(suite.ctx, archivedProposal)
+	readArchivedProposal, found := suite.keeper.GetArchivedProposal(suite.ctx, archivedProposal.ID)
+
+	// check before and after match
+	suite.True(found)
+	suite.Equal(archivedProposal, readArchivedProposal)
+
+	// delete from store
+	suite.keeper.DeleteArchivedProposal(suite.ctx, archivedProposal.ID)
+
+	// check does not exist, including in the by time index
+	_, found = suite.keeper.GetArchivedProposal(suite.ctx, archivedProposal.ID)
+	suite.False(found)
+	suite.keeper.IterateArchivedProposalsByTime(suite.ctx, closeTime, func(uint64) bool {
+		suite.Fail("expected by time index to be empty")
+		return false
+	})
+}
+
+func (suite *KeeperTestSuite) TestPruneArchivedProposals() {
+	suite.app.InitializeFromGenesisStates()
+	suite.ctx = suite.app.NewContext(true, abci.Header{})
+
+	firstCloseTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for i, closeTime := range []time.Time{firstCloseTime, firstCloseTime.Add(24 * time.Hour), firstCloseTime.Add(48 * time.Hour)} {
+		id := uint64(i + 1)
+		suite.keeper.SetArchivedProposal(suite.ctx, types.NewArchivedProposal(
+			types.NewProposal(gov.NewTextProposal("A Title", "A description of this proposal."), id, 1, closeTime),
+			types.ProposalTally{ProposalID: id},
+			types.AttributeValueProposalTimeout,
+			"",
+			nil,
+			1,
+			closeTime,
+		))
+	}
+
+	// with the default zero retention nothing is pruned
+	ctx := suite.ctx.WithBlockTime(firstCloseTime.Add(365 * 24 * time.Hour))
+	suite.keeper.PruneArchivedProposals(ctx)
+	suite.Len(suite.keeper.GetArchivedProposals(ctx), 3)
+
+	// proposals closed at or before the retention cutoff are pruned
+	suite.keeper.SetParams(ctx, types.NewParams(24*time.Hour))
+	ctx = suite.ctx.WithBlockTime(firstCloseTime.Add(48 * time.Hour))
+	suite.keeper.PruneArchivedProposals(ctx)
+
+	_, found := suite.keeper.GetArchivedProposal(ctx, 1)
+	suite.False(found)
+	_, found = suite.keeper.GetArchivedProposal(ctx, 2)
+	suite.False(found)
+	_, found = suite.keeper.GetArchivedProposal(ctx, 3)
+	suite.True(found)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
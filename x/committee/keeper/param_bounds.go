@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+var _ types.ParamKeeper = Keeper{}
+var _ types.ParamChangeHistory = Keeper{}
+
+// GetSubspace implements types.ParamKeeper so the keeper can be passed to permissions that check param change history.
+func (k Keeper) GetSubspace(subspace string) (params.Subspace, bool) {
+	return k.ParamKeeper.GetSubspace(subspace)
+}
+
+// GetParamChangeTime returns when a bounded param field was last changed by a committee.
+func (k Keeper) GetParamChangeTime(ctx sdk.Context, subspace, key, id, field string) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamChangeTimeKeyPrefix)
+	bz := store.Get(types.GetParamChangeTimeKey(subspace, key, id, field))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var pct types.ParamChangeTime
+	k.cdc.MustUnmarshalBinaryBare(bz, &pct)
+	return pct.Time, true
+}
+
+// SetParamChangeTime stores when a bounded param field was last changed.
+func (k Keeper) SetParamChangeTime(ctx sdk.Context, pct types.ParamChangeTime) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamChangeTimeKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(pct)
+	store.Set(types.GetParamChangeTimeKey(pct.Subspace, pct.Key, pct.ID, pct.Field), bz)
+}
+
+// IterateParamChangeTimes provides an iterator over all stored param change times.
+// For each param change time, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateParamChangeTimes(ctx sdk.Context, cb func(pct types.ParamChangeTime) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ParamChangeTimeKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pct types.ParamChangeTime
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pct)
+
+		if cb(pct) {
+			break
+		}
+	}
+}
+
+// GetParamChangeTimes returns all stored param change times.
+func (k Keeper) GetParamChangeTimes(ctx sdk.Context) []types.ParamChangeTime {
+	results := []types.ParamChangeTime{}
+	k.IterateParamChangeTimes(ctx, func(pct types.ParamChangeTime) bool {
+		results = append(results, pct)
+		return false
+	})
+	return results
+}
+
+// getChangedParamBounds returns the fields bounded by any committee's permissions that a proposal changes.
+func (k Keeper) getChangedParamBounds(ctx sdk.Context, pubProposal types.PubProposal) types.ParamBounds {
+	bounds := types.ParamBounds{}
+	k.IterateCommittees(ctx, func(com types.Committee) bool {
+		for _, perm := range com.Permissions {
+			if bp, ok := perm.(types.BoundedParamChangePermission); ok {
+				bounds = append(bounds, bp.ChangedParamBounds(ctx, k, pubProposal)...)
+			}
+		}
+		return false
+	})
+	return bounds
+}
+
+// recordParamChanges stores the current block time as the last change time of the bounded fields.
+func (k Keeper) recordParamChanges(ctx sdk.Context, bounds types.ParamBounds) {
+	for _, pb := range bounds {
+		k.SetParamChangeTime(ctx, types.NewParamChangeTime(pb.Subspace, pb.Key, pb.ID, pb.Field, ctx.BlockTime()))
+	}
+}
@@ -15,6 +15,7 @@ import (
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/committee/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
@@ -262,6 +263,163 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 	}
 
 }
+func (suite *PermissionTestSuite) TestBoundedParamChangePermission_Allows() {
+	testCPs := cdptypes.CollateralParams{
+		{
+			Denom:               "bnb",
+			Type:                "bnb-a",
+			LiquidationRatio:    d("2.0"),
+			DebtLimit:           c("usdx", 1000000000000),
+			StabilityFee:        d("1.000000001547125958"),
+			LiquidationPenalty:  d("0.05"),
+			AuctionSize:         i(100),
+			Prefix:              0x20,
+			ConversionFactor:    i(6),
+			SpotMarketID:        "bnb:usd",
+			LiquidationMarketID: "bnb:usd",
+		},
+	}
+	testCDPParams := cdptypes.DefaultParams()
+	testCDPParams.CollateralParams = testCPs
+	testCDPParams.GlobalDebtLimit = testCPs[0].DebtLimit
+
+	testMM := hardtypes.NewMoneyMarket(
+		"bnb", hardtypes.NewBorrowLimit(true, d("1000"), d("0.5")), "bnb:usd", i(100000000),
+		hardtypes.NewInterestRateModel(d("0.05"), d("2"), d("0.8"), d("10")), d("0.05"), d("0.05"),
+		hardtypes.EnglishAuctionType,
+	)
+	testHardGenState := hardtypes.DefaultGenesisState()
+	testHardGenState.Params = hardtypes.NewParams(hardtypes.MoneyMarkets{testMM}, d("10"))
+
+	withStabilityFee := func(fee sdk.Dec) cdptypes.CollateralParams {
+		cps := make(cdptypes.CollateralParams, len(testCPs))
+		copy(cps, testCPs)
+		cps[0].StabilityFee = fee
+		return cps
+	}
+	cpChange := func(cps cdptypes.CollateralParams) paramstypes.ParamChange {
+		return paramstypes.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), string(suite.cdc.MustMarshalJSON(cps)))
+	}
+	stabilityFeeBound := types.NewParamBound(
+		cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), "bnb-a", "stability_fee",
+		d("0.000000001"), sdk.ZeroDec(), d("1"), d("1.000000003"), time.Hour*24,
+	)
+	borrowLimitBound := types.NewParamBound(
+		hardtypes.ModuleName, string(hardtypes.KeyMoneyMarkets), "bnb", "borrow_limit.maximum_limit",
+		sdk.ZeroDec(), d("0.1"), sdk.ZeroDec(), sdk.ZeroDec(), 0,
+	)
+	permission := types.NewBoundedParamChangePermission(types.ParamBounds{stabilityFeeBound, borrowLimitBound})
+
+	updatedMM := testMM
+	updatedMM.BorrowLimit.MaximumLimit = d("1100")
+	tooLargeMM := testMM
+	tooLargeMM.BorrowLimit.MaximumLimit = d("1100.1")
+	unboundedMM := testMM
+	unboundedMM.ReserveFactor = d("0.1")
+
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name           string
+		changes        []paramstypes.ParamChange
+		lastChangeTime time.Time
+		expectAllowed  bool
+	}{
+		{
+			name:          "change within bounds",
+			changes:       []paramstypes.ParamChange{cpChange(withStabilityFee(d("1.000000002")))},
+			expectAllowed: true,
+		},
+		{
+			name:          "change larger than max absolute change",
+			changes:       []paramstypes.ParamChange{cpChange(withStabilityFee(d("1.000000002547125959")))},
+			expectAllowed: false,
+		},
+		{
+			name:          "change below floor",
+			changes:       []paramstypes.ParamChange{cpChange(withStabilityFee(d("0.9999999999")))},
+			expectAllowed: false,
+		},
+		{
+			name: "change to field without bound",
+			changes: func() []paramstypes.ParamChange {
+				cps := withStabilityFee(d("1.000000002"))
+				cps[0].LiquidationRatio = d("1.5")
+				return []paramstypes.ParamChange{cpChange(cps)}
+			}(),
+			expectAllowed: false,
+		},
+		{
+			name:          "change to param without bound",
+			changes:       []paramstypes.ParamChange{paramstypes.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyDebtThreshold), string(suite.cdc.MustMarshalJSON(i(1234))))},
+			expectAllowed: false,
+		},
+		{
+			name:           "change during cooldown",
+			changes:        []paramstypes.ParamChange{cpChange(withStabilityFee(d("1.000000002")))},
+			lastChangeTime: firstBlockTime.Add(-time.Hour),
+			expectAllowed:  false,
+		},
+		{
+			name:           "change after cooldown",
+			changes:        []paramstypes.ParamChange{cpChange(withStabilityFee(d("1.000000002")))},
+			lastChangeTime: firstBlockTime.Add(-time.Hour * 24),
+			expectAllowed:  true,
+		},
+		{
+			name:          "change within relative bound",
+			changes:       []paramstypes.ParamChange{paramstypes.NewParamChange(hardtypes.ModuleName, string(hardtypes.KeyMoneyMarkets), string(suite.cdc.MustMarshalJSON(hardtypes.MoneyMarkets{updatedMM})))},
+			expectAllowed: true,
+		},
+		{
+			name:          "change larger than relative bound",
+			changes:       []paramstypes.ParamChange{paramstypes.NewParamChange(hardtypes.ModuleName, string(hardtypes.KeyMoneyMarkets), string(suite.cdc.MustMarshalJSON(hardtypes.MoneyMarkets{tooLargeMM})))},
+			expectAllowed: false,
+		},
+		{
+			name:          "change to money market field without bound",
+			changes:       []paramstypes.ParamChange{paramstypes.NewParamChange(hardtypes.ModuleName, string(hardtypes.KeyMoneyMarkets), string(suite.cdc.MustMarshalJSON(hardtypes.MoneyMarkets{unboundedMM})))},
+			expectAllowed: false,
+		},
+		{
+			name:          "invalid param value",
+			changes:       []paramstypes.ParamChange{paramstypes.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), "not json")},
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, abci.Header{Time: firstBlockTime})
+			tApp.InitializeFromGenesisStates(
+				newPricefeedGenState([]string{"bnb"}, []sdk.Dec{d("15.01")}),
+				newCDPGenesisState(testCDPParams),
+				app.GenesisState{hardtypes.ModuleName: tApp.Codec().MustMarshalJSON(testHardGenState)},
+			)
+			keeper := tApp.GetCommitteeKeeper()
+			if !tc.lastChangeTime.IsZero() {
+				keeper.SetParamChangeTime(ctx, types.NewParamChangeTime(
+					stabilityFeeBound.Subspace, stabilityFeeBound.Key, stabilityFeeBound.ID, stabilityFeeBound.Field, tc.lastChangeTime,
+				))
+			}
+			pubProposal := paramstypes.NewParameterChangeProposal("A Title", "A description for this proposal.", tc.changes)
+
+			suite.Equal(tc.expectAllowed, permission.Allows(ctx, tApp.Codec(), keeper, pubProposal))
+		})
+	}
+
+	// cooldowns cannot be checked without a param change history
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates(
+		newPricefeedGenState([]string{"bnb"}, []sdk.Dec{d("15.01")}),
+		newCDPGenesisState(testCDPParams),
+	)
+	pubProposal := paramstypes.NewParameterChangeProposal("A Title", "A description for this proposal.", []paramstypes.ParamChange{cpChange(withStabilityFee(d("1.000000002")))})
+	suite.False(permission.Allows(ctx, tApp.Codec(), tApp.GetParamsKeeper(), pubProposal))
+}
+
 func TestPermissionTestSuite(t *testing.T) {
 	suite.Run(t, new(PermissionTestSuite))
 }
@@ -21,7 +21,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 	}
 
 	// Check committee has permissions to enact proposal.
-	if !com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, pubProposal) {
+	if !com.HasPermissionsFor(ctx, k.cdc, k, pubProposal) {
 		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -48,7 +48,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 }
 
 // AddVote submits a vote on a proposal.
-func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) error {
+func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, voteType types.VoteType) error {
 	// Validate
 	pr, found := k.GetProposal(ctx, proposalID)
 	if !found {
@@ -62,12 +62,22 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 	if !found {
 		return sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
 	}
-	if !com.HasMember(voter) {
-		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "voter must be a member of committee")
+	if err := voteType.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidVoteType, err.Error())
+	}
+	if com.IsMemberCommittee() {
+		if !com.HasMember(voter) {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "voter must be a member of committee")
+		}
+	} else if _, found := k.GetVotingPowerSnapshot(ctx, proposalID, voter); !found {
+		// voting power is fixed when a voter first votes, later votes only change the vote type
+		if err := k.snapshotVotingPower(ctx, com, proposalID, voter); err != nil {
+			return err
+		}
 	}
 
 	// Store vote, overwriting any prior vote
-	k.SetVote(ctx, types.NewVote(proposalID, voter))
+	k.SetVote(ctx, types.NewVote(proposalID, voter, voteType))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -75,6 +85,7 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.ID)),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", pr.ID)),
 			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyVoteType, voteType.String()),
 		),
 	)
 	return nil
@@ -82,28 +93,50 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 
 // GetProposalResult calculates if a proposal currently has enough votes to pass.
 func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64) (bool, error) {
+	tally, err := k.TallyVotes(ctx, proposalID)
+	if err != nil {
+		return false, err
+	}
+	return tally.Passes(), nil
+}
+
+// TallyVotes sums the weights of the yes, no and abstain votes on a proposal
+func (k Keeper) TallyVotes(ctx sdk.Context, proposalID uint64) (types.ProposalTally, error) {
 	pr, found := k.GetProposal(ctx, proposalID)
 	if !found {
-		return false, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+		return types.ProposalTally{}, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
 	}
 	com, found := k.GetCommittee(ctx, pr.CommitteeID)
 	if !found {
-		return false, sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
+		return types.ProposalTally{}, sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
 	}
 
-	numVotes := k.TallyVotes(ctx, proposalID)
-
-	proposalResult := sdk.NewDec(numVotes).GTE(com.VoteThreshold.MulInt64(int64(len(com.Members))))
-
-	return proposalResult, nil
-}
-
-// TallyVotes counts all the votes on a proposal
-func (k Keeper) TallyVotes(ctx sdk.Context, proposalID uint64) int64 {
+	possibleVotes := com.TotalWeight()
+	if !com.IsMemberCommittee() {
+		possibleVotes = sdk.NewDecFromInt(k.GetTotalVotingPower(ctx, com))
+	}
 
-	votes := k.GetVotesByProposal(ctx, proposalID)
+	yesVotes, noVotes, abstainVotes := sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+	for _, vote := range k.GetVotesByProposal(ctx, proposalID) {
+		// members can be removed from a committee while proposals are active, their votes no longer count
+		weight := com.GetMemberWeight(vote.Voter)
+		if !com.IsMemberCommittee() {
+			weight = sdk.ZeroDec()
+			if vps, found := k.GetVotingPowerSnapshot(ctx, proposalID, vote.Voter); found {
+				weight = sdk.NewDecFromInt(vps.VotingPower)
+			}
+		}
+		switch vote.VoteType {
+		case types.Yes:
+			yesVotes = yesVotes.Add(weight)
+		case types.No:
+			noVotes = noVotes.Add(weight)
+		case types.Abstain:
+			abstainVotes = abstainVotes.Add(weight)
+		}
+	}
 
-	return int64(len(votes))
+	return types.NewProposalTally(proposalID, yesVotes, noVotes, abstainVotes, possibleVotes, com.VoteThreshold, com.GetQuorum()), nil
 }
 
 // EnactProposal makes the changes proposed in a proposal.
@@ -114,7 +147,7 @@ func (k Keeper) EnactProposal(ctx sdk.Context, proposal types.Proposal) error {
 	if !found {
 		return sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", proposal.CommitteeID)
 	}
-	if !com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, proposal.PubProposal) {
+	if !com.HasPermissionsFor(ctx, k.cdc, k, proposal.PubProposal) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -122,34 +155,83 @@ func (k Keeper) EnactProposal(ctx sdk.Context, proposal types.Proposal) error {
 		return err
 	}
 
-	// enact the proposal
-	handler := k.router.GetRoute(proposal.ProposalRoute())
-	if err := handler(ctx, proposal.PubProposal); err != nil {
+	// enact the proposal in a cached context so that either all of a batch proposal's contents are enacted or none are
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.enactPubProposal(cacheCtx, proposal.PubProposal); err != nil {
+		return err
+	}
+	writeCache()
+	return nil
+}
+
+// enactPubProposal makes the changes of a pubproposal, enacting the contents of batch proposals in order.
+func (k Keeper) enactPubProposal(ctx sdk.Context, pubProposal types.PubProposal) error {
+	switch p := pubProposal.(type) {
+	case types.VetoProposal:
+		// vetoes are handled by the keeper as the committee proposal handler is not registered on the committee router
+		return k.VetoQueuedProposal(ctx, p.ProposalID)
+	case types.BatchProposal:
+		for _, content := range p.Contents {
+			if err := k.enactPubProposal(ctx, content); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// find bounded param fields the proposal changes before the current values are overwritten
+	changedBounds := k.getChangedParamBounds(ctx, pubProposal)
+
+	handler := k.router.GetRoute(pubProposal.ProposalRoute())
+	if err := handler(ctx, pubProposal); err != nil {
 		// the handler should not error as it was checked in ValidatePubProposal
 		panic(fmt.Sprintf("unexpected handler error: %s", err))
 	}
+
+	k.recordParamChanges(ctx, changedBounds)
 	return nil
 }
 
-// EnactPassedProposals puts in place the changes proposed in any proposal that has enough votes
+// EnactPassedProposals puts in place the changes proposed in any proposal that has enough votes.
+// Proposals from committees with an enactment delay are queued instead, to be enacted once the delay has passed.
+// Vetoes are never delayed, as a delayed veto could only take effect after the proposal it vetoes had been enacted.
+// When a delayed batch proposal contains vetoes they are enacted straight away and the rest of the batch is queued.
 func (k Keeper) EnactPassedProposals(ctx sdk.Context) {
 	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
-		passes, err := k.GetProposalResult(ctx, proposal.ID)
+		tally, err := k.TallyVotes(ctx, proposal.ID)
 		if err != nil {
 			panic(err)
 		}
 
-		if !passes {
+		if !tally.Passes() {
 			// continue to next proposal
 			return false
 		}
 
-		err = k.EnactProposal(ctx, proposal)
-		outcome := types.AttributeValueProposalPassed
-		if err != nil {
+		var outcome string
+		vetoes, delayable := splitVetoes(proposal.PubProposal)
+		if com, found := k.GetCommittee(ctx, proposal.CommitteeID); found && com.EnactmentDelay > 0 && delayable != nil {
+			if vetoes != nil {
+				vetoProposal := proposal
+				vetoProposal.PubProposal = vetoes
+				err = k.EnactProposal(ctx, vetoProposal)
+			}
 			outcome = types.AttributeValueProposalFailed
+			if err == nil {
+				queuedProposal := proposal
+				queuedProposal.PubProposal = delayable
+				k.QueueProposal(ctx, queuedProposal, com.EnactmentDelay)
+				outcome = types.AttributeValueProposalQueued
+			}
+		} else {
+			err = k.EnactProposal(ctx, proposal)
+			outcome = types.AttributeValueProposalPassed
+			if err != nil {
+				outcome = types.AttributeValueProposalFailed
+			}
 		}
 
+		k.ArchiveProposal(ctx, proposal, tally, outcome, err)
 		k.DeleteProposalAndVotes(ctx, proposal.ID)
 
 		ctx.EventManager().EmitEvent(
@@ -164,13 +246,71 @@ func (k Keeper) EnactPassedProposals(ctx sdk.Context) {
 	})
 }
 
-// CloseExpiredProposals removes proposals (and associated votes) that have past their deadline.
+// splitVetoes separates the vetoes of a pubproposal from the changes that are subject to enactment delays.
+// The contents of batch proposals are split into two batch proposals, either result is nil if it has no contents.
+func splitVetoes(pubProposal types.PubProposal) (vetoes, delayable types.PubProposal) {
+	switch p := pubProposal.(type) {
+	case types.VetoProposal:
+		return p, nil
+	case types.BatchProposal:
+		var vetoContents, delayableContents []types.PubProposal
+		for _, content := range p.Contents {
+			if _, ok := content.(types.VetoProposal); ok {
+				vetoContents = append(vetoContents, content)
+			} else {
+				delayableContents = append(delayableContents, content)
+			}
+		}
+		if len(vetoContents) > 0 {
+			vetoes = types.NewBatchProposal(p.Title, p.Description, vetoContents)
+		}
+		if len(delayableContents) > 0 {
+			delayable = types.NewBatchProposal(p.Title, p.Description, delayableContents)
+		}
+		return vetoes, delayable
+	}
+	return nil, pubProposal
+}
+
+// CloseRejectedProposals archives and removes proposals (and associated votes) that can no longer receive enough yes votes to pass.
+func (k Keeper) CloseRejectedProposals(ctx sdk.Context) {
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		tally, err := k.TallyVotes(ctx, proposal.ID)
+		if err != nil {
+			panic(err)
+		}
+		if !tally.CannotPass() {
+			return false
+		}
+
+		k.ArchiveProposal(ctx, proposal, tally, types.AttributeValueProposalRejected, nil)
+		k.DeleteProposalAndVotes(ctx, proposal.ID)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalClose,
+				sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+				sdk.NewAttribute(types.AttributeKeyProposalCloseStatus, types.AttributeValueProposalRejected),
+			),
+		)
+		return false
+	})
+}
+
+// CloseExpiredProposals archives and removes proposals (and associated votes) that have past their deadline.
 func (k Keeper) CloseExpiredProposals(ctx sdk.Context) {
 	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
 		if !proposal.HasExpiredBy(ctx.BlockTime()) {
 			return false
 		}
 
+		tally, err := k.TallyVotes(ctx, proposal.ID)
+		if err != nil {
+			panic(err)
+		}
+
+		k.ArchiveProposal(ctx, proposal, tally, types.AttributeValueProposalTimeout, nil)
 		k.DeleteProposalAndVotes(ctx, proposal.ID)
 
 		ctx.EventManager().EmitEvent(
@@ -186,7 +326,7 @@ func (k Keeper) CloseExpiredProposals(ctx sdk.Context) {
 }
 
 // ValidatePubProposal checks if a pubproposal is valid.
-func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubProposal) (returnErr error) {
+func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubProposal) error {
 	if pubProposal == nil {
 		return sdkerrors.Wrap(types.ErrInvalidPubProposal, "pub proposal cannot be nil")
 	}
@@ -194,12 +334,33 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		return err
 	}
 
+	// Run the proposal's changes through the associated handler using a cached version of state to ensure changes are not permanent.
+	cacheCtx, _ := ctx.CacheContext()
+	return k.validatePubProposalChanges(cacheCtx, pubProposal)
+}
+
+// validatePubProposalChanges runs the changes of a pubproposal through its handler.
+// The contents of batch proposals are run in order so that later contents are validated against the changes of earlier ones.
+func (k Keeper) validatePubProposalChanges(ctx sdk.Context, pubProposal types.PubProposal) (returnErr error) {
+	switch p := pubProposal.(type) {
+	case types.VetoProposal:
+		if _, found := k.GetQueuedProposal(ctx, p.ProposalID); !found {
+			return sdkerrors.Wrapf(types.ErrUnknownQueuedProposal, "%d", p.ProposalID)
+		}
+		k.DeleteQueuedProposal(ctx, p.ProposalID)
+		return nil
+	case types.BatchProposal:
+		for i, content := range p.Contents {
+			if err := k.validatePubProposalChanges(ctx, content); err != nil {
+				return sdkerrors.Wrapf(err, "batch proposal content %d", i)
+			}
+		}
+		return nil
+	}
+
 	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
 		return sdkerrors.Wrapf(types.ErrNoProposalHandlerExists, "%T", pubProposal)
 	}
-
-	// Run the proposal's changes through the associated handler using a cached version of state to ensure changes are not permanent.
-	cacheCtx, _ := ctx.CacheContext()
 	handler := k.router.GetRoute(pubProposal.ProposalRoute())
 
 	// Handle an edge case where a param change proposal causes the proposal handler to panic.
@@ -212,7 +373,7 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		}
 	}()
 
-	if err := handler(cacheCtx, pubProposal); err != nil {
+	if err := handler(ctx, pubProposal); err != nil {
 		return err
 	}
 	return nil
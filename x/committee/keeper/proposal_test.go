@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"reflect"
 	"time"
 
@@ -16,6 +17,8 @@ import (
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/committee"
 	"github.com/kava-labs/kava/x/committee/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	issuancetypes "github.com/kava-labs/kava/x/issuance/types"
 	"github.com/kava-labs/kava/x/pricefeed"
 )
 
@@ -63,6 +66,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 		Permissions:      []types.Permission{types.GodPermission{}},
 		VoteThreshold:    d("0.667"),
 		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
 	}
 
 	noPermissionsCom := normalCom
@@ -297,7 +301,7 @@ func (suite *KeeperTestSuite) TestAddVote() {
 			suite.NoError(err)
 
 			ctx = ctx.WithBlockTime(tc.voteTime)
-			err = keeper.AddVote(ctx, tc.proposalID, tc.voter)
+			err = keeper.AddVote(ctx, tc.proposalID, tc.voter, types.Yes)
 
 			if tc.expectErr {
 				suite.NotNil(err)
@@ -318,7 +322,13 @@ func (suite *KeeperTestSuite) TestGetProposalResult() {
 		Permissions:      []types.Permission{types.GodPermission{}},
 		VoteThreshold:    d("0.667"),
 		ProposalDuration: time.Hour * 24 * 7,
+		Quorum:           d("0"),
 	}
+	weightedCom := normalCom
+	weightedCom.MemberWeights = []types.MemberWeight{types.NewMemberWeight(suite.addresses[0], d("10"))}
+	quorumCom := normalCom
+	quorumCom.VoteThreshold = d("0.2")
+	quorumCom.Quorum = d("0.5")
 	var defaultID uint64 = 1
 	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
 
@@ -333,10 +343,10 @@ func (suite *KeeperTestSuite) TestGetProposalResult() {
 			name:      "enough votes",
 			committee: normalCom,
 			votes: []types.Vote{
-				{ProposalID: defaultID, Voter: suite.addresses[0]},
-				{ProposalID: defaultID, Voter: suite.addresses[1]},
-				{ProposalID: defaultID, Voter: suite.addresses[2]},
-				{ProposalID: defaultID, Voter: suite.addresses[3]},
+				{ProposalID: defaultID, Voter: suite.addresses[0], VoteType: types.Yes},
+				{ProposalID: defaultID, Voter: suite.addresses[1], VoteType: types.Yes},
+				{ProposalID: defaultID, Voter: suite.addresses[2], VoteType: types.Yes},
+				{ProposalID: defaultID, Voter: suite.addresses[3], VoteType: types.Yes},
 			},
 			proposalPasses: true,
 			expectErr:      false,
@@ -345,11 +355,71 @@ func (suite *KeeperTestSuite) TestGetProposalResult() {
 			name:      "not enough votes",
 			committee: normalCom,
 			votes: []types.Vote{
-				{ProposalID: defaultID, Voter: suite.addresses[0]},
+				{ProposalID: defaultID, Voter: suite.addresses[0], VoteType: types.Yes},
 			},
 			proposalPasses: false,
 			expectErr:      false,
 		},
+		{
+			name:      "no and abstain votes do not count towards threshold",
+			committee: normalCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.addresses[0], VoteType: types.Yes},
+				{ProposalID: defaultID, Voter: suite.addresses[1], VoteType: types.Yes},
+				{ProposalID: defaultID, Voter: suite.addresses[2], VoteType: types.No},
+				{ProposalID: defaultID, Voter: suite.addresses[3], VoteType: types.Abstain},
+			},
+			proposalPasses: false,
+			expectErr:      false,
+		},
+		{
+			name:      "weighted member passes proposal alone",
+			committee: weightedCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.addresses[0], VoteType: types.Yes},
+			},
+			proposalPasses: true,
+			expectErr:      false,
+		},
+		{
+			name:      "unweighted members cannot outvote weighted member",
+			committee: weightedCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.addresses[1], VoteType: types.Yes},
+				{ProposalID: defaultID, Voter: suite.addresses[2], VoteType: types.Yes},
+				{ProposalID: defaultID, Voter: suite.addresses[3], VoteType: types.Yes},
+				{ProposalID: defaultID, Voter: suite.addresses[4], VoteType: types.Yes},
+			},
+			proposalPasses: false,
+			expectErr:      false,
+		},
+		{
+			name:      "quorum not reached",
+			committee: quorumCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.addresses[0], VoteType: types.Yes},
+			},
+			proposalPasses: false,
+			expectErr:      false,
+		},
+		{
+			name:      "quorum reached with abstain votes",
+			committee: quorumCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.addresses[0], VoteType: types.Yes},
+				{ProposalID: defaultID, Voter: suite.addresses[1], VoteType: types.Abstain},
+				{ProposalID: defaultID, Voter: suite.addresses[2], VoteType: types.Abstain},
+			},
+			proposalPasses: true,
+			expectErr:      false,
+		},
+		{
+			name:           "proposal not found",
+			committee:      normalCom,
+			votes:          []types.Vote{},
+			proposalPasses: false,
+			expectErr:      true,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -373,7 +443,11 @@ func (suite *KeeperTestSuite) TestGetProposalResult() {
 				),
 			)
 
-			proposalPasses, err := keeper.GetProposalResult(ctx, defaultID)
+			proposalID := defaultID
+			if tc.expectErr {
+				proposalID = defaultID + 1
+			}
+			proposalPasses, err := keeper.GetProposalResult(ctx, proposalID)
 
 			if tc.expectErr {
 				suite.NotNil(err)
@@ -391,6 +465,11 @@ func committeeGenState(cdc *codec.Codec, committees []types.Committee, proposals
 		committees,
 		proposals,
 		votes,
+		[]types.VotingPowerSnapshot{},
+		[]types.ParamChangeTime{},
+		[]types.QueuedProposal{},
+		types.DefaultParams(),
+		[]types.ArchivedProposal{},
 	)
 	return app.GenesisState{committee.ModuleName: cdc.MustMarshalJSON(gs)}
 }
@@ -483,6 +562,66 @@ func (suite *KeeperTestSuite) TestValidatePubProposal() {
 			),
 			expectErr: true,
 		},
+		{
+			name: "valid (batch proposal)",
+			pubProposal: types.NewBatchProposal(
+				"A Title",
+				"A description of this proposal.",
+				[]types.PubProposal{
+					gov.NewTextProposal("A Title", "A description of this proposal."),
+					params.NewParameterChangeProposal(
+						"Change the debt limit",
+						"This proposal changes the debt limit of the cdp module.",
+						[]params.ParamChange{{
+							Subspace: cdptypes.ModuleName,
+							Key:      string(cdptypes.KeyGlobalDebtLimit),
+							Value:    string(types.ModuleCdc.MustMarshalJSON(c("usdx", 100000000000))),
+						}},
+					),
+				},
+			),
+			expectErr: false,
+		},
+		{
+			name: "invalid (batch proposal with failing content)",
+			pubProposal: types.NewBatchProposal(
+				"A Title",
+				"A description of this proposal.",
+				[]types.PubProposal{
+					gov.NewTextProposal("A Title", "A description of this proposal."),
+					params.NewParameterChangeProposal(
+						"A Title",
+						"A description of this proposal.",
+						[]params.ParamChange{{
+							Subspace: cdptypes.ModuleName,
+							Key:      "nonsense-key",
+							Value:    "nonsense-value",
+						}},
+					),
+				},
+			),
+			expectErr: true,
+		},
+		{
+			name:        "invalid (money market pause for unknown market)",
+			pubProposal: hardtypes.NewMoneyMarketPauseProposal("A Title", "A description of this proposal.", "fake", true),
+			expectErr:   true,
+		},
+		{
+			name:        "invalid (seize coins for unknown asset)",
+			pubProposal: issuancetypes.NewSeizeCoinsProposal("A Title", "A description of this proposal.", "fake"),
+			expectErr:   true,
+		},
+		{
+			name:        "invalid (liquidation sweep for unsupported collateral type)",
+			pubProposal: cdptypes.NewLiquidationSweepProposal("A Title", "A description of this proposal.", "fake-a"),
+			expectErr:   true,
+		},
+		{
+			name:        "invalid (empty batch proposal)",
+			pubProposal: types.NewBatchProposal("A Title", "A description of this proposal.", nil),
+			expectErr:   true,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -511,6 +650,7 @@ func (suite *KeeperTestSuite) TestCloseExpiredProposals() {
 				Permissions:      []types.Permission{types.GodPermission{}},
 				VoteThreshold:    d("0.667"),
 				ProposalDuration: time.Hour * 24 * 7,
+				Quorum:           d("0"),
 			},
 			{
 				ID:               2,
@@ -518,6 +658,7 @@ func (suite *KeeperTestSuite) TestCloseExpiredProposals() {
 				Permissions:      nil,
 				VoteThreshold:    d("0.667"),
 				ProposalDuration: time.Hour * 24 * 7,
+				Quorum:           d("0"),
 			},
 		},
 		[]types.Proposal{
@@ -535,10 +676,15 @@ func (suite *KeeperTestSuite) TestCloseExpiredProposals() {
 			},
 		},
 		[]types.Vote{
-			{ProposalID: 1, Voter: suite.addresses[0]},
-			{ProposalID: 1, Voter: suite.addresses[1]},
-			{ProposalID: 2, Voter: suite.addresses[2]},
+			{ProposalID: 1, Voter: suite.addresses[0], VoteType: types.Yes},
+			{ProposalID: 1, Voter: suite.addresses[1], VoteType: types.Yes},
+			{ProposalID: 2, Voter: suite.addresses[2], VoteType: types.Yes},
 		},
+		[]types.VotingPowerSnapshot{},
+		[]types.ParamChangeTime{},
+		[]types.QueuedProposal{},
+		types.DefaultParams(),
+		[]types.ArchivedProposal{},
 	)
 	suite.app.InitializeFromGenesisStates(
 		NewCommitteeGenesisState(suite.app.Codec(), testGenesis),
@@ -574,9 +720,348 @@ func (suite *KeeperTestSuite) TestCloseExpiredProposals() {
 		if ctx.BlockTime().Equal(p.Deadline) || ctx.BlockTime().After(p.Deadline) {
 			suite.False(found)
 			suite.Empty(votes[p.ID])
+			archivedProposal, found := suite.keeper.GetArchivedProposal(ctx, p.ID)
+			suite.True(found)
+			suite.Equal(types.AttributeValueProposalTimeout, archivedProposal.Outcome)
+			suite.Equal(p, archivedProposal.Proposal)
 		} else {
 			suite.True(found)
 			suite.NotEmpty(votes[p.ID])
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestCloseRejectedProposals() {
+
+	// Setup test state
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	testGenesis := types.NewGenesisState(
+		4,
+		[]types.Committee{
+			{
+				ID:               1,
+				Description:      "This committee is for testing.",
+				Members:          suite.addresses[:3],
+				Permissions:      []types.Permission{types.GodPermission{}},
+				VoteThreshold:    d("0.667"),
+				ProposalDuration: time.Hour * 24 * 7,
+				Quorum:           d("0"),
+			},
+		},
+		[]types.Proposal{
+			{
+				ID:          1,
+				CommitteeID: 1,
+				PubProposal: gov.NewTextProposal("A Title", "A description of this proposal."),
+				Deadline:    firstBlockTime.Add(7 * 24 * time.Hour),
+			},
+			{
+				ID:          2,
+				CommitteeID: 1,
+				PubProposal: gov.NewTextProposal("Another Title", "A description of this other proposal."),
+				Deadline:    firstBlockTime.Add(7 * 24 * time.Hour),
+			},
+			{
+				ID:          3,
+				CommitteeID: 1,
+				PubProposal: gov.NewTextProposal("A Third Title", "A description of this third proposal."),
+				Deadline:    firstBlockTime.Add(7 * 24 * time.Hour),
+			},
+		},
+		[]types.Vote{
+			// can still pass if the other members vote yes
+			{ProposalID: 1, Voter: suite.addresses[0], VoteType: types.Yes},
+			// cannot pass
+			{ProposalID: 2, Voter: suite.addresses[0], VoteType: types.No},
+			{ProposalID: 2, Voter: suite.addresses[1], VoteType: types.Abstain},
+		},
+		[]types.VotingPowerSnapshot{},
+		[]types.ParamChangeTime{},
+		[]types.QueuedProposal{},
+		types.DefaultParams(),
+		[]types.ArchivedProposal{},
+	)
+	suite.app.InitializeFromGenesisStates(
+		NewCommitteeGenesisState(suite.app.Codec(), testGenesis),
+	)
+
+	// close proposals
+	ctx := suite.app.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+	suite.keeper.CloseRejectedProposals(ctx)
+
+	// check
+	votes := getProposalVoteMap(suite.keeper, ctx)
+
+	_, found := suite.keeper.GetProposal(ctx, 1)
+	suite.True(found)
+	suite.NotEmpty(votes[1])
+
+	_, found = suite.keeper.GetProposal(ctx, 2)
+	suite.False(found)
+	suite.Empty(votes[2])
+
+	archivedProposal, found := suite.keeper.GetArchivedProposal(ctx, 2)
+	suite.True(found)
+	suite.Equal(types.AttributeValueProposalRejected, archivedProposal.Outcome)
+	suite.Equal(testGenesis.Proposals[1], archivedProposal.Proposal)
+	suite.Len(archivedProposal.Votes, 2)
+	suite.Equal(d("1"), archivedProposal.Tally.NoVotes)
+	suite.Equal(firstBlockTime, archivedProposal.CloseTime)
+
+	_, found = suite.keeper.GetProposal(ctx, 3)
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestAddVote_TokenCommittee() {
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	tokenCom := types.NewTokenCommittee(
+		1, "This committee is for testing.", suite.addresses[:1], []types.Permission{types.GodPermission{}},
+		d("0.5"), time.Hour*24*7, d("0"), "hard", 0,
+	)
+	authGenState := app.NewAuthGenState(
+		suite.addresses[:3],
+		[]sdk.Coins{cs(c("hard", 100)), cs(c("hard", 50)), cs(c("hard", 100))},
+	)
+	suite.app.InitializeFromGenesisStates(
+		authGenState,
+		committeeGenState(
+			suite.app.Codec(),
+			[]types.Committee{tokenCom},
+			[]types.Proposal{{
+				PubProposal: gov.NewTextProposal("A Title", "A description of this proposal."),
+				ID:          1,
+				CommitteeID: tokenCom.ID,
+				Deadline:    firstBlockTime.Add(time.Hour * 24 * 7),
+			}},
+			[]types.Vote{},
+		),
+	)
+	ctx := suite.app.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+	ak := suite.app.GetAccountKeeper()
+
+	// non members can vote with their token holdings, which are escrowed
+	suite.NoError(suite.keeper.AddVote(ctx, 1, suite.addresses[1], types.No))
+	suite.Equal(sdk.Coins(nil), ak.GetAccount(ctx, suite.addresses[1]).GetCoins())
+	vps, found := suite.keeper.GetVotingPowerSnapshot(ctx, 1, suite.addresses[1])
+	suite.True(found)
+	suite.Equal(i(50), vps.VotingPower)
+	suite.Equal(cs(c("hard", 50)), vps.Escrow)
+
+	// changing a vote does not change voting power
+	suite.NoError(suite.keeper.AddVote(ctx, 1, suite.addresses[1], types.Abstain))
+	vps, _ = suite.keeper.GetVotingPowerSnapshot(ctx, 1, suite.addresses[1])
+	suite.Equal(i(50), vps.VotingPower)
+
+	// addresses without tokens cannot vote
+	err := suite.keeper.AddVote(ctx, 1, suite.addresses[3], types.Yes)
+	suite.True(errors.Is(err, types.ErrNoVotingPower))
+
+	suite.NoError(suite.keeper.AddVote(ctx, 1, suite.addresses[0], types.Yes))
+	tally, err := suite.keeper.TallyVotes(ctx, 1)
+	suite.NoError(err)
+	suite.Equal(d("100"), tally.YesVotes)
+	suite.Equal(d("0"), tally.NoVotes)
+	suite.Equal(d("50"), tally.AbstainVotes)
+	suite.Equal(d("250"), tally.PossibleVotes)
+	suite.False(tally.Passes())
+
+	// escrowed tokens are returned when the proposal closes
+	suite.keeper.DeleteProposalAndVotes(ctx, 1)
+	suite.Equal(cs(c("hard", 100)), ak.GetAccount(ctx, suite.addresses[0]).GetCoins())
+	suite.Equal(cs(c("hard", 50)), ak.GetAccount(ctx, suite.addresses[1]).GetCoins())
+	suite.Empty(suite.keeper.GetVotingPowerSnapshots(ctx))
+}
+
+func (suite *KeeperTestSuite) TestAddVote_StakeCommittee() {
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	stakeCom := types.NewStakeCommittee(
+		1, "This committee is for testing.", suite.addresses[:1], []types.Permission{types.GodPermission{}},
+		d("0.5"), time.Hour*24*7, d("0"), 0,
+	)
+	authGenState := app.NewAuthGenState(suite.addresses[:1], []sdk.Coins{cs(c("ukava", 100))})
+	suite.app.InitializeFromGenesisStates(
+		authGenState,
+		committeeGenState(
+			suite.app.Codec(),
+			[]types.Committee{stakeCom},
+			[]types.Proposal{{
+				PubProposal: gov.NewTextProposal("A Title", "A description of this proposal."),
+				ID:          1,
+				CommitteeID: stakeCom.ID,
+				Deadline:    firstBlockTime.Add(time.Hour * 24 * 7),
+			}},
+			[]types.Vote{},
+		),
+	)
+	ctx := suite.app.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+
+	// unstaked tokens give no voting power
+	err := suite.keeper.AddVote(ctx, 1, suite.addresses[0], types.Yes)
+	suite.True(errors.Is(err, types.ErrNoVotingPower))
+	suite.Equal(cs(c("ukava", 100)), suite.app.GetAccountKeeper().GetAccount(ctx, suite.addresses[0]).GetCoins())
+}
+
+func (suite *KeeperTestSuite) TestEnactProposal_RecordsParamChangeTimes() {
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	cdpParams := cdptypes.DefaultParams()
+	cdpParams.CollateralParams = cdptypes.CollateralParams{{
+		Denom:               "bnb",
+		Type:                "bnb-a",
+		LiquidationRatio:    d("2.0"),
+		DebtLimit:           c("usdx", 1000000000000),
+		StabilityFee:        d("1.000000001547125958"),
+		LiquidationPenalty:  d("0.05"),
+		AuctionSize:         i(100),
+		Prefix:              0x20,
+		ConversionFactor:    i(6),
+		SpotMarketID:        "bnb:usd",
+		LiquidationMarketID: "bnb:usd",
+	}}
+	cdpParams.GlobalDebtLimit = cdpParams.CollateralParams[0].DebtLimit
+	updatedCPs := make(cdptypes.CollateralParams, 1)
+	copy(updatedCPs, cdpParams.CollateralParams)
+	updatedCPs[0].StabilityFee = d("1.000000002")
+
+	bound := types.NewParamBound(
+		cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), "bnb-a", "stability_fee",
+		d("0.000000001"), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), time.Hour*24,
+	)
+	com := types.NewCommittee(
+		1, "This committee is for testing.", suite.addresses[:3],
+		[]types.Permission{types.NewBoundedParamChangePermission(types.ParamBounds{bound})},
+		d("0.5"), time.Hour*24*7, nil, d("0"), 0,
+	)
+	suite.app.InitializeFromGenesisStates(
+		newPricefeedGenState([]string{"bnb"}, []sdk.Dec{d("15.01")}),
+		newCDPGenesisState(cdpParams),
+		committeeGenState(suite.app.Codec(), []types.Committee{com}, []types.Proposal{}, []types.Vote{}),
+	)
+	ctx := suite.app.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+	pubProposal := params.NewParameterChangeProposal("A Title", "A description of this proposal.", []params.ParamChange{
+		params.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), string(suite.app.Codec().MustMarshalJSON(updatedCPs))),
+	})
+
+	err := suite.keeper.EnactProposal(ctx, types.NewProposal(pubProposal, 1, com.ID, firstBlockTime.Add(time.Hour)))
+	suite.NoError(err)
+	changeTime, found := suite.keeper.GetParamChangeTime(ctx, bound.Subspace, bound.Key, bound.ID, bound.Field)
+	suite.True(found)
+	suite.Equal(firstBlockTime, changeTime)
+
+	// further changes are not allowed until the cooldown has passed
+	updatedCPs[0].StabilityFee = d("1.0000000025")
+	pubProposal = params.NewParameterChangeProposal("A Title", "A description of this proposal.", []params.ParamChange{
+		params.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), string(suite.app.Codec().MustMarshalJSON(updatedCPs))),
+	})
+	suite.False(com.HasPermissionsFor(ctx, suite.app.Codec(), suite.keeper, pubProposal))
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(time.Hour * 24))
+	suite.True(com.HasPermissionsFor(ctx, suite.app.Codec(), suite.keeper, pubProposal))
+}
+
+func (suite *KeeperTestSuite) TestEnactProposal_RecordsParamChangeTimesFromOtherCommittees() {
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	cdpParams := cdptypes.DefaultParams()
+	cdpParams.CollateralParams = cdptypes.CollateralParams{{
+		Denom:               "bnb",
+		Type:                "bnb-a",
+		LiquidationRatio:    d("2.0"),
+		DebtLimit:           c("usdx", 1000000000000),
+		StabilityFee:        d("1.000000001547125958"),
+		LiquidationPenalty:  d("0.05"),
+		AuctionSize:         i(100),
+		Prefix:              0x20,
+		ConversionFactor:    i(6),
+		SpotMarketID:        "bnb:usd",
+		LiquidationMarketID: "bnb:usd",
+	}}
+	cdpParams.GlobalDebtLimit = cdpParams.CollateralParams[0].DebtLimit
+
+	bound := types.NewParamBound(
+		cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), "bnb-a", "stability_fee",
+		d("0.000000001"), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), time.Hour*24,
+	)
+	emergencyCom := types.NewCommittee(
+		1, "This committee is for emergency changes.", suite.addresses[:3],
+		[]types.Permission{types.NewBoundedParamChangePermission(types.ParamBounds{bound})},
+		d("0.5"), time.Hour*24*7, nil, d("0"), 0,
+	)
+	broadCom := types.NewCommittee(
+		2, "This committee can change any param.", suite.addresses[3:],
+		[]types.Permission{types.GodPermission{}},
+		d("0.5"), time.Hour*24*7, nil, d("0"), 0,
+	)
+	suite.app.InitializeFromGenesisStates(
+		newPricefeedGenState([]string{"bnb"}, []sdk.Dec{d("15.01")}),
+		newCDPGenesisState(cdpParams),
+		committeeGenState(suite.app.Codec(), []types.Committee{emergencyCom, broadCom}, []types.Proposal{}, []types.Vote{}),
+	)
+	ctx := suite.app.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+
+	// the broad committee changes the bounded field along with an unbounded field and an unbounded param
+	updatedCPs := make(cdptypes.CollateralParams, 1)
+	copy(updatedCPs, cdpParams.CollateralParams)
+	updatedCPs[0].StabilityFee = d("1.000000002")
+	updatedCPs[0].LiquidationRatio = d("2.5")
+	pubProposal := params.NewParameterChangeProposal("A Title", "A description of this proposal.", []params.ParamChange{
+		params.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), string(suite.app.Codec().MustMarshalJSON(updatedCPs))),
+		params.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyDebtThreshold), string(suite.app.Codec().MustMarshalJSON(i(200000000000)))),
+	})
+	suite.False(emergencyCom.HasPermissionsFor(ctx, suite.app.Codec(), suite.keeper, pubProposal))
+	err := suite.keeper.EnactProposal(ctx, types.NewProposal(pubProposal, 1, broadCom.ID, firstBlockTime.Add(time.Hour)))
+	suite.NoError(err)
+	changeTime, found := suite.keeper.GetParamChangeTime(ctx, bound.Subspace, bound.Key, bound.ID, bound.Field)
+	suite.True(found)
+	suite.Equal(firstBlockTime, changeTime)
+
+	// the emergency committee cannot move the field again until the cooldown has passed
+	updatedCPs[0].StabilityFee = d("1.0000000025")
+	pubProposal = params.NewParameterChangeProposal("A Title", "A description of this proposal.", []params.ParamChange{
+		params.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), string(suite.app.Codec().MustMarshalJSON(updatedCPs))),
+	})
+	suite.False(emergencyCom.HasPermissionsFor(ctx, suite.app.Codec(), suite.keeper, pubProposal))
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(time.Hour * 24))
+	suite.True(emergencyCom.HasPermissionsFor(ctx, suite.app.Codec(), suite.keeper, pubProposal))
+}
+
+func (suite *KeeperTestSuite) TestEnactProposal_Batch() {
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	com := types.NewCommittee(
+		1, "This committee is for testing.", suite.addresses[:3], []types.Permission{types.GodPermission{}},
+		d("0.5"), time.Hour*24*7, nil, d("0"), 0,
+	)
+	suite.app.InitializeFromGenesisStates(
+		committeeGenState(suite.app.Codec(), []types.Committee{com}, []types.Proposal{}, []types.Vote{}),
+	)
+	ctx := suite.app.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+	cdpParams := suite.app.GetCDPKeeper().GetParams(ctx)
+	newDebtThreshold := cdpParams.DebtAuctionThreshold.Add(i(1000000))
+	newSurplusThreshold := cdpParams.SurplusAuctionThreshold.Add(i(1000000))
+
+	debtChange := params.NewParameterChangeProposal("A Title", "A description of this proposal.", []params.ParamChange{{
+		Subspace: cdptypes.ModuleName,
+		Key:      string(cdptypes.KeyDebtThreshold),
+		Value:    string(types.ModuleCdc.MustMarshalJSON(newDebtThreshold)),
+	}})
+	surplusChange := params.NewParameterChangeProposal("A Title", "A description of this proposal.", []params.ParamChange{{
+		Subspace: cdptypes.ModuleName,
+		Key:      string(cdptypes.KeySurplusThreshold),
+		Value:    string(types.ModuleCdc.MustMarshalJSON(newSurplusThreshold)),
+	}})
+	invalidChange := params.NewParameterChangeProposal("A Title", "A description of this proposal.", []params.ParamChange{{
+		Subspace: cdptypes.ModuleName,
+		Key:      string(cdptypes.KeySurplusThreshold),
+		Value:    `{"denom": "usdx",`,
+	}})
+
+	// a batch with an invalid content changes nothing
+	batch := types.NewBatchProposal("A Title", "A description of this proposal.", []types.PubProposal{debtChange, invalidChange})
+	err := suite.keeper.EnactProposal(ctx, types.NewProposal(batch, 1, com.ID, firstBlockTime.Add(time.Hour)))
+	suite.Error(err)
+	suite.Equal(cdpParams, suite.app.GetCDPKeeper().GetParams(ctx))
+
+	// a valid batch enacts all of its contents
+	batch = types.NewBatchProposal("A Title", "A description of this proposal.", []types.PubProposal{debtChange, surplusChange})
+	err = suite.keeper.EnactProposal(ctx, types.NewProposal(batch, 2, com.ID, firstBlockTime.Add(time.Hour)))
+	suite.NoError(err)
+	suite.Equal(newDebtThreshold, suite.app.GetCDPKeeper().GetParams(ctx).DebtAuctionThreshold)
+	suite.Equal(newSurplusThreshold, suite.app.GetCDPKeeper().GetParams(ctx).SurplusAuctionThreshold)
+}
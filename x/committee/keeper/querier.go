@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -32,6 +33,16 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryNextProposalID(ctx, req, keeper)
 		case types.QueryRawParams:
 			return queryRawParams(ctx, path[1:], req, keeper)
+		case types.QueryQueuedProposals:
+			return queryQueuedProposals(ctx, path[1:], req, keeper)
+		case types.QueryQueuedProposal:
+			return queryQueuedProposal(ctx, path[1:], req, keeper)
+		case types.QueryArchivedProposals:
+			return queryArchivedProposals(ctx, path[1:], req, keeper)
+		case types.QueryArchivedProposal:
+			return queryArchivedProposal(ctx, path[1:], req, keeper)
+		case types.QueryParams:
+			return queryParams(ctx, req, keeper)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
@@ -122,6 +133,88 @@ func queryNextProposalID(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	return bz, nil
 }
 
+func queryQueuedProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+
+	queuedProposals := keeper.GetQueuedProposals(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, queuedProposals)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryQueuedProposal(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	queuedProposal, found := keeper.GetQueuedProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownQueuedProposal, "%d", params.ProposalID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, queuedProposal)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryArchivedProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryArchivedProposalsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	archivedProposals := []types.ArchivedProposal{}
+	keeper.IterateArchivedProposals(ctx, func(ap types.ArchivedProposal) bool {
+		if params.CommitteeID != 0 && ap.CommitteeID != params.CommitteeID {
+			return false
+		}
+		if params.Outcome != "" && ap.Outcome != params.Outcome {
+			return false
+		}
+		archivedProposals = append(archivedProposals, ap)
+		return false
+	})
+
+	start, end := client.Paginate(len(archivedProposals), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		archivedProposals = []types.ArchivedProposal{}
+	} else {
+		archivedProposals = archivedProposals[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, archivedProposals)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryArchivedProposal(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	archivedProposal, found := keeper.GetArchivedProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownArchivedProposal, "%d", params.ProposalID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, archivedProposal)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
@@ -173,13 +266,12 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	_, found := keeper.GetProposal(ctx, params.ProposalID)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", params.ProposalID)
+	tally, err := keeper.TallyVotes(ctx, params.ProposalID)
+	if err != nil {
+		return nil, err
 	}
-	numVotes := keeper.TallyVotes(ctx, params.ProposalID)
 
-	bz, err := codec.MarshalJSONIndent(keeper.cdc, numVotes)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, tally)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -210,3 +302,13 @@ func queryRawParams(ctx sdk.Context, path []string, req abci.RequestQuery, keepe
 	}
 	return bz, nil
 }
+
+func queryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	params := keeper.GetParams(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -58,6 +58,7 @@ func (suite *QuerierTestSuite) SetupTest() {
 				Permissions:      []types.Permission{types.GodPermission{}},
 				VoteThreshold:    d("0.667"),
 				ProposalDuration: time.Hour * 24 * 7,
+				Quorum:           d("0"),
 			},
 			{
 				ID:               2,
@@ -65,6 +66,7 @@ func (suite *QuerierTestSuite) SetupTest() {
 				Permissions:      nil,
 				VoteThreshold:    d("0.667"),
 				ProposalDuration: time.Hour * 24 * 7,
+				Quorum:           d("0"),
 			},
 		},
 		[]types.Proposal{
@@ -72,10 +74,15 @@ func (suite *QuerierTestSuite) SetupTest() {
 			{ID: 2, CommitteeID: 1, PubProposal: gov.NewTextProposal("Another Title", "A description of this other proposal."), Deadline: testTime.Add(21 * 24 * time.Hour)},
 		},
 		[]types.Vote{
-			{ProposalID: 1, Voter: suite.addresses[0]},
-			{ProposalID: 1, Voter: suite.addresses[1]},
-			{ProposalID: 2, Voter: suite.addresses[2]},
+			{ProposalID: 1, Voter: suite.addresses[0], VoteType: types.Yes},
+			{ProposalID: 1, Voter: suite.addresses[1], VoteType: types.Yes},
+			{ProposalID: 2, Voter: suite.addresses[2], VoteType: types.Yes},
 		},
+		[]types.VotingPowerSnapshot{},
+		[]types.ParamChangeTime{},
+		[]types.QueuedProposal{},
+		types.DefaultParams(),
+		[]types.ArchivedProposal{},
 	)
 	suite.app.InitializeFromGenesisStates(
 		NewCommitteeGenesisState(suite.cdc, suite.testGenesis),
@@ -245,11 +252,20 @@ func (suite *QuerierTestSuite) TestQueryTally() {
 	suite.NotNil(bz)
 
 	// Unmarshal the bytes
-	var tally int64
+	var tally types.ProposalTally
 	suite.NoError(suite.cdc.UnmarshalJSON(bz, &tally))
 
 	// Check
-	suite.Equal(int64(len(suite.votes[propID])), tally)
+	expectedTally := types.NewProposalTally(
+		propID,
+		sdk.NewDec(int64(len(suite.votes[propID]))),
+		sdk.ZeroDec(),
+		sdk.ZeroDec(),
+		sdk.NewDec(int64(len(suite.testGenesis.Committees[0].Members))),
+		suite.testGenesis.Committees[0].VoteThreshold,
+		sdk.ZeroDec(),
+	)
+	suite.Equal(expectedTally, tally)
 }
 
 type TestSubParam struct {
@@ -279,7 +295,7 @@ func (suite *QuerierTestSuite) TestQueryRawParams() {
 	paramValue := TestSubParam{
 		Some:   "test",
 		Test:   d("1000000000000.000000000000000001"),
-		Params: []types.Vote{{1, suite.addresses[0]}, {12, suite.addresses[1]}},
+		Params: []types.Vote{{1, suite.addresses[0], types.Yes}, {12, suite.addresses[1], types.Yes}},
 	}
 	subspace.Set(ctx, []byte(paramKey), paramValue)
 
@@ -302,6 +318,83 @@ func (suite *QuerierTestSuite) TestQueryRawParams() {
 	suite.Equal(suite.cdc.MustMarshalJSON(paramValue), returnedParamValue)
 }
 
+func (suite *QuerierTestSuite) TestQueryArchivedProposals() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	archivedProposals := []types.ArchivedProposal{
+		types.NewArchivedProposal(
+			types.NewProposal(gov.NewTextProposal("A Title", "A description of this proposal."), 3, 1, testTime),
+			types.NewProposalTally(3, d("0"), d("0"), d("0"), d("3"), d("0.667"), d("0")), types.AttributeValueProposalPassed, "", nil, 1, testTime,
+		),
+		types.NewArchivedProposal(
+			types.NewProposal(gov.NewTextProposal("A Title", "A description of this proposal."), 4, 2, testTime),
+			types.NewProposalTally(4, d("0"), d("0"), d("0"), d("3"), d("0.667"), d("0")), types.AttributeValueProposalTimeout, "", nil, 1, testTime,
+		),
+		types.NewArchivedProposal(
+			types.NewProposal(gov.NewTextProposal("A Title", "A description of this proposal."), 5, 1, testTime),
+			types.NewProposalTally(5, d("0"), d("0"), d("0"), d("3"), d("0.667"), d("0")), types.AttributeValueProposalTimeout, "", nil, 1, testTime,
+		),
+	}
+	for _, ap := range archivedProposals {
+		suite.keeper.SetArchivedProposal(ctx, ap)
+	}
+
+	testCases := []struct {
+		name     string
+		params   types.QueryArchivedProposalsParams
+		expected []types.ArchivedProposal
+	}{
+		{"all", types.NewQueryArchivedProposalsParams(1, 100, 0, ""), archivedProposals},
+		{"by committee", types.NewQueryArchivedProposalsParams(1, 100, 1, ""), []types.ArchivedProposal{archivedProposals[0], archivedProposals[2]}},
+		{"by outcome", types.NewQueryArchivedProposalsParams(1, 100, 0, types.AttributeValueProposalTimeout), archivedProposals[1:]},
+		{"by committee and outcome", types.NewQueryArchivedProposalsParams(1, 100, 1, types.AttributeValueProposalTimeout), archivedProposals[2:]},
+		{"paginated", types.NewQueryArchivedProposalsParams(2, 2, 0, ""), archivedProposals[2:]},
+		{"page out of range", types.NewQueryArchivedProposalsParams(3, 2, 0, ""), nil},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			query := abci.RequestQuery{
+				Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryArchivedProposals}, "/"),
+				Data: suite.cdc.MustMarshalJSON(tc.params),
+			}
+			bz, err := suite.querier(ctx, []string{types.QueryArchivedProposals}, query)
+			suite.NoError(err)
+
+			var results []types.ArchivedProposal
+			suite.NoError(suite.cdc.UnmarshalJSON(bz, &results))
+			suite.Equal(tc.expected, results)
+		})
+	}
+
+	// query a single archived proposal
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryArchivedProposal}, "/"),
+		Data: suite.cdc.MustMarshalJSON(types.NewQueryProposalParams(4)),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryArchivedProposal}, query)
+	suite.NoError(err)
+	var archivedProposal types.ArchivedProposal
+	suite.NoError(suite.cdc.UnmarshalJSON(bz, &archivedProposal))
+	suite.Equal(archivedProposals[1], archivedProposal)
+
+	query.Data = suite.cdc.MustMarshalJSON(types.NewQueryProposalParams(1))
+	_, err = suite.querier(ctx, []string{types.QueryArchivedProposal}, query)
+	suite.Error(err)
+}
+
+func (suite *QuerierTestSuite) TestQueryParams() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryParams}, "/"),
+	}
+
+	bz, err := suite.querier(ctx, []string{types.QueryParams}, query)
+	suite.NoError(err)
+
+	var p types.Params
+	suite.NoError(suite.cdc.UnmarshalJSON(bz, &p))
+	suite.Equal(types.DefaultParams(), p)
+}
+
 func TestQuerierTestSuite(t *testing.T) {
 	suite.Run(t, new(QuerierTestSuite))
 }
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+var _ types.ProposalQueue = Keeper{}
+
+// GetQueuedProposal gets a passed proposal waiting to be enacted from the store.
+func (k Keeper) GetQueuedProposal(ctx sdk.Context, proposalID uint64) (types.QueuedProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := store.Get(types.GetKeyFromID(proposalID))
+	if bz == nil {
+		return types.QueuedProposal{}, false
+	}
+	var queuedProposal types.QueuedProposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &queuedProposal)
+	return queuedProposal, true
+}
+
+// SetQueuedProposal puts a queued proposal into the store.
+func (k Keeper) SetQueuedProposal(ctx sdk.Context, queuedProposal types.QueuedProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(queuedProposal)
+	store.Set(types.GetKeyFromID(queuedProposal.ID), bz)
+}
+
+// DeleteQueuedProposal removes a queued proposal from the store.
+func (k Keeper) DeleteQueuedProposal(ctx sdk.Context, proposalID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	store.Delete(types.GetKeyFromID(proposalID))
+}
+
+// IterateQueuedProposals provides an iterator over all queued proposals.
+// For each queued proposal, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateQueuedProposals(ctx sdk.Context, cb func(queuedProposal types.QueuedProposal) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var queuedProposal types.QueuedProposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &queuedProposal)
+
+		if cb(queuedProposal) {
+			break
+		}
+	}
+}
+
+// GetQueuedProposals returns all queued proposals.
+func (k Keeper) GetQueuedProposals(ctx sdk.Context) []types.QueuedProposal {
+	results := []types.QueuedProposal{}
+	k.IterateQueuedProposals(ctx, func(qp types.QueuedProposal) bool {
+		results = append(results, qp)
+		return false
+	})
+	return results
+}
+
+// QueueProposal stores a passed proposal to be enacted once its committee's enactment delay has passed.
+func (k Keeper) QueueProposal(ctx sdk.Context, proposal types.Proposal, enactmentDelay time.Duration) {
+	queuedProposal := types.NewQueuedProposal(proposal, ctx.BlockTime().Add(enactmentDelay))
+	k.SetQueuedProposal(ctx, queuedProposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalQueue,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyEnactmentTime, queuedProposal.EnactmentTime.String()),
+		),
+	)
+}
+
+// VetoQueuedProposal removes a queued proposal so that it is never enacted.
+func (k Keeper) VetoQueuedProposal(ctx sdk.Context, proposalID uint64) error {
+	queuedProposal, found := k.GetQueuedProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownQueuedProposal, "%d", proposalID)
+	}
+
+	k.DeleteQueuedProposal(ctx, proposalID)
+	k.updateArchivedProposalOutcome(ctx, proposalID, types.AttributeValueProposalVetoed, nil)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVeto,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", queuedProposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", queuedProposal.ID)),
+		),
+	)
+	return nil
+}
+
+// EnactQueuedProposals puts in place the changes proposed in any queued proposal whose enactment delay has passed
+func (k Keeper) EnactQueuedProposals(ctx sdk.Context) {
+	k.IterateQueuedProposals(ctx, func(queuedProposal types.QueuedProposal) bool {
+		if !queuedProposal.IsEnactableBy(ctx.BlockTime()) {
+			return false
+		}
+
+		// permissions and validity are checked again as state could have changed while the proposal was queued
+		err := k.EnactProposal(ctx, queuedProposal.Proposal)
+		outcome := types.AttributeValueProposalPassed
+		if err != nil {
+			outcome = types.AttributeValueProposalFailed
+		}
+
+		k.DeleteQueuedProposal(ctx, queuedProposal.ID)
+		k.updateArchivedProposalOutcome(ctx, queuedProposal.ID, outcome, err)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalEnact,
+				sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", queuedProposal.CommitteeID)),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", queuedProposal.ID)),
+				sdk.NewAttribute(types.AttributeKeyProposalCloseStatus, outcome),
+			),
+		)
+		return false
+	})
+}
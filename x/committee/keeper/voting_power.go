@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// GetVotingPower returns the voting power an address currently has in a stake or token committee.
+func (k Keeper) GetVotingPower(ctx sdk.Context, com types.Committee, voter sdk.AccAddress) sdk.Int {
+	switch com.Type {
+	case types.StakeCommitteeType:
+		return k.getBondedTokens(ctx, voter)
+	case types.TokenCommitteeType:
+		acc := k.accountKeeper.GetAccount(ctx, voter)
+		if acc == nil {
+			return sdk.ZeroInt()
+		}
+		return acc.SpendableCoins(ctx.BlockTime()).AmountOf(com.TallyDenom)
+	default:
+		return sdk.ZeroInt()
+	}
+}
+
+// GetTotalVotingPower returns the voting power of all possible voters in a stake or token committee.
+func (k Keeper) GetTotalVotingPower(ctx sdk.Context, com types.Committee) sdk.Int {
+	switch com.Type {
+	case types.StakeCommitteeType:
+		return k.stakingKeeper.TotalBondedTokens(ctx)
+	case types.TokenCommitteeType:
+		return k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(com.TallyDenom)
+	default:
+		return sdk.ZeroInt()
+	}
+}
+
+// snapshotVotingPower records the voting power of a voter on a proposal, escrowing the tokens of token committee voters.
+// Staked tokens are not escrowed as they cannot be transferred until unbonded.
+func (k Keeper) snapshotVotingPower(ctx sdk.Context, com types.Committee, proposalID uint64, voter sdk.AccAddress) error {
+	power := k.GetVotingPower(ctx, com, voter)
+	if !power.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrNoVotingPower, "%s", voter)
+	}
+
+	escrow := sdk.NewCoins()
+	if com.Type == types.TokenCommitteeType {
+		escrow = sdk.NewCoins(sdk.NewCoin(com.TallyDenom, power))
+		if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, voter, types.ModuleName, escrow); err != nil {
+			return err
+		}
+	}
+
+	k.SetVotingPowerSnapshot(ctx, types.NewVotingPowerSnapshot(proposalID, voter, power, escrow))
+	return nil
+}
+
+// getBondedTokens returns the amount of tokens a delegator has staked with bonded validators.
+func (k Keeper) getBondedTokens(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	totalDelegated := sdk.ZeroDec()
+
+	maxUInt := ^uint16(0)
+	delegations := k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, maxUInt)
+	for _, delegation := range delegations {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+		// only bonded tokens count towards total bonded tokens, so only they give voting power
+		if validator.GetStatus() != sdk.Bonded || validator.GetTokens().IsZero() {
+			continue
+		}
+		totalDelegated = totalDelegated.Add(validator.TokensFromShares(delegation.GetShares()))
+	}
+	return totalDelegated.TruncateInt()
+}
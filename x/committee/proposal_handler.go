@@ -13,6 +13,8 @@ func NewProposalHandler(k Keeper) govtypes.Handler {
 			return handleCommitteeChangeProposal(ctx, k, c)
 		case CommitteeDeleteProposal:
 			return handleCommitteeDeleteProposal(ctx, k, c)
+		case VetoProposal:
+			return handleVetoProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
@@ -50,3 +52,11 @@ func handleCommitteeDeleteProposal(ctx sdk.Context, k Keeper, committeeProposal
 	k.DeleteCommittee(ctx, committeeProposal.CommitteeID)
 	return nil
 }
+
+func handleVetoProposal(ctx sdk.Context, k Keeper, vetoProposal VetoProposal) error {
+	if err := vetoProposal.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, err.Error())
+	}
+
+	return k.VetoQueuedProposal(ctx, vetoProposal.ProposalID)
+}
@@ -46,6 +46,7 @@ func (suite *ProposalHandlerTestSuite) SetupTest() {
 				Permissions:      []types.Permission{types.GodPermission{}},
 				VoteThreshold:    d("0.667"),
 				ProposalDuration: time.Hour * 24 * 7,
+				Quorum:           d("0"),
 			},
 			{
 				ID:               2,
@@ -53,14 +54,20 @@ func (suite *ProposalHandlerTestSuite) SetupTest() {
 				Permissions:      nil,
 				VoteThreshold:    d("0.667"),
 				ProposalDuration: time.Hour * 24 * 7,
+				Quorum:           d("0"),
 			},
 		},
 		[]committee.Proposal{
 			{ID: 1, CommitteeID: 1, PubProposal: gov.NewTextProposal("A Title", "A description of this proposal."), Deadline: testTime.Add(7 * 24 * time.Hour)},
 		},
 		[]committee.Vote{
-			{ProposalID: 1, Voter: suite.addresses[0]},
+			{ProposalID: 1, Voter: suite.addresses[0], VoteType: committee.Yes},
 		},
+		[]committee.VotingPowerSnapshot{},
+		[]committee.ParamChangeTime{},
+		[]committee.QueuedProposal{},
+		committee.DefaultParams(),
+		[]committee.ArchivedProposal{},
 	)
 }
 
@@ -80,6 +87,7 @@ func (suite *ProposalHandlerTestSuite) TestProposalHandler_ChangeCommittee() {
 					Members:          suite.addresses[:1],
 					VoteThreshold:    d("1"),
 					ProposalDuration: time.Hour * 24,
+					Quorum:           d("0"),
 				},
 			),
 			expectPass: true,
@@ -95,6 +103,7 @@ func (suite *ProposalHandlerTestSuite) TestProposalHandler_ChangeCommittee() {
 					Permissions:      suite.testGenesis.Committees[0].Permissions,
 					VoteThreshold:    suite.testGenesis.Committees[0].VoteThreshold,
 					ProposalDuration: suite.testGenesis.Committees[0].ProposalDuration,
+					Quorum:           d("0"),
 				},
 			),
 			expectPass: true,
@@ -119,6 +128,7 @@ func (suite *ProposalHandlerTestSuite) TestProposalHandler_ChangeCommittee() {
 					Permissions:      suite.testGenesis.Committees[0].Permissions,
 					VoteThreshold:    suite.testGenesis.Committees[0].VoteThreshold,
 					ProposalDuration: suite.testGenesis.Committees[0].ProposalDuration,
+					Quorum:           d("0"),
 				},
 			),
 			expectPass: false,
@@ -222,6 +232,59 @@ func (suite *ProposalHandlerTestSuite) TestProposalHandler_DeleteCommittee() {
 	}
 }
 
+func (suite *ProposalHandlerTestSuite) TestProposalHandler_Veto() {
+	genState := suite.testGenesis
+	genState.NextProposalID = 3
+	genState.QueuedProposals = []committee.QueuedProposal{
+		committee.NewQueuedProposal(
+			committee.NewProposal(gov.NewTextProposal("A Title", "A description of this proposal."), 2, 1, testTime.Add(7*24*time.Hour)),
+			testTime.Add(24*time.Hour),
+		),
+	}
+
+	testCases := []struct {
+		name       string
+		proposal   committee.VetoProposal
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			proposal:   committee.NewVetoProposal("A Title", "A proposal description.", 2),
+			expectPass: true,
+		},
+		{
+			name:       "proposal not queued",
+			proposal:   committee.NewVetoProposal("A Title", "A proposal description.", 1),
+			expectPass: false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Setup
+			suite.app = app.NewTestApp()
+			suite.keeper = suite.app.GetCommitteeKeeper()
+			suite.app = suite.app.InitializeFromGenesisStates(
+				NewCommitteeGenState(suite.app.Codec(), genState),
+			)
+			suite.ctx = suite.app.NewContext(true, abci.Header{Height: 1, Time: testTime})
+			handler := committee.NewProposalHandler(suite.keeper)
+
+			// Run
+			err := handler(suite.ctx, tc.proposal)
+
+			// Check
+			if tc.expectPass {
+				suite.NoError(err)
+				_, found := suite.keeper.GetQueuedProposal(suite.ctx, tc.proposal.ProposalID)
+				suite.False(found)
+			} else {
+				suite.Error(err)
+				suite.Equal(genState, committee.ExportGenesis(suite.ctx, suite.keeper))
+			}
+		})
+	}
+}
+
 func TestProposalHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(ProposalHandlerTestSuite))
 }
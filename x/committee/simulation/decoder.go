@@ -32,7 +32,32 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &voteB)
 		return fmt.Sprintf("%v\n%v", voteA, voteB)
 
-	case bytes.Equal(kvA.Key[:1], types.NextProposalIDKey):
+	case bytes.Equal(kvA.Key[:1], types.VotingPowerSnapshotKeyPrefix):
+		var snapshotA, snapshotB types.VotingPowerSnapshot
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &snapshotA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &snapshotB)
+		return fmt.Sprintf("%v\n%v", snapshotA, snapshotB)
+
+	case bytes.Equal(kvA.Key[:1], types.ParamChangeTimeKeyPrefix):
+		var pctA, pctB types.ParamChangeTime
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &pctA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &pctB)
+		return fmt.Sprintf("%v\n%v", pctA, pctB)
+
+	case bytes.Equal(kvA.Key[:1], types.QueuedProposalKeyPrefix):
+		var queuedProposalA, queuedProposalB types.QueuedProposal
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &queuedProposalA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &queuedProposalB)
+		return fmt.Sprintf("%v\n%v", queuedProposalA, queuedProposalB)
+
+	case bytes.Equal(kvA.Key[:1], types.ArchivedProposalKeyPrefix):
+		var archivedProposalA, archivedProposalB types.ArchivedProposal
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &archivedProposalA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &archivedProposalB)
+		return fmt.Sprintf("%v\n%v", archivedProposalA, archivedProposalB)
+
+	case bytes.Equal(kvA.Key[:1], types.NextProposalIDKey),
+		bytes.Equal(kvA.Key[:1], types.ArchivedProposalByTimeKeyPrefix):
 		proposalIDA := types.Uint64FromBytes(kvA.Value)
 		proposalIDB := types.Uint64FromBytes(kvB.Value)
 		return fmt.Sprintf("%d\n%d", proposalIDA, proposalIDB)
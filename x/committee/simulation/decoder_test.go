@@ -33,6 +33,9 @@ func TestDecodeStore(t *testing.T) {
 		[]types.Permission{types.TextPermission{}},
 		sdk.MustNewDecFromStr("0.667"),
 		time.Hour*24*7,
+		nil,
+		sdk.ZeroDec(),
+		0,
 	)
 	proposal := types.Proposal{
 		ID:          34,
@@ -43,6 +46,7 @@ func TestDecodeStore(t *testing.T) {
 	vote := types.Vote{
 		ProposalID: 9,
 		Voter:      nil,
+		VoteType:   types.Yes,
 	}
 
 	kvPairs := kv.Pairs{
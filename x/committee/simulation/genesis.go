@@ -39,6 +39,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		[]types.Permission{types.GodPermission{}},
 		sdk.MustNewDecFromStr("0.5"),
 		AverageBlockTime*10,
+		[]types.MemberWeight{},
+		sdk.ZeroDec(),
+		0,
 	)
 
 	// Create other committees
@@ -58,6 +61,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 		committees,
 		[]types.Proposal{},
 		[]types.Vote{},
+		[]types.VotingPowerSnapshot{},
+		[]types.ParamChangeTime{},
+		[]types.QueuedProposal{},
+		types.DefaultParams(),
+		[]types.ArchivedProposal{},
 	)
 	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, genesisState))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
@@ -82,6 +90,17 @@ func RandomCommittee(r *rand.Rand, availableAccs []simulation.Account, allowedPa
 	// pick committee vote threshold, must be in interval (0,1]
 	threshold := simulation.RandomDecAmount(r, sdk.MustNewDecFromStr("1").Sub(sdk.SmallestDec())).Add(sdk.SmallestDec())
 
+	// pick member weights, must be positive
+	var memberWeights []types.MemberWeight
+	for _, m := range members {
+		if r.Intn(100) < 50 {
+			memberWeights = append(memberWeights, types.NewMemberWeight(m, sdk.NewDec(int64(r.Intn(10)+1))))
+		}
+	}
+
+	// pick committee quorum, must be in interval [0,1]
+	quorum := simulation.RandomDecAmount(r, sdk.OneDec())
+
 	return types.NewCommittee(
 		r.Uint64(), // could collide with other committees, but unlikely
 		simulation.RandStringOfLength(r, r.Intn(types.MaxCommitteeDescriptionLength+1)),
@@ -89,6 +108,9 @@ func RandomCommittee(r *rand.Rand, availableAccs []simulation.Account, allowedPa
 		RandomPermissions(r, allowedParams),
 		threshold,
 		dur,
+		memberWeights,
+		quorum,
+		0,
 	), nil
 }
 
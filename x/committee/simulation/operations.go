@@ -82,7 +82,7 @@ func SimulateMsgSubmitProposal(cdc *codec.Codec, ak AccountKeeper, k keeper.Keep
 		var selectedCommittee types.Committee
 		var found bool
 		for _, c := range committees {
-			if c.HasPermissionsFor(ctx, cdc, k.ParamKeeper, pp) {
+			if c.HasPermissionsFor(ctx, cdc, k, pp) {
 				selectedCommittee = c
 				found = true
 				break
@@ -170,7 +170,7 @@ func SimulateMsgVote(k keeper.Keeper, ak AccountKeeper, voter sdk.AccAddress, pr
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
 		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
 
-		msg := types.NewMsgVote(voter, proposalID)
+		msg := types.NewMsgVote(voter, proposalID, types.Yes)
 
 		account := ak.GetAccount(ctx, voter)
 		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
@@ -6,6 +6,10 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
+
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	issuancetypes "github.com/kava-labs/kava/x/issuance/types"
 )
 
 // ModuleCdc is a generic codec to be used throughout module
@@ -24,6 +28,9 @@ func init() {
 	RegisterProposalTypeCodec(govtypes.TextProposal{}, "cosmos-sdk/TextProposal")
 	RegisterProposalTypeCodec(upgrade.SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal")
 	RegisterProposalTypeCodec(upgrade.CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal")
+	RegisterProposalTypeCodec(hardtypes.MoneyMarketPauseProposal{}, "hard/MoneyMarketPauseProposal")
+	RegisterProposalTypeCodec(issuancetypes.SeizeCoinsProposal{}, "issuance/SeizeCoinsProposal")
+	RegisterProposalTypeCodec(cdptypes.LiquidationSweepProposal{}, "cdp/LiquidationSweepProposal")
 }
 
 // RegisterCodec registers the necessary types for the module
@@ -33,6 +40,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*PubProposal)(nil), nil)
 	cdc.RegisterConcrete(CommitteeChangeProposal{}, "kava/CommitteeChangeProposal", nil)
 	cdc.RegisterConcrete(CommitteeDeleteProposal{}, "kava/CommitteeDeleteProposal", nil)
+	cdc.RegisterConcrete(VetoProposal{}, "kava/VetoProposal", nil)
+	cdc.RegisterConcrete(BatchProposal{}, "kava/BatchProposal", nil)
 
 	// Permissions
 	cdc.RegisterInterface((*Permission)(nil), nil)
@@ -41,6 +50,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(TextPermission{}, "kava/TextPermission", nil)
 	cdc.RegisterConcrete(SoftwareUpgradePermission{}, "kava/SoftwareUpgradePermission", nil)
 	cdc.RegisterConcrete(SubParamChangePermission{}, "kava/SubParamChangePermission", nil)
+	cdc.RegisterConcrete(BoundedParamChangePermission{}, "kava/BoundedParamChangePermission", nil)
+	cdc.RegisterConcrete(VetoPermission{}, "kava/VetoPermission", nil)
+	cdc.RegisterConcrete(MoneyMarketPausePermission{}, "kava/MoneyMarketPausePermission", nil)
+	cdc.RegisterConcrete(SeizeCoinsPermission{}, "kava/SeizeCoinsPermission", nil)
+	cdc.RegisterConcrete(LiquidationSweepPermission{}, "kava/LiquidationSweepPermission", nil)
 
 	// Msgs
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "kava/MsgSubmitProposal", nil)
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -25,9 +27,14 @@ type Committee struct {
 	Permissions      []Permission     `json:"permissions" yaml:"permissions"`
 	VoteThreshold    sdk.Dec          `json:"vote_threshold" yaml:"vote_threshold"`       // Smallest percentage of members that must vote for a proposal to pass.
 	ProposalDuration time.Duration    `json:"proposal_duration" yaml:"proposal_duration"` // The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
+	MemberWeights    []MemberWeight   `json:"member_weights" yaml:"member_weights"`       // Voting weights of members, members not listed have a weight of one.
+	Quorum           sdk.Dec          `json:"quorum" yaml:"quorum"`                       // Smallest percentage of member weight that must vote (yes, no or abstain) for a proposal to pass.
+	Type             CommitteeType    `json:"type" yaml:"type"`                           // How voting power is assigned. Members of stake and token committees can submit proposals but anyone with voting power can vote.
+	TallyDenom       string           `json:"tally_denom" yaml:"tally_denom"`             // The denom whose holdings give voting power in token committees.
+	EnactmentDelay   time.Duration    `json:"enactment_delay" yaml:"enactment_delay"`     // The length of time passed proposals are queued for before they are enacted, during which they can be vetoed.
 }
 
-func NewCommittee(id uint64, description string, members []sdk.AccAddress, permissions []Permission, threshold sdk.Dec, duration time.Duration) Committee {
+func NewCommittee(id uint64, description string, members []sdk.AccAddress, permissions []Permission, threshold sdk.Dec, duration time.Duration, memberWeights []MemberWeight, quorum sdk.Dec, enactmentDelay time.Duration) Committee {
 	return Committee{
 		ID:               id,
 		Description:      description,
@@ -35,6 +42,130 @@ func NewCommittee(id uint64, description string, members []sdk.AccAddress, permi
 		Permissions:      permissions,
 		VoteThreshold:    threshold,
 		ProposalDuration: duration,
+		MemberWeights:    memberWeights,
+		Quorum:           quorum,
+		EnactmentDelay:   enactmentDelay,
+	}
+}
+
+// NewStakeCommittee returns a committee where voting power is the amount of bonded tokens a voter has staked.
+func NewStakeCommittee(id uint64, description string, members []sdk.AccAddress, permissions []Permission, threshold sdk.Dec, duration time.Duration, quorum sdk.Dec, enactmentDelay time.Duration) Committee {
+	return Committee{
+		ID:               id,
+		Description:      description,
+		Members:          members,
+		Permissions:      permissions,
+		VoteThreshold:    threshold,
+		ProposalDuration: duration,
+		MemberWeights:    []MemberWeight{},
+		Quorum:           quorum,
+		Type:             StakeCommitteeType,
+		EnactmentDelay:   enactmentDelay,
+	}
+}
+
+// NewTokenCommittee returns a committee where voting power is the amount of the tally denom a voter holds.
+func NewTokenCommittee(id uint64, description string, members []sdk.AccAddress, permissions []Permission, threshold sdk.Dec, duration time.Duration, quorum sdk.Dec, tallyDenom string, enactmentDelay time.Duration) Committee {
+	return Committee{
+		ID:               id,
+		Description:      description,
+		Members:          members,
+		Permissions:      permissions,
+		VoteThreshold:    threshold,
+		ProposalDuration: duration,
+		MemberWeights:    []MemberWeight{},
+		Quorum:           quorum,
+		Type:             TokenCommitteeType,
+		TallyDenom:       tallyDenom,
+		EnactmentDelay:   enactmentDelay,
+	}
+}
+
+// CommitteeType specifies where the voting power of a committee comes from.
+type CommitteeType byte
+
+const (
+	MemberCommitteeType CommitteeType = 0x00 // voting power from committee membership
+	StakeCommitteeType  CommitteeType = 0x01 // voting power from staked tokens
+	TokenCommitteeType  CommitteeType = 0x02 // voting power from holdings of the tally denom
+)
+
+// CommitteeTypeFromString returns a CommitteeType from a string. It returns an error if the string is invalid.
+func CommitteeTypeFromString(str string) (CommitteeType, error) {
+	switch strings.ToLower(str) {
+	case "member":
+		return MemberCommitteeType, nil
+	case "stake":
+		return StakeCommitteeType, nil
+	case "token":
+		return TokenCommitteeType, nil
+	default:
+		return MemberCommitteeType, fmt.Errorf("'%s' is not a valid committee type", str)
+	}
+}
+
+// Validate returns an error if the committee type is not member, stake or token.
+func (ct CommitteeType) Validate() error {
+	switch ct {
+	case MemberCommitteeType, StakeCommitteeType, TokenCommitteeType:
+		return nil
+	default:
+		return fmt.Errorf("invalid committee type: %d", ct)
+	}
+}
+
+// MarshalJSON encodes the committee type as a string.
+func (ct CommitteeType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ct.String())
+}
+
+// UnmarshalJSON decodes a committee type from a string. Committees without a type are member committees.
+func (ct *CommitteeType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*ct = MemberCommitteeType
+		return nil
+	}
+	bz, err := CommitteeTypeFromString(s)
+	if err != nil {
+		return err
+	}
+	*ct = bz
+	return nil
+}
+
+// MarshalYAML encodes the committee type as a string.
+func (ct CommitteeType) MarshalYAML() (interface{}, error) {
+	return ct.String(), nil
+}
+
+// String implements the fmt.Stringer interface.
+func (ct CommitteeType) String() string {
+	switch ct {
+	case MemberCommitteeType:
+		return "member"
+	case StakeCommitteeType:
+		return "stake"
+	case TokenCommitteeType:
+		return "token"
+	default:
+		return ""
+	}
+}
+
+// MemberWeight is the voting weight of a committee member.
+type MemberWeight struct {
+	Member sdk.AccAddress `json:"member" yaml:"member"`
+	Weight sdk.Dec        `json:"weight" yaml:"weight"`
+}
+
+func NewMemberWeight(member sdk.AccAddress, weight sdk.Dec) MemberWeight {
+	return MemberWeight{
+		Member: member,
+		Weight: weight,
 	}
 }
 
@@ -47,9 +178,56 @@ func (c Committee) HasMember(addr sdk.AccAddress) bool {
 	return false
 }
 
+// IsMemberCommittee returns whether voting power in the committee comes from membership rather than token holdings.
+func (c Committee) IsMemberCommittee() bool {
+	return c.Type == MemberCommitteeType
+}
+
+// GetMemberWeight returns the voting weight of an address. Members without a configured weight have a weight of one, non members have zero weight.
+func (c Committee) GetMemberWeight(addr sdk.AccAddress) sdk.Dec {
+	if !c.HasMember(addr) {
+		return sdk.ZeroDec()
+	}
+	for _, mw := range c.MemberWeights {
+		if mw.Member.Equals(addr) {
+			return mw.Weight
+		}
+	}
+	return sdk.OneDec()
+}
+
+// TotalWeight returns the sum of the voting weights of all members.
+func (c Committee) TotalWeight() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, m := range c.Members {
+		total = total.Add(c.GetMemberWeight(m))
+	}
+	return total
+}
+
+// GetQuorum returns the committee quorum, committees without a quorum have a quorum of zero.
+func (c Committee) GetQuorum() sdk.Dec {
+	if c.Quorum.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return c.Quorum
+}
+
 // HasPermissionsFor returns whether the committee is authorized to enact a proposal.
 // As long as one permission allows the proposal then it goes through. Its the OR of all permissions.
+// Batch proposals are allowed when every one of their contents is allowed.
 func (c Committee) HasPermissionsFor(ctx sdk.Context, appCdc *codec.Codec, pk ParamKeeper, proposal PubProposal) bool {
+	if batch, ok := proposal.(BatchProposal); ok {
+		if len(batch.Contents) == 0 {
+			return false
+		}
+		for _, content := range batch.Contents {
+			if !c.HasPermissionsFor(ctx, appCdc, pk, content) {
+				return false
+			}
+		}
+		return true
+	}
 	for _, p := range c.Permissions {
 		if p.Allows(ctx, appCdc, pk, proposal) {
 			return true
@@ -85,6 +263,11 @@ func (c Committee) Validate() error {
 		if p == nil {
 			return fmt.Errorf("committee cannot have a nil permission")
 		}
+		if vp, ok := p.(validatablePermission); ok {
+			if err := vp.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 
 	// threshold must be in the range (0,1]
@@ -96,6 +279,43 @@ func (c Committee) Validate() error {
 		return fmt.Errorf("invalid proposal duration: %s", c.ProposalDuration)
 	}
 
+	if c.EnactmentDelay < 0 {
+		return fmt.Errorf("invalid enactment delay: %s", c.EnactmentDelay)
+	}
+
+	weightMap := make(map[string]bool, len(c.MemberWeights))
+	for _, mw := range c.MemberWeights {
+		if _, ok := weightMap[mw.Member.String()]; ok {
+			return fmt.Errorf("committee cannot have duplicate member weights, %s", mw.Member)
+		}
+		if !c.HasMember(mw.Member) {
+			return fmt.Errorf("member weight address %s is not a committee member", mw.Member)
+		}
+		if mw.Weight.IsNil() || !mw.Weight.IsPositive() {
+			return fmt.Errorf("invalid weight for member %s: %s", mw.Member, mw.Weight)
+		}
+		weightMap[mw.Member.String()] = true
+	}
+
+	// quorum must be in the range [0,1]
+	if !c.Quorum.IsNil() && (c.Quorum.IsNegative() || c.Quorum.GT(sdk.OneDec())) {
+		return fmt.Errorf("invalid quorum: %s", c.Quorum)
+	}
+
+	if err := c.Type.Validate(); err != nil {
+		return err
+	}
+	if !c.IsMemberCommittee() && len(c.MemberWeights) > 0 {
+		return fmt.Errorf("%s committee cannot have member weights", c.Type)
+	}
+	if c.Type == TokenCommitteeType {
+		if err := sdk.ValidateDenom(c.TallyDenom); err != nil {
+			return fmt.Errorf("invalid tally denom: %w", err)
+		}
+	} else if c.TallyDenom != "" {
+		return fmt.Errorf("%s committee cannot have a tally denom", c.Type)
+	}
+
 	return nil
 }
 
@@ -137,19 +357,209 @@ func (p Proposal) String() string {
 	return string(bz)
 }
 
+// QueuedProposal is a passed proposal waiting for its committee's enactment delay to pass before it is enacted.
+type QueuedProposal struct {
+	Proposal      `json:"proposal" yaml:"proposal"`
+	EnactmentTime time.Time `json:"enactment_time" yaml:"enactment_time"`
+}
+
+func NewQueuedProposal(proposal Proposal, enactmentTime time.Time) QueuedProposal {
+	return QueuedProposal{
+		Proposal:      proposal,
+		EnactmentTime: enactmentTime,
+	}
+}
+
+// IsEnactableBy returns whether the proposal's enactment delay has passed by a certain time.
+func (qp QueuedProposal) IsEnactableBy(time time.Time) bool {
+	return !time.Before(qp.EnactmentTime)
+}
+
+// Validate performs basic validation of queued proposal fields.
+func (qp QueuedProposal) Validate() error {
+	if qp.PubProposal == nil {
+		return fmt.Errorf("queued proposal %d has a nil pub proposal", qp.ID)
+	}
+	if err := qp.PubProposal.ValidateBasic(); err != nil {
+		return err
+	}
+	if qp.EnactmentTime.IsZero() {
+		return fmt.Errorf("queued proposal %d has no enactment time", qp.ID)
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (qp QueuedProposal) String() string {
+	bz, _ := yaml.Marshal(qp)
+	return string(bz)
+}
+
+// ArchivedProposal is the record of a closed proposal, kept after the proposal and its votes are deleted.
+type ArchivedProposal struct {
+	Proposal       `json:"proposal" yaml:"proposal"`
+	Tally          ProposalTally `json:"tally" yaml:"tally"`
+	Outcome        string        `json:"outcome" yaml:"outcome"`
+	EnactmentError string        `json:"enactment_error" yaml:"enactment_error"`
+	Votes          []Vote        `json:"votes" yaml:"votes"`
+	CloseHeight    int64         `json:"close_height" yaml:"close_height"`
+	CloseTime      time.Time     `json:"close_time" yaml:"close_time"`
+}
+
+// NewArchivedProposal returns a new ArchivedProposal
+func NewArchivedProposal(proposal Proposal, tally ProposalTally, outcome, enactmentError string, votes []Vote, closeHeight int64, closeTime time.Time) ArchivedProposal {
+	return ArchivedProposal{
+		Proposal:       proposal,
+		Tally:          tally,
+		Outcome:        outcome,
+		EnactmentError: enactmentError,
+		Votes:          votes,
+		CloseHeight:    closeHeight,
+		CloseTime:      closeTime,
+	}
+}
+
+// Validate performs basic validation of archived proposal fields.
+func (ap ArchivedProposal) Validate() error {
+	if ap.PubProposal == nil {
+		return fmt.Errorf("archived proposal %d has a nil pub proposal", ap.ID)
+	}
+	if err := ap.PubProposal.ValidateBasic(); err != nil {
+		return err
+	}
+	if ap.Tally.ProposalID != ap.ID {
+		return fmt.Errorf("archived proposal %d has a tally for proposal %d", ap.ID, ap.Tally.ProposalID)
+	}
+	if !IsValidProposalOutcome(ap.Outcome) {
+		return fmt.Errorf("archived proposal %d has an invalid outcome: %s", ap.ID, ap.Outcome)
+	}
+	if ap.EnactmentError != "" && ap.Outcome != AttributeValueProposalFailed {
+		return fmt.Errorf("archived proposal %d has an enactment error but did not fail", ap.ID)
+	}
+	for _, v := range ap.Votes {
+		if v.ProposalID != ap.ID {
+			return fmt.Errorf("archived proposal %d has a vote for proposal %d", ap.ID, v.ProposalID)
+		}
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+	if ap.CloseHeight < 0 {
+		return fmt.Errorf("archived proposal %d has a negative close height", ap.ID)
+	}
+	if ap.CloseTime.IsZero() {
+		return fmt.Errorf("archived proposal %d has no close time", ap.ID)
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (ap ArchivedProposal) String() string {
+	bz, _ := yaml.Marshal(ap)
+	return string(bz)
+}
+
+// IsValidProposalOutcome returns whether a string is the outcome of a closed proposal.
+func IsValidProposalOutcome(outcome string) bool {
+	switch outcome {
+	case AttributeValueProposalPassed, AttributeValueProposalFailed, AttributeValueProposalRejected,
+		AttributeValueProposalTimeout, AttributeValueProposalQueued, AttributeValueProposalVetoed:
+		return true
+	}
+	return false
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
 
+// VoteType is the option a voter chose when voting on a proposal.
+type VoteType byte
+
+const (
+	NullVoteType VoteType = 0x00
+	Yes          VoteType = 0x01
+	No           VoteType = 0x02
+	Abstain      VoteType = 0x03
+)
+
+// VoteTypeFromString returns a VoteType from a string. It returns an error if the string is invalid.
+func VoteTypeFromString(str string) (VoteType, error) {
+	switch strings.ToLower(str) {
+	case "yes", "y":
+		return Yes, nil
+	case "no", "n":
+		return No, nil
+	case "abstain", "a":
+		return Abstain, nil
+	default:
+		return NullVoteType, fmt.Errorf("'%s' is not a valid vote type", str)
+	}
+}
+
+// Validate returns an error if the vote type is not yes, no or abstain.
+func (vt VoteType) Validate() error {
+	switch vt {
+	case Yes, No, Abstain:
+		return nil
+	default:
+		return fmt.Errorf("invalid vote type: %d", vt)
+	}
+}
+
+// MarshalJSON encodes the vote type as a string.
+func (vt VoteType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(vt.String())
+}
+
+// UnmarshalJSON decodes a vote type from a string.
+func (vt *VoteType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*vt = NullVoteType
+		return nil
+	}
+	bz, err := VoteTypeFromString(s)
+	if err != nil {
+		return err
+	}
+	*vt = bz
+	return nil
+}
+
+// MarshalYAML encodes the vote type as a string.
+func (vt VoteType) MarshalYAML() (interface{}, error) {
+	return vt.String(), nil
+}
+
+// String implements the fmt.Stringer interface.
+func (vt VoteType) String() string {
+	switch vt {
+	case Yes:
+		return "Yes"
+	case No:
+		return "No"
+	case Abstain:
+		return "Abstain"
+	default:
+		return ""
+	}
+}
+
 type Vote struct {
 	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
 	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	VoteType   VoteType       `json:"vote_type" yaml:"vote_type"`
 }
 
-func NewVote(proposalID uint64, voter sdk.AccAddress) Vote {
+func NewVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType) Vote {
 	return Vote{
 		ProposalID: proposalID,
 		Voter:      voter,
+		VoteType:   voteType,
 	}
 }
 
@@ -157,5 +567,90 @@ func (v Vote) Validate() error {
 	if v.Voter.Empty() {
 		return fmt.Errorf("voter address cannot be empty")
 	}
+	return v.VoteType.Validate()
+}
+
+// VotingPowerSnapshot records the voting power of a voter in a stake or token committee when they first voted on a proposal.
+// Tokens held by voters in token committees are escrowed until the proposal closes so they cannot be transferred and voted with again.
+type VotingPowerSnapshot struct {
+	ProposalID  uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Voter       sdk.AccAddress `json:"voter" yaml:"voter"`
+	VotingPower sdk.Int        `json:"voting_power" yaml:"voting_power"`
+	Escrow      sdk.Coins      `json:"escrow" yaml:"escrow"`
+}
+
+func NewVotingPowerSnapshot(proposalID uint64, voter sdk.AccAddress, votingPower sdk.Int, escrow sdk.Coins) VotingPowerSnapshot {
+	return VotingPowerSnapshot{
+		ProposalID:  proposalID,
+		Voter:       voter,
+		VotingPower: votingPower,
+		Escrow:      escrow,
+	}
+}
+
+func (vps VotingPowerSnapshot) Validate() error {
+	if vps.Voter.Empty() {
+		return fmt.Errorf("voter address cannot be empty")
+	}
+	if vps.VotingPower.IsNil() || !vps.VotingPower.IsPositive() {
+		return fmt.Errorf("invalid voting power: %s", vps.VotingPower)
+	}
+	if !vps.Escrow.IsValid() {
+		return fmt.Errorf("invalid escrow: %s", vps.Escrow)
+	}
 	return nil
 }
+
+// ------------------------------------------
+//				Tally
+// ------------------------------------------
+
+// ProposalTally is the breakdown of the weighted votes on a proposal.
+type ProposalTally struct {
+	ProposalID    uint64  `json:"proposal_id" yaml:"proposal_id"`
+	YesVotes      sdk.Dec `json:"yes_votes" yaml:"yes_votes"`
+	NoVotes       sdk.Dec `json:"no_votes" yaml:"no_votes"`
+	AbstainVotes  sdk.Dec `json:"abstain_votes" yaml:"abstain_votes"`
+	PossibleVotes sdk.Dec `json:"possible_votes" yaml:"possible_votes"`
+	VoteThreshold sdk.Dec `json:"vote_threshold" yaml:"vote_threshold"`
+	Quorum        sdk.Dec `json:"quorum" yaml:"quorum"`
+}
+
+func NewProposalTally(proposalID uint64, yesVotes, noVotes, abstainVotes, possibleVotes, voteThreshold, quorum sdk.Dec) ProposalTally {
+	return ProposalTally{
+		ProposalID:    proposalID,
+		YesVotes:      yesVotes,
+		NoVotes:       noVotes,
+		AbstainVotes:  abstainVotes,
+		PossibleVotes: possibleVotes,
+		VoteThreshold: voteThreshold,
+		Quorum:        quorum,
+	}
+}
+
+// TotalVotes returns the weight of all votes cast, including abstentions.
+func (t ProposalTally) TotalVotes() sdk.Dec {
+	return t.YesVotes.Add(t.NoVotes).Add(t.AbstainVotes)
+}
+
+// QuorumReached returns whether enough of the possible vote weight has voted.
+func (t ProposalTally) QuorumReached() bool {
+	return t.TotalVotes().GTE(t.Quorum.Mul(t.PossibleVotes))
+}
+
+// Passes returns whether the proposal has enough yes votes and has reached quorum.
+func (t ProposalTally) Passes() bool {
+	return t.YesVotes.IsPositive() && t.YesVotes.GTE(t.VoteThreshold.Mul(t.PossibleVotes)) && t.QuorumReached()
+}
+
+// CannotPass returns whether the proposal can no longer pass, even if all members yet to vote were to vote yes.
+func (t ProposalTally) CannotPass() bool {
+	remainingVotes := t.PossibleVotes.Sub(t.TotalVotes())
+	return t.YesVotes.Add(remainingVotes).LT(t.VoteThreshold.Mul(t.PossibleVotes))
+}
+
+// String implements the fmt.Stringer interface.
+func (t ProposalTally) String() string {
+	bz, _ := yaml.Marshal(t)
+	return string(bz)
+}
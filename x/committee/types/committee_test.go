@@ -0,0 +1,299 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+func TestCommittee_Validate(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest2"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest3"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest4"))),
+	}
+	testCom := NewCommittee(
+		1, "This committee is for testing.", addresses[:3], []Permission{GodPermission{}},
+		d("0.667"), time.Hour*24*7, []MemberWeight{NewMemberWeight(addresses[0], d("2"))}, d("0.5"), 0,
+	)
+
+	testCases := []struct {
+		name       string
+		modify     func(com Committee) Committee
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			modify:     func(com Committee) Committee { return com },
+			expectPass: true,
+		},
+		{
+			name: "nil quorum",
+			modify: func(com Committee) Committee {
+				com.Quorum = sdk.Dec{}
+				return com
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative quorum",
+			modify: func(com Committee) Committee {
+				com.Quorum = d("-0.1")
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "quorum above one",
+			modify: func(com Committee) Committee {
+				com.Quorum = d("1.1")
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate member weights",
+			modify: func(com Committee) Committee {
+				com.MemberWeights = []MemberWeight{NewMemberWeight(addresses[0], d("2")), NewMemberWeight(addresses[0], d("3"))}
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "member weight for non member",
+			modify: func(com Committee) Committee {
+				com.MemberWeights = []MemberWeight{NewMemberWeight(addresses[3], d("2"))}
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero member weight",
+			modify: func(com Committee) Committee {
+				com.MemberWeights = []MemberWeight{NewMemberWeight(addresses[0], d("0"))}
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "token committee",
+			modify: func(com Committee) Committee {
+				return NewTokenCommittee(com.ID, com.Description, com.Members, com.Permissions, com.VoteThreshold, com.ProposalDuration, com.Quorum, "hard", 0)
+			},
+			expectPass: true,
+		},
+		{
+			name: "token committee with invalid tally denom",
+			modify: func(com Committee) Committee {
+				return NewTokenCommittee(com.ID, com.Description, com.Members, com.Permissions, com.VoteThreshold, com.ProposalDuration, com.Quorum, "", 0)
+			},
+			expectPass: false,
+		},
+		{
+			name: "token committee with member weights",
+			modify: func(com Committee) Committee {
+				com.Type = TokenCommitteeType
+				com.TallyDenom = "hard"
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "stake committee",
+			modify: func(com Committee) Committee {
+				return NewStakeCommittee(com.ID, com.Description, com.Members, com.Permissions, com.VoteThreshold, com.ProposalDuration, com.Quorum, 0)
+			},
+			expectPass: true,
+		},
+		{
+			name: "stake committee with tally denom",
+			modify: func(com Committee) Committee {
+				com = NewStakeCommittee(com.ID, com.Description, com.Members, com.Permissions, com.VoteThreshold, com.ProposalDuration, com.Quorum, 0)
+				com.TallyDenom = "hard"
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid committee type",
+			modify: func(com Committee) Committee {
+				com.Type = CommitteeType(0x09)
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "enactment delay",
+			modify: func(com Committee) Committee {
+				com.EnactmentDelay = time.Hour * 24
+				return com
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative enactment delay",
+			modify: func(com Committee) Committee {
+				com.EnactmentDelay = -time.Hour
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "veto permission",
+			modify: func(com Committee) Committee {
+				com.Permissions = []Permission{NewVetoPermission([]uint64{2, 3})}
+				return com
+			},
+			expectPass: true,
+		},
+		{
+			name: "veto permission with duplicate committees",
+			modify: func(com Committee) Committee {
+				com.Permissions = []Permission{NewVetoPermission([]uint64{2, 2})}
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "veto permission without committees",
+			modify: func(com Committee) Committee {
+				com.Permissions = []Permission{NewVetoPermission(nil)}
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "action permissions",
+			modify: func(com Committee) Committee {
+				com.Permissions = []Permission{
+					NewMoneyMarketPausePermission([]string{"bnb"}),
+					NewSeizeCoinsPermission([]string{"usdtoken"}),
+					NewLiquidationSweepPermission([]string{"bnb-a"}),
+				}
+				return com
+			},
+			expectPass: true,
+		},
+		{
+			name: "money market pause permission without denoms",
+			modify: func(com Committee) Committee {
+				com.Permissions = []Permission{NewMoneyMarketPausePermission(nil)}
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "seize coins permission with duplicate denoms",
+			modify: func(com Committee) Committee {
+				com.Permissions = []Permission{NewSeizeCoinsPermission([]string{"usdtoken", "usdtoken"})}
+				return com
+			},
+			expectPass: false,
+		},
+		{
+			name: "liquidation sweep permission with blank collateral type",
+			modify: func(com Committee) Committee {
+				com.Permissions = []Permission{NewLiquidationSweepPermission([]string{" "})}
+				return com
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.modify(testCom).Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.Equal(t, d("2"), testCom.GetMemberWeight(addresses[0]))
+	require.Equal(t, d("1"), testCom.GetMemberWeight(addresses[1]))
+	require.Equal(t, d("0"), testCom.GetMemberWeight(addresses[3]))
+	require.Equal(t, d("4"), testCom.TotalWeight())
+}
+
+func TestVoteTypeFromString(t *testing.T) {
+	testCases := []struct {
+		input      string
+		expected   VoteType
+		expectPass bool
+	}{
+		{"yes", Yes, true},
+		{"Y", Yes, true},
+		{"no", No, true},
+		{"abstain", Abstain, true},
+		{"a", Abstain, true},
+		{"maybe", NullVoteType, false},
+		{"", NullVoteType, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			voteType, err := VoteTypeFromString(tc.input)
+			if tc.expectPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, voteType)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProposalTally(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tally         ProposalTally
+		expectPasses  bool
+		expectRejects bool
+	}{
+		{
+			name:          "threshold reached",
+			tally:         NewProposalTally(1, d("7"), d("1"), d("0"), d("10"), d("0.667"), d("0")),
+			expectPasses:  true,
+			expectRejects: false,
+		},
+		{
+			name:          "threshold not reached but can pass",
+			tally:         NewProposalTally(1, d("5"), d("1"), d("0"), d("10"), d("0.667"), d("0")),
+			expectPasses:  false,
+			expectRejects: false,
+		},
+		{
+			name:          "too many no and abstain votes",
+			tally:         NewProposalTally(1, d("5"), d("2"), d("2"), d("10"), d("0.667"), d("0")),
+			expectPasses:  false,
+			expectRejects: true,
+		},
+		{
+			name:          "threshold reached but quorum not reached",
+			tally:         NewProposalTally(1, d("3"), d("0"), d("0"), d("10"), d("0.3"), d("0.5")),
+			expectPasses:  false,
+			expectRejects: false,
+		},
+		{
+			name:          "quorum reached with abstain votes",
+			tally:         NewProposalTally(1, d("3"), d("0"), d("2"), d("10"), d("0.3"), d("0.5")),
+			expectPasses:  true,
+			expectRejects: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectPasses, tc.tally.Passes())
+			require.Equal(t, tc.expectRejects, tc.tally.CannotPass())
+		})
+	}
+}
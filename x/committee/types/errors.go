@@ -14,4 +14,8 @@ var (
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "pubproposal has no corresponding handler")
 	ErrUnknownSubspace         = sdkerrors.Register(ModuleName, 10, "subspace not found")
+	ErrInvalidVoteType         = sdkerrors.Register(ModuleName, 11, "invalid vote type")
+	ErrNoVotingPower           = sdkerrors.Register(ModuleName, 12, "voter has no voting power")
+	ErrUnknownQueuedProposal   = sdkerrors.Register(ModuleName, 13, "queued proposal not found")
+	ErrUnknownArchivedProposal = sdkerrors.Register(ModuleName, 14, "archived proposal not found")
 )
@@ -5,13 +5,21 @@ const (
 	EventTypeProposalSubmit = "proposal_submit"
 	EventTypeProposalClose  = "proposal_close"
 	EventTypeProposalVote   = "proposal_vote"
+	EventTypeProposalQueue  = "proposal_queue"
+	EventTypeProposalEnact  = "proposal_enact"
+	EventTypeProposalVeto   = "proposal_veto"
 
 	AttributeValueCategory          = "committee"
 	AttributeKeyCommitteeID         = "committee_id"
 	AttributeKeyProposalID          = "proposal_id"
 	AttributeKeyProposalCloseStatus = "status"
 	AttributeKeyVoter               = "voter"
+	AttributeKeyVoteType            = "vote_type"
+	AttributeKeyEnactmentTime       = "enactment_time"
 	AttributeValueProposalPassed    = "proposal_passed"
 	AttributeValueProposalTimeout   = "proposal_timeout"
 	AttributeValueProposalFailed    = "proposal_failed"
+	AttributeValueProposalRejected  = "proposal_rejected"
+	AttributeValueProposalQueued    = "proposal_queued"
+	AttributeValueProposalVetoed    = "proposal_vetoed"
 )
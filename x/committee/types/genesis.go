@@ -14,15 +14,28 @@ type GenesisState struct {
 	Committees     []Committee `json:"committees" yaml:"committees"`
 	Proposals      []Proposal  `json:"proposals" yaml:"proposals"`
 	Votes          []Vote      `json:"votes" yaml:"votes"`
+
+	VotingPowerSnapshots []VotingPowerSnapshot `json:"voting_power_snapshots" yaml:"voting_power_snapshots"`
+	ParamChangeTimes     []ParamChangeTime     `json:"param_change_times" yaml:"param_change_times"`
+	QueuedProposals      []QueuedProposal      `json:"queued_proposals" yaml:"queued_proposals"`
+
+	Params            Params             `json:"params" yaml:"params"`
+	ArchivedProposals []ArchivedProposal `json:"archived_proposals" yaml:"archived_proposals"`
 }
 
 // NewGenesisState returns a new genesis state object for the module.
-func NewGenesisState(nextProposalID uint64, committees []Committee, proposals []Proposal, votes []Vote) GenesisState {
+func NewGenesisState(nextProposalID uint64, committees []Committee, proposals []Proposal, votes []Vote, snapshots []VotingPowerSnapshot, paramChangeTimes []ParamChangeTime, queuedProposals []QueuedProposal,
+	params Params, archivedProposals []ArchivedProposal) GenesisState {
 	return GenesisState{
-		NextProposalID: nextProposalID,
-		Committees:     committees,
-		Proposals:      proposals,
-		Votes:          votes,
+		NextProposalID:       nextProposalID,
+		Committees:           committees,
+		Proposals:            proposals,
+		Votes:                votes,
+		VotingPowerSnapshots: snapshots,
+		ParamChangeTimes:     paramChangeTimes,
+		QueuedProposals:      queuedProposals,
+		Params:               params,
+		ArchivedProposals:    archivedProposals,
 	}
 }
 
@@ -33,6 +46,11 @@ func DefaultGenesisState() GenesisState {
 		[]Committee{},
 		[]Proposal{},
 		[]Vote{},
+		[]VotingPowerSnapshot{},
+		[]ParamChangeTime{},
+		[]QueuedProposal{},
+		DefaultParams(),
+		[]ArchivedProposal{},
 	)
 }
 
@@ -91,6 +109,7 @@ func (gs GenesisState) Validate() error {
 	}
 
 	// validate votes
+	voteMap := make(map[string]bool, len(gs.Votes))
 	for _, v := range gs.Votes {
 		// validate committee
 		if err := v.Validate(); err != nil {
@@ -101,6 +120,77 @@ func (gs GenesisState) Validate() error {
 		if !proposalMap[v.ProposalID] {
 			return fmt.Errorf("vote refers to non existent proposal; vote: %+v", v)
 		}
+		voteMap[string(GetVoteKey(v.ProposalID, v.Voter))] = true
+	}
+
+	// validate voting power snapshots
+	snapshotMap := make(map[string]bool, len(gs.VotingPowerSnapshots))
+	for _, vps := range gs.VotingPowerSnapshots {
+		if err := vps.Validate(); err != nil {
+			return err
+		}
+
+		// check there are no duplicate snapshots and each snapshot has a corresponding vote
+		key := string(GetVoteKey(vps.ProposalID, vps.Voter))
+		if snapshotMap[key] {
+			return fmt.Errorf("duplicate voting power snapshot found in genesis state; snapshot: %+v", vps)
+		}
+		snapshotMap[key] = true
+		if !voteMap[key] {
+			return fmt.Errorf("voting power snapshot refers to non existent vote; snapshot: %+v", vps)
+		}
+	}
+
+	// validate param change times
+	paramChangeTimeMap := make(map[string]bool, len(gs.ParamChangeTimes))
+	for _, pct := range gs.ParamChangeTimes {
+		if err := pct.Validate(); err != nil {
+			return err
+		}
+		key := string(GetParamChangeTimeKey(pct.Subspace, pct.Key, pct.ID, pct.Field))
+		if paramChangeTimeMap[key] {
+			return fmt.Errorf("duplicate param change time found in genesis state; param change time: %+v", pct)
+		}
+		paramChangeTimeMap[key] = true
+	}
+
+	// validate queued proposals
+	for _, qp := range gs.QueuedProposals {
+		// check there are no duplicate IDs, queued proposals are no longer stored as proposals
+		if _, ok := proposalMap[qp.ID]; ok {
+			return fmt.Errorf("duplicate proposal ID found in genesis state; id: %d", qp.ID)
+		}
+		proposalMap[qp.ID] = true
+
+		if qp.ID >= gs.NextProposalID {
+			return fmt.Errorf("NextProposalID is not greater than all proposal IDs; id: %d", qp.ID)
+		}
+
+		if err := qp.Validate(); err != nil {
+			return fmt.Errorf("queued proposal %d invalid: %w", qp.ID, err)
+		}
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// validate archived proposals
+	archivedProposalMap := make(map[uint64]bool, len(gs.ArchivedProposals))
+	for _, ap := range gs.ArchivedProposals {
+		// check there are no duplicate IDs, archived proposals can share an ID with a queued proposal
+		if archivedProposalMap[ap.ID] {
+			return fmt.Errorf("duplicate archived proposal ID found in genesis state; id: %d", ap.ID)
+		}
+		archivedProposalMap[ap.ID] = true
+
+		if ap.ID >= gs.NextProposalID {
+			return fmt.Errorf("NextProposalID is not greater than all proposal IDs; id: %d", ap.ID)
+		}
+
+		if err := ap.Validate(); err != nil {
+			return fmt.Errorf("archived proposal %d invalid: %w", ap.ID, err)
+		}
 	}
 	return nil
 }
@@ -45,8 +45,8 @@ func TestGenesisState_Validate(t *testing.T) {
 			{ID: 1, CommitteeID: 1, PubProposal: govtypes.NewTextProposal("A Title", "A description of this proposal."), Deadline: testTime.Add(7 * 24 * time.Hour)},
 		},
 		Votes: []Vote{
-			{ProposalID: 1, Voter: addresses[0]},
-			{ProposalID: 1, Voter: addresses[1]},
+			{ProposalID: 1, Voter: addresses[0], VoteType: Yes},
+			{ProposalID: 1, Voter: addresses[1], VoteType: Yes},
 		},
 	}
 
@@ -151,6 +151,139 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "voting power snapshot",
+			genState: GenesisState{
+				NextProposalID:       testGenesis.NextProposalID,
+				Committees:           testGenesis.Committees,
+				Proposals:            testGenesis.Proposals,
+				Votes:                testGenesis.Votes,
+				VotingPowerSnapshots: []VotingPowerSnapshot{NewVotingPowerSnapshot(1, addresses[0], sdk.NewInt(100), sdk.NewCoins())},
+			},
+			expectPass: true,
+		},
+		{
+			name: "voting power snapshot without vote",
+			genState: GenesisState{
+				NextProposalID:       testGenesis.NextProposalID,
+				Committees:           testGenesis.Committees,
+				Proposals:            testGenesis.Proposals,
+				Votes:                testGenesis.Votes,
+				VotingPowerSnapshots: []VotingPowerSnapshot{NewVotingPowerSnapshot(1, addresses[2], sdk.NewInt(100), sdk.NewCoins())},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid voting power snapshot",
+			genState: GenesisState{
+				NextProposalID:       testGenesis.NextProposalID,
+				Committees:           testGenesis.Committees,
+				Proposals:            testGenesis.Proposals,
+				Votes:                testGenesis.Votes,
+				VotingPowerSnapshots: []VotingPowerSnapshot{NewVotingPowerSnapshot(1, addresses[0], sdk.ZeroInt(), sdk.NewCoins())},
+			},
+			expectPass: false,
+		},
+		{
+			name: "queued proposal",
+			genState: GenesisState{
+				NextProposalID:  testGenesis.NextProposalID + 1,
+				Committees:      testGenesis.Committees,
+				Proposals:       testGenesis.Proposals,
+				Votes:           testGenesis.Votes,
+				QueuedProposals: []QueuedProposal{NewQueuedProposal(NewProposal(govtypes.NewTextProposal("A Title", "A description of this proposal."), 2, 1, testTime), testTime)},
+			},
+			expectPass: true,
+		},
+		{
+			name: "queued proposal with duplicate ID",
+			genState: GenesisState{
+				NextProposalID:  testGenesis.NextProposalID,
+				Committees:      testGenesis.Committees,
+				Proposals:       testGenesis.Proposals,
+				Votes:           testGenesis.Votes,
+				QueuedProposals: []QueuedProposal{NewQueuedProposal(testGenesis.Proposals[0], testTime)},
+			},
+			expectPass: false,
+		},
+		{
+			name: "queued proposal without enactment time",
+			genState: GenesisState{
+				NextProposalID:  testGenesis.NextProposalID + 1,
+				Committees:      testGenesis.Committees,
+				Proposals:       testGenesis.Proposals,
+				Votes:           testGenesis.Votes,
+				QueuedProposals: []QueuedProposal{NewQueuedProposal(NewProposal(govtypes.NewTextProposal("A Title", "A description of this proposal."), 2, 1, testTime), time.Time{})},
+			},
+			expectPass: false,
+		},
+		{
+			name: "archived proposal",
+			genState: GenesisState{
+				NextProposalID:    testGenesis.NextProposalID + 1,
+				Committees:        testGenesis.Committees,
+				Proposals:         testGenesis.Proposals,
+				Votes:             testGenesis.Votes,
+				Params:            NewParams(time.Hour * 24 * 30),
+				ArchivedProposals: []ArchivedProposal{testArchivedProposal(2, AttributeValueProposalRejected, testTime)},
+			},
+			expectPass: true,
+		},
+		{
+			name: "archived proposal with duplicate ID",
+			genState: GenesisState{
+				NextProposalID:    testGenesis.NextProposalID + 1,
+				Committees:        testGenesis.Committees,
+				Proposals:         testGenesis.Proposals,
+				Votes:             testGenesis.Votes,
+				ArchivedProposals: []ArchivedProposal{testArchivedProposal(2, AttributeValueProposalRejected, testTime), testArchivedProposal(2, AttributeValueProposalPassed, testTime)},
+			},
+			expectPass: false,
+		},
+		{
+			name: "archived proposal with ID not below next proposal ID",
+			genState: GenesisState{
+				NextProposalID:    testGenesis.NextProposalID,
+				Committees:        testGenesis.Committees,
+				Proposals:         testGenesis.Proposals,
+				Votes:             testGenesis.Votes,
+				ArchivedProposals: []ArchivedProposal{testArchivedProposal(2, AttributeValueProposalRejected, testTime)},
+			},
+			expectPass: false,
+		},
+		{
+			name: "archived proposal with invalid outcome",
+			genState: GenesisState{
+				NextProposalID:    testGenesis.NextProposalID + 1,
+				Committees:        testGenesis.Committees,
+				Proposals:         testGenesis.Proposals,
+				Votes:             testGenesis.Votes,
+				ArchivedProposals: []ArchivedProposal{testArchivedProposal(2, "proposal_unknown", testTime)},
+			},
+			expectPass: false,
+		},
+		{
+			name: "archived proposal without close time",
+			genState: GenesisState{
+				NextProposalID:    testGenesis.NextProposalID + 1,
+				Committees:        testGenesis.Committees,
+				Proposals:         testGenesis.Proposals,
+				Votes:             testGenesis.Votes,
+				ArchivedProposals: []ArchivedProposal{testArchivedProposal(2, AttributeValueProposalRejected, time.Time{})},
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative archive retention",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				Params:         NewParams(-time.Hour),
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -167,3 +300,15 @@ func TestGenesisState_Validate(t *testing.T) {
 	}
 
 }
+
+func testArchivedProposal(id uint64, outcome string, closeTime time.Time) ArchivedProposal {
+	return NewArchivedProposal(
+		NewProposal(govtypes.NewTextProposal("A Title", "A description of this proposal."), id, 1, closeTime),
+		NewProposalTally(id, d("0"), d("1"), d("0"), d("3"), d("0.667"), d("0")),
+		outcome,
+		"",
+		nil,
+		1,
+		closeTime,
+	)
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/binary"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -30,6 +32,13 @@ var (
 	VoteKeyPrefix      = []byte{0x02} // prefix for keys that store votes
 
 	NextProposalIDKey = []byte{0x03} // key for the next proposal id
+
+	VotingPowerSnapshotKeyPrefix = []byte{0x04} // prefix for keys that store voting power snapshots
+	ParamChangeTimeKeyPrefix     = []byte{0x05} // prefix for keys that store the last change times of bounded param fields
+	QueuedProposalKeyPrefix      = []byte{0x06} // prefix for keys that store passed proposals waiting to be enacted
+
+	ArchivedProposalKeyPrefix       = []byte{0x07} // prefix for keys that store closed proposals
+	ArchivedProposalByTimeKeyPrefix = []byte{0x08} // prefix for keys that are part of the archived proposals by close time index
 )
 
 // GetKeyFromID returns the bytes to use as a key for a uint64 id
@@ -41,6 +50,16 @@ func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	return append(GetKeyFromID(proposalID), voter.Bytes()...)
 }
 
+// GetArchivedProposalByTimeKey returns the key for iterating archived proposals by close time
+func GetArchivedProposalByTimeKey(closeTime time.Time, proposalID uint64) []byte {
+	return append(sdk.FormatTimeBytes(closeTime), GetKeyFromID(proposalID)...)
+}
+
+// GetParamChangeTimeKey returns the key for the last change time of a bounded param field
+func GetParamChangeTimeKey(subspace, key, id, field string) []byte {
+	return []byte(strings.Join([]string{subspace, key, id, field}, "/"))
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
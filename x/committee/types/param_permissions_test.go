@@ -18,9 +18,9 @@ func cs(coins ...sdk.Coin) sdk.Coins        { return sdk.NewCoins(coins...) }
 
 func (suite *PermissionsTestSuite) TestAllowedCollateralParams_Allows() {
 	testCPs := cdptypes.CollateralParams{
-		cdptypes.NewCollateralParam("bnb", "bnb-a", d("2.0"), c("usdx", 1000000000000), d("1.000000001547125958"), i(100), d("0.05"), 0x20, "bnb:usd", "bnb:usd", d("0.01"), i(10), i(6)),
-		cdptypes.NewCollateralParam("btc", "btc-a", d("1.5"), c("usdx", 1000000000), d("1.000000001547125958"), i(1000), d("0.1"), 0x30, "btc:usd", "btc:usd", d("0.01"), i(10), i(8)),
-		cdptypes.NewCollateralParam("atom", "atom-a", d("2.0"), c("usdx", 1000000000), d("1.000000001547125958"), i(1000), d("0.07"), 0x40, "atom:usd", "atom:usd", d("0.01"), i(10), i(6)),
+		cdptypes.NewCollateralParam("bnb", "bnb-a", d("2.0"), c("usdx", 1000000000000), d("1.000000001547125958"), i(100), d("0.05"), 0x20, "bnb:usd", "bnb:usd", d("0.01"), i(10), i(6), cdptypes.EnglishAuctionType),
+		cdptypes.NewCollateralParam("btc", "btc-a", d("1.5"), c("usdx", 1000000000), d("1.000000001547125958"), i(1000), d("0.1"), 0x30, "btc:usd", "btc:usd", d("0.01"), i(10), i(8), cdptypes.EnglishAuctionType),
+		cdptypes.NewCollateralParam("atom", "atom-a", d("2.0"), c("usdx", 1000000000), d("1.000000001547125958"), i(1000), d("0.07"), 0x40, "atom:usd", "atom:usd", d("0.01"), i(10), i(6), cdptypes.EnglishAuctionType),
 	}
 	updatedTestCPs := make(cdptypes.CollateralParams, len(testCPs))
 	updatedTestCPs[0] = testCPs[1]
@@ -411,6 +411,7 @@ func (suite *PermissionsTestSuite) TestAllowedCollateralParam_Allows() {
 		d("0.01"),
 		i(10),
 		i(8),
+		cdptypes.EnglishAuctionType,
 	)
 	newMarketIDCP := testCP
 	newMarketIDCP.SpotMarketID = "btc:usd"
@@ -422,6 +423,9 @@ func (suite *PermissionsTestSuite) TestAllowedCollateralParam_Allows() {
 	newMarketIDCP.SpotMarketID = "btc:usd"
 	newDebtLimitCP.DebtLimit = c("usdx", 1000)
 
+	newAuctionTypeCP := testCP
+	newAuctionTypeCP.AuctionType = cdptypes.DutchAuctionType
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedCollateralParam
@@ -484,6 +488,26 @@ func (suite *PermissionsTestSuite) TestAllowedCollateralParam_Allows() {
 			incoming:      newMarketIDAndDebtLimitCP,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed auction type change",
+			allowed: AllowedCollateralParam{
+				Type:        "bnb-a",
+				AuctionType: true,
+			},
+			current:       testCP,
+			incoming:      newAuctionTypeCP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed auction type change",
+			allowed: AllowedCollateralParam{
+				Type:      "bnb-a",
+				DebtLimit: true,
+			},
+			current:       testCP,
+			incoming:      newAuctionTypeCP,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -616,6 +640,20 @@ func (suite *PermissionsTestSuite) TestAllowedAssetParam_Allows() {
 	newCoinidAndLimitAP.CoinID = 0
 	newCoinidAndLimitAP.SupplyLimit.Limit = i(1000)
 
+	newDeputiesAP := testAP
+	newDeputiesAP.Deputies = bep3types.DeputyParams{
+		bep3types.NewDeputyParam(sdk.AccAddress(crypto.AddressHash([]byte("KavaTestUser2"))), sdk.NewInt(2000), sdk.ZeroInt()),
+	}
+
+	testFeeSchedule := bep3types.NewFeeSchedule(nil, sdk.MustNewDecFromStr("0.001"), sdk.NewInt(1000), 10, sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.9"))
+
+	newFeeScheduleAP := testAP
+	newFeeScheduleAP.FeeSchedule = testFeeSchedule
+
+	newDeputyFeeScheduleAP := newDeputiesAP
+	newDeputyFeeScheduleAP.Deputies = bep3types.DeputyParams{newDeputiesAP.Deputies[0]}
+	newDeputyFeeScheduleAP.Deputies[0].FeeSchedule = testFeeSchedule
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedAssetParam
@@ -663,6 +701,66 @@ func (suite *PermissionsTestSuite) TestAllowedAssetParam_Allows() {
 			incoming:      newCoinidAndLimitAP,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed deputies change",
+			allowed: AllowedAssetParam{
+				Denom:    "usdx",
+				Deputies: true,
+			},
+			current:       testAP,
+			incoming:      newDeputiesAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed deputies change",
+			allowed: AllowedAssetParam{
+				Denom: "usdx",
+				Limit: true,
+			},
+			current:       testAP,
+			incoming:      newDeputiesAP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed fee schedule change",
+			allowed: AllowedAssetParam{
+				Denom:       "usdx",
+				FeeSchedule: true,
+			},
+			current:       testAP,
+			incoming:      newFeeScheduleAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed fee schedule change",
+			allowed: AllowedAssetParam{
+				Denom:    "usdx",
+				Deputies: true,
+			},
+			current:       testAP,
+			incoming:      newFeeScheduleAP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed deputy fee schedule change",
+			allowed: AllowedAssetParam{
+				Denom:       "usdx",
+				FeeSchedule: true,
+			},
+			current:       newDeputiesAP,
+			incoming:      newDeputyFeeScheduleAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed deputies change with allowed fee schedule change",
+			allowed: AllowedAssetParam{
+				Denom:       "usdx",
+				FeeSchedule: true,
+			},
+			current:       testAP,
+			incoming:      newDeputyFeeScheduleAP,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -703,6 +801,32 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 	newOraclesAndActiveM.Oracles = nil
 	newOraclesAndActiveM.Active = false
 
+	newTWAPM := testM
+	newTWAPM.TWAP = pricefeedtypes.NewTWAPSource("bnb:usd:30", 30*24*time.Hour)
+
+	newDerivedM := testM
+	newDerivedM.Derived = pricefeedtypes.NewDerivedSource(pricefeedtypes.DerivedInputs{
+		pricefeedtypes.NewDerivedInput("bnb:btc", false),
+		pricefeedtypes.NewDerivedInput("btc:usd", false),
+	})
+
+	guard := pricefeedtypes.NewPriceGuard(sdk.MustNewDecFromStr("0.1"), 2, time.Hour, 0)
+	guardedM := testM
+	guardedM.Guard = &guard
+
+	sameGuard := pricefeedtypes.NewPriceGuard(sdk.MustNewDecFromStr("0.10"), 2, time.Hour, 0)
+	sameGuardedM := testM
+	sameGuardedM.Guard = &sameGuard
+
+	weightedM := testM
+	weightedM.OracleWeights = pricefeedtypes.OracleWeights{pricefeedtypes.NewOracleWeight(testM.Oracles[0], 2)}
+
+	commitRevealM := testM
+	commitRevealM.VotePeriod = 5
+
+	historyM := testM
+	historyM.PriceHistory = 1000
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedMarket
@@ -750,6 +874,136 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 			incoming:      newOraclesAndActiveM,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed twap change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				TWAP:     true,
+			},
+			current:       testM,
+			incoming:      newTWAPM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed twap change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Active:   true,
+			},
+			current:       testM,
+			incoming:      newTWAPM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed derived change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Derived:  true,
+			},
+			current:       testM,
+			incoming:      newDerivedM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed derived change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				TWAP:     true,
+			},
+			current:       testM,
+			incoming:      newDerivedM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed guard change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Guard:    true,
+			},
+			current:       testM,
+			incoming:      guardedM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed guard change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Active:   true,
+			},
+			current:       guardedM,
+			incoming:      testM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed no guard change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Active:   true,
+			},
+			current:       guardedM,
+			incoming:      sameGuardedM,
+			expectAllowed: true,
+		},
+		{
+			name: "allowed vote period change",
+			allowed: AllowedMarket{
+				MarketID:   "bnb:usd",
+				VotePeriod: true,
+			},
+			current:       testM,
+			incoming:      commitRevealM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed vote period change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Active:   true,
+			},
+			current:       commitRevealM,
+			incoming:      testM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed price history change",
+			allowed: AllowedMarket{
+				MarketID:     "bnb:usd",
+				PriceHistory: true,
+			},
+			current:       testM,
+			incoming:      historyM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed price history change",
+			allowed: AllowedMarket{
+				MarketID:   "bnb:usd",
+				VotePeriod: true,
+			},
+			current:       historyM,
+			incoming:      testM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed oracle weights change",
+			allowed: AllowedMarket{
+				MarketID:      "bnb:usd",
+				OracleWeights: true,
+			},
+			current:       testM,
+			incoming:      weightedM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed oracle weights change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Guard:    true,
+			},
+			current:       testM,
+			incoming:      weightedM,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
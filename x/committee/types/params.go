@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter keys and default values
+var (
+	KeyArchiveRetention     = []byte("ArchiveRetention")
+	DefaultArchiveRetention = time.Duration(0)
+)
+
+// Params governance parameters for the committee module
+type Params struct {
+	// ArchiveRetention is how long closed proposals are kept in the archive. Zero keeps them forever.
+	ArchiveRetention time.Duration `json:"archive_retention" yaml:"archive_retention"`
+}
+
+// NewParams returns a new params object
+func NewParams(archiveRetention time.Duration) Params {
+	return Params{
+		ArchiveRetention: archiveRetention,
+	}
+}
+
+// DefaultParams returns default params for committee module
+func DefaultParams() Params {
+	return NewParams(DefaultArchiveRetention)
+}
+
+// ParamKeyTable Key declaration for parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyArchiveRetention, &p.ArchiveRetention, validateArchiveRetentionParam),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	return validateArchiveRetentionParam(p.ArchiveRetention)
+}
+
+func validateArchiveRetentionParam(i interface{}) error {
+	retention, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if retention < 0 {
+		return fmt.Errorf("archive retention cannot be negative: %s", retention)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+	Archive Retention: %s
+	`, p.ArchiveRetention)
+}
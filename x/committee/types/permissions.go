@@ -1,15 +1,24 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
 
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/hard"
+	issuancetypes "github.com/kava-labs/kava/x/issuance/types"
 	"github.com/kava-labs/kava/x/pricefeed"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -23,6 +32,11 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(TextPermission{}, "kava/TextPermission")
 	govtypes.RegisterProposalTypeCodec(SoftwareUpgradePermission{}, "kava/SoftwareUpgradePermission")
 	govtypes.RegisterProposalTypeCodec(SubParamChangePermission{}, "kava/SubParamChangePermission")
+	govtypes.RegisterProposalTypeCodec(BoundedParamChangePermission{}, "kava/BoundedParamChangePermission")
+	govtypes.RegisterProposalTypeCodec(VetoPermission{}, "kava/VetoPermission")
+	govtypes.RegisterProposalTypeCodec(MoneyMarketPausePermission{}, "kava/MoneyMarketPausePermission")
+	govtypes.RegisterProposalTypeCodec(SeizeCoinsPermission{}, "kava/SeizeCoinsPermission")
+	govtypes.RegisterProposalTypeCodec(LiquidationSweepPermission{}, "kava/LiquidationSweepPermission")
 }
 
 // Permission is anything with a method that validates whether a proposal is allowed by it or not.
@@ -30,6 +44,11 @@ type Permission interface {
 	Allows(sdk.Context, *codec.Codec, ParamKeeper, PubProposal) bool
 }
 
+// validatablePermission is implemented by permissions that carry state which must be checked for validity
+type validatablePermission interface {
+	Validate() error
+}
+
 // ------------------------------------------
 //				GodPermission
 // ------------------------------------------
@@ -434,13 +453,14 @@ type AllowedCollateralParam struct {
 	ConversionFactor                 bool   `json:"conversion_factor" yaml:"conversion_factor"`
 	KeeperRewardPercentage           bool   `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"`
 	CheckCollateralizationIndexCount bool   `json:"check_collateralization_index_count" yaml:"check_collateralization_index_count"`
+	AuctionType                      bool   `json:"auction_type" yaml:"auction_type"`
 }
 
 // NewAllowedCollateralParam return a new AllowedCollateralParam
 func NewAllowedCollateralParam(
 	ctype string, denom, liqRatio, debtLimit,
 	stabilityFee, auctionSize, liquidationPenalty,
-	prefix, spotMarket, liquidationMarket, conversionFactor, keeperReward, ltvIndexCount bool) AllowedCollateralParam {
+	prefix, spotMarket, liquidationMarket, conversionFactor, keeperReward, ltvIndexCount, auctionType bool) AllowedCollateralParam {
 	return AllowedCollateralParam{
 		Type:                             ctype,
 		Denom:                            denom,
@@ -455,6 +475,7 @@ func NewAllowedCollateralParam(
 		ConversionFactor:                 conversionFactor,
 		KeeperRewardPercentage:           keeperReward,
 		CheckCollateralizationIndexCount: ltvIndexCount,
+		AuctionType:                      auctionType,
 	}
 }
 
@@ -472,7 +493,8 @@ func (acp AllowedCollateralParam) Allows(current, incoming cdptypes.CollateralPa
 		((current.LiquidationMarketID == incoming.LiquidationMarketID) || acp.LiquidationMarketID) &&
 		((current.KeeperRewardPercentage.Equal(incoming.KeeperRewardPercentage)) || acp.KeeperRewardPercentage) &&
 		((current.CheckCollateralizationIndexCount.Equal(incoming.CheckCollateralizationIndexCount)) || acp.CheckCollateralizationIndexCount) &&
-		(current.ConversionFactor.Equal(incoming.ConversionFactor) || acp.ConversionFactor)
+		(current.ConversionFactor.Equal(incoming.ConversionFactor) || acp.ConversionFactor) &&
+		((current.AuctionType == incoming.AuctionType) || acp.AuctionType)
 	return allowed
 }
 
@@ -553,6 +575,8 @@ type AllowedAssetParam struct {
 	Active        bool   `json:"active" yaml:"active"`
 	MaxSwapAmount bool   `json:"max_swap_amount" yaml:"max_swap_amount"`
 	MinBlockLock  bool   `json:"min_block_lock" yaml:"min_block_lock"`
+	Deputies      bool   `json:"deputies" yaml:"deputies"`
+	FeeSchedule   bool   `json:"fee_schedule" yaml:"fee_schedule"`
 }
 
 // Allows bep3 AssetParam parameters than can be changed by committee
@@ -563,10 +587,23 @@ func (aap AllowedAssetParam) Allows(current, incoming bep3types.AssetParam) bool
 		(current.SupplyLimit.Equals(incoming.SupplyLimit) || aap.Limit) &&
 		((current.Active == incoming.Active) || aap.Active) &&
 		((current.MaxSwapAmount.Equal(incoming.MaxSwapAmount)) || aap.MaxSwapAmount) &&
-		((current.MinBlockLock == incoming.MinBlockLock) || aap.MinBlockLock)
+		((current.MinBlockLock == incoming.MinBlockLock) || aap.MinBlockLock) &&
+		(current.FeeSchedule.Equal(incoming.FeeSchedule) || aap.FeeSchedule) &&
+		(current.Deputies.Equal(incoming.Deputies) || aap.Deputies ||
+			(aap.FeeSchedule && withoutFeeSchedules(current.Deputies).Equal(withoutFeeSchedules(incoming.Deputies))))
 	return allowed
 }
 
+// withoutFeeSchedules returns a copy of deputies with their fee schedules cleared, so deputies can be compared ignoring fee schedules
+func withoutFeeSchedules(deputies bep3types.DeputyParams) bep3types.DeputyParams {
+	cleared := make(bep3types.DeputyParams, len(deputies))
+	for i, deputy := range deputies {
+		deputy.FeeSchedule = bep3types.FeeSchedule{}
+		cleared[i] = deputy
+	}
+	return cleared
+}
+
 // AllowedMarkets slice of AllowedMarket
 type AllowedMarkets []AllowedMarket
 
@@ -621,11 +658,17 @@ func (ams AllowedMarkets) Allows(current, incoming pricefeedtypes.Markets) bool
 
 // AllowedMarket permission struct for market parameters (pricefeed module)
 type AllowedMarket struct {
-	MarketID   string `json:"market_id" yaml:"market_id"`
-	BaseAsset  bool   `json:"base_asset" yaml:"base_asset"`
-	QuoteAsset bool   `json:"quote_asset" yaml:"quote_asset"`
-	Oracles    bool   `json:"oracles" yaml:"oracles"`
-	Active     bool   `json:"active" yaml:"active"`
+	MarketID      string `json:"market_id" yaml:"market_id"`
+	BaseAsset     bool   `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset    bool   `json:"quote_asset" yaml:"quote_asset"`
+	Oracles       bool   `json:"oracles" yaml:"oracles"`
+	Active        bool   `json:"active" yaml:"active"`
+	TWAP          bool   `json:"twap" yaml:"twap"`
+	Derived       bool   `json:"derived" yaml:"derived"`
+	Guard         bool   `json:"guard" yaml:"guard"`
+	OracleWeights bool   `json:"oracle_weights" yaml:"oracle_weights"`
+	VotePeriod    bool   `json:"vote_period" yaml:"vote_period"`
+	PriceHistory  bool   `json:"price_history" yaml:"price_history"`
 }
 
 // Allows determines if market param changes are permitted
@@ -634,10 +677,53 @@ func (am AllowedMarket) Allows(current, incoming pricefeedtypes.Market) bool {
 		((current.BaseAsset == incoming.BaseAsset) || am.BaseAsset) &&
 		((current.QuoteAsset == incoming.QuoteAsset) || am.QuoteAsset) &&
 		(addressesEqual(current.Oracles, incoming.Oracles) || am.Oracles) &&
-		((current.Active == incoming.Active) || am.Active)
+		((current.Active == incoming.Active) || am.Active) &&
+		((current.TWAP == incoming.TWAP) || am.TWAP) &&
+		(derivedSourcesEqual(current.Derived, incoming.Derived) || am.Derived) &&
+		(guardsEqual(current.Guard, incoming.Guard) || am.Guard) &&
+		(oracleWeightsEqual(current.OracleWeights, incoming.OracleWeights) || am.OracleWeights) &&
+		((current.VotePeriod == incoming.VotePeriod) || am.VotePeriod) &&
+		((current.PriceHistory == incoming.PriceHistory) || am.PriceHistory)
 	return allowed
 }
 
+// guardsEqual checks if two optional price guards are equal
+func guardsEqual(guard1, guard2 *pricefeedtypes.PriceGuard) bool {
+	if guard1 == nil || guard2 == nil {
+		return guard1 == guard2
+	}
+	return guard1.MaxDeviation.Equal(guard2.MaxDeviation) &&
+		guard1.MinOracles == guard2.MinOracles &&
+		guard1.MaxStaleness == guard2.MaxStaleness &&
+		guard1.RecoveryUpdates == guard2.RecoveryUpdates
+}
+
+// derivedSourcesEqual checks if two derived sources are equal, the order of the inputs matters
+func derivedSourcesEqual(source1, source2 pricefeedtypes.DerivedSource) bool {
+	if len(source1.Inputs) != len(source2.Inputs) {
+		return false
+	}
+	for i := range source1.Inputs {
+		if source1.Inputs[i] != source2.Inputs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// oracleWeightsEqual checks if two slices of oracle weights are equal, the order matters
+func oracleWeightsEqual(weights1, weights2 pricefeedtypes.OracleWeights) bool {
+	if len(weights1) != len(weights2) {
+		return false
+	}
+	for i := range weights1 {
+		if !weights1[i].Oracle.Equals(weights2[i].Oracle) || weights1[i].Weight != weights2[i].Weight {
+			return false
+		}
+	}
+	return true
+}
+
 // addressesEqual check if slices of addresses are equal, the order matters
 func addressesEqual(addrs1, addrs2 []sdk.AccAddress) bool {
 	if len(addrs1) != len(addrs2) {
@@ -659,10 +745,11 @@ type AllowedMoneyMarket struct {
 	InterestRateModel      bool   `json:"interest_rate_model" yaml:"interest_rate_model"`
 	ReserveFactor          bool   `json:"reserve_factor" yaml:"reserve_factor"`
 	KeeperRewardPercentage bool   `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"`
+	AuctionType            bool   `json:"auction_type" yaml:"auction_type"`
 }
 
 // NewAllowedMoneyMarket returns a new AllowedMoneyMarket
-func NewAllowedMoneyMarket(denom string, bl, sm, cf, irm, rf, kr bool) AllowedMoneyMarket {
+func NewAllowedMoneyMarket(denom string, bl, sm, cf, irm, rf, kr, at bool) AllowedMoneyMarket {
 	return AllowedMoneyMarket{
 		Denom:                  denom,
 		BorrowLimit:            bl,
@@ -671,6 +758,7 @@ func NewAllowedMoneyMarket(denom string, bl, sm, cf, irm, rf, kr bool) AllowedMo
 		InterestRateModel:      irm,
 		ReserveFactor:          rf,
 		KeeperRewardPercentage: kr,
+		AuctionType:            at,
 	}
 }
 
@@ -682,7 +770,8 @@ func (amm AllowedMoneyMarket) Allows(current, incoming hard.MoneyMarket) bool {
 		((current.ConversionFactor.Equal(incoming.ConversionFactor)) || amm.ConversionFactor) &&
 		((current.InterestRateModel.Equal(incoming.InterestRateModel)) || amm.InterestRateModel) &&
 		((current.ReserveFactor.Equal(incoming.ReserveFactor)) || amm.ReserveFactor) &&
-		((current.KeeperRewardPercentage.Equal(incoming.KeeperRewardPercentage)) || amm.KeeperRewardPercentage)
+		((current.KeeperRewardPercentage.Equal(incoming.KeeperRewardPercentage)) || amm.KeeperRewardPercentage) &&
+		((current.AuctionType == incoming.AuctionType) || amm.AuctionType)
 	return allowed
 }
 
@@ -731,3 +820,632 @@ func (amms AllowedMoneyMarkets) Allows(current, incoming hard.MoneyMarkets) bool
 
 	return allAllowed
 }
+
+// ------------------------------------------
+//				BoundedParamChangePermission
+// ------------------------------------------
+
+// BoundedParamChangePermission allows changes to numeric fields of module params within per field limits.
+// All other fields of the changed params must stay the same.
+type BoundedParamChangePermission struct {
+	ParamBounds ParamBounds `json:"param_bounds" yaml:"param_bounds"`
+}
+
+var _ Permission = BoundedParamChangePermission{}
+
+// NewBoundedParamChangePermission returns a new BoundedParamChangePermission
+func NewBoundedParamChangePermission(bounds ParamBounds) BoundedParamChangePermission {
+	return BoundedParamChangePermission{
+		ParamBounds: bounds,
+	}
+}
+
+// MarshalYAML implement yaml marshalling
+func (perm BoundedParamChangePermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type        string      `yaml:"type"`
+		ParamBounds ParamBounds `yaml:"param_bounds"`
+	}{
+		Type:        "bounded_param_change_permission",
+		ParamBounds: perm.ParamBounds,
+	}
+	return valueToMarshal, nil
+}
+
+// Validate checks the permission's bounds are valid
+func (perm BoundedParamChangePermission) Validate() error {
+	return perm.ParamBounds.Validate()
+}
+
+// Allows implement permission interface
+func (perm BoundedParamChangePermission) Allows(ctx sdk.Context, _ *codec.Codec, pk ParamKeeper, p PubProposal) bool {
+	changes, err := perm.paramBoundChanges(ctx, pk, p)
+	if err != nil {
+		return false
+	}
+	history, hasHistory := pk.(ParamChangeHistory)
+	for _, change := range changes {
+		if change.Bound.Cooldown > 0 {
+			// without a record of past changes the cooldown cannot be enforced, so disallow
+			if !hasHistory {
+				return false
+			}
+			lastChange, found := history.GetParamChangeTime(ctx, change.Bound.Subspace, change.Bound.Key, change.Bound.ID, change.Bound.Field)
+			if found && ctx.BlockTime().Before(lastChange.Add(change.Bound.Cooldown)) {
+				return false
+			}
+		}
+		if !change.Bound.AllowsChange(change.Current, change.Incoming) {
+			return false
+		}
+	}
+	return true
+}
+
+// ChangedParamBounds returns the bounds of fields that a proposal changes.
+// Changes to params and fields without bounds are ignored, so the bounded fields a proposal changes are found even when the permission does not allow it.
+func (perm BoundedParamChangePermission) ChangedParamBounds(ctx sdk.Context, pk ParamKeeper, p PubProposal) ParamBounds {
+	changes, _, err := perm.findParamBoundChanges(ctx, pk, p)
+	if err != nil {
+		return nil
+	}
+	bounds := ParamBounds{}
+	for _, change := range changes {
+		bounds = append(bounds, change.Bound)
+	}
+	return bounds
+}
+
+// paramBoundChange is the current and incoming value of a bounded field that a proposal changes
+type paramBoundChange struct {
+	Bound    ParamBound
+	Current  sdk.Dec
+	Incoming sdk.Dec
+}
+
+// paramBoundChanges returns the bounded fields that a proposal changes.
+// It returns an error if the proposal is not a param change, it changes a param without bounds, or it changes fields that are not bounded.
+func (perm BoundedParamChangePermission) paramBoundChanges(ctx sdk.Context, pk ParamKeeper, p PubProposal) ([]paramBoundChange, error) {
+	changes, unboundedChanges, err := perm.findParamBoundChanges(ctx, pk, p)
+	if err != nil {
+		return nil, err
+	}
+	if unboundedChanges {
+		return nil, fmt.Errorf("proposal changes params or fields without bounds")
+	}
+	return changes, nil
+}
+
+// findParamBoundChanges applies the param changes of a proposal in a cached context and returns the bounded fields that change,
+// along with whether any param or field without bounds changes. It returns an error if the proposal is not a param change or its changes cannot be applied.
+func (perm BoundedParamChangePermission) findParamBoundChanges(ctx sdk.Context, pk ParamKeeper, p PubProposal) ([]paramBoundChange, bool, error) {
+	proposal, ok := p.(paramstypes.ParameterChangeProposal)
+	if !ok {
+		return nil, false, fmt.Errorf("%T is not a param change proposal", p)
+	}
+
+	// apply the changes to a cached context to get the param values as they would be stored
+	cacheCtx, _ := ctx.CacheContext()
+	unboundedChanges := false
+	var changedParams AllowedParams
+	for _, change := range proposal.Changes {
+		subspace, found := pk.GetSubspace(change.Subspace)
+		if !found {
+			return nil, false, fmt.Errorf("subspace %s not found", change.Subspace)
+		}
+		if err := updateParam(cacheCtx, subspace, change.Key, change.Value); err != nil {
+			return nil, false, err
+		}
+		if !perm.ParamBounds.HasParam(change.Subspace, change.Key) {
+			unboundedChanges = true
+			continue
+		}
+		if !changedParams.Contains(change) {
+			changedParams = append(changedParams, AllowedParam{Subspace: change.Subspace, Key: change.Key})
+		}
+	}
+
+	var changes []paramBoundChange
+	for _, param := range changedParams {
+		subspace, _ := pk.GetSubspace(param.Subspace)
+		current, err := decodeParamJSON(subspace.GetRaw(ctx, []byte(param.Key)))
+		if err != nil {
+			return nil, false, err
+		}
+		incoming, err := decodeParamJSON(subspace.GetRaw(cacheCtx, []byte(param.Key)))
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, bound := range perm.ParamBounds {
+			if bound.Subspace != param.Subspace || bound.Key != param.Key {
+				continue
+			}
+			currentParent, field, err := bound.findField(current)
+			if err != nil {
+				return nil, false, err
+			}
+			incomingParent, _, err := bound.findField(incoming)
+			if err != nil {
+				return nil, false, err
+			}
+			currentValue, err := jsonToDec(currentParent[field])
+			if err != nil {
+				return nil, false, err
+			}
+			incomingValue, err := jsonToDec(incomingParent[field])
+			if err != nil {
+				return nil, false, err
+			}
+			if !currentValue.Equal(incomingValue) {
+				changes = append(changes, paramBoundChange{Bound: bound, Current: currentValue, Incoming: incomingValue})
+			}
+			// reset the bounded field so only unbounded changes remain
+			incomingParent[field] = currentParent[field]
+		}
+
+		if !reflect.DeepEqual(current, incoming) {
+			unboundedChanges = true
+		}
+	}
+	return changes, unboundedChanges, nil
+}
+
+// updateParam sets a param from a json value, validating it with the subspace's param validation.
+func updateParam(ctx sdk.Context, subspace params.Subspace, key, value string) (err error) {
+	// the subspace panics on unregistered keys
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("param %s/%s could not be updated: %v", subspace.Name(), key, r)
+		}
+	}()
+	return subspace.Update(ctx, []byte(key), []byte(value))
+}
+
+// decodeParamJSON decodes a stored param value into generic json values, keeping numbers exact
+func decodeParamJSON(bz []byte) (interface{}, error) {
+	if bz == nil {
+		return nil, fmt.Errorf("param not set")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// jsonToDec converts a decoded json number or numeric string to a Dec
+func jsonToDec(value interface{}) (sdk.Dec, error) {
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	case json.Number:
+		str = v.String()
+	default:
+		return sdk.Dec{}, fmt.Errorf("%v is not a number", value)
+	}
+	return sdk.NewDecFromStr(str)
+}
+
+// paramListIDFields are the json fields that identify items of list params
+var paramListIDFields = map[string]string{
+	cdptypes.ModuleName + "/" + string(cdptypes.KeyCollateralParams):    "type",
+	hard.ModuleName + "/" + string(hard.KeyMoneyMarkets):                "denom",
+	bep3types.ModuleName + "/" + string(bep3types.KeyAssetParams):       "denom",
+	pricefeedtypes.ModuleName + "/" + string(pricefeedtypes.KeyMarkets): "market_id",
+}
+
+// ParamBound limits how a single numeric field of a module param can change.
+// Zero values disable a limit.
+type ParamBound struct {
+	Subspace          string        `json:"subspace" yaml:"subspace"`
+	Key               string        `json:"key" yaml:"key"`
+	ID                string        `json:"id" yaml:"id"`                                   // Identifies an item of a list param, eg a collateral type, money market denom, asset denom, or market id
+	Field             string        `json:"field" yaml:"field"`                             // Dot separated json path to the field, eg "stability_fee" or "borrow_limit.maximum_limit"
+	MaxAbsoluteChange sdk.Dec       `json:"max_absolute_change" yaml:"max_absolute_change"` // Largest allowed difference between the current and new value
+	MaxRelativeChange sdk.Dec       `json:"max_relative_change" yaml:"max_relative_change"` // Largest allowed difference as a fraction of the current value
+	Floor             sdk.Dec       `json:"floor" yaml:"floor"`                             // Smallest allowed new value
+	Ceiling           sdk.Dec       `json:"ceiling" yaml:"ceiling"`                         // Largest allowed new value
+	Cooldown          time.Duration `json:"cooldown" yaml:"cooldown"`                       // Minimum time between changes to the field
+}
+
+// NewParamBound returns a new ParamBound
+func NewParamBound(subspace, key, id, field string, maxAbsChange, maxRelChange, floor, ceiling sdk.Dec, cooldown time.Duration) ParamBound {
+	return ParamBound{
+		Subspace:          subspace,
+		Key:               key,
+		ID:                id,
+		Field:             field,
+		MaxAbsoluteChange: maxAbsChange,
+		MaxRelativeChange: maxRelChange,
+		Floor:             floor,
+		Ceiling:           ceiling,
+		Cooldown:          cooldown,
+	}
+}
+
+// Validate checks the bound is valid
+func (pb ParamBound) Validate() error {
+	if strings.TrimSpace(pb.Subspace) == "" || strings.TrimSpace(pb.Key) == "" || strings.TrimSpace(pb.Field) == "" {
+		return fmt.Errorf("param bound subspace, key and field cannot be blank: %s/%s %s", pb.Subspace, pb.Key, pb.Field)
+	}
+	_, isList := paramListIDFields[pb.Subspace+"/"+pb.Key]
+	if isList && pb.ID == "" {
+		return fmt.Errorf("param bound for %s/%s must have an id", pb.Subspace, pb.Key)
+	}
+	for _, limit := range []sdk.Dec{pb.MaxAbsoluteChange, pb.MaxRelativeChange, pb.Floor, pb.Ceiling} {
+		if !limit.IsNil() && limit.IsNegative() {
+			return fmt.Errorf("param bound limits cannot be negative: %s", limit)
+		}
+	}
+	if isLimitSet(pb.Floor) && isLimitSet(pb.Ceiling) && pb.Floor.GT(pb.Ceiling) {
+		return fmt.Errorf("param bound floor %s is greater than ceiling %s", pb.Floor, pb.Ceiling)
+	}
+	if pb.Cooldown < 0 {
+		return fmt.Errorf("param bound cooldown cannot be negative: %s", pb.Cooldown)
+	}
+	return nil
+}
+
+// AllowsChange returns whether a field can change from the current to the incoming value, ignoring the cooldown
+func (pb ParamBound) AllowsChange(current, incoming sdk.Dec) bool {
+	if current.Equal(incoming) {
+		return true
+	}
+	change := incoming.Sub(current).Abs()
+	if isLimitSet(pb.MaxAbsoluteChange) && change.GT(pb.MaxAbsoluteChange) {
+		return false
+	}
+	if isLimitSet(pb.MaxRelativeChange) && change.GT(pb.MaxRelativeChange.Mul(current.Abs())) {
+		return false
+	}
+	if isLimitSet(pb.Floor) && incoming.LT(pb.Floor) {
+		return false
+	}
+	if isLimitSet(pb.Ceiling) && incoming.GT(pb.Ceiling) {
+		return false
+	}
+	return true
+}
+
+// findField returns the json object containing the bounded field, and the field's name within it
+func (pb ParamBound) findField(value interface{}) (map[string]interface{}, string, error) {
+	if idField, isList := paramListIDFields[pb.Subspace+"/"+pb.Key]; isList {
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, "", fmt.Errorf("param %s/%s is not a list", pb.Subspace, pb.Key)
+		}
+		value = nil
+		for _, item := range items {
+			obj, ok := item.(map[string]interface{})
+			if ok && obj[idField] == pb.ID {
+				value = obj
+				break
+			}
+		}
+		if value == nil {
+			return nil, "", fmt.Errorf("param %s/%s has no item %s", pb.Subspace, pb.Key, pb.ID)
+		}
+	}
+
+	path := strings.Split(pb.Field, ".")
+	for _, name := range path[:len(path)-1] {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, "", fmt.Errorf("field %s not found in param %s/%s", pb.Field, pb.Subspace, pb.Key)
+		}
+		value = obj[name]
+	}
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, "", fmt.Errorf("field %s not found in param %s/%s", pb.Field, pb.Subspace, pb.Key)
+	}
+	field := path[len(path)-1]
+	if _, found := obj[field]; !found {
+		return nil, "", fmt.Errorf("field %s not found in param %s/%s", pb.Field, pb.Subspace, pb.Key)
+	}
+	return obj, field, nil
+}
+
+// isLimitSet returns whether a limit is enabled, unset and zero limits are disabled
+func isLimitSet(limit sdk.Dec) bool {
+	return !limit.IsNil() && !limit.IsZero()
+}
+
+// ParamBounds slice of ParamBound
+type ParamBounds []ParamBound
+
+// HasParam returns whether any bound applies to a param
+func (pbs ParamBounds) HasParam(subspace, key string) bool {
+	for _, pb := range pbs {
+		if pb.Subspace == subspace && pb.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the bounds are valid and there is at most one bound per field
+func (pbs ParamBounds) Validate() error {
+	seen := make(map[string]bool, len(pbs))
+	for _, pb := range pbs {
+		if err := pb.Validate(); err != nil {
+			return err
+		}
+		path := string(GetParamChangeTimeKey(pb.Subspace, pb.Key, pb.ID, pb.Field))
+		if seen[path] {
+			return fmt.Errorf("duplicate param bound for %s", path)
+		}
+		seen[path] = true
+	}
+	return nil
+}
+
+// ParamChangeHistory is implemented by param keepers that record when bounded param fields were last changed.
+type ParamChangeHistory interface {
+	GetParamChangeTime(ctx sdk.Context, subspace, key, id, field string) (time.Time, bool)
+}
+
+// ParamChangeTime records when a bounded param field was last changed by a committee
+type ParamChangeTime struct {
+	Subspace string    `json:"subspace" yaml:"subspace"`
+	Key      string    `json:"key" yaml:"key"`
+	ID       string    `json:"id" yaml:"id"`
+	Field    string    `json:"field" yaml:"field"`
+	Time     time.Time `json:"time" yaml:"time"`
+}
+
+// NewParamChangeTime returns a new ParamChangeTime
+func NewParamChangeTime(subspace, key, id, field string, changeTime time.Time) ParamChangeTime {
+	return ParamChangeTime{
+		Subspace: subspace,
+		Key:      key,
+		ID:       id,
+		Field:    field,
+		Time:     changeTime,
+	}
+}
+
+// Validate performs basic validation of a ParamChangeTime
+func (pct ParamChangeTime) Validate() error {
+	if strings.TrimSpace(pct.Subspace) == "" || strings.TrimSpace(pct.Key) == "" || strings.TrimSpace(pct.Field) == "" {
+		return fmt.Errorf("param change time subspace, key and field cannot be blank: %s/%s %s", pct.Subspace, pct.Key, pct.Field)
+	}
+	if pct.Time.IsZero() {
+		return fmt.Errorf("param change time cannot be zero")
+	}
+	return nil
+}
+
+// ------------------------------------------
+//				VetoPermission
+// ------------------------------------------
+
+// VetoPermission allows vetoing queued proposals from certain committees before they are enacted.
+type VetoPermission struct {
+	CommitteeIDs []uint64 `json:"committee_ids" yaml:"committee_ids"`
+}
+
+var _ Permission = VetoPermission{}
+
+// NewVetoPermission returns a new VetoPermission
+func NewVetoPermission(committeeIDs []uint64) VetoPermission {
+	return VetoPermission{
+		CommitteeIDs: committeeIDs,
+	}
+}
+
+// Allows implement permission interface
+func (perm VetoPermission) Allows(ctx sdk.Context, _ *codec.Codec, pk ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(VetoProposal)
+	if !ok {
+		return false
+	}
+	queue, ok := pk.(ProposalQueue)
+	if !ok {
+		return false
+	}
+	queuedProposal, found := queue.GetQueuedProposal(ctx, proposal.ProposalID)
+	if !found {
+		return false
+	}
+	for _, id := range perm.CommitteeIDs {
+		if id == queuedProposal.CommitteeID {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalYAML implement yaml marshalling
+func (perm VetoPermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type         string   `yaml:"type"`
+		CommitteeIDs []uint64 `yaml:"committee_ids"`
+	}{
+		Type:         "veto_permission",
+		CommitteeIDs: perm.CommitteeIDs,
+	}
+	return valueToMarshal, nil
+}
+
+// Validate checks the permission lists at least one committee and no duplicates
+func (perm VetoPermission) Validate() error {
+	if len(perm.CommitteeIDs) == 0 {
+		return fmt.Errorf("veto permission must list at least one committee")
+	}
+	idMap := make(map[uint64]bool, len(perm.CommitteeIDs))
+	for _, id := range perm.CommitteeIDs {
+		if idMap[id] {
+			return fmt.Errorf("veto permission cannot have duplicate committee IDs, %d", id)
+		}
+		idMap[id] = true
+	}
+	return nil
+}
+
+// ProposalQueue is implemented by param keepers that can look up passed proposals waiting to be enacted.
+type ProposalQueue interface {
+	GetQueuedProposal(ctx sdk.Context, proposalID uint64) (QueuedProposal, bool)
+}
+
+// ------------------------------------------
+//				MoneyMarketPausePermission
+// ------------------------------------------
+
+// MoneyMarketPausePermission allows pausing and unpausing certain hard money markets.
+type MoneyMarketPausePermission struct {
+	AllowedDenoms []string `json:"allowed_denoms" yaml:"allowed_denoms"`
+}
+
+var _ Permission = MoneyMarketPausePermission{}
+
+// NewMoneyMarketPausePermission returns a new MoneyMarketPausePermission
+func NewMoneyMarketPausePermission(allowedDenoms []string) MoneyMarketPausePermission {
+	return MoneyMarketPausePermission{
+		AllowedDenoms: allowedDenoms,
+	}
+}
+
+// Allows implement permission interface
+func (perm MoneyMarketPausePermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(hard.MoneyMarketPauseProposal)
+	if !ok {
+		return false
+	}
+	return containsString(perm.AllowedDenoms, proposal.Denom)
+}
+
+// MarshalYAML implement yaml marshalling
+func (perm MoneyMarketPausePermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type          string   `yaml:"type"`
+		AllowedDenoms []string `yaml:"allowed_denoms"`
+	}{
+		Type:          "money_market_pause_permission",
+		AllowedDenoms: perm.AllowedDenoms,
+	}
+	return valueToMarshal, nil
+}
+
+// Validate checks the permission lists at least one denom and no duplicates
+func (perm MoneyMarketPausePermission) Validate() error {
+	return validateAllowedStrings("money market pause permission", "denom", perm.AllowedDenoms)
+}
+
+// ------------------------------------------
+//				SeizeCoinsPermission
+// ------------------------------------------
+
+// SeizeCoinsPermission allows seizing certain issued assets from their blocked addresses.
+type SeizeCoinsPermission struct {
+	AllowedDenoms []string `json:"allowed_denoms" yaml:"allowed_denoms"`
+}
+
+var _ Permission = SeizeCoinsPermission{}
+
+// NewSeizeCoinsPermission returns a new SeizeCoinsPermission
+func NewSeizeCoinsPermission(allowedDenoms []string) SeizeCoinsPermission {
+	return SeizeCoinsPermission{
+		AllowedDenoms: allowedDenoms,
+	}
+}
+
+// Allows implement permission interface
+func (perm SeizeCoinsPermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(issuancetypes.SeizeCoinsProposal)
+	if !ok {
+		return false
+	}
+	return containsString(perm.AllowedDenoms, proposal.Denom)
+}
+
+// MarshalYAML implement yaml marshalling
+func (perm SeizeCoinsPermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type          string   `yaml:"type"`
+		AllowedDenoms []string `yaml:"allowed_denoms"`
+	}{
+		Type:          "seize_coins_permission",
+		AllowedDenoms: perm.AllowedDenoms,
+	}
+	return valueToMarshal, nil
+}
+
+// Validate checks the permission lists at least one denom and no duplicates
+func (perm SeizeCoinsPermission) Validate() error {
+	return validateAllowedStrings("seize coins permission", "denom", perm.AllowedDenoms)
+}
+
+// ------------------------------------------
+//				LiquidationSweepPermission
+// ------------------------------------------
+
+// LiquidationSweepPermission allows liquidating all risky cdps of certain collateral types.
+type LiquidationSweepPermission struct {
+	AllowedCollateralTypes []string `json:"allowed_collateral_types" yaml:"allowed_collateral_types"`
+}
+
+var _ Permission = LiquidationSweepPermission{}
+
+// NewLiquidationSweepPermission returns a new LiquidationSweepPermission
+func NewLiquidationSweepPermission(allowedCollateralTypes []string) LiquidationSweepPermission {
+	return LiquidationSweepPermission{
+		AllowedCollateralTypes: allowedCollateralTypes,
+	}
+}
+
+// Allows implement permission interface
+func (perm LiquidationSweepPermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(cdptypes.LiquidationSweepProposal)
+	if !ok {
+		return false
+	}
+	return containsString(perm.AllowedCollateralTypes, proposal.CollateralType)
+}
+
+// MarshalYAML implement yaml marshalling
+func (perm LiquidationSweepPermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type                   string   `yaml:"type"`
+		AllowedCollateralTypes []string `yaml:"allowed_collateral_types"`
+	}{
+		Type:                   "liquidation_sweep_permission",
+		AllowedCollateralTypes: perm.AllowedCollateralTypes,
+	}
+	return valueToMarshal, nil
+}
+
+// Validate checks the permission lists at least one collateral type and no duplicates
+func (perm LiquidationSweepPermission) Validate() error {
+	return validateAllowedStrings("liquidation sweep permission", "collateral type", perm.AllowedCollateralTypes)
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAllowedStrings(permission, field string, allowed []string) error {
+	if len(allowed) == 0 {
+		return fmt.Errorf("%s must list at least one %s", permission, field)
+	}
+	seen := make(map[string]bool, len(allowed))
+	for _, s := range allowed {
+		if strings.TrimSpace(s) == "" {
+			return fmt.Errorf("%s cannot have a blank %s", permission, field)
+		}
+		if seen[s] {
+			return fmt.Errorf("%s cannot have duplicate %ss, %s", permission, field, s)
+		}
+		seen[s] = true
+	}
+	return nil
+}
@@ -352,6 +352,90 @@ func (suite *PermissionsTestSuite) TestSoftwareUpgradePermission_Allows() {
 	}
 }
 
+func (suite *PermissionsTestSuite) TestParamBound_Validate() {
+	testCases := []struct {
+		name       string
+		bound      ParamBound
+		expectPass bool
+	}{
+		{
+			name:       "valid",
+			bound:      NewParamBound("cdp", "CollateralParams", "bnb-a", "stability_fee", d("0.1"), d("0.1"), d("1"), d("2"), time.Hour),
+			expectPass: true,
+		},
+		{
+			name:       "valid without limits",
+			bound:      NewParamBound("cdp", "DebtThreshold", "", "amount", sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, 0),
+			expectPass: true,
+		},
+		{
+			name:       "blank field",
+			bound:      NewParamBound("cdp", "CollateralParams", "bnb-a", "", d("0.1"), d("0.1"), d("1"), d("2"), time.Hour),
+			expectPass: false,
+		},
+		{
+			name:       "list param without id",
+			bound:      NewParamBound("hard", "MoneyMarkets", "", "reserve_factor", d("0.1"), d("0.1"), d("1"), d("2"), time.Hour),
+			expectPass: false,
+		},
+		{
+			name:       "negative limit",
+			bound:      NewParamBound("cdp", "CollateralParams", "bnb-a", "stability_fee", d("-0.1"), d("0.1"), d("1"), d("2"), time.Hour),
+			expectPass: false,
+		},
+		{
+			name:       "floor above ceiling",
+			bound:      NewParamBound("cdp", "CollateralParams", "bnb-a", "stability_fee", d("0.1"), d("0.1"), d("3"), d("2"), time.Hour),
+			expectPass: false,
+		},
+		{
+			name:       "negative cooldown",
+			bound:      NewParamBound("cdp", "CollateralParams", "bnb-a", "stability_fee", d("0.1"), d("0.1"), d("1"), d("2"), -time.Hour),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.bound.Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+
+	bound := NewParamBound("cdp", "CollateralParams", "bnb-a", "stability_fee", d("0.1"), d("0.1"), d("1"), d("2"), time.Hour)
+	suite.Error(ParamBounds{bound, bound}.Validate())
+}
+
+func (suite *PermissionsTestSuite) TestParamBound_AllowsChange() {
+	testCases := []struct {
+		name          string
+		bound         ParamBound
+		current       sdk.Dec
+		incoming      sdk.Dec
+		expectAllowed bool
+	}{
+		{"no change", NewParamBound("s", "k", "", "f", d("0.1"), sdk.ZeroDec(), d("5"), sdk.ZeroDec(), 0), d("1"), d("1"), true},
+		{"within absolute change", NewParamBound("s", "k", "", "f", d("0.1"), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), 0), d("1"), d("0.9"), true},
+		{"over absolute change", NewParamBound("s", "k", "", "f", d("0.1"), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), 0), d("1"), d("1.11"), false},
+		{"within relative change", NewParamBound("s", "k", "", "f", sdk.ZeroDec(), d("0.5"), sdk.ZeroDec(), sdk.ZeroDec(), 0), d("10"), d("15"), true},
+		{"over relative change", NewParamBound("s", "k", "", "f", sdk.ZeroDec(), d("0.5"), sdk.ZeroDec(), sdk.ZeroDec(), 0), d("10"), d("4"), false},
+		{"relative change from zero", NewParamBound("s", "k", "", "f", sdk.ZeroDec(), d("0.5"), sdk.ZeroDec(), sdk.ZeroDec(), 0), d("0"), d("1"), false},
+		{"below floor", NewParamBound("s", "k", "", "f", sdk.ZeroDec(), sdk.ZeroDec(), d("1"), sdk.ZeroDec(), 0), d("2"), d("0.5"), false},
+		{"above ceiling", NewParamBound("s", "k", "", "f", sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), d("3"), 0), d("2"), d("3.5"), false},
+		{"no limits", NewParamBound("s", "k", "", "f", sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, 0), d("2"), d("300"), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Equal(tc.expectAllowed, tc.bound.AllowsChange(tc.current, tc.incoming))
+		})
+	}
+}
+
 func TestPermissionsTestSuite(t *testing.T) {
 	suite.Run(t, new(PermissionsTestSuite))
 }
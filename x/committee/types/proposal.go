@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	yaml "gopkg.in/yaml.v2"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,11 +12,13 @@ import (
 const (
 	ProposalTypeCommitteeChange = "CommitteeChange"
 	ProposalTypeCommitteeDelete = "CommitteeDelete"
+	ProposalTypeVeto            = "Veto"
+	ProposalTypeBatch           = "Batch"
 )
 
 // ensure proposal types fulfill the PubProposal interface and the gov Content interface.
-var _, _ govtypes.Content = CommitteeChangeProposal{}, CommitteeDeleteProposal{}
-var _, _ PubProposal = CommitteeChangeProposal{}, CommitteeDeleteProposal{}
+var _, _, _, _ govtypes.Content = CommitteeChangeProposal{}, CommitteeDeleteProposal{}, VetoProposal{}, BatchProposal{}
+var _, _, _, _ PubProposal = CommitteeChangeProposal{}, CommitteeDeleteProposal{}, VetoProposal{}, BatchProposal{}
 
 func init() {
 	// Gov proposals need to be registered on gov's ModuleCdc so MsgSubmitProposal can be encoded.
@@ -23,6 +27,9 @@ func init() {
 
 	govtypes.RegisterProposalType(ProposalTypeCommitteeDelete)
 	govtypes.RegisterProposalTypeCodec(CommitteeDeleteProposal{}, "kava/CommitteeDeleteProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeVeto)
+	govtypes.RegisterProposalTypeCodec(VetoProposal{}, "kava/VetoProposal")
 }
 
 // CommitteeChangeProposal is a gov proposal for creating a new committee or modifying an existing one.
@@ -106,3 +113,97 @@ func (cdp CommitteeDeleteProposal) String() string {
 	bz, _ := yaml.Marshal(cdp)
 	return string(bz)
 }
+
+// VetoProposal is a gov or committee proposal for cancelling a passed committee proposal before it is enacted.
+type VetoProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	ProposalID  uint64 `json:"proposal_id" yaml:"proposal_id"`
+}
+
+func NewVetoProposal(title string, description string, proposalID uint64) VetoProposal {
+	return VetoProposal{
+		Title:       title,
+		Description: description,
+		ProposalID:  proposalID,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (vp VetoProposal) GetTitle() string { return vp.Title }
+
+// GetDescription returns the description of the proposal.
+func (vp VetoProposal) GetDescription() string { return vp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (vp VetoProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (vp VetoProposal) ProposalType() string { return ProposalTypeVeto }
+
+// ValidateBasic runs basic stateless validity checks
+func (vp VetoProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(vp)
+}
+
+// String implements the Stringer interface.
+func (vp VetoProposal) String() string {
+	bz, _ := yaml.Marshal(vp)
+	return string(bz)
+}
+
+// BatchProposal is a committee proposal for enacting several proposals together.
+// Its contents are enacted in order, and either all of them are enacted or none are.
+type BatchProposal struct {
+	Title       string        `json:"title" yaml:"title"`
+	Description string        `json:"description" yaml:"description"`
+	Contents    []PubProposal `json:"contents" yaml:"contents"`
+}
+
+func NewBatchProposal(title string, description string, contents []PubProposal) BatchProposal {
+	return BatchProposal{
+		Title:       title,
+		Description: description,
+		Contents:    contents,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (bp BatchProposal) GetTitle() string { return bp.Title }
+
+// GetDescription returns the description of the proposal.
+func (bp BatchProposal) GetDescription() string { return bp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (bp BatchProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (bp BatchProposal) ProposalType() string { return ProposalTypeBatch }
+
+// ValidateBasic runs basic stateless validity checks
+func (bp BatchProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(bp); err != nil {
+		return err
+	}
+	if len(bp.Contents) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, "batch proposal must have at least one content")
+	}
+	for i, content := range bp.Contents {
+		if content == nil {
+			return sdkerrors.Wrapf(ErrInvalidPubProposal, "batch proposal content %d is nil", i)
+		}
+		if _, ok := content.(BatchProposal); ok {
+			return sdkerrors.Wrapf(ErrInvalidPubProposal, "batch proposal content %d cannot be a batch proposal", i)
+		}
+		if err := content.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, fmt.Sprintf("batch proposal content %d", i))
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (bp BatchProposal) String() string {
+	bz, _ := yaml.Marshal(bp)
+	return string(bz)
+}
@@ -6,15 +6,21 @@ import (
 
 // Query endpoints supported by the Querier
 const (
-	QueryCommittees     = "committees"
-	QueryCommittee      = "committee"
-	QueryProposals      = "proposals"
-	QueryProposal       = "proposal"
-	QueryNextProposalID = "next-proposal-id"
-	QueryVotes          = "votes"
-	QueryVote           = "vote"
-	QueryTally          = "tally"
-	QueryRawParams      = "raw_params"
+	QueryCommittees      = "committees"
+	QueryCommittee       = "committee"
+	QueryProposals       = "proposals"
+	QueryProposal        = "proposal"
+	QueryNextProposalID  = "next-proposal-id"
+	QueryVotes           = "votes"
+	QueryVote            = "vote"
+	QueryTally           = "tally"
+	QueryRawParams       = "raw_params"
+	QueryQueuedProposals = "queued-proposals"
+	QueryQueuedProposal  = "queued-proposal"
+	QueryParams          = "params"
+
+	QueryArchivedProposals = "archived-proposals"
+	QueryArchivedProposal  = "archived-proposal"
 )
 
 type QueryCommitteeParams struct {
@@ -60,3 +66,22 @@ func NewQueryRawParamsParams(subspace, key string) QueryRawParamsParams {
 		Key:      key,
 	}
 }
+
+// QueryArchivedProposalsParams is the params for a filtered archived proposals query.
+// A zero committee ID or empty outcome matches all archived proposals.
+type QueryArchivedProposalsParams struct {
+	Page        int    `json:"page" yaml:"page"`
+	Limit       int    `json:"limit" yaml:"limit"`
+	CommitteeID uint64 `json:"committee_id" yaml:"committee_id"`
+	Outcome     string `json:"outcome" yaml:"outcome"`
+}
+
+// NewQueryArchivedProposalsParams creates a new QueryArchivedProposalsParams
+func NewQueryArchivedProposalsParams(page, limit int, committeeID uint64, outcome string) QueryArchivedProposalsParams {
+	return QueryArchivedProposalsParams{
+		Page:        page,
+		Limit:       limit,
+		CommitteeID: committeeID,
+		Outcome:     outcome,
+	}
+}
@@ -8,33 +8,38 @@ import (
 )
 
 const (
-	AttributeKeyBorrow       = types.AttributeKeyBorrow
-	AttributeKeyBorrowCoins  = types.AttributeKeyBorrowCoins
-	AttributeKeyBorrower     = types.AttributeKeyBorrower
-	AttributeKeyDeposit      = types.AttributeKeyDeposit
-	AttributeKeyDepositCoins = types.AttributeKeyDepositCoins
-	AttributeKeyDepositDenom = types.AttributeKeyDepositDenom
-	AttributeKeyDepositor    = types.AttributeKeyDepositor
-	AttributeKeyRepayCoins   = types.AttributeKeyRepayCoins
-	AttributeKeySender       = types.AttributeKeySender
-	AttributeValueCategory   = types.AttributeValueCategory
-	DefaultParamspace        = types.DefaultParamspace
-	EventTypeHardLiquidation = types.EventTypeHardLiquidation
-	EventTypeHardBorrow      = types.EventTypeHardBorrow
-	EventTypeHardDeposit     = types.EventTypeHardDeposit
-	EventTypeHardRepay       = types.EventTypeHardRepay
-	EventTypeHardWithdrawal  = types.EventTypeHardWithdrawal
-	ModuleAccountName        = types.ModuleAccountName
-	ModuleName               = types.ModuleName
-	QuerierRoute             = types.QuerierRoute
-	QueryGetBorrows          = types.QueryGetBorrows
-	QueryGetDeposits         = types.QueryGetDeposits
-	QueryGetModuleAccounts   = types.QueryGetModuleAccounts
-	QueryGetParams           = types.QueryGetParams
-	QueryGetTotalBorrowed    = types.QueryGetTotalBorrowed
-	QueryGetTotalDeposited   = types.QueryGetTotalDeposited
-	RouterKey                = types.RouterKey
-	StoreKey                 = types.StoreKey
+	AttributeKeyBorrow            = types.AttributeKeyBorrow
+	AttributeKeyBorrowCoins       = types.AttributeKeyBorrowCoins
+	AttributeKeyBorrower          = types.AttributeKeyBorrower
+	AttributeKeyDeposit           = types.AttributeKeyDeposit
+	AttributeKeyDepositCoins      = types.AttributeKeyDepositCoins
+	AttributeKeyDepositDenom      = types.AttributeKeyDepositDenom
+	AttributeKeyDepositor         = types.AttributeKeyDepositor
+	AttributeKeyPaused            = types.AttributeKeyPaused
+	AttributeKeyRepayCoins        = types.AttributeKeyRepayCoins
+	AttributeKeySender            = types.AttributeKeySender
+	AttributeValueCategory        = types.AttributeValueCategory
+	DefaultParamspace             = types.DefaultParamspace
+	DutchAuctionType              = types.DutchAuctionType
+	EnglishAuctionType            = types.EnglishAuctionType
+	EventTypeHardLiquidation      = types.EventTypeHardLiquidation
+	EventTypeHardBorrow           = types.EventTypeHardBorrow
+	EventTypeHardDeposit          = types.EventTypeHardDeposit
+	EventTypeHardMoneyMarketPause = types.EventTypeHardMoneyMarketPause
+	EventTypeHardRepay            = types.EventTypeHardRepay
+	EventTypeHardWithdrawal       = types.EventTypeHardWithdrawal
+	ModuleAccountName             = types.ModuleAccountName
+	ModuleName                    = types.ModuleName
+	ProposalTypeMoneyMarketPause  = types.ProposalTypeMoneyMarketPause
+	QuerierRoute                  = types.QuerierRoute
+	QueryGetBorrows               = types.QueryGetBorrows
+	QueryGetDeposits              = types.QueryGetDeposits
+	QueryGetModuleAccounts        = types.QueryGetModuleAccounts
+	QueryGetParams                = types.QueryGetParams
+	QueryGetTotalBorrowed         = types.QueryGetTotalBorrowed
+	QueryGetTotalDeposited        = types.QueryGetTotalDeposited
+	RouterKey                     = types.RouterKey
+	StoreKey                      = types.StoreKey
 )
 
 var (
@@ -59,6 +64,7 @@ var (
 	NewGenesisState               = types.NewGenesisState
 	NewInterestRateModel          = types.NewInterestRateModel
 	NewMoneyMarket                = types.NewMoneyMarket
+	NewMoneyMarketPauseProposal   = types.NewMoneyMarketPauseProposal
 	NewMsgBorrow                  = types.NewMsgBorrow
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgLiquidate               = types.NewMsgLiquidate
@@ -85,6 +91,7 @@ var (
 	DefaultBorrows                      = types.DefaultBorrows
 	DefaultDeposits                     = types.DefaultDeposits
 	DefaultMoneyMarkets                 = types.DefaultMoneyMarkets
+	DefaultPausedMoneyMarkets           = types.DefaultPausedMoneyMarkets
 	DefaultTotalBorrowed                = types.DefaultTotalBorrowed
 	DefaultTotalReserves                = types.DefaultTotalReserves
 	DefaultTotalSupplied                = types.DefaultTotalSupplied
@@ -106,22 +113,26 @@ var (
 	ErrInsufficientModAccountBalance    = types.ErrInsufficientModAccountBalance
 	ErrInvalidAccountType               = types.ErrInvalidAccountType
 	ErrInvalidDepositDenom              = types.ErrInvalidDepositDenom
+	ErrInvalidPubProposal               = types.ErrInvalidPubProposal
 	ErrInvalidReceiver                  = types.ErrInvalidReceiver
 	ErrInvalidRepaymentDenom            = types.ErrInvalidRepaymentDenom
 	ErrInvalidWithdrawAmount            = types.ErrInvalidWithdrawAmount
 	ErrInvalidWithdrawDenom             = types.ErrInvalidWithdrawDenom
 	ErrMarketNotFound                   = types.ErrMarketNotFound
 	ErrMoneyMarketNotFound              = types.ErrMoneyMarketNotFound
+	ErrMoneyMarketPaused                = types.ErrMoneyMarketPaused
 	ErrNegativeBorrowedCoins            = types.ErrNegativeBorrowedCoins
 	ErrNegativeSuppliedCoins            = types.ErrNegativeSuppliedCoins
 	ErrPreviousAccrualTimeNotFound      = types.ErrPreviousAccrualTimeNotFound
 	ErrPriceNotFound                    = types.ErrPriceNotFound
+	ErrPriceUnhealthy                   = types.ErrPriceUnhealthy
 	ErrSuppliedCoinsNotFound            = types.ErrSuppliedCoinsNotFound
 	ErrReservesExceedCash               = types.ErrReservesExceedCash
 	GovDenom                            = types.GovDenom
 	KeyMoneyMarkets                     = types.KeyMoneyMarkets
 	ModuleCdc                           = types.ModuleCdc
 	MoneyMarketsPrefix                  = types.MoneyMarketsPrefix
+	PausedMoneyMarketsPrefix            = types.PausedMoneyMarketsPrefix
 	PreviousAccrualTimePrefix           = types.PreviousAccrualTimePrefix
 	SuppliedCoinsPrefix                 = types.SuppliedCoinsPrefix
 	SupplyInterestFactorPrefix          = types.SupplyInterestFactorPrefix
@@ -147,6 +158,7 @@ type (
 	InterestRateModel         = types.InterestRateModel
 	InterestRateModels        = types.InterestRateModels
 	MoneyMarket               = types.MoneyMarket
+	MoneyMarketPauseProposal  = types.MoneyMarketPauseProposal
 	MoneyMarkets              = types.MoneyMarkets
 	MsgBorrow                 = types.MsgBorrow
 	MsgDeposit                = types.MsgDeposit
@@ -38,6 +38,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, gs
 	k.SetBorrowedCoins(ctx, gs.TotalBorrowed)
 	k.SetTotalReserves(ctx, gs.TotalReserves)
 
+	for _, denom := range gs.PausedMoneyMarkets {
+		k.SetMoneyMarketPaused(ctx, denom, true)
+	}
+
 	// check if the module account exists
 	DepositModuleAccount := supplyKeeper.GetModuleAccount(ctx, ModuleAccountName)
 	if DepositModuleAccount == nil {
@@ -106,5 +110,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	return NewGenesisState(
 		params, gats, deposits, borrows,
 		totalSupplied, totalBorrowed, totalReserves,
+		k.GetPausedMoneyMarkets(ctx),
 	)
 }
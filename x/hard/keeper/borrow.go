@@ -115,6 +115,20 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 	if amount.IsZero() {
 		return types.ErrBorrowEmptyCoins
 	}
+	if err := k.validateMoneyMarketsNotPaused(ctx, amount); err != nil {
+		return err
+	}
+	// The loan-to-value check below needs healthy prices for the borrower's whole position
+	positionCoins := amount
+	if deposit, found := k.GetDeposit(ctx, borrower); found {
+		positionCoins = positionCoins.Add(deposit.Amount...)
+	}
+	if borrow, found := k.GetBorrow(ctx, borrower); found {
+		positionCoins = positionCoins.Add(borrow.Amount...)
+	}
+	if err := k.validatePricesHealthy(ctx, positionCoins); err != nil {
+		return err
+	}
 
 	// The reserve coins aren't available for users to borrow
 	hardMaccCoins := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
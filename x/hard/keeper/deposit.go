@@ -116,7 +116,7 @@ func (k Keeper) ValidateDeposit(ctx sdk.Context, coins sdk.Coins) error {
 		}
 	}
 
-	return nil
+	return k.validateMoneyMarketsNotPaused(ctx, coins)
 }
 
 // GetTotalDeposited returns the total amount deposited for the input deposit type and deposit denom
@@ -810,11 +810,13 @@ func (suite *KeeperTestSuite) TestBorrowInterest() {
 						sdk.NewInt(KAVA_CF),       // Conversion Factor
 						tc.args.interestRateModel, // Interest Rate Model
 						tc.args.reserveFactor,     // Reserve Factor
-						sdk.ZeroDec()),            // Keeper Reward Percentage
+						sdk.ZeroDec(),             // Keeper Reward Percentage
+						types.EnglishAuctionType), // Auction Type
 				},
 				sdk.NewDec(10),
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultPausedMoneyMarkets,
 			)
 
 			// Pricefeed module genesis state
@@ -1217,18 +1219,21 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 						sdk.NewInt(KAVA_CF),       // Conversion Factor
 						tc.args.interestRateModel, // Interest Rate Model
 						tc.args.reserveFactor,     // Reserve Factor
-						sdk.ZeroDec()),            // Keeper Reward Percentage
+						sdk.ZeroDec(),             // Keeper Reward Percentage
+						types.EnglishAuctionType), // Auction Type
 					types.NewMoneyMarket("bnb",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
 						"bnb:usd",                 // Market ID
 						sdk.NewInt(BNB_CF),        // Conversion Factor
 						tc.args.interestRateModel, // Interest Rate Model
 						tc.args.reserveFactor,     // Reserve Factor
-						sdk.ZeroDec()),            // Keeper Reward Percentage
+						sdk.ZeroDec(),             // Keeper Reward Percentage
+						types.EnglishAuctionType), // Auction Type
 				},
 				sdk.NewDec(10),
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultPausedMoneyMarkets,
 			)
 
 			// Pricefeed module genesis state
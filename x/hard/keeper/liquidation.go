@@ -45,6 +45,10 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 		return types.ErrBorrowNotFound
 	}
 
+	if err := k.validatePricesHealthy(ctx, deposit.Amount.Add(borrow.Amount...)); err != nil {
+		return err
+	}
+
 	isWithinRange, err := k.IsWithinValidLtvRange(ctx, deposit, borrow)
 	if err != nil {
 		return err
@@ -131,6 +135,28 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 	return err
 }
 
+// startCollateralAuction starts an auction of the type set in the lot's money market, selling the lot to raise the bid
+func (k Keeper) startCollateralAuction(ctx sdk.Context, lot, bid sdk.Coin, returnAddrs []sdk.AccAddress,
+	weights []sdk.Int, debt sdk.Coin, liqMap map[string]LiqData) error {
+	moneyMarket, found := k.GetMoneyMarket(ctx, lot.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", lot.Denom)
+	}
+
+	if moneyMarket.AuctionType != types.DutchAuctionType {
+		_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+		return err
+	}
+
+	// dutch auctions start from the price of one base unit of the lot in base units of the bid
+	lotData := liqMap[lot.Denom]
+	bidData := liqMap[bid.Denom]
+	oraclePrice := lotData.price.MulInt(bidData.conversionFactor).Quo(bidData.price.MulInt(lotData.conversionFactor))
+
+	_, err := k.auctionKeeper.StartDutchCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt, oraclePrice)
+	return err
+}
+
 // StartAuctions attempts to start auctions for seized assets
 func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows, deposits sdk.Coins,
 	depositCoinValues, borrowCoinValues types.ValuationMap, ltv sdk.Dec, liqMap map[string]LiqData) (sdk.Coins, error) {
@@ -178,7 +204,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = full borrow amount, lot = maxLotSize
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				err := k.startCollateralAuction(ctx, lot, bid, returnAddrs, weights, debt, liqMap)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -229,7 +255,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				}
 
 				// Start auction: bid = maxBid, lot = whole deposit amount
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+				err := k.startCollateralAuction(ctx, lot, bid, returnAddrs, weights, debt, liqMap)
 				if err != nil {
 					return liquidatedCoins, err
 				}
@@ -396,6 +422,33 @@ func getDenoms(coins sdk.Coins) []string {
 	return denoms
 }
 
+// GetAuctionReferencePrice returns the oracle price of one unit of a hard auction's lot, in units of its bid.
+// Both denoms are priced with their money market's spot market. It returns false if either denom can't be priced.
+func (k Keeper) GetAuctionReferencePrice(ctx sdk.Context, lotDenom, bidDenom string) (sdk.Dec, bool) {
+	lotPrice, found := k.getBaseUnitPrice(ctx, lotDenom)
+	if !found {
+		return sdk.Dec{}, false
+	}
+	bidPrice, found := k.getBaseUnitPrice(ctx, bidDenom)
+	if !found || !bidPrice.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	return lotPrice.Quo(bidPrice), true
+}
+
+// getBaseUnitPrice returns the USD price of one unit of a money market's denom.
+func (k Keeper) getBaseUnitPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	mm, found := k.GetMoneyMarket(ctx, denom)
+	if !found {
+		return sdk.Dec{}, false
+	}
+	priceData, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+	if err != nil {
+		return sdk.Dec{}, false
+	}
+	return priceData.Price.QuoInt(mm.ConversionFactor), true
+}
+
 func removeDuplicates(one []string, two []string) []string {
 	check := make(map[string]int)
 	fullList := append(one, two...)
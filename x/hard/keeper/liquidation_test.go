@@ -32,6 +32,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 		expectedKeeperCoins        sdk.Coins         // coins keeper address should have after successfully liquidating position
 		expectedBorrowerCoins      sdk.Coins         // additional coins (if any) the borrower address should have after successfully liquidating position
 		expectedAuctions           auctypes.Auctions // the auctions we should expect to find have been started
+		auctionType                string            // auction type of all money markets, english if blank
 	}
 
 	type errArgs struct {
@@ -99,6 +100,47 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				contains:   "",
 			},
 		},
+		{
+			"valid: keeper liquidates borrow into dutch auction",
+			args{
+				borrower:                   borrower,
+				keeper:                     keeper,
+				keeperRewardPercent:        sdk.MustNewDecFromStr("0.05"),
+				initialModuleCoins:         sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				initialBorrowerCoins:       sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				initialKeeperCoins:         sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				depositCoins:               sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))),
+				borrowCoins:                sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(8*KAVA_CF))),
+				liquidateAfter:             oneMonthInSeconds,
+				auctionType:                types.DutchAuctionType,
+				expectedTotalSuppliedCoins: sdk.NewCoins(sdk.NewInt64Coin("ukava", 504138)),
+				expectedTotalBorrowedCoins: nil,
+				expectedKeeperCoins:        sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100500020))),
+				expectedBorrowerCoins:      sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(98000001))), // initial - deposit + borrow + liquidation leftovers
+				expectedAuctions: auctypes.Auctions{
+					auctypes.DutchCollateralAuction{
+						BaseAuction: auctypes.BaseAuction{
+							ID:              1,
+							Initiator:       "hard",
+							Lot:             sdk.NewInt64Coin("ukava", 9500390),
+							Bidder:          nil,
+							Bid:             sdk.NewInt64Coin("ukava", 0),
+							HasReceivedBids: false,
+						},
+						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
+						MaxBid:            sdk.NewInt64Coin("ukava", 8004766),
+						LotReturns:        lotReturns,
+						StartPrice:        sdk.MustNewDecFromStr("1.2"), // lot and bid are both ukava, with the default markup
+						FloorPrice:        auctypes.DefaultDutchPriceFloor,
+						DecayCurve:        auctypes.DefaultDutchDecayCurve,
+					},
+				},
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
 		{
 			"valid: single deposit, multiple borrows",
 			args{
@@ -463,57 +505,65 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				types.MoneyMarkets{
 					types.NewMoneyMarket("usdx",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
-						"usdx:usd",                   // Market ID
-						sdk.NewInt(KAVA_CF),          // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"usdx:usd",                  // Market ID
+						sdk.NewInt(KAVA_CF),         // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						tc.args.auctionType),        // Auction Type
 					types.NewMoneyMarket("usdt",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
-						"usdt:usd",                   // Market ID
-						sdk.NewInt(KAVA_CF),          // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"usdt:usd",                  // Market ID
+						sdk.NewInt(KAVA_CF),         // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						tc.args.auctionType),        // Auction Type
 					types.NewMoneyMarket("usdc",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
-						"usdc:usd",                   // Market ID
-						sdk.NewInt(KAVA_CF),          // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"usdc:usd",                  // Market ID
+						sdk.NewInt(KAVA_CF),         // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						tc.args.auctionType),        // Auction Type
 					types.NewMoneyMarket("dai",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
-						"dai:usd",                    // Market ID
-						sdk.NewInt(KAVA_CF),          // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"dai:usd",                   // Market ID
+						sdk.NewInt(KAVA_CF),         // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						tc.args.auctionType),        // Auction Type
 					types.NewMoneyMarket("ukava",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
-						"kava:usd",                   // Market ID
-						sdk.NewInt(KAVA_CF),          // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"kava:usd",                  // Market ID
+						sdk.NewInt(KAVA_CF),         // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						tc.args.auctionType),        // Auction Type
 					types.NewMoneyMarket("bnb",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
-						"bnb:usd",                    // Market ID
-						sdk.NewInt(BNB_CF),           // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"bnb:usd",                   // Market ID
+						sdk.NewInt(BNB_CF),          // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						tc.args.auctionType),        // Auction Type
 					types.NewMoneyMarket("btc",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*BTCB_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
-						"btc:usd",                    // Market ID
-						sdk.NewInt(BTCB_CF),          // Conversion Factor
-						model,                        // Interest Rate Model
-						reserveFactor,                // Reserve Factor
-						tc.args.keeperRewardPercent), // Keeper Reward Percent
+						"btc:usd",                   // Market ID
+						sdk.NewInt(BTCB_CF),         // Conversion Factor
+						model,                       // Interest Rate Model
+						reserveFactor,               // Reserve Factor
+						tc.args.keeperRewardPercent, // Keeper Reward Percent
+						tc.args.auctionType),        // Auction Type
 				},
 				sdk.NewDec(10),
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultPausedMoneyMarkets,
 			)
 
 			// Pricefeed module genesis state
@@ -643,7 +693,15 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				accBorrower := suite.getAccountAtCtx(tc.args.borrower, liqCtx)
 				suite.Require().Equal(tc.args.expectedBorrowerCoins, accBorrower.GetCoins())
 
-				// Check that the expected auctions have been created
+				// Check that the expected auctions have been created, dutch auctions starting at the liquidation time
+				for i, a := range tc.args.expectedAuctions {
+					if dutchAuction, ok := a.(auctypes.DutchCollateralAuction); ok {
+						dutchAuction.StartTime = runAtTime.UTC()
+						dutchAuction.EndTime = runAtTime.UTC().Add(auctypes.DefaultMaxAuctionDuration)
+						dutchAuction.MaxEndTime = dutchAuction.EndTime
+						tc.args.expectedAuctions[i] = dutchAuction
+					}
+				}
 				auctions := suite.auctionKeeper.GetAllAuctions(liqCtx)
 				suite.Require().True(len(auctions) > 0)
 				suite.Require().Equal(tc.args.expectedAuctions, auctions)
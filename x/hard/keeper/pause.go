@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// IsMoneyMarketPaused returns whether deposits and borrows of a money market are paused
+func (k Keeper) IsMoneyMarketPaused(ctx sdk.Context, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PausedMoneyMarketsPrefix)
+	return store.Has([]byte(denom))
+}
+
+// SetMoneyMarketPaused pauses or unpauses deposits and borrows of a money market
+func (k Keeper) SetMoneyMarketPaused(ctx sdk.Context, denom string, paused bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PausedMoneyMarketsPrefix)
+	if !paused {
+		store.Delete([]byte(denom))
+		return
+	}
+	store.Set([]byte(denom), k.cdc.MustMarshalBinaryBare(paused))
+}
+
+// GetPausedMoneyMarkets returns the denoms of all paused money markets
+func (k Keeper) GetPausedMoneyMarkets(ctx sdk.Context) []string {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PausedMoneyMarketsPrefix)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(types.PausedMoneyMarketsPrefix):]))
+	}
+	return denoms
+}
+
+// PauseMoneyMarket pauses or unpauses deposits and borrows of an existing money market
+func (k Keeper) PauseMoneyMarket(ctx sdk.Context, denom string, paused bool) error {
+	if _, found := k.GetMoneyMarket(ctx, denom); !found {
+		return sdkerrors.Wrapf(types.ErrMoneyMarketNotFound, "%s", denom)
+	}
+	k.SetMoneyMarketPaused(ctx, denom, paused)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardMoneyMarketPause,
+			sdk.NewAttribute(types.AttributeKeyDepositDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(paused)),
+		),
+	)
+	return nil
+}
+
+// validateMoneyMarketsNotPaused returns an error if any of the coins are in a paused money market
+func (k Keeper) validateMoneyMarketsNotPaused(ctx sdk.Context, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if k.IsMoneyMarketPaused(ctx, coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrMoneyMarketPaused, "%s", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// validatePricesHealthy returns an error if the spot price of any of the coins' money markets is unhealthy
+func (k Keeper) validatePricesHealthy(ctx sdk.Context, coins sdk.Coins) error {
+	for _, coin := range coins {
+		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		if !k.pricefeedKeeper.IsMarketHealthy(ctx, moneyMarket.SpotMarketID) {
+			return sdkerrors.Wrapf(types.ErrPriceUnhealthy, "market %s", moneyMarket.SpotMarketID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestPauseMoneyMarket() {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	authGS := app.NewAuthGenState([]sdk.AccAddress{depositor}, []sdk.Coins{sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1000)))})
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), sdk.MustNewDecFromStr("0.6")), "bnb:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.EnglishAuctionType),
+		},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+		types.DefaultPausedMoneyMarkets,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{
+				MarketID:      "bnb:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("10.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+		},
+	}
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	err := suite.keeper.PauseMoneyMarket(suite.ctx, "fake", true)
+	suite.Require().True(errors.Is(err, types.ErrMoneyMarketNotFound))
+
+	err = suite.keeper.PauseMoneyMarket(suite.ctx, "bnb", true)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.IsMoneyMarketPaused(suite.ctx, "bnb"))
+	suite.Require().Equal([]string{"bnb"}, suite.keeper.GetPausedMoneyMarkets(suite.ctx))
+
+	err = suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100))))
+	suite.Require().True(errors.Is(err, types.ErrMoneyMarketPaused))
+
+	err = suite.keeper.PauseMoneyMarket(suite.ctx, "bnb", false)
+	suite.Require().NoError(err)
+	suite.Require().False(suite.keeper.IsMoneyMarketPaused(suite.ctx, "bnb"))
+	suite.Require().Empty(suite.keeper.GetPausedMoneyMarkets(suite.ctx))
+
+	err = suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100))))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestUnhealthyPriceBlocksBorrowAndWithdraw() {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	authGS := app.NewAuthGenState([]sdk.AccAddress{depositor}, []sdk.Coins{sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1000000000)))})
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), sdk.MustNewDecFromStr("0.6")), "bnb:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec(), types.EnglishAuctionType),
+		},
+		sdk.NewDec(10),
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+		types.DefaultPausedMoneyMarkets,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{
+				MarketID:      "bnb:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("10.00"),
+				Expiry:        time.Now().Add(1 * time.Hour),
+			},
+		},
+	}
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)},
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	err := suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(500000000))))
+	suite.Require().NoError(err)
+
+	// Trip the bnb:usd price guard
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	trip := pricefeed.NewGuardTrip("bnb:usd", pricefeed.GuardTripDeviation, 1, suite.ctx.BlockTime(), sdk.MustNewDecFromStr("20.00"), sdk.MustNewDecFromStr("10.00"))
+	pricefeedKeeper.SetGuardTrip(suite.ctx, trip)
+
+	err = suite.keeper.Borrow(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100000000))))
+	suite.Require().True(errors.Is(err, types.ErrPriceUnhealthy))
+
+	// Deposits and withdrawals without a borrow are not blocked
+	err = suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100000000))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Withdraw(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100000000))))
+	suite.Require().NoError(err)
+
+	// Once the market recovers, borrows are allowed again
+	trip.RecoveredHeight = 2
+	trip.RecoveredTime = suite.ctx.BlockTime()
+	pricefeedKeeper.SetGuardTrip(suite.ctx, trip)
+
+	err = suite.keeper.Borrow(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100000000))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Withdraw(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100000000))))
+	suite.Require().NoError(err)
+
+	// Withdrawals by a depositor with a borrow are blocked while the price is unhealthy
+	trip = pricefeed.NewGuardTrip("bnb:usd", pricefeed.GuardTripDeviation, 3, suite.ctx.BlockTime(), sdk.MustNewDecFromStr("20.00"), sdk.MustNewDecFromStr("10.00"))
+	pricefeedKeeper.SetGuardTrip(suite.ctx, trip)
+	err = suite.keeper.Withdraw(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100000000))))
+	suite.Require().True(errors.Is(err, types.ErrPriceUnhealthy))
+}
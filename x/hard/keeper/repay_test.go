@@ -172,22 +172,25 @@ func (suite *KeeperTestSuite) TestRepay() {
 				types.MoneyMarkets{
 					types.NewMoneyMarket("usdx",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1")), // Borrow Limit
-						"usdx:usd",                     // Market ID
-						sdk.NewInt(USDX_CF),            // Conversion Factor
-						model,                          // Interest Rate Model
-						sdk.MustNewDecFromStr("0.05"),  // Reserve Factor
-						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+						"usdx:usd",                    // Market ID
+						sdk.NewInt(USDX_CF),           // Conversion Factor
+						model,                         // Interest Rate Model
+						sdk.MustNewDecFromStr("0.05"), // Reserve Factor
+						sdk.MustNewDecFromStr("0.05"), // Keeper Reward Percent
+						types.EnglishAuctionType),     // Auction Type
 					types.NewMoneyMarket("ukava",
 						types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
-						"kava:usd",                     // Market ID
-						sdk.NewInt(KAVA_CF),            // Conversion Factor
-						model,                          // Interest Rate Model
-						sdk.MustNewDecFromStr("0.05"),  // Reserve Factor
-						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+						"kava:usd",                    // Market ID
+						sdk.NewInt(KAVA_CF),           // Conversion Factor
+						model,                         // Interest Rate Model
+						sdk.MustNewDecFromStr("0.05"), // Reserve Factor
+						sdk.MustNewDecFromStr("0.05"), // Keeper Reward Percent
+						types.EnglishAuctionType),     // Auction Type
 				},
 				sdk.NewDec(10),
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+				types.DefaultPausedMoneyMarkets,
 			)
 
 			// Pricefeed module genesis state
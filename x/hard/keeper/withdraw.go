@@ -38,6 +38,13 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 		borrow = types.Borrow{}
 	}
 
+	// withdrawals without a borrow do not depend on prices, so they are not blocked by unhealthy prices
+	if len(borrow.Amount) > 0 {
+		if err := k.validatePricesHealthy(ctx, deposit.Amount.Add(borrow.Amount...)); err != nil {
+			return err
+		}
+	}
+
 	proposedDeposit := types.NewDeposit(deposit.Depositor, deposit.Amount.Sub(amount), types.SupplyInterestFactors{})
 	valid, err := k.IsWithinValidLtvRange(ctx, proposedDeposit, borrow)
 	if err != nil {
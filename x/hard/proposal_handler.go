@@ -0,0 +1,30 @@
+package hard
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// NewProposalHandler creates a handler for hard proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.MoneyMarketPauseProposal:
+			return handleMoneyMarketPauseProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleMoneyMarketPauseProposal(ctx sdk.Context, k keeper.Keeper, p types.MoneyMarketPauseProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPubProposal, err.Error())
+	}
+	return k.PauseMoneyMarket(ctx, p.Denom, p.Paused)
+}
@@ -19,4 +19,5 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBorrow{}, "hard/MsgBorrow", nil)
 	cdc.RegisterConcrete(MsgLiquidate{}, "hard/MsgLiquidate", nil)
 	cdc.RegisterConcrete(MsgRepay{}, "hard/MsgRepay", nil)
+	cdc.RegisterConcrete(MoneyMarketPauseProposal{}, "hard/MoneyMarketPauseProposal", nil)
 }
@@ -69,4 +69,10 @@ var (
 	ErrExceedsProtocolBorrowableBalance = sdkerrors.Register(ModuleName, 31, "exceeds borrowable module account balance")
 	// ErrReservesExceedCash for when the protocol is insolvent because available reserves exceeds available cash
 	ErrReservesExceedCash = sdkerrors.Register(ModuleName, 32, "insolvency - protocol reserves exceed available cash")
+	// ErrMoneyMarketPaused for when a deposit or borrow is made in a paused money market
+	ErrMoneyMarketPaused = sdkerrors.Register(ModuleName, 33, "money market paused")
+	// ErrInvalidPubProposal for when a hard proposal is invalid
+	ErrInvalidPubProposal = sdkerrors.Register(ModuleName, 34, "invalid pubproposal")
+	// ErrPriceUnhealthy for when a price guard has marked the price of a money market unhealthy
+	ErrPriceUnhealthy = sdkerrors.Register(ModuleName, 35, "price unhealthy")
 )
@@ -17,12 +17,13 @@ type GenesisState struct {
 	TotalSupplied             sdk.Coins                `json:"total_supplied" yaml:"total_supplied"`
 	TotalBorrowed             sdk.Coins                `json:"total_borrowed" yaml:"total_borrowed"`
 	TotalReserves             sdk.Coins                `json:"total_reserves" yaml:"total_reserves"`
+	PausedMoneyMarkets        []string                 `json:"paused_money_markets" yaml:"paused_money_markets"`
 }
 
 // NewGenesisState returns a new genesis state
 func NewGenesisState(
 	params Params, prevAccumulationTimes GenesisAccumulationTimes, deposits Deposits,
-	borrows Borrows, totalSupplied, totalBorrowed, totalReserves sdk.Coins) GenesisState {
+	borrows Borrows, totalSupplied, totalBorrowed, totalReserves sdk.Coins, pausedMoneyMarkets []string) GenesisState {
 	return GenesisState{
 		Params:                    params,
 		PreviousAccumulationTimes: prevAccumulationTimes,
@@ -31,6 +32,7 @@ func NewGenesisState(
 		TotalSupplied:             totalSupplied,
 		TotalBorrowed:             totalBorrowed,
 		TotalReserves:             totalReserves,
+		PausedMoneyMarkets:        pausedMoneyMarkets,
 	}
 }
 
@@ -44,6 +46,7 @@ func DefaultGenesisState() GenesisState {
 		TotalSupplied:             DefaultTotalSupplied,
 		TotalBorrowed:             DefaultTotalBorrowed,
 		TotalReserves:             DefaultTotalReserves,
+		PausedMoneyMarkets:        DefaultPausedMoneyMarkets,
 	}
 }
 
@@ -73,6 +76,25 @@ func (gs GenesisState) Validate() error {
 	if !gs.TotalReserves.IsValid() {
 		return fmt.Errorf("invalid total reserves coins: %s", gs.TotalReserves)
 	}
+
+	pausedMap := make(map[string]bool, len(gs.PausedMoneyMarkets))
+	for _, denom := range gs.PausedMoneyMarkets {
+		if pausedMap[denom] {
+			return fmt.Errorf("duplicate paused money market: %s", denom)
+		}
+		pausedMap[denom] = true
+
+		found := false
+		for _, mm := range gs.Params.MoneyMarkets {
+			if mm.Denom == denom {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("paused money market %s not found in params", denom)
+		}
+	}
 	return nil
 }
 
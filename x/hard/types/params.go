@@ -9,6 +9,12 @@ import (
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 )
 
+// Auction types that liquidated deposits can be sold in
+const (
+	EnglishAuctionType = cdptypes.EnglishAuctionType
+	DutchAuctionType   = cdptypes.DutchAuctionType
+)
+
 // Parameter keys and default values
 var (
 	KeyMoneyMarkets              = []byte("MoneyMarkets")
@@ -22,6 +28,7 @@ var (
 	DefaultTotalReserves         = sdk.Coins{}
 	DefaultDeposits              = Deposits{}
 	DefaultBorrows               = Borrows{}
+	DefaultPausedMoneyMarkets    = []string{}
 )
 
 // Params governance parameters for hard module
@@ -83,11 +90,12 @@ type MoneyMarket struct {
 	InterestRateModel      InterestRateModel `json:"interest_rate_model" yaml:"interest_rate_model"`
 	ReserveFactor          sdk.Dec           `json:"reserve_factor" yaml:"reserve_factor"`
 	KeeperRewardPercentage sdk.Dec           `json:"keeper_reward_percentage" yaml:"keeper_reward_percentages"`
+	AuctionType            string            `json:"auction_type" yaml:"auction_type"` // english (the default if blank) or dutch
 }
 
 // NewMoneyMarket returns a new MoneyMarket
 func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string, conversionFactor sdk.Int,
-	interestRateModel InterestRateModel, reserveFactor, keeperRewardPercentage sdk.Dec) MoneyMarket {
+	interestRateModel InterestRateModel, reserveFactor, keeperRewardPercentage sdk.Dec, auctionType string) MoneyMarket {
 	return MoneyMarket{
 		Denom:                  denom,
 		BorrowLimit:            borrowLimit,
@@ -96,6 +104,7 @@ func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string,
 		InterestRateModel:      interestRateModel,
 		ReserveFactor:          reserveFactor,
 		KeeperRewardPercentage: keeperRewardPercentage,
+		AuctionType:            auctionType,
 	}
 }
 
@@ -121,6 +130,10 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("Keeper reward percentage must be between 0.0-1.0")
 	}
 
+	if mm.AuctionType != "" && mm.AuctionType != EnglishAuctionType && mm.AuctionType != DutchAuctionType {
+		return fmt.Errorf("Auction type must be %s or %s, is %s", EnglishAuctionType, DutchAuctionType, mm.AuctionType)
+	}
+
 	return nil
 }
 
@@ -147,6 +160,9 @@ func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if !mm.KeeperRewardPercentage.Equal(mmCompareTo.KeeperRewardPercentage) {
 		return false
 	}
+	if mm.AuctionType != mmCompareTo.AuctionType {
+		return false
+	}
 	return true
 }
 
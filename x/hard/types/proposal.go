@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeMoneyMarketPause is the type of proposals that pause or unpause a money market
+	ProposalTypeMoneyMarketPause = "MoneyMarketPause"
+)
+
+// ensure proposal types fulfill the gov Content interface.
+var _ govtypes.Content = MoneyMarketPauseProposal{}
+
+// MoneyMarketPauseProposal is a proposal for pausing or unpausing deposits and borrows in a single money market.
+type MoneyMarketPauseProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Denom       string `json:"denom" yaml:"denom"`
+	Paused      bool   `json:"paused" yaml:"paused"`
+}
+
+// NewMoneyMarketPauseProposal returns a new MoneyMarketPauseProposal
+func NewMoneyMarketPauseProposal(title, description, denom string, paused bool) MoneyMarketPauseProposal {
+	return MoneyMarketPauseProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+		Paused:      paused,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (mmp MoneyMarketPauseProposal) GetTitle() string { return mmp.Title }
+
+// GetDescription returns the description of the proposal.
+func (mmp MoneyMarketPauseProposal) GetDescription() string { return mmp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (mmp MoneyMarketPauseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (mmp MoneyMarketPauseProposal) ProposalType() string { return ProposalTypeMoneyMarketPause }
+
+// ValidateBasic runs basic stateless validity checks
+func (mmp MoneyMarketPauseProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(mmp); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(mmp.Denom); err != nil {
+		return fmt.Errorf("invalid money market denom: %w", err)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (mmp MoneyMarketPauseProposal) String() string {
+	bz, _ := yaml.Marshal(mmp)
+	return string(bz)
+}
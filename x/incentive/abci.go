@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
 )
 
 // BeginBlocker runs at the start of every block
@@ -33,4 +34,11 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			panic(err)
 		}
 	}
+	k.PruneRewardBudgetSpends(ctx)
+	for _, claimType := range types.ClaimTypes {
+		err := k.SweepExpiredClaims(ctx, claimType)
+		if err != nil {
+			panic(err)
+		}
+	}
 }
@@ -11,19 +11,29 @@ const (
 	BeginningOfMonth               = keeper.BeginningOfMonth
 	MidMonth                       = keeper.MidMonth
 	PaymentHour                    = keeper.PaymentHour
+	AttributeKeyBudget             = types.AttributeKeyBudget
 	AttributeKeyClaimAmount        = types.AttributeKeyClaimAmount
+	AttributeKeyClaimEnd           = types.AttributeKeyClaimEnd
 	AttributeKeyClaimPeriod        = types.AttributeKeyClaimPeriod
 	AttributeKeyClaimType          = types.AttributeKeyClaimType
 	AttributeKeyClaimedBy          = types.AttributeKeyClaimedBy
+	AttributeKeyCollateralType     = types.AttributeKeyCollateralType
+	AttributeKeyDestination        = types.AttributeKeyDestination
 	AttributeKeyRewardPeriod       = types.AttributeKeyRewardPeriod
+	AttributeKeyRewardType         = types.AttributeKeyRewardType
+	AttributeKeySweptAmount        = types.AttributeKeySweptAmount
 	AttributeValueCategory         = types.AttributeValueCategory
 	BondDenom                      = types.BondDenom
 	DefaultParamspace              = types.DefaultParamspace
+	EventTypeBudgetExhausted       = types.EventTypeBudgetExhausted
 	EventTypeClaim                 = types.EventTypeClaim
 	EventTypeClaimPeriod           = types.EventTypeClaimPeriod
 	EventTypeClaimPeriodExpiry     = types.EventTypeClaimPeriodExpiry
 	EventTypeRewardPeriod          = types.EventTypeRewardPeriod
+	HardBorrowRewardType           = types.HardBorrowRewardType
+	HardDelegatorRewardType        = types.HardDelegatorRewardType
 	HardLiquidityProviderClaimType = types.HardLiquidityProviderClaimType
+	HardSupplyRewardType           = types.HardSupplyRewardType
 	Large                          = types.Large
 	Medium                         = types.Medium
 	ModuleName                     = types.ModuleName
@@ -31,6 +41,7 @@ const (
 	QueryGetClaimPeriods           = types.QueryGetClaimPeriods
 	QueryGetHardRewards            = types.QueryGetHardRewards
 	QueryGetParams                 = types.QueryGetParams
+	QueryGetRewardBudgets          = types.QueryGetRewardBudgets
 	QueryGetRewardPeriods          = types.QueryGetRewardPeriods
 	QueryGetRewards                = types.QueryGetRewards
 	QueryGetUSDXMintingRewards     = types.QueryGetUSDXMintingRewards
@@ -41,6 +52,7 @@ const (
 	Small                          = types.Small
 	StoreKey                       = types.StoreKey
 	USDXMintingClaimType           = types.USDXMintingClaimType
+	USDXMintingRewardType          = types.USDXMintingRewardType
 )
 
 var (
@@ -48,12 +60,21 @@ var (
 	CalculateTimeElapsed                   = keeper.CalculateTimeElapsed
 	NewKeeper                              = keeper.NewKeeper
 	NewQuerier                             = keeper.NewQuerier
+	RegisterInvariants                     = keeper.RegisterInvariants
+	RewardBudgetInvariant                  = keeper.RewardBudgetInvariant
+	UpdateHoldingPeriods                   = keeper.UpdateHoldingPeriods
 	DefaultGenesisState                    = types.DefaultGenesisState
 	DefaultParams                          = types.DefaultParams
+	GetRewardBudgetSpentKey                = types.GetRewardBudgetSpentKey
 	GetTotalVestingPeriodLength            = types.GetTotalVestingPeriodLength
+	NewClaimGracePeriod                    = types.NewClaimGracePeriod
 	NewGenesisAccumulationTime             = types.NewGenesisAccumulationTime
+	NewGenesisClaimSweepEnd                = types.NewGenesisClaimSweepEnd
+	NewGenesisRewardBudgetSpend            = types.NewGenesisRewardBudgetSpend
 	NewGenesisState                        = types.NewGenesisState
 	NewHardLiquidityProviderClaim          = types.NewHardLiquidityProviderClaim
+	NewHoldingBoost                        = types.NewHoldingBoost
+	NewHoldingPeriod                       = types.NewHoldingPeriod
 	NewMsgClaimHardLiquidityProviderReward = types.NewMsgClaimHardLiquidityProviderReward
 	NewMsgClaimUSDXMintingReward           = types.NewMsgClaimUSDXMintingReward
 	NewMultiRewardIndex                    = types.NewMultiRewardIndex
@@ -62,23 +83,35 @@ var (
 	NewParams                              = types.NewParams
 	NewPeriod                              = types.NewPeriod
 	NewQueryHardRewardsParams              = types.NewQueryHardRewardsParams
+	NewQueryRewardBudgetsParams            = types.NewQueryRewardBudgetsParams
 	NewQueryRewardsParams                  = types.NewQueryRewardsParams
 	NewQueryUSDXMintingRewardsParams       = types.NewQueryUSDXMintingRewardsParams
+	NewRewardBudget                        = types.NewRewardBudget
+	NewRewardBudgetSpent                   = types.NewRewardBudgetSpent
 	NewRewardIndex                         = types.NewRewardIndex
 	NewRewardPeriod                        = types.NewRewardPeriod
 	NewUSDXMintingClaim                    = types.NewUSDXMintingClaim
 	ParamKeyTable                          = types.ParamKeyTable
 	RegisterCodec                          = types.RegisterCodec
+	ValidateClaimType                      = types.ValidateClaimType
+	ValidateRewardType                     = types.ValidateRewardType
 
 	// variable aliases
+	ClaimSweepKeyPrefix                             = types.ClaimSweepKeyPrefix
+	ClaimTypes                                      = types.ClaimTypes
 	DefaultActive                                   = types.DefaultActive
 	DefaultClaimEnd                                 = types.DefaultClaimEnd
+	DefaultClaimGracePeriods                        = types.DefaultClaimGracePeriods
+	DefaultClaimSweepEnds                           = types.DefaultClaimSweepEnds
 	DefaultGenesisAccumulationTimes                 = types.DefaultGenesisAccumulationTimes
 	DefaultHardClaims                               = types.DefaultHardClaims
+	DefaultHoldingBoosts                            = types.DefaultHoldingBoosts
 	DefaultMultiRewardPeriods                       = types.DefaultMultiRewardPeriods
 	DefaultMultipliers                              = types.DefaultMultipliers
+	DefaultRewardBudgetSpends                       = types.DefaultRewardBudgetSpends
 	DefaultRewardPeriods                            = types.DefaultRewardPeriods
 	DefaultUSDXClaims                               = types.DefaultUSDXClaims
+	DefaultUnclaimedRewardsDestination              = types.DefaultUnclaimedRewardsDestination
 	ErrAccountNotFound                              = types.ErrAccountNotFound
 	ErrClaimExpired                                 = types.ErrClaimExpired
 	ErrClaimNotFound                                = types.ErrClaimNotFound
@@ -97,17 +130,23 @@ var (
 	HardSupplyRewardIndexesKeyPrefix                = types.HardSupplyRewardIndexesKeyPrefix
 	IncentiveMacc                                   = types.IncentiveMacc
 	KeyClaimEnd                                     = types.KeyClaimEnd
+	KeyClaimGracePeriods                            = types.KeyClaimGracePeriods
 	KeyHardBorrowRewardPeriods                      = types.KeyHardBorrowRewardPeriods
 	KeyHardDelegatorRewardPeriods                   = types.KeyHardDelegatorRewardPeriods
+	KeyHardSupplyHoldingBoosts                      = types.KeyHardSupplyHoldingBoosts
 	KeyHardSupplyRewardPeriods                      = types.KeyHardSupplyRewardPeriods
 	KeyMultipliers                                  = types.KeyMultipliers
+	KeyUSDXMintingHoldingBoosts                     = types.KeyUSDXMintingHoldingBoosts
 	KeyUSDXMintingRewardPeriods                     = types.KeyUSDXMintingRewardPeriods
+	KeyUnclaimedRewardsDestination                  = types.KeyUnclaimedRewardsDestination
 	ModuleCdc                                       = types.ModuleCdc
 	PreviousHardBorrowRewardAccrualTimeKeyPrefix    = types.PreviousHardBorrowRewardAccrualTimeKeyPrefix
 	PreviousHardDelegatorRewardAccrualTimeKeyPrefix = types.PreviousHardDelegatorRewardAccrualTimeKeyPrefix
 	PreviousHardSupplyRewardAccrualTimeKeyPrefix    = types.PreviousHardSupplyRewardAccrualTimeKeyPrefix
 	PreviousUSDXMintingRewardAccrualTimeKeyPrefix   = types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix
 	PrincipalDenom                                  = types.PrincipalDenom
+	RewardBudgetSpentKeyPrefix                      = types.RewardBudgetSpentKeyPrefix
+	RewardTypes                                     = types.RewardTypes
 	USDXMintingClaimKeyPrefix                       = types.USDXMintingClaimKeyPrefix
 	USDXMintingRewardDenom                          = types.USDXMintingRewardDenom
 	USDXMintingRewardFactorKeyPrefix                = types.USDXMintingRewardFactorKeyPrefix
@@ -122,14 +161,24 @@ type (
 	CDPHooks                            = types.CDPHooks
 	CdpKeeper                           = types.CdpKeeper
 	Claim                               = types.Claim
+	ClaimGracePeriod                    = types.ClaimGracePeriod
+	ClaimGracePeriods                   = types.ClaimGracePeriods
 	Claims                              = types.Claims
 	GenesisAccumulationTime             = types.GenesisAccumulationTime
 	GenesisAccumulationTimes            = types.GenesisAccumulationTimes
+	GenesisClaimSweepEnd                = types.GenesisClaimSweepEnd
+	GenesisClaimSweepEnds               = types.GenesisClaimSweepEnds
+	GenesisRewardBudgetSpend            = types.GenesisRewardBudgetSpend
+	GenesisRewardBudgetSpends           = types.GenesisRewardBudgetSpends
 	GenesisState                        = types.GenesisState
 	HARDHooks                           = types.HARDHooks
 	HardKeeper                          = types.HardKeeper
 	HardLiquidityProviderClaim          = types.HardLiquidityProviderClaim
 	HardLiquidityProviderClaims         = types.HardLiquidityProviderClaims
+	HoldingBoost                        = types.HoldingBoost
+	HoldingBoosts                       = types.HoldingBoosts
+	HoldingPeriod                       = types.HoldingPeriod
+	HoldingPeriods                      = types.HoldingPeriods
 	MsgClaimHardLiquidityProviderReward = types.MsgClaimHardLiquidityProviderReward
 	MsgClaimUSDXMintingReward           = types.MsgClaimUSDXMintingReward
 	MultiRewardIndex                    = types.MultiRewardIndex
@@ -142,8 +191,12 @@ type (
 	Params                              = types.Params
 	PostClaimReq                        = types.PostClaimReq
 	QueryHardRewardsParams              = types.QueryHardRewardsParams
+	QueryRewardBudgetsParams            = types.QueryRewardBudgetsParams
 	QueryRewardsParams                  = types.QueryRewardsParams
 	QueryUSDXMintingRewardsParams       = types.QueryUSDXMintingRewardsParams
+	RewardBudget                        = types.RewardBudget
+	RewardBudgets                       = types.RewardBudgets
+	RewardBudgetSpent                   = types.RewardBudgetSpent
 	RewardIndex                         = types.RewardIndex
 	RewardIndexes                       = types.RewardIndexes
 	RewardPeriod                        = types.RewardPeriod
@@ -17,8 +17,9 @@ import (
 )
 
 const (
-	flagOwner = "owner"
-	flagType  = "type"
+	flagOwner          = "owner"
+	flagType           = "type"
+	flagCollateralType = "collateral-type"
 )
 
 // GetQueryCmd returns the cli query commands for the incentive module
@@ -31,6 +32,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	incentiveQueryCmd.AddCommand(flags.GetCommands(
 		queryParamsCmd(queryRoute, cdc),
 		queryRewardsCmd(queryRoute, cdc),
+		queryRewardBudgetsCmd(queryRoute, cdc),
 	)...)
 
 	return incentiveQueryCmd
@@ -139,6 +141,55 @@ func queryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func queryRewardBudgetsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-budgets",
+		Short: "query the remaining budget of reward periods",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the budget, spent and remaining rewards of reward periods with optional flags for reward type and collateral type
+
+			Example:
+			$ %s query %s reward-budgets
+			$ %s query %s reward-budgets --type hard_supply
+			$ %s query %s reward-budgets --type usdx_minting --collateral-type bnb-a
+			`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			strType := strings.ToLower(viper.GetString(flagType))
+			if strType != "" {
+				if err := types.ValidateRewardType(strType); err != nil {
+					return err
+				}
+			}
+			params := types.NewQueryRewardBudgetsParams(strType, viper.GetString(flagCollateralType))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetRewardBudgets)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var budgets types.RewardBudgets
+			if err := cdc.UnmarshalJSON(res, &budgets); err != nil {
+				return fmt.Errorf("failed to unmarshal reward budgets: %w", err)
+			}
+			return cliCtx.PrintOutput(budgets)
+		},
+	}
+	cmd.Flags().String(flagType, "", "(optional) filter by reward type")
+	cmd.Flags().String(flagCollateralType, "", "(optional) filter by collateral type")
+	return cmd
+}
+
 func executeHardRewardsQuery(queryRoute string, cdc *codec.Codec, cliCtx context.CLIContext,
 	params types.QueryHardRewardsParams) (types.HardLiquidityProviderClaims, error) {
 	bz, err := cdc.MarshalJSON(params)
@@ -71,6 +71,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper types.SupplyKeep
 		k.SetPreviousHardDelegatorRewardAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
 	}
 
+	for _, spend := range gs.RewardBudgetSpends {
+		k.SetRewardBudgetSpent(ctx, spend.RewardType, spend.CollateralType, spend.PeriodStart, spend.Spent)
+	}
+
+	for _, sweepEnd := range gs.ClaimSweepEnds {
+		k.SetPreviousClaimSweepEnd(ctx, sweepEnd.ClaimType, sweepEnd.ClaimEnd)
+	}
+
 	for i, claim := range gs.USDXMintingClaims {
 		for j, ri := range claim.RewardIndexes {
 			if ri.RewardFactor != sdk.ZeroDec() {
@@ -162,5 +170,5 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		gats = append(gats, gat)
 	}
 
-	return types.NewGenesisState(params, gats, DefaultGenesisAccumulationTimes, DefaultGenesisAccumulationTimes, DefaultGenesisAccumulationTimes, synchronizedUsdxClaims, synchronizedHardClaims)
+	return types.NewGenesisState(params, gats, DefaultGenesisAccumulationTimes, DefaultGenesisAccumulationTimes, DefaultGenesisAccumulationTimes, synchronizedUsdxClaims, synchronizedHardClaims, k.GetGenesisRewardBudgetSpends(ctx), k.GetGenesisClaimSweepEnds(ctx))
 }
@@ -0,0 +1,44 @@
+package incentive_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/incentive"
+)
+
+func TestExportGenesisClaimSweepEnds(t *testing.T) {
+	claimEnd := time.Date(2021, 6, 1, 14, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: claimEnd.Add(time.Hour)})
+	tApp.InitializeFromGenesisStates()
+	keeper := tApp.GetIncentiveKeeper()
+
+	// only swept claim types are exported
+	keeper.SetPreviousClaimSweepEnd(ctx, incentive.USDXMintingClaimType, claimEnd)
+	exportedGenesisState := incentive.ExportGenesis(ctx, keeper)
+	require.Equal(t,
+		incentive.GenesisClaimSweepEnds{incentive.NewGenesisClaimSweepEnd(incentive.USDXMintingClaimType, claimEnd)},
+		exportedGenesisState.ClaimSweepEnds,
+	)
+
+	// importing the exported state restores the sweep progress
+	tApp = app.NewTestApp()
+	ctx = tApp.NewContext(true, abci.Header{Height: 1, Time: claimEnd.Add(time.Hour)})
+	tApp.InitializeFromGenesisStates(app.GenesisState{incentive.ModuleName: incentive.ModuleCdc.MustMarshalJSON(exportedGenesisState)})
+	keeper = tApp.GetIncentiveKeeper()
+
+	sweepEnd, found := keeper.GetPreviousClaimSweepEnd(ctx, incentive.USDXMintingClaimType)
+	require.True(t, found)
+	require.Equal(t, claimEnd, sweepEnd)
+	_, found = keeper.GetPreviousClaimSweepEnd(ctx, incentive.HardLiquidityProviderClaimType)
+	require.False(t, found)
+
+	require.Equal(t, exportedGenesisState, incentive.ExportGenesis(ctx, keeper))
+}
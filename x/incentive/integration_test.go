@@ -164,6 +164,8 @@ func NewIncentiveGenState(previousAccumTime, endTime time.Time, rewardPeriods ..
 				incentive.NewMultiplier(incentive.Large, 12, d("1.0")),
 			},
 			endTime,
+			incentive.DefaultClaimGracePeriods, incentive.DefaultUnclaimedRewardsDestination,
+			incentive.DefaultHoldingBoosts, incentive.DefaultHoldingBoosts,
 		),
 		accumulationTimes,
 		accumulationTimes,
@@ -171,6 +173,8 @@ func NewIncentiveGenState(previousAccumTime, endTime time.Time, rewardPeriods ..
 		incentive.DefaultGenesisAccumulationTimes,
 		incentive.DefaultUSDXClaims,
 		incentive.DefaultHardClaims,
+		incentive.DefaultRewardBudgetSpends,
+		incentive.DefaultClaimSweepEnds,
 	)
 	return app.GenesisState{incentive.ModuleName: incentive.ModuleCdc.MustMarshalJSON(genesis)}
 }
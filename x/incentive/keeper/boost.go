@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// UpdateHoldingPeriods records the position amount observed when a claim is synchronized and returns the updated
+// holding periods along with the boost factor that applies to rewards accrued since the previous synchronization.
+//
+// Claims are synchronized before every modification of the underlying position, so the observed amount is the amount
+// that was held since the previous synchronization. The boost factor is averaged over the time since the previous
+// synchronization, so rewards do not depend on how often a position is synchronized. A position that is below the boost threshold loses its holding period,
+// a position that was reduced (for example by a withdrawal or repayment) restarts its holding period.
+func UpdateHoldingPeriods(periods types.HoldingPeriods, boost types.HoldingBoost, foundBoost bool, collateralType string, amount sdk.Int, blockTime time.Time) (types.HoldingPeriods, sdk.Dec) {
+	if !foundBoost || amount.IsZero() || amount.LT(boost.Threshold) {
+		return periods.RemoveHoldingPeriod(collateralType), sdk.OneDec()
+	}
+
+	holdingPeriod, index, found := periods.GetHoldingPeriod(collateralType)
+	if !found {
+		return append(periods, types.NewHoldingPeriod(collateralType, blockTime, amount)), sdk.OneDec()
+	}
+
+	if amount.LT(holdingPeriod.Amount) {
+		periods[index] = types.NewHoldingPeriod(collateralType, blockTime, amount)
+		return periods, sdk.OneDec()
+	}
+
+	lastSync := holdingPeriod.LastSync
+	if lastSync.Before(holdingPeriod.Since) {
+		lastSync = holdingPeriod.Since
+	}
+	periods[index].Amount = amount
+	periods[index].LastSync = blockTime
+	return periods, boost.AverageBoostFactor(lastSync.Sub(holdingPeriod.Since), blockTime.Sub(holdingPeriod.Since))
+}
+
+// resetHoldingPeriod restarts the holding period of a newly opened position
+func resetHoldingPeriod(periods types.HoldingPeriods, boost types.HoldingBoost, foundBoost bool, collateralType string, amount sdk.Int, blockTime time.Time) types.HoldingPeriods {
+	periods = periods.RemoveHoldingPeriod(collateralType)
+	periods, _ = UpdateHoldingPeriods(periods, boost, foundBoost, collateralType, amount, blockTime)
+	return periods
+}
+
+// getHoldingBoostFactor returns the boost factor of a position without updating its holding period, used when simulating synchronization
+func getHoldingBoostFactor(periods types.HoldingPeriods, boost types.HoldingBoost, foundBoost bool, collateralType string, amount sdk.Int, blockTime time.Time) sdk.Dec {
+	periodsCopy := make(types.HoldingPeriods, len(periods))
+	copy(periodsCopy, periods)
+	_, factor := UpdateHoldingPeriods(periodsCopy, boost, foundBoost, collateralType, amount, blockTime)
+	return factor
+}
+
+func (k Keeper) resetUSDXMintingHoldingPeriod(ctx sdk.Context, periods types.HoldingPeriods, cdp cdptypes.CDP) types.HoldingPeriods {
+	boost, foundBoost := k.GetUSDXMintingHoldingBoost(ctx, cdp.Type)
+	return resetHoldingPeriod(periods, boost, foundBoost, cdp.Type, cdp.GetTotalPrincipal().Amount, ctx.BlockTime())
+}
+
+func (k Keeper) resetHardSupplyHoldingPeriod(ctx sdk.Context, periods types.HoldingPeriods, coin sdk.Coin) types.HoldingPeriods {
+	boost, foundBoost := k.GetHardSupplyHoldingBoost(ctx, coin.Denom)
+	return resetHoldingPeriod(periods, boost, foundBoost, coin.Denom, coin.Amount, ctx.BlockTime())
+}
@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateHoldingPeriods() {
+	since := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	boost := types.NewHoldingBoost("bnb-a", i(1000), d("2.0"), time.Hour*24*10)
+	type args struct {
+		periods         types.HoldingPeriods
+		foundBoost      bool
+		amount          sdk.Int
+		blockTime       time.Time
+		expectedPeriods types.HoldingPeriods
+		expectedFactor  sdk.Dec
+	}
+	type test struct {
+		name string
+		args args
+	}
+	testCases := []test{
+		{
+			"new holding period",
+			args{
+				periods:         nil,
+				foundBoost:      true,
+				amount:          i(2000),
+				blockTime:       since,
+				expectedPeriods: types.HoldingPeriods{types.NewHoldingPeriod("bnb-a", since, i(2000))},
+				expectedFactor:  sdk.OneDec(),
+			},
+		},
+		{
+			"halfway through ramp",
+			args{
+				periods:         types.HoldingPeriods{types.NewHoldingPeriod("bnb-a", since, i(2000))},
+				foundBoost:      true,
+				amount:          i(2500),
+				blockTime:       since.Add(time.Hour * 24 * 5),
+				expectedPeriods: types.HoldingPeriods{syncedHoldingPeriod("bnb-a", since, i(2500), since.Add(time.Hour*24*5))},
+				expectedFactor:  d("1.25"),
+			},
+		},
+		{
+			"boost capped after ramp",
+			args{
+				periods:         types.HoldingPeriods{syncedHoldingPeriod("bnb-a", since, i(2000), since.Add(time.Hour*24*10))},
+				foundBoost:      true,
+				amount:          i(2000),
+				blockTime:       since.Add(time.Hour * 24 * 30),
+				expectedPeriods: types.HoldingPeriods{syncedHoldingPeriod("bnb-a", since, i(2000), since.Add(time.Hour*24*30))},
+				expectedFactor:  d("2.0"),
+			},
+		},
+		{
+			"boost averaged since last sync",
+			args{
+				periods:         types.HoldingPeriods{syncedHoldingPeriod("bnb-a", since, i(2000), since.Add(time.Hour*24*5))},
+				foundBoost:      true,
+				amount:          i(2000),
+				blockTime:       since.Add(time.Hour * 24 * 10),
+				expectedPeriods: types.HoldingPeriods{syncedHoldingPeriod("bnb-a", since, i(2000), since.Add(time.Hour*24*10))},
+				expectedFactor:  d("1.75"),
+			},
+		},
+		{
+			"boost averaged across the end of the ramp",
+			args{
+				periods:         types.HoldingPeriods{types.NewHoldingPeriod("bnb-a", since, i(2000))},
+				foundBoost:      true,
+				amount:          i(2000),
+				blockTime:       since.Add(time.Hour * 24 * 20),
+				expectedPeriods: types.HoldingPeriods{syncedHoldingPeriod("bnb-a", since, i(2000), since.Add(time.Hour*24*20))},
+				expectedFactor:  d("1.75"),
+			},
+		},
+		{
+			"reduced position resets holding period",
+			args{
+				periods:         types.HoldingPeriods{types.NewHoldingPeriod("bnb-a", since, i(2000))},
+				foundBoost:      true,
+				amount:          i(1500),
+				blockTime:       since.Add(time.Hour * 24 * 5),
+				expectedPeriods: types.HoldingPeriods{types.NewHoldingPeriod("bnb-a", since.Add(time.Hour*24*5), i(1500))},
+				expectedFactor:  sdk.OneDec(),
+			},
+		},
+		{
+			"position below threshold removes holding period",
+			args{
+				periods:         types.HoldingPeriods{types.NewHoldingPeriod("bnb-a", since, i(2000))},
+				foundBoost:      true,
+				amount:          i(999),
+				blockTime:       since.Add(time.Hour * 24 * 5),
+				expectedPeriods: nil,
+				expectedFactor:  sdk.OneDec(),
+			},
+		},
+		{
+			"no boost configured",
+			args{
+				periods:         types.HoldingPeriods{types.NewHoldingPeriod("bnb-a", since, i(2000))},
+				foundBoost:      false,
+				amount:          i(2000),
+				blockTime:       since.Add(time.Hour * 24 * 5),
+				expectedPeriods: nil,
+				expectedFactor:  sdk.OneDec(),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			periods, factor := keeper.UpdateHoldingPeriods(tc.args.periods, boost, tc.args.foundBoost, "bnb-a", tc.args.amount, tc.args.blockTime)
+			suite.Require().Equal(tc.args.expectedPeriods, periods)
+			suite.Require().Equal(tc.args.expectedFactor, factor)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSynchronizeUSDXMintingRewardWithHoldingBoost() {
+	ctype := "bnb-a"
+	rewardsPerSecond := c("ukava", 122354)
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	initialCollateral := c("bnb", 1000000000000)
+	initialPrincipal := c("usdx", 10000000000)
+
+	suite.SetupWithGenState()
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+	params := types.DefaultParams()
+	params.USDXMintingRewardPeriods = types.RewardPeriods{types.NewRewardPeriod(true, ctype, initialTime, initialTime.Add(time.Hour*24*365*4), rewardsPerSecond)}
+	params.ClaimEnd = initialTime.Add(time.Hour * 24 * 365 * 5)
+	params.USDXMintingHoldingBoosts = types.HoldingBoosts{types.NewHoldingBoost(ctype, i(1000000000), d("1.5"), time.Hour*24*10)}
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetPreviousUSDXMintingAccrualTime(suite.ctx, ctype, initialTime)
+	suite.keeper.SetUSDXMintingRewardFactor(suite.ctx, ctype, sdk.ZeroDec())
+
+	sk := suite.app.GetSupplyKeeper()
+	sk.MintCoins(suite.ctx, cdptypes.ModuleName, sdk.NewCoins(initialCollateral))
+	sk.SendCoinsFromModuleToAccount(suite.ctx, cdptypes.ModuleName, suite.addrs[0], sdk.NewCoins(initialCollateral))
+
+	cdpKeeper := suite.app.GetCDPKeeper()
+	err := cdpKeeper.AddCdp(suite.ctx, suite.addrs[0], initialCollateral, initialPrincipal, ctype)
+	suite.Require().NoError(err)
+
+	claim, found := suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(types.HoldingPeriods{types.NewHoldingPeriod(ctype, initialTime, initialPrincipal.Amount)}, claim.HoldingPeriods)
+
+	// hold the position for the full ramp duration
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(time.Hour * 24 * 10))
+	rewardPeriod, found := suite.keeper.GetUSDXMintingRewardPeriod(suite.ctx, ctype)
+	suite.Require().True(found)
+	err = suite.keeper.AccumulateUSDXMintingRewards(suite.ctx, rewardPeriod)
+	suite.Require().NoError(err)
+
+	cdp, found := cdpKeeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], ctype)
+	suite.Require().True(found)
+	simulatedClaim := suite.keeper.SimulateUSDXMintingSynchronization(suite.ctx, claim)
+	suite.keeper.SynchronizeUSDXMintingReward(suite.ctx, cdp)
+
+	// 10 days of rewards (105713856000ukava) with a boost averaging 1.25x over the ramp
+	claim, found = suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(c("ukava", 132142320000), claim.Reward)
+	suite.Require().Equal(claim.Reward, simulatedClaim.Reward)
+	suite.Require().Equal(initialTime, claim.HoldingPeriods[0].Since)
+}
+
+func (suite *KeeperTestSuite) TestSynchronizeUSDXMintingRewardWithHoldingBoostSyncFrequency() {
+	ctype := "bnb-a"
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	initialCollateral := c("bnb", 1000000000000)
+	initialPrincipal := c("usdx", 10000000000)
+
+	suite.SetupWithGenState()
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+	rewardPeriod := types.NewRewardPeriod(true, ctype, initialTime, initialTime.Add(time.Hour*24*365*4), c("ukava", 122354))
+	params := types.DefaultParams()
+	params.USDXMintingRewardPeriods = types.RewardPeriods{rewardPeriod}
+	params.ClaimEnd = initialTime.Add(time.Hour * 24 * 365 * 5)
+	params.USDXMintingHoldingBoosts = types.HoldingBoosts{types.NewHoldingBoost(ctype, i(1000000000), d("1.5"), time.Hour*24*10)}
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetPreviousUSDXMintingAccrualTime(suite.ctx, ctype, initialTime)
+	suite.keeper.SetUSDXMintingRewardFactor(suite.ctx, ctype, sdk.ZeroDec())
+
+	sk := suite.app.GetSupplyKeeper()
+	cdpKeeper := suite.app.GetCDPKeeper()
+	for _, addr := range suite.addrs[:2] {
+		sk.MintCoins(suite.ctx, cdptypes.ModuleName, sdk.NewCoins(initialCollateral))
+		sk.SendCoinsFromModuleToAccount(suite.ctx, cdptypes.ModuleName, addr, sdk.NewCoins(initialCollateral))
+		err := cdpKeeper.AddCdp(suite.ctx, addr, initialCollateral, initialPrincipal, ctype)
+		suite.Require().NoError(err)
+	}
+
+	// the first position is synchronized several times, the second only at the end of the window
+	for _, days := range []time.Duration{2, 5, 10, 20} {
+		suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(time.Hour * 24 * days))
+		err := suite.keeper.AccumulateUSDXMintingRewards(suite.ctx, rewardPeriod)
+		suite.Require().NoError(err)
+		cdp, found := cdpKeeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], ctype)
+		suite.Require().True(found)
+		suite.keeper.SynchronizeUSDXMintingReward(suite.ctx, cdp)
+	}
+	cdp, found := cdpKeeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[1], ctype)
+	suite.Require().True(found)
+	suite.keeper.SynchronizeUSDXMintingReward(suite.ctx, cdp)
+
+	// each position earns 20 days of half the rewards (105713856000ukava) with a boost averaging 1.375x
+	frequentClaim, found := suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	rareClaim, found := suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().Equal(c("ukava", 145356552000), rareClaim.Reward)
+	suite.Require().Equal(rareClaim.Reward, frequentClaim.Reward)
+}
+
+func (suite *KeeperTestSuite) TestSynchronizeUSDXMintingRewardWithHoldingBoostAndBudget() {
+	ctype := "bnb-a"
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	initialCollateral := c("bnb", 1000000000000)
+	initialPrincipal := c("usdx", 10000000000)
+
+	suite.SetupWithGenState()
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+	rewardPeriod := types.NewRewardPeriod(true, ctype, initialTime, initialTime.Add(time.Hour*24*365*4), c("ukava", 122354))
+	rewardPeriod.Budget = cs(c("ukava", 130000000000))
+	params := types.DefaultParams()
+	params.USDXMintingRewardPeriods = types.RewardPeriods{rewardPeriod}
+	params.ClaimEnd = initialTime.Add(time.Hour * 24 * 365 * 5)
+	params.USDXMintingHoldingBoosts = types.HoldingBoosts{types.NewHoldingBoost(ctype, i(1000000000), d("1.5"), time.Hour*24*10)}
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetPreviousUSDXMintingAccrualTime(suite.ctx, ctype, initialTime)
+	suite.keeper.SetUSDXMintingRewardFactor(suite.ctx, ctype, sdk.ZeroDec())
+
+	sk := suite.app.GetSupplyKeeper()
+	sk.MintCoins(suite.ctx, cdptypes.ModuleName, sdk.NewCoins(initialCollateral))
+	sk.SendCoinsFromModuleToAccount(suite.ctx, cdptypes.ModuleName, suite.addrs[0], sdk.NewCoins(initialCollateral))
+
+	cdpKeeper := suite.app.GetCDPKeeper()
+	err := cdpKeeper.AddCdp(suite.ctx, suite.addrs[0], initialCollateral, initialPrincipal, ctype)
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(time.Hour * 24 * 10))
+	err = suite.keeper.AccumulateUSDXMintingRewards(suite.ctx, rewardPeriod)
+	suite.Require().NoError(err)
+
+	claim, found := suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	simulatedClaim := suite.keeper.SimulateUSDXMintingSynchronization(suite.ctx, claim)
+	suite.Require().Equal(cs(c("ukava", 105713856000)), suite.keeper.GetRewardBudgets(suite.ctx)[0].Spent)
+
+	cdp, found := cdpKeeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], ctype)
+	suite.Require().True(found)
+	suite.keeper.SynchronizeUSDXMintingReward(suite.ctx, cdp)
+
+	// the 1.25x average boost of 10 days of rewards (105713856000ukava) is cut to what remains of the budget
+	claim, found = suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(c("ukava", 130000000000), claim.Reward)
+	suite.Require().Equal(claim.Reward, simulatedClaim.Reward)
+	budget := suite.keeper.GetRewardBudgets(suite.ctx)[0]
+	suite.Require().Equal(rewardPeriod.Budget, budget.Spent)
+	suite.Require().True(budget.Remaining.Empty())
+}
+
+func syncedHoldingPeriod(collateralType string, since time.Time, amount sdk.Int, lastSync time.Time) types.HoldingPeriod {
+	hp := types.NewHoldingPeriod(collateralType, since, amount)
+	hp.LastSync = lastSync
+	return hp
+}
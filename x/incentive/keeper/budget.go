@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// applyRewardBudget caps the input rewards at the remaining budget of a reward period and records them as spent.
+// An empty budget places no limit on the rewards. Once every denom of the budget has been spent the period stops accruing rewards.
+// Reward periods are identified by their start time, so a reward period that replaces another for the same collateral type starts with nothing spent.
+func (k Keeper) applyRewardBudget(ctx sdk.Context, rewardType, collateralType string, periodStart time.Time, budget, newRewards sdk.Coins) sdk.Coins {
+	// unbounded periods do not track what they have accrued
+	if budget.Empty() {
+		return newRewards
+	}
+	spent, _ := k.GetRewardBudgetSpent(ctx, rewardType, collateralType, periodStart)
+
+	allowedRewards := sdk.NewCoins()
+	for _, coin := range newRewards {
+		remaining := budget.AmountOf(coin.Denom).Sub(spent.AmountOf(coin.Denom))
+		amount := sdk.MinInt(coin.Amount, sdk.MaxInt(remaining, sdk.ZeroInt()))
+		allowedRewards = allowedRewards.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	if allowedRewards.IsZero() {
+		return allowedRewards
+	}
+
+	newSpent := spent.Add(allowedRewards...)
+	k.SetRewardBudgetSpent(ctx, rewardType, collateralType, periodStart, newSpent)
+
+	if newSpent.IsAllGTE(budget) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBudgetExhausted,
+				sdk.NewAttribute(types.AttributeKeyRewardType, rewardType),
+				sdk.NewAttribute(types.AttributeKeyCollateralType, collateralType),
+				sdk.NewAttribute(types.AttributeKeyBudget, budget.String()),
+			),
+		)
+	}
+	return allowedRewards
+}
+
+// boostRewards applies a holding boost factor to the rewards a position accrued from a reward period, returning the boosted amount.
+// The rewards added by the boost were not accrued against the period's budget, so they are charged to it here and capped at what remains of it.
+func (k Keeper) boostRewards(ctx sdk.Context, rewardType, collateralType string, periodStart time.Time, budget sdk.Coins, denom string, rewards, boostFactor sdk.Dec) sdk.Int {
+	amount := rewards.RoundInt()
+	boost := rewards.Mul(boostFactor).RoundInt().Sub(amount)
+	if !boost.IsPositive() {
+		return amount
+	}
+	allowedBoost := k.applyRewardBudget(ctx, rewardType, collateralType, periodStart, budget, sdk.NewCoins(sdk.NewCoin(denom, boost)))
+	return amount.Add(allowedBoost.AmountOf(denom))
+}
+
+// GetRewardBudgets returns the budget, spent and remaining rewards for every reward period in the params
+func (k Keeper) GetRewardBudgets(ctx sdk.Context) types.RewardBudgets {
+	budgets := types.RewardBudgets{}
+	k.iterateRewardPeriodBudgets(ctx, func(rewardType, collateralType string, periodStart time.Time, budget sdk.Coins) {
+		spent, _ := k.GetRewardBudgetSpent(ctx, rewardType, collateralType, periodStart)
+		budgets = append(budgets, types.NewRewardBudget(rewardType, collateralType, budget, spent))
+	})
+	return budgets
+}
+
+// GetGenesisRewardBudgetSpends returns the rewards accrued against the budget of every reward period in the params
+func (k Keeper) GetGenesisRewardBudgetSpends(ctx sdk.Context) types.GenesisRewardBudgetSpends {
+	spends := types.GenesisRewardBudgetSpends{}
+	k.iterateRewardPeriodBudgets(ctx, func(rewardType, collateralType string, periodStart time.Time, _ sdk.Coins) {
+		if spent, found := k.GetRewardBudgetSpent(ctx, rewardType, collateralType, periodStart); found {
+			spends = append(spends, types.NewGenesisRewardBudgetSpend(rewardType, collateralType, periodStart, spent))
+		}
+	})
+	return spends
+}
+
+// PruneRewardBudgetSpends deletes the rewards accrued against budgets that are no longer in the params,
+// because their reward period has been replaced, removed or made unbounded.
+func (k Keeper) PruneRewardBudgetSpends(ctx sdk.Context) {
+	periodStarts := make(map[string]time.Time)
+	k.iterateRewardPeriodBudgets(ctx, func(rewardType, collateralType string, periodStart time.Time, budget sdk.Coins) {
+		if !budget.Empty() {
+			periodStarts[string(types.GetRewardBudgetSpentKey(rewardType, collateralType))] = periodStart
+		}
+	})
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardBudgetSpentKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	var staleKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var spent types.RewardBudgetSpent
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &spent)
+		if periodStart, found := periodStarts[string(iterator.Key())]; !found || !periodStart.Equal(spent.PeriodStart) {
+			staleKeys = append(staleKeys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+}
+
+// iterateRewardPeriodBudgets calls cb with the reward type, collateral type, start and budget of every reward period in the params
+func (k Keeper) iterateRewardPeriodBudgets(ctx sdk.Context, cb func(rewardType, collateralType string, periodStart time.Time, budget sdk.Coins)) {
+	params := k.GetParams(ctx)
+	for _, rp := range params.USDXMintingRewardPeriods {
+		cb(types.USDXMintingRewardType, rp.CollateralType, rp.Start, rp.Budget)
+	}
+	for _, mrp := range params.HardSupplyRewardPeriods {
+		cb(types.HardSupplyRewardType, mrp.CollateralType, mrp.Start, mrp.Budget)
+	}
+	for _, mrp := range params.HardBorrowRewardPeriods {
+		cb(types.HardBorrowRewardType, mrp.CollateralType, mrp.Start, mrp.Budget)
+	}
+	for _, rp := range params.HardDelegatorRewardPeriods {
+		cb(types.HardDelegatorRewardType, rp.CollateralType, rp.Start, rp.Budget)
+	}
+}
+
+// rewardsForDuration returns the rewards paid out by rewardsPerSecond over the input number of seconds
+func rewardsForDuration(rewardsPerSecond sdk.Coins, seconds sdk.Int) sdk.Coins {
+	rewards := sdk.NewCoins()
+	for _, coin := range rewardsPerSecond {
+		rewards = rewards.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(seconds)))
+	}
+	return rewards
+}
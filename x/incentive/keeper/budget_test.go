@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+	"github.com/kava-labs/kava/x/kavadist"
+)
+
+func (suite *KeeperTestSuite) TestAccumulateUSDXMintingRewardsWithBudget() {
+	type args struct {
+		budget               sdk.Coins
+		timeElapsed          []int
+		expectedRewardFactor sdk.Dec
+		expectedSpent        sdk.Coins
+		expectedRemaining    sdk.Coins
+	}
+	type test struct {
+		name string
+		args args
+	}
+	testCases := []test{
+		{
+			"unbounded budget",
+			args{
+				budget:               nil,
+				timeElapsed:          []int{7, 86400},
+				expectedRewardFactor: d("0.010572242078"),
+				expectedSpent:        sdk.Coins{},
+				expectedRemaining:    sdk.NewCoins(),
+			},
+		},
+		{
+			"budget not exhausted",
+			args{
+				budget:               cs(c("ukava", 5000000)),
+				timeElapsed:          []int{7},
+				expectedRewardFactor: d("0.000000856478"),
+				expectedSpent:        cs(c("ukava", 856478)),
+				expectedRemaining:    cs(c("ukava", 4143522)),
+			},
+		},
+		{
+			"budget exhausted",
+			args{
+				budget:               cs(c("ukava", 5000000)),
+				timeElapsed:          []int{7, 86400, 86400},
+				expectedRewardFactor: d("0.000005"),
+				expectedSpent:        cs(c("ukava", 5000000)),
+				expectedRemaining:    sdk.NewCoins(),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctype := "bnb-a"
+			initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+			suite.SetupWithGenState()
+			suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+			cdpKeeper := suite.app.GetCDPKeeper()
+			cdpKeeper.SetTotalPrincipal(suite.ctx, ctype, cdptypes.DefaultStableDenom, i(1000000000000))
+
+			rewardPeriod := types.NewRewardPeriod(true, ctype, initialTime, initialTime.Add(time.Hour*24*365*4), c("ukava", 122354))
+			rewardPeriod.Budget = tc.args.budget
+			params := types.DefaultParams()
+			params.USDXMintingRewardPeriods = types.RewardPeriods{rewardPeriod}
+			params.ClaimEnd = initialTime.Add(time.Hour * 24 * 365 * 5)
+			suite.keeper.SetParams(suite.ctx, params)
+			suite.keeper.SetPreviousUSDXMintingAccrualTime(suite.ctx, ctype, initialTime)
+			suite.keeper.SetUSDXMintingRewardFactor(suite.ctx, ctype, sdk.ZeroDec())
+
+			for _, elapsed := range tc.args.timeElapsed {
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Duration(int(time.Second) * elapsed)))
+				err := suite.keeper.AccumulateUSDXMintingRewards(suite.ctx, rewardPeriod)
+				suite.Require().NoError(err)
+			}
+
+			rewardFactor, _ := suite.keeper.GetUSDXMintingRewardFactor(suite.ctx, ctype)
+			suite.Require().Equal(tc.args.expectedRewardFactor, rewardFactor)
+
+			budgets := suite.keeper.GetRewardBudgets(suite.ctx)
+			suite.Require().Equal(types.NewRewardBudget(types.USDXMintingRewardType, ctype, tc.args.budget, tc.args.expectedSpent), budgets[0])
+			suite.Require().Equal(tc.args.expectedRemaining, budgets[0].Remaining)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAccumulateHardSupplyRewardsWithBudget() {
+	ctype := "bnb"
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.SetupWithGenState()
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+	// Mint coins to hard module account
+	supplyKeeper := suite.app.GetSupplyKeeper()
+	hardMaccCoins := sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200000000)))
+	supplyKeeper.MintCoins(suite.ctx, hardtypes.ModuleAccountName, hardMaccCoins)
+
+	rewardPeriod := types.NewMultiRewardPeriod(true, ctype, initialTime, initialTime.Add(time.Hour*24*365*4), cs(c("hard", 1000), c("ukava", 1000)))
+	rewardPeriod.Budget = cs(c("hard", 5000), c("ukava", 1000000))
+	params := types.DefaultParams()
+	params.HardSupplyRewardPeriods = types.MultiRewardPeriods{rewardPeriod}
+	params.ClaimEnd = initialTime.Add(time.Hour * 24 * 365 * 5)
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetPreviousHardSupplyRewardAccrualTime(suite.ctx, ctype, initialTime)
+	suite.keeper.SetHardSupplyRewardIndexes(suite.ctx, ctype, types.RewardIndexes{
+		types.NewRewardIndex("hard", sdk.ZeroDec()),
+		types.NewRewardIndex("ukava", sdk.ZeroDec()),
+	})
+
+	suite.hardKeeper.SetSupplyInterestFactor(suite.ctx, ctype, sdk.MustNewDecFromStr("1.0"))
+	suite.hardKeeper.SetPreviousAccrualTime(suite.ctx, ctype, initialTime)
+
+	// User deposits so there is supply to distribute rewards to
+	hardKeeper := suite.app.GetHardKeeper()
+	userAddr := suite.addrs[3]
+	err := hardKeeper.Deposit(suite.ctx, userAddr, cs(c(ctype, 10000000000)))
+	suite.Require().NoError(err)
+
+	// 10 seconds of rewards exceeds the hard budget
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(time.Second * 10))
+	err = suite.keeper.AccumulateHardSupplyRewards(suite.ctx, rewardPeriod)
+	suite.Require().NoError(err)
+
+	budgets := suite.keeper.GetRewardBudgets(suite.ctx)
+	suite.Require().Equal(cs(c("hard", 5000), c("ukava", 10000)), budgets[0].Spent)
+	suite.Require().Equal(cs(c("ukava", 990000)), budgets[0].Remaining)
+
+	// hard rewards stop accruing once the hard budget is exhausted, ukava rewards continue
+	indexes, _ := suite.keeper.GetHardSupplyRewardIndexes(suite.ctx, ctype)
+	hardIndex, _ := indexes.GetRewardIndex("hard")
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(time.Second * 20))
+	err = suite.keeper.AccumulateHardSupplyRewards(suite.ctx, rewardPeriod)
+	suite.Require().NoError(err)
+
+	indexes, _ = suite.keeper.GetHardSupplyRewardIndexes(suite.ctx, ctype)
+	newHardIndex, _ := indexes.GetRewardIndex("hard")
+	suite.Require().Equal(hardIndex, newHardIndex)
+	budgets = suite.keeper.GetRewardBudgets(suite.ctx)
+	suite.Require().Equal(cs(c("hard", 5000), c("ukava", 20000)), budgets[0].Spent)
+}
+
+func (suite *KeeperTestSuite) TestRewardBudgetInvariant() {
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.SetupWithGenState()
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+	rewardPeriod := types.NewRewardPeriod(true, "bnb-a", initialTime, initialTime.Add(time.Hour*24*365*4), c("ukava", 122354))
+	rewardPeriod.Budget = cs(c("ukava", 5000000))
+	params := types.DefaultParams()
+	params.USDXMintingRewardPeriods = types.RewardPeriods{rewardPeriod}
+	params.ClaimEnd = initialTime.Add(time.Hour * 24 * 365 * 5)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	invariant := keeper.RewardBudgetInvariant(suite.keeper)
+	_, broken := invariant(suite.ctx)
+	suite.Require().True(broken)
+
+	sk := suite.app.GetSupplyKeeper()
+	err := sk.MintCoins(suite.ctx, kavadist.ModuleName, cs(c("ukava", 1000000)))
+	suite.Require().NoError(err)
+	_, broken = invariant(suite.ctx)
+	suite.Require().True(broken)
+
+	// accrued rewards are no longer part of the remaining budget
+	suite.keeper.SetRewardBudgetSpent(suite.ctx, types.USDXMintingRewardType, "bnb-a", initialTime, cs(c("ukava", 4000000)))
+	_, broken = invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// but the module account must also hold the rewards accrued to claims that have not been paid out
+	suite.keeper.SetUSDXMintingClaim(suite.ctx, types.NewUSDXMintingClaim(suite.addrs[0], c("ukava", 500000), types.RewardIndexes{types.NewRewardIndex("bnb-a", sdk.ZeroDec())}))
+	suite.keeper.SetHardLiquidityProviderClaim(suite.ctx, types.NewHardLiquidityProviderClaim(suite.addrs[1], cs(c("ukava", 500000)), nil, nil, nil))
+	_, broken = invariant(suite.ctx)
+	suite.Require().True(broken)
+	err = sk.MintCoins(suite.ctx, kavadist.ModuleName, cs(c("ukava", 999999)))
+	suite.Require().NoError(err)
+	_, broken = invariant(suite.ctx)
+	suite.Require().True(broken)
+	err = sk.MintCoins(suite.ctx, kavadist.ModuleName, cs(c("ukava", 1)))
+	suite.Require().NoError(err)
+	_, broken = invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// a reward period that replaces the previous one for the same collateral type starts with nothing spent
+	replacementStart := initialTime.Add(time.Hour * 24 * 30)
+	replacementPeriod := types.NewRewardPeriod(true, "bnb-a", replacementStart, replacementStart.Add(time.Hour*24*365*4), c("ukava", 122354))
+	replacementPeriod.Budget = cs(c("ukava", 5000000))
+	params.USDXMintingRewardPeriods = types.RewardPeriods{replacementPeriod}
+	suite.keeper.SetParams(suite.ctx, params)
+	budgets := suite.keeper.GetRewardBudgets(suite.ctx)
+	suite.Require().Len(budgets, 1)
+	suite.Require().Equal(cs(c("ukava", 5000000)), budgets[0].Remaining)
+	_, broken = invariant(suite.ctx)
+	suite.Require().True(broken)
+	err = sk.MintCoins(suite.ctx, kavadist.ModuleName, cs(c("ukava", 4000000)))
+	suite.Require().NoError(err)
+	_, broken = invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// rewards accrued by the replacement period are counted against its own budget
+	suite.keeper.SetRewardBudgetSpent(suite.ctx, types.USDXMintingRewardType, "bnb-a", replacementStart, cs(c("ukava", 1000000)))
+	_, found := suite.keeper.GetRewardBudgetSpent(suite.ctx, types.USDXMintingRewardType, "bnb-a", initialTime)
+	suite.Require().False(found)
+	budgets = suite.keeper.GetRewardBudgets(suite.ctx)
+	suite.Require().Equal(cs(c("ukava", 4000000)), budgets[0].Remaining)
+}
+
+func (suite *KeeperTestSuite) TestPruneRewardBudgetSpends() {
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.SetupWithGenState()
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+	newRewardPeriod := func(collateralType string, start time.Time, budget sdk.Coins) types.RewardPeriod {
+		rp := types.NewRewardPeriod(true, collateralType, start, start.Add(time.Hour*24*365*4), c("ukava", 122354))
+		rp.Budget = budget
+		return rp
+	}
+	params := types.DefaultParams()
+	params.USDXMintingRewardPeriods = types.RewardPeriods{
+		newRewardPeriod("bnb-a", initialTime, cs(c("ukava", 5000000))),
+		newRewardPeriod("btcb-a", initialTime, cs(c("ukava", 5000000))),
+		newRewardPeriod("xrpb-a", initialTime, cs(c("ukava", 5000000))),
+	}
+	params.ClaimEnd = initialTime.Add(time.Hour * 24 * 365 * 5)
+	suite.keeper.SetParams(suite.ctx, params)
+	for _, rp := range params.USDXMintingRewardPeriods {
+		suite.keeper.SetRewardBudgetSpent(suite.ctx, types.USDXMintingRewardType, rp.CollateralType, initialTime, cs(c("ukava", 1000000)))
+	}
+
+	// spends of reward periods still in the params are kept
+	suite.keeper.PruneRewardBudgetSpends(suite.ctx)
+	suite.Require().Len(suite.keeper.GetGenesisRewardBudgetSpends(suite.ctx), 3)
+
+	// spends are deleted once their period is replaced, made unbounded or removed
+	params.USDXMintingRewardPeriods = types.RewardPeriods{
+		newRewardPeriod("bnb-a", initialTime.Add(time.Hour), cs(c("ukava", 5000000))),
+		newRewardPeriod("btcb-a", initialTime, nil),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.PruneRewardBudgetSpends(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetGenesisRewardBudgetSpends(suite.ctx))
+
+	// restoring the original periods does not bring back the pruned spends
+	params.USDXMintingRewardPeriods = types.RewardPeriods{newRewardPeriod("bnb-a", initialTime, cs(c("ukava", 5000000)))}
+	suite.keeper.SetParams(suite.ctx, params)
+	_, found := suite.keeper.GetRewardBudgetSpent(suite.ctx, types.USDXMintingRewardType, "bnb-a", initialTime)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestQueryRewardBudgets() {
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.SetupWithGenState()
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+	rewardPeriod := types.NewRewardPeriod(true, "bnb-a", initialTime, initialTime.Add(time.Hour*24*365*4), c("ukava", 122354))
+	rewardPeriod.Budget = cs(c("ukava", 5000000))
+	params := types.DefaultParams()
+	params.USDXMintingRewardPeriods = types.RewardPeriods{rewardPeriod}
+	params.HardDelegatorRewardPeriods = types.RewardPeriods{types.NewRewardPeriod(true, "ukava", initialTime, initialTime.Add(time.Hour*24*365*4), c("hard", 1000))}
+	params.ClaimEnd = initialTime.Add(time.Hour * 24 * 365 * 5)
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetRewardBudgetSpent(suite.ctx, types.USDXMintingRewardType, "bnb-a", initialTime, cs(c("ukava", 1000000)))
+
+	querier := keeper.NewQuerier(suite.keeper)
+	query := abci.RequestQuery{
+		Path: "/custom/incentive/reward-budgets",
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryRewardBudgetsParams(types.USDXMintingRewardType, "")),
+	}
+	bz, err := querier(suite.ctx, []string{types.QueryGetRewardBudgets}, query)
+	suite.Require().NoError(err)
+
+	var budgets types.RewardBudgets
+	types.ModuleCdc.MustUnmarshalJSON(bz, &budgets)
+	suite.Require().Len(budgets, 1)
+	suite.Require().Equal(cs(c("ukava", 4000000)), budgets[0].Remaining)
+}
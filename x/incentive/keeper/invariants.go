@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// RegisterInvariants registers all incentive invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "reward-budgets",
+		RewardBudgetInvariant(k))
+}
+
+// RewardBudgetInvariant checks that the incentive module account holds enough coins to pay out every unclaimed reward
+// and the remaining budget of every reward period
+func RewardBudgetInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalRemaining := sdk.NewCoins()
+		for _, rb := range k.GetRewardBudgets(ctx) {
+			totalRemaining = totalRemaining.Add(rb.Remaining...)
+		}
+
+		totalUnclaimed := sdk.NewCoins()
+		k.IterateUSDXMintingClaims(ctx, func(c types.USDXMintingClaim) (stop bool) {
+			totalUnclaimed = totalUnclaimed.Add(c.Reward)
+			return false
+		})
+		k.IterateHardLiquidityProviderClaims(ctx, func(c types.HardLiquidityProviderClaim) (stop bool) {
+			totalUnclaimed = totalUnclaimed.Add(c.Reward...)
+			return false
+		})
+
+		moduleAccCoins := k.supplyKeeper.GetModuleAccount(ctx, types.IncentiveMacc).GetCoins()
+		broken := !moduleAccCoins.IsAllGTE(totalRemaining.Add(totalUnclaimed...))
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"reward budgets",
+			fmt.Sprintf(
+				"\tunclaimed rewards: %s\n"+
+					"\tremaining reward budgets: %s\n"+
+					"\tactual ModuleAccount coins: %s\n",
+				totalUnclaimed, totalRemaining, moduleAccCoins),
+		)
+		return invariantMessage, broken
+	}
+}
@@ -295,3 +295,42 @@ func (k Keeper) SetPreviousHardDelegatorRewardAccrualTime(ctx sdk.Context, denom
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardDelegatorRewardAccrualTimeKeyPrefix)
 	store.Set([]byte(denom), k.cdc.MustMarshalBinaryBare(blockTime))
 }
+
+// GetPreviousClaimSweepEnd returns the claim end at which the input claim type was last swept
+func (k Keeper) GetPreviousClaimSweepEnd(ctx sdk.Context, claimType string) (claimEnd time.Time, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ClaimSweepKeyPrefix)
+	bz := store.Get([]byte(claimType))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &claimEnd)
+	return claimEnd, true
+}
+
+// SetPreviousClaimSweepEnd sets the claim end at which the input claim type was last swept
+func (k Keeper) SetPreviousClaimSweepEnd(ctx sdk.Context, claimType string, claimEnd time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ClaimSweepKeyPrefix)
+	store.Set([]byte(claimType), k.cdc.MustMarshalBinaryBare(claimEnd))
+}
+
+// GetRewardBudgetSpent returns the rewards accrued against the budget of the reward period with the input start time.
+// Nothing is found if the rewards were accrued by an earlier reward period for the same collateral type.
+func (k Keeper) GetRewardBudgetSpent(ctx sdk.Context, rewardType, collateralType string, periodStart time.Time) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardBudgetSpentKeyPrefix)
+	bz := store.Get(types.GetRewardBudgetSpentKey(rewardType, collateralType))
+	if bz == nil {
+		return sdk.Coins{}, false
+	}
+	var spent types.RewardBudgetSpent
+	k.cdc.MustUnmarshalBinaryBare(bz, &spent)
+	if !spent.PeriodStart.Equal(periodStart) {
+		return sdk.Coins{}, false
+	}
+	return spent.Spent, true
+}
+
+// SetRewardBudgetSpent sets the rewards accrued against the budget of the reward period with the input start time
+func (k Keeper) SetRewardBudgetSpent(ctx sdk.Context, rewardType, collateralType string, periodStart time.Time, spent sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardBudgetSpentKeyPrefix)
+	store.Set(types.GetRewardBudgetSpentKey(rewardType, collateralType), k.cdc.MustMarshalBinaryBare(types.NewRewardBudgetSpent(periodStart, spent)))
+}
@@ -80,3 +80,21 @@ func (k Keeper) GetClaimEnd(ctx sdk.Context) time.Time {
 	params := k.GetParams(ctx)
 	return params.ClaimEnd
 }
+
+// GetClaimEndForType returns the claim end time for the input claim type, including any grace period
+func (k Keeper) GetClaimEndForType(ctx sdk.Context, claimType string) time.Time {
+	params := k.GetParams(ctx)
+	return params.ClaimEnd.Add(params.ClaimGracePeriods.GetGracePeriod(claimType))
+}
+
+// GetUSDXMintingHoldingBoost returns the holding boost for the input collateral type if it's found in the params
+func (k Keeper) GetUSDXMintingHoldingBoost(ctx sdk.Context, collateralType string) (types.HoldingBoost, bool) {
+	params := k.GetParams(ctx)
+	return params.USDXMintingHoldingBoosts.GetHoldingBoost(collateralType)
+}
+
+// GetHardSupplyHoldingBoost returns the holding boost for the input deposit denom if it's found in the params
+func (k Keeper) GetHardSupplyHoldingBoost(ctx sdk.Context, denom string) (types.HoldingBoost, bool) {
+	params := k.GetParams(ctx)
+	return params.HardSupplyHoldingBoosts.GetHoldingBoost(denom)
+}
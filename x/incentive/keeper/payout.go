@@ -35,7 +35,7 @@ func (k Keeper) ClaimUSDXMintingReward(ctx sdk.Context, addr sdk.AccAddress, mul
 		return sdkerrors.Wrapf(types.ErrInvalidMultiplier, string(multiplierName))
 	}
 
-	claimEnd := k.GetClaimEnd(ctx)
+	claimEnd := k.GetClaimEndForType(ctx, types.USDXMintingClaimType)
 
 	if ctx.BlockTime().After(claimEnd) {
 		return sdkerrors.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
@@ -86,7 +86,7 @@ func (k Keeper) ClaimHardReward(ctx sdk.Context, addr sdk.AccAddress, multiplier
 		return sdkerrors.Wrapf(types.ErrInvalidMultiplier, string(multiplierName))
 	}
 
-	claimEnd := k.GetClaimEnd(ctx)
+	claimEnd := k.GetClaimEndForType(ctx, types.HardLiquidityProviderClaimType)
 
 	if ctx.BlockTime().After(claimEnd) {
 		return sdkerrors.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
@@ -97,6 +97,8 @@ func (suite *KeeperTestSuite) TestPayoutUSDXMintingClaim() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.ctype, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond)},
 				tc.args.multipliers,
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousUSDXMintingAccrualTime(suite.ctx, tc.args.ctype, tc.args.initialTime)
@@ -320,6 +322,8 @@ func (suite *KeeperTestSuite) TestPayoutHardLiquidityProviderClaim() {
 				rewardPeriods, multiRewardPeriods, multiRewardPeriods, rewardPeriods,
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 
@@ -21,6 +21,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetHardRewards(ctx, req, k)
 		case types.QueryGetUSDXMintingRewards:
 			return queryGetUSDXMintingRewards(ctx, req, k)
+		case types.QueryGetRewardBudgets:
+			return queryGetRewardBudgets(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -121,3 +123,28 @@ func queryGetUSDXMintingRewards(ctx sdk.Context, req abci.RequestQuery, k Keeper
 	}
 	return bz, nil
 }
+
+func queryGetRewardBudgets(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryRewardBudgetsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	budgets := types.RewardBudgets{}
+	for _, rb := range k.GetRewardBudgets(ctx) {
+		if params.RewardType != "" && rb.RewardType != params.RewardType {
+			continue
+		}
+		if params.CollateralType != "" && rb.CollateralType != params.CollateralType {
+			continue
+		}
+		budgets = append(budgets, rb)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, budgets)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
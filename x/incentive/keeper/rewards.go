@@ -32,12 +32,15 @@ func (k Keeper) AccumulateUSDXMintingRewards(ctx sdk.Context, rewardPeriod types
 		k.SetPreviousUSDXMintingAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
-	newRewards := timeElapsed.Mul(rewardPeriod.RewardsPerSecond.Amount)
 	cdpFactor, found := k.cdpKeeper.GetInterestFactor(ctx, rewardPeriod.CollateralType)
 	if !found {
 		k.SetPreviousUSDXMintingAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
+	newRewards := k.applyRewardBudget(
+		ctx, types.USDXMintingRewardType, rewardPeriod.CollateralType, rewardPeriod.Start, rewardPeriod.Budget,
+		rewardsForDuration(sdk.NewCoins(rewardPeriod.RewardsPerSecond), timeElapsed),
+	).AmountOf(rewardPeriod.RewardsPerSecond.Denom)
 	rewardFactor := newRewards.ToDec().Mul(cdpFactor).Quo(totalPrincipal)
 
 	previousRewardFactor, found := k.GetUSDXMintingRewardFactor(ctx, rewardPeriod.CollateralType)
@@ -91,10 +94,14 @@ func (k Keeper) AccumulateHardBorrowRewards(ctx sdk.Context, rewardPeriod types.
 		k.SetPreviousHardBorrowRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
+	budgetedRewards := k.applyRewardBudget(
+		ctx, types.HardBorrowRewardType, rewardPeriod.CollateralType, rewardPeriod.Start, rewardPeriod.Budget,
+		rewardsForDuration(rewardPeriod.RewardsPerSecond, timeElapsed),
+	)
 
 	newRewardIndexes := previousRewardIndexes
 	for _, rewardCoin := range rewardPeriod.RewardsPerSecond {
-		newRewards := rewardCoin.Amount.ToDec().Mul(timeElapsed.ToDec())
+		newRewards := budgetedRewards.AmountOf(rewardCoin.Denom).ToDec()
 		previousRewardIndex, found := previousRewardIndexes.GetRewardIndex(rewardCoin.Denom)
 		if !found {
 			previousRewardIndex = types.NewRewardIndex(rewardCoin.Denom, sdk.ZeroDec())
@@ -157,10 +164,14 @@ func (k Keeper) AccumulateHardSupplyRewards(ctx sdk.Context, rewardPeriod types.
 		k.SetPreviousHardSupplyRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
+	budgetedRewards := k.applyRewardBudget(
+		ctx, types.HardSupplyRewardType, rewardPeriod.CollateralType, rewardPeriod.Start, rewardPeriod.Budget,
+		rewardsForDuration(rewardPeriod.RewardsPerSecond, timeElapsed),
+	)
 
 	newRewardIndexes := previousRewardIndexes
 	for _, rewardCoin := range rewardPeriod.RewardsPerSecond {
-		newRewards := rewardCoin.Amount.ToDec().Mul(timeElapsed.ToDec())
+		newRewards := budgetedRewards.AmountOf(rewardCoin.Denom).ToDec()
 		previousRewardIndex, found := previousRewardIndexes.GetRewardIndex(rewardCoin.Denom)
 		if !found {
 			previousRewardIndex = types.NewRewardIndex(rewardCoin.Denom, sdk.ZeroDec())
@@ -199,6 +210,7 @@ func (k Keeper) InitializeUSDXMintingClaim(ctx sdk.Context, cdp cdptypes.CDP) {
 	claim, found := k.GetUSDXMintingClaim(ctx, cdp.Owner)
 	if !found { // this is the owner's first usdx minting reward claim
 		claim = types.NewUSDXMintingClaim(cdp.Owner, sdk.NewCoin(types.USDXMintingRewardDenom, sdk.ZeroInt()), types.RewardIndexes{types.NewRewardIndex(cdp.Type, rewardFactor)})
+		claim.HoldingPeriods = k.resetUSDXMintingHoldingPeriod(ctx, claim.HoldingPeriods, cdp)
 		k.SetUSDXMintingClaim(ctx, claim)
 		return
 	}
@@ -209,6 +221,7 @@ func (k Keeper) InitializeUSDXMintingClaim(ctx sdk.Context, cdp cdptypes.CDP) {
 	} else { // the owner has a previous usdx minting reward for this collateral type
 		claim.RewardIndexes[index] = types.NewRewardIndex(cdp.Type, rewardFactor)
 	}
+	claim.HoldingPeriods = k.resetUSDXMintingHoldingPeriod(ctx, claim.HoldingPeriods, cdp)
 	k.SetUSDXMintingClaim(ctx, claim)
 }
 
@@ -228,6 +241,7 @@ func (k Keeper) SynchronizeUSDXMintingReward(ctx sdk.Context, cdp cdptypes.CDP)
 	claim, found := k.GetUSDXMintingClaim(ctx, cdp.Owner)
 	if !found {
 		claim = types.NewUSDXMintingClaim(cdp.Owner, sdk.NewCoin(types.USDXMintingRewardDenom, sdk.ZeroInt()), types.RewardIndexes{types.NewRewardIndex(cdp.Type, globalRewardFactor)})
+		claim.HoldingPeriods = k.resetUSDXMintingHoldingPeriod(ctx, claim.HoldingPeriods, cdp)
 		k.SetUSDXMintingClaim(ctx, claim)
 		return
 	}
@@ -236,16 +250,30 @@ func (k Keeper) SynchronizeUSDXMintingReward(ctx sdk.Context, cdp cdptypes.CDP)
 	index, hasRewardIndex := claim.HasRewardIndex(cdp.Type)
 	if !hasRewardIndex { // this is the owner's first usdx minting reward for this collateral type
 		claim.RewardIndexes = append(claim.RewardIndexes, types.NewRewardIndex(cdp.Type, globalRewardFactor))
+		claim.HoldingPeriods = k.resetUSDXMintingHoldingPeriod(ctx, claim.HoldingPeriods, cdp)
 		k.SetUSDXMintingClaim(ctx, claim)
 		return
 	}
+
+	// record the principal held since the last synchronization before calculating the boost
+	boost, foundBoost := k.GetUSDXMintingHoldingBoost(ctx, cdp.Type)
+	var boostFactor sdk.Dec
+	claim.HoldingPeriods, boostFactor = UpdateHoldingPeriods(claim.HoldingPeriods, boost, foundBoost, cdp.Type, cdp.GetTotalPrincipal().Amount, ctx.BlockTime())
+
 	userRewardFactor := claim.RewardIndexes[index].RewardFactor
 	rewardsAccumulatedFactor := globalRewardFactor.Sub(userRewardFactor)
 	if rewardsAccumulatedFactor.IsZero() {
+		if foundBoost {
+			k.SetUSDXMintingClaim(ctx, claim)
+		}
 		return
 	}
 	claim.RewardIndexes[index].RewardFactor = globalRewardFactor
-	newRewardsAmount := rewardsAccumulatedFactor.Mul(cdp.GetTotalPrincipal().Amount.ToDec()).RoundInt()
+	rewardPeriod, _ := k.GetUSDXMintingRewardPeriod(ctx, cdp.Type)
+	newRewardsAmount := k.boostRewards(
+		ctx, types.USDXMintingRewardType, cdp.Type, rewardPeriod.Start, rewardPeriod.Budget, types.USDXMintingRewardDenom,
+		rewardsAccumulatedFactor.Mul(cdp.GetTotalPrincipal().Amount.ToDec()), boostFactor,
+	)
 	if newRewardsAmount.IsZero() {
 		k.SetUSDXMintingClaim(ctx, claim)
 		return
@@ -281,6 +309,10 @@ func (k Keeper) InitializeHardSupplyReward(ctx sdk.Context, deposit hardtypes.De
 	}
 
 	claim.SupplyRewardIndexes = supplyRewardIndexes
+	claim.SupplyHoldingPeriods = nil
+	for _, coin := range deposit.Amount {
+		claim.SupplyHoldingPeriods = k.resetHardSupplyHoldingPeriod(ctx, claim.SupplyHoldingPeriods, coin)
+	}
 	k.SetHardLiquidityProviderClaim(ctx, claim)
 }
 
@@ -292,7 +324,20 @@ func (k Keeper) SynchronizeHardSupplyReward(ctx sdk.Context, deposit hardtypes.D
 		return
 	}
 
+	// denoms that were fully withdrawn lose their holding period
+	for _, hp := range claim.SupplyHoldingPeriods {
+		if deposit.Amount.AmountOf(hp.CollateralType).IsZero() {
+			claim.SupplyHoldingPeriods = claim.SupplyHoldingPeriods.RemoveHoldingPeriod(hp.CollateralType)
+		}
+	}
+
 	for _, coin := range deposit.Amount {
+		// record the amount deposited since the last synchronization before calculating the boost
+		boost, foundBoost := k.GetHardSupplyHoldingBoost(ctx, coin.Denom)
+		var boostFactor sdk.Dec
+		claim.SupplyHoldingPeriods, boostFactor = UpdateHoldingPeriods(claim.SupplyHoldingPeriods, boost, foundBoost, coin.Denom, coin.Amount, ctx.BlockTime())
+		rewardPeriod, _ := k.GetHardSupplyRewardPeriods(ctx, coin.Denom)
+
 		globalRewardIndexes, foundGlobalRewardIndexes := k.GetHardSupplyRewardIndexes(ctx, coin.Denom)
 		if !foundGlobalRewardIndexes {
 			continue
@@ -326,7 +371,10 @@ func (k Keeper) SynchronizeHardSupplyReward(ctx sdk.Context, deposit hardtypes.D
 			if rewardsAccumulatedFactor.IsZero() {
 				continue
 			}
-			newRewardsAmount := rewardsAccumulatedFactor.Mul(deposit.Amount.AmountOf(coin.Denom).ToDec()).RoundInt()
+			newRewardsAmount := k.boostRewards(
+				ctx, types.HardSupplyRewardType, coin.Denom, rewardPeriod.Start, rewardPeriod.Budget, globalRewardIndex.CollateralType,
+				rewardsAccumulatedFactor.Mul(deposit.Amount.AmountOf(coin.Denom).ToDec()), boostFactor,
+			)
 			if newRewardsAmount.IsZero() || newRewardsAmount.IsNegative() {
 				continue
 			}
@@ -568,7 +616,10 @@ func (k Keeper) AccumulateHardDelegatorRewards(ctx sdk.Context, rewardPeriod typ
 		return nil
 	}
 
-	newRewards := timeElapsed.Mul(rewardPeriod.RewardsPerSecond.Amount)
+	newRewards := k.applyRewardBudget(
+		ctx, types.HardDelegatorRewardType, rewardPeriod.CollateralType, rewardPeriod.Start, rewardPeriod.Budget,
+		rewardsForDuration(sdk.NewCoins(rewardPeriod.RewardsPerSecond), timeElapsed),
+	).AmountOf(rewardPeriod.RewardsPerSecond.Denom)
 	rewardFactor := newRewards.ToDec().Quo(totalBonded)
 
 	previousRewardFactor, found := k.GetHardDelegatorRewardFactor(ctx, rewardPeriod.CollateralType)
@@ -682,6 +733,9 @@ func CalculateTimeElapsed(start, end, blockTime time.Time, previousAccrualTime t
 
 // SimulateHardSynchronization calculates a user's outstanding hard rewards by simulating reward synchronization
 func (k Keeper) SimulateHardSynchronization(ctx sdk.Context, claim types.HardLiquidityProviderClaim) types.HardLiquidityProviderClaim {
+	// boosts are charged against reward budgets in a cached context that is discarded
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	// 1. Simulate Hard supply-side rewards
 	for _, ri := range claim.SupplyRewardIndexes {
 		globalRewardIndexes, foundGlobalRewardIndexes := k.GetHardSupplyRewardIndexes(ctx, ri.CollateralType)
@@ -718,7 +772,13 @@ func (k Keeper) SimulateHardSynchronization(ctx sdk.Context, claim types.HardLiq
 			if !found {
 				continue
 			}
-			newRewardsAmount := rewardsAccumulatedFactor.Mul(deposit.Amount.AmountOf(ri.CollateralType).ToDec()).RoundInt()
+			boost, foundBoost := k.GetHardSupplyHoldingBoost(ctx, ri.CollateralType)
+			boostFactor := getHoldingBoostFactor(claim.SupplyHoldingPeriods, boost, foundBoost, ri.CollateralType, deposit.Amount.AmountOf(ri.CollateralType), ctx.BlockTime())
+			rewardPeriod, _ := k.GetHardSupplyRewardPeriods(ctx, ri.CollateralType)
+			newRewardsAmount := k.boostRewards(
+				ctx, types.HardSupplyRewardType, ri.CollateralType, rewardPeriod.Start, rewardPeriod.Budget, globalRewardIndex.CollateralType,
+				rewardsAccumulatedFactor.Mul(deposit.Amount.AmountOf(ri.CollateralType).ToDec()), boostFactor,
+			)
 			if newRewardsAmount.IsZero() || newRewardsAmount.IsNegative() {
 				continue
 			}
@@ -845,8 +905,11 @@ func (k Keeper) SimulateHardSynchronization(ctx sdk.Context, claim types.HardLiq
 
 // SimulateUSDXMintingSynchronization calculates a user's outstanding USDX minting rewards by simulating reward synchronization
 func (k Keeper) SimulateUSDXMintingSynchronization(ctx sdk.Context, claim types.USDXMintingClaim) types.USDXMintingClaim {
+	// boosts are charged against reward budgets in a cached context that is discarded
+	ctx, _ = ctx.CacheContext()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	for _, ri := range claim.RewardIndexes {
-		_, found := k.GetUSDXMintingRewardPeriod(ctx, ri.CollateralType)
+		rewardPeriod, found := k.GetUSDXMintingRewardPeriod(ctx, ri.CollateralType)
 		if !found {
 			continue
 		}
@@ -873,7 +936,12 @@ func (k Keeper) SimulateUSDXMintingSynchronization(ctx sdk.Context, claim types.
 		if !found {
 			continue
 		}
-		newRewardsAmount := rewardsAccumulatedFactor.Mul(cdp.GetTotalPrincipal().Amount.ToDec()).RoundInt()
+		boost, foundBoost := k.GetUSDXMintingHoldingBoost(ctx, ri.CollateralType)
+		boostFactor := getHoldingBoostFactor(claim.HoldingPeriods, boost, foundBoost, ri.CollateralType, cdp.GetTotalPrincipal().Amount, ctx.BlockTime())
+		newRewardsAmount := k.boostRewards(
+			ctx, types.USDXMintingRewardType, ri.CollateralType, rewardPeriod.Start, rewardPeriod.Budget, types.USDXMintingRewardDenom,
+			rewardsAccumulatedFactor.Mul(cdp.GetTotalPrincipal().Amount.ToDec()), boostFactor,
+		)
 		if newRewardsAmount.IsZero() {
 			continue
 		}
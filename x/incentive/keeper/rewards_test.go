@@ -81,6 +81,8 @@ func (suite *KeeperTestSuite) TestAccumulateUSDXMintingRewards() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.ctype, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond)},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousUSDXMintingAccrualTime(suite.ctx, tc.args.ctype, tc.args.initialTime)
@@ -156,6 +158,8 @@ func (suite *KeeperTestSuite) TestSynchronizeUSDXMintingReward() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.ctype, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond)},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousUSDXMintingAccrualTime(suite.ctx, tc.args.ctype, tc.args.initialTime)
@@ -320,6 +324,8 @@ func (suite *KeeperTestSuite) TestAccumulateHardBorrowRewards() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.borrow.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond[0])},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousHardBorrowRewardAccrualTime(suite.ctx, tc.args.borrow.Denom, tc.args.initialTime)
@@ -586,6 +592,8 @@ func (suite *KeeperTestSuite) TestSynchronizeHardBorrowReward() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.incentiveBorrowRewardDenom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), c("hard", 1))},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, incentiveParams)
 			suite.keeper.SetPreviousHardBorrowRewardAccrualTime(suite.ctx, tc.args.incentiveBorrowRewardDenom, tc.args.initialTime)
@@ -723,8 +731,8 @@ func (suite *KeeperTestSuite) TestSynchronizeHardBorrowReward() {
 			suite.Require().NoError(err)
 
 			// 5. Committee votes and passes proposal
-			err = suite.committeeKeeper.AddVote(suite.ctx, proposalID, committeeMemberOne)
-			err = suite.committeeKeeper.AddVote(suite.ctx, proposalID, committeeMemberTwo)
+			err = suite.committeeKeeper.AddVote(suite.ctx, proposalID, committeeMemberOne, committee.Yes)
+			err = suite.committeeKeeper.AddVote(suite.ctx, proposalID, committeeMemberTwo, committee.Yes)
 
 			// 6. Check proposal passed
 			proposalPasses, err := suite.committeeKeeper.GetProposalResult(suite.ctx, proposalID)
@@ -850,6 +858,8 @@ func (suite *KeeperTestSuite) TestAccumulateHardDelegatorRewards() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond)},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, tc.args.delegation.Denom, tc.args.initialTime)
@@ -1032,6 +1042,8 @@ func (suite *KeeperTestSuite) TestInitializeHardSupplyRewards() {
 				rewardPeriods, multiRewardPeriods, multiRewardPeriods, rewardPeriods,
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 
@@ -1184,6 +1196,8 @@ func (suite *KeeperTestSuite) TestAccumulateHardSupplyRewards() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.deposit.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), c("hard", 1))},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousHardSupplyRewardAccrualTime(suite.ctx, tc.args.deposit.Denom, tc.args.initialTime)
@@ -1458,6 +1472,8 @@ func (suite *KeeperTestSuite) TestSynchronizeHardSupplyReward() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.incentiveSupplyRewardDenom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), c("hard", 1))},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, incentiveParams)
 			suite.keeper.SetPreviousHardSupplyRewardAccrualTime(suite.ctx, tc.args.incentiveSupplyRewardDenom, tc.args.initialTime)
@@ -1593,8 +1609,8 @@ func (suite *KeeperTestSuite) TestSynchronizeHardSupplyReward() {
 			suite.Require().NoError(err)
 
 			// 5. Committee votes and passes proposal
-			err = suite.committeeKeeper.AddVote(suite.ctx, proposalID, committeeMemberOne)
-			err = suite.committeeKeeper.AddVote(suite.ctx, proposalID, committeeMemberTwo)
+			err = suite.committeeKeeper.AddVote(suite.ctx, proposalID, committeeMemberOne, committee.Yes)
+			err = suite.committeeKeeper.AddVote(suite.ctx, proposalID, committeeMemberTwo, committee.Yes)
 
 			// 6. Check proposal passed
 			proposalPasses, err := suite.committeeKeeper.GetProposalResult(suite.ctx, proposalID)
@@ -1761,6 +1777,8 @@ func (suite *KeeperTestSuite) TestUpdateHardSupplyIndexDenoms() {
 				rewardPeriods, multiRewardPeriods, multiRewardPeriods, rewardPeriods,
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 
@@ -1961,6 +1979,8 @@ func (suite *KeeperTestSuite) TestInitializeHardBorrowRewards() {
 				rewardPeriods, multiRewardPeriods, multiRewardPeriods, rewardPeriods,
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 
@@ -2102,6 +2122,8 @@ func (suite *KeeperTestSuite) TestUpdateHardBorrowIndexDenoms() {
 				rewardPeriods, multiRewardPeriods, multiRewardPeriods, rewardPeriods,
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 
@@ -2212,6 +2234,8 @@ func (suite *KeeperTestSuite) TestSynchronizeHardDelegatorReward() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond)},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, tc.args.delegation.Denom, tc.args.initialTime)
@@ -2327,6 +2351,8 @@ func (suite *KeeperTestSuite) TestSimulateHardSupplyRewardSynchronization() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.deposit.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond[0])},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousHardSupplyRewardAccrualTime(suite.ctx, tc.args.deposit.Denom, tc.args.initialTime)
@@ -2464,6 +2490,8 @@ func (suite *KeeperTestSuite) TestSimulateHardBorrowRewardSynchronization() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.borrow.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond[0])},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousHardBorrowRewardAccrualTime(suite.ctx, tc.args.borrow.Denom, tc.args.initialTime)
@@ -2605,6 +2633,8 @@ func (suite *KeeperTestSuite) TestSimulateHardDelegatorRewardSynchronization() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond[0])},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, tc.args.delegation.Denom, tc.args.initialTime)
@@ -2722,6 +2752,8 @@ func (suite *KeeperTestSuite) TestSimulateUSDXMintingRewardSynchronization() {
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.ctype, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond[0])},
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
 				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.DefaultClaimGracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetParams(suite.ctx, params)
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// SweepExpiredClaims moves the unclaimed rewards of every claim of the input type to the unclaimed rewards
+// destination once the claim end for that type has passed, and zeroes out the expired claims.
+// Each claim end is only swept once - if governance moves the claim end or grace period, claims are swept again after the new end.
+func (k Keeper) SweepExpiredClaims(ctx sdk.Context, claimType string) error {
+	params := k.GetParams(ctx)
+	if params.UnclaimedRewardsDestination.Empty() {
+		// sweeping is disabled
+		return nil
+	}
+
+	claimEnd := k.GetClaimEndForType(ctx, claimType)
+	if !ctx.BlockTime().After(claimEnd) {
+		return nil
+	}
+	previousSweepEnd, found := k.GetPreviousClaimSweepEnd(ctx, claimType)
+	if found && previousSweepEnd.Equal(claimEnd) {
+		return nil
+	}
+
+	var unclaimed sdk.Coins
+	switch claimType {
+	case types.USDXMintingClaimType:
+		for _, c := range k.GetAllUSDXMintingClaims(ctx) {
+			claim, err := k.SynchronizeUSDXMintingClaim(ctx, c)
+			if err != nil {
+				return err
+			}
+			unclaimed = unclaimed.Add(claim.Reward)
+			k.ZeroUSDXMintingClaim(ctx, claim)
+		}
+	case types.HardLiquidityProviderClaimType:
+		for _, c := range k.GetAllHardLiquidityProviderClaims(ctx) {
+			k.SynchronizeHardLiquidityProviderClaim(ctx, c.Owner)
+			claim, found := k.GetHardLiquidityProviderClaim(ctx, c.Owner)
+			if !found {
+				return sdkerrors.Wrapf(types.ErrClaimNotFound, "address: %s", c.Owner)
+			}
+			unclaimed = unclaimed.Add(claim.Reward...)
+			k.ZeroHardLiquidityProviderClaim(ctx, claim)
+		}
+	default:
+		return sdkerrors.Wrap(types.ErrInvalidClaimType, claimType)
+	}
+
+	// never sweep more than the module account holds, any shortfall was never funded
+	macc := k.supplyKeeper.GetModuleAccount(ctx, types.IncentiveMacc)
+	sweptCoins := sdk.NewCoins()
+	for _, coin := range unclaimed {
+		amount := sdk.MinInt(coin.Amount, macc.GetCoins().AmountOf(coin.Denom))
+		sweptCoins = sweptCoins.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	if !sweptCoins.IsZero() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.IncentiveMacc, params.UnclaimedRewardsDestination, sweptCoins)
+		if err != nil {
+			return err
+		}
+	}
+	k.SetPreviousClaimSweepEnd(ctx, claimType, claimEnd)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimPeriodExpiry,
+			sdk.NewAttribute(types.AttributeKeyClaimType, claimType),
+			sdk.NewAttribute(types.AttributeKeyClaimEnd, claimEnd.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimAmount, unclaimed.String()),
+			sdk.NewAttribute(types.AttributeKeySweptAmount, sweptCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, params.UnclaimedRewardsDestination.String()),
+		),
+	)
+	return nil
+}
+
+// GetGenesisClaimSweepEnds returns the claim end at which each claim type was last swept, for claim types that have been swept
+func (k Keeper) GetGenesisClaimSweepEnds(ctx sdk.Context) types.GenesisClaimSweepEnds {
+	sweepEnds := types.GenesisClaimSweepEnds{}
+	for _, claimType := range types.ClaimTypes {
+		if claimEnd, found := k.GetPreviousClaimSweepEnd(ctx, claimType); found {
+			sweepEnds = append(sweepEnds, types.NewGenesisClaimSweepEnd(claimType, claimEnd))
+		}
+	}
+	return sweepEnds
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+	"github.com/kava-labs/kava/x/kavadist"
+)
+
+func (suite *KeeperTestSuite) TestSweepExpiredClaims() {
+	type args struct {
+		claimEnd           time.Time
+		gracePeriods       types.ClaimGracePeriods
+		destination        sdk.AccAddress
+		blockTime          time.Time
+		claimRewards       []sdk.Coin
+		kavadistBalance    sdk.Coins
+		expectedSwept      sdk.Coins
+		expectClaimsZeroed bool
+	}
+	type test struct {
+		name string
+		args args
+	}
+	claimEnd := time.Date(2021, 6, 1, 14, 0, 0, 0, time.UTC)
+	sweepDest := sdk.AccAddress(crypto.AddressHash([]byte("UnclaimedRewardsDest")))
+	testCases := []test{
+		{
+			"claims swept after claim end",
+			args{
+				claimEnd:           claimEnd,
+				gracePeriods:       types.DefaultClaimGracePeriods,
+				destination:        sweepDest,
+				blockTime:          claimEnd.Add(time.Second),
+				claimRewards:       []sdk.Coin{c("ukava", 1000), c("ukava", 2500)},
+				kavadistBalance:    cs(c("ukava", 1000000)),
+				expectedSwept:      cs(c("ukava", 3500)),
+				expectClaimsZeroed: true,
+			},
+		},
+		{
+			"claims not swept before claim end",
+			args{
+				claimEnd:           claimEnd,
+				gracePeriods:       types.DefaultClaimGracePeriods,
+				destination:        sweepDest,
+				blockTime:          claimEnd,
+				claimRewards:       []sdk.Coin{c("ukava", 1000)},
+				kavadistBalance:    cs(c("ukava", 1000000)),
+				expectedSwept:      sdk.Coins(nil),
+				expectClaimsZeroed: false,
+			},
+		},
+		{
+			"claims not swept during grace period",
+			args{
+				claimEnd:           claimEnd,
+				gracePeriods:       types.ClaimGracePeriods{types.NewClaimGracePeriod(types.USDXMintingClaimType, time.Hour*24*30)},
+				destination:        sweepDest,
+				blockTime:          claimEnd.Add(time.Hour * 24),
+				claimRewards:       []sdk.Coin{c("ukava", 1000)},
+				kavadistBalance:    cs(c("ukava", 1000000)),
+				expectedSwept:      sdk.Coins(nil),
+				expectClaimsZeroed: false,
+			},
+		},
+		{
+			"claims not swept without destination",
+			args{
+				claimEnd:           claimEnd,
+				gracePeriods:       types.DefaultClaimGracePeriods,
+				destination:        types.DefaultUnclaimedRewardsDestination,
+				blockTime:          claimEnd.Add(time.Second),
+				claimRewards:       []sdk.Coin{c("ukava", 1000)},
+				kavadistBalance:    cs(c("ukava", 1000000)),
+				expectedSwept:      sdk.Coins(nil),
+				expectClaimsZeroed: false,
+			},
+		},
+		{
+			"sweep capped by module balance",
+			args{
+				claimEnd:           claimEnd,
+				gracePeriods:       types.DefaultClaimGracePeriods,
+				destination:        sweepDest,
+				blockTime:          claimEnd.Add(time.Second),
+				claimRewards:       []sdk.Coin{c("ukava", 1000), c("ukava", 2500)},
+				kavadistBalance:    cs(c("ukava", 3000)),
+				expectedSwept:      cs(c("ukava", 3000)),
+				expectClaimsZeroed: true,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupWithGenState()
+			suite.ctx = suite.ctx.WithBlockTime(tc.args.blockTime)
+
+			params := types.DefaultParams()
+			params.ClaimEnd = tc.args.claimEnd
+			params.ClaimGracePeriods = tc.args.gracePeriods
+			params.UnclaimedRewardsDestination = tc.args.destination
+			suite.keeper.SetParams(suite.ctx, params)
+
+			sk := suite.app.GetSupplyKeeper()
+			err := sk.MintCoins(suite.ctx, kavadist.ModuleName, tc.args.kavadistBalance)
+			suite.Require().NoError(err)
+
+			for i, reward := range tc.args.claimRewards {
+				claim := types.NewUSDXMintingClaim(suite.addrs[i], reward, types.RewardIndexes{types.NewRewardIndex("bnb-a", sdk.ZeroDec())})
+				suite.keeper.SetUSDXMintingClaim(suite.ctx, claim)
+			}
+
+			err = suite.keeper.SweepExpiredClaims(suite.ctx, types.USDXMintingClaimType)
+			suite.Require().NoError(err)
+
+			if !tc.args.destination.Empty() {
+				acc := suite.getAccount(tc.args.destination)
+				if tc.args.expectedSwept.IsZero() {
+					suite.Require().Nil(acc)
+				} else {
+					suite.Require().Equal(tc.args.expectedSwept, acc.GetCoins())
+				}
+			}
+
+			for i, reward := range tc.args.claimRewards {
+				claim, found := suite.keeper.GetUSDXMintingClaim(suite.ctx, suite.addrs[i])
+				suite.Require().True(found)
+				if tc.args.expectClaimsZeroed {
+					suite.Require().Equal(c(reward.Denom, 0), claim.Reward)
+				} else {
+					suite.Require().Equal(reward, claim.Reward)
+				}
+			}
+
+			// sweeping again for the same claim end is a no-op
+			if tc.args.expectClaimsZeroed {
+				err = suite.keeper.SweepExpiredClaims(suite.ctx, types.USDXMintingClaimType)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.args.expectedSwept, suite.getAccount(tc.args.destination).GetCoins())
+			}
+		})
+	}
+}
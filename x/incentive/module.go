@@ -125,7 +125,9 @@ func (AppModule) Name() string {
 }
 
 // RegisterInvariants registers the incentive module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the incentive module.
 func (AppModule) Route() string {
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reward types that can be funded by a reward period budget
+const (
+	USDXMintingRewardType   = "usdx_minting"
+	HardSupplyRewardType    = "hard_supply"
+	HardBorrowRewardType    = "hard_borrow"
+	HardDelegatorRewardType = "hard_delegator"
+)
+
+// RewardTypes is a list of all reward types that have reward periods
+var RewardTypes = []string{USDXMintingRewardType, HardSupplyRewardType, HardBorrowRewardType, HardDelegatorRewardType}
+
+// ValidateRewardType checks that the input reward type is one of the supported reward types
+func ValidateRewardType(rewardType string) error {
+	for _, rt := range RewardTypes {
+		if rt == rewardType {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid reward type: %s", rewardType)
+}
+
+// validateRewardBudget checks that a non-empty budget covers every denom paid out by the reward period
+func validateRewardBudget(budget, rewardsPerSecond sdk.Coins) error {
+	if !budget.IsValid() {
+		return fmt.Errorf("invalid reward budget: %s", budget)
+	}
+	if budget.Empty() {
+		return nil
+	}
+	for _, coin := range rewardsPerSecond {
+		if budget.AmountOf(coin.Denom).IsZero() {
+			return fmt.Errorf("reward budget %s does not include reward denom %s", budget, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// RewardBudget reports the budget of a reward period along with how much of it has been accrued
+type RewardBudget struct {
+	RewardType     string    `json:"reward_type" yaml:"reward_type"`
+	CollateralType string    `json:"collateral_type" yaml:"collateral_type"`
+	Budget         sdk.Coins `json:"budget" yaml:"budget"`
+	Spent          sdk.Coins `json:"spent" yaml:"spent"`
+	Remaining      sdk.Coins `json:"remaining" yaml:"remaining"`
+}
+
+// NewRewardBudget returns a new RewardBudget, computing the remaining amount from the budget and spent amounts
+func NewRewardBudget(rewardType, collateralType string, budget, spent sdk.Coins) RewardBudget {
+	remaining := sdk.NewCoins()
+	for _, coin := range budget {
+		amount := coin.Amount.Sub(spent.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return RewardBudget{
+		RewardType:     rewardType,
+		CollateralType: collateralType,
+		Budget:         budget,
+		Spent:          spent,
+		Remaining:      remaining,
+	}
+}
+
+// String implements fmt.Stringer
+func (rb RewardBudget) String() string {
+	return fmt.Sprintf(`Reward Budget:
+	Reward Type: %s,
+	Collateral Type: %s,
+	Budget: %s,
+	Spent: %s,
+	Remaining: %s,
+	`, rb.RewardType, rb.CollateralType, rb.Budget, rb.Spent, rb.Remaining)
+}
+
+// RewardBudgets slice of RewardBudget
+type RewardBudgets []RewardBudget
+
+// RewardBudgetSpent stores the rewards accrued against the budget of a reward period.
+// Reward periods are identified by their start time, so rewards accrued by a reward period that has been replaced are not counted against the new one.
+type RewardBudgetSpent struct {
+	PeriodStart time.Time `json:"period_start" yaml:"period_start"`
+	Spent       sdk.Coins `json:"spent" yaml:"spent"`
+}
+
+// NewRewardBudgetSpent returns a new RewardBudgetSpent
+func NewRewardBudgetSpent(periodStart time.Time, spent sdk.Coins) RewardBudgetSpent {
+	return RewardBudgetSpent{
+		PeriodStart: periodStart,
+		Spent:       spent,
+	}
+}
+
+// GenesisRewardBudgetSpend stores the amount of rewards accrued against the budget of a reward period
+type GenesisRewardBudgetSpend struct {
+	RewardType     string    `json:"reward_type" yaml:"reward_type"`
+	CollateralType string    `json:"collateral_type" yaml:"collateral_type"`
+	PeriodStart    time.Time `json:"period_start" yaml:"period_start"`
+	Spent          sdk.Coins `json:"spent" yaml:"spent"`
+}
+
+// NewGenesisRewardBudgetSpend returns a new GenesisRewardBudgetSpend
+func NewGenesisRewardBudgetSpend(rewardType, collateralType string, periodStart time.Time, spent sdk.Coins) GenesisRewardBudgetSpend {
+	return GenesisRewardBudgetSpend{
+		RewardType:     rewardType,
+		CollateralType: collateralType,
+		PeriodStart:    periodStart,
+		Spent:          spent,
+	}
+}
+
+// Validate performs validation of GenesisRewardBudgetSpend
+func (grbs GenesisRewardBudgetSpend) Validate() error {
+	if err := ValidateRewardType(grbs.RewardType); err != nil {
+		return err
+	}
+	if strings.TrimSpace(grbs.CollateralType) == "" {
+		return fmt.Errorf("reward budget collateral type cannot be blank")
+	}
+	if grbs.PeriodStart.IsZero() {
+		return fmt.Errorf("reward budget period start cannot be zero for %s %s", grbs.RewardType, grbs.CollateralType)
+	}
+	if !grbs.Spent.IsValid() {
+		return fmt.Errorf("invalid spent amount %s for %s %s", grbs.Spent, grbs.RewardType, grbs.CollateralType)
+	}
+	return nil
+}
+
+// GenesisRewardBudgetSpends slice of GenesisRewardBudgetSpend
+type GenesisRewardBudgetSpends []GenesisRewardBudgetSpend
+
+// Validate performs validation of GenesisRewardBudgetSpends
+func (grbss GenesisRewardBudgetSpends) Validate() error {
+	seen := make(map[string]bool)
+	for _, grbs := range grbss {
+		if err := grbs.Validate(); err != nil {
+			return err
+		}
+		key := grbs.RewardType + "/" + grbs.CollateralType
+		if seen[key] {
+			return fmt.Errorf("duplicated reward budget spend for %s %s", grbs.RewardType, grbs.CollateralType)
+		}
+		seen[key] = true
+	}
+	return nil
+}
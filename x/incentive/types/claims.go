@@ -15,6 +15,19 @@ const (
 	BondDenom                      = "ukava"
 )
 
+// ClaimTypes lists every claim type supported by the incentive module
+var ClaimTypes = []string{USDXMintingClaimType, HardLiquidityProviderClaimType}
+
+// ValidateClaimType returns an error if the input is not a supported claim type
+func ValidateClaimType(claimType string) error {
+	for _, ct := range ClaimTypes {
+		if ct == claimType {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid claim type: %s", claimType)
+}
+
 // Claim is an interface for handling common claim actions
 type Claim interface {
 	GetOwner() sdk.AccAddress
@@ -97,8 +110,9 @@ func (c BaseMultiClaim) String() string {
 
 // USDXMintingClaim is for USDX minting rewards
 type USDXMintingClaim struct {
-	BaseClaim     `json:"base_claim" yaml:"base_claim"`
-	RewardIndexes RewardIndexes `json:"reward_indexes" yaml:"reward_indexes"`
+	BaseClaim      `json:"base_claim" yaml:"base_claim"`
+	RewardIndexes  RewardIndexes  `json:"reward_indexes" yaml:"reward_indexes"`
+	HoldingPeriods HoldingPeriods `json:"holding_periods" yaml:"holding_periods"`
 }
 
 // NewUSDXMintingClaim returns a new USDXMintingClaim
@@ -127,6 +141,10 @@ func (c USDXMintingClaim) Validate() error {
 		return err
 	}
 
+	if err := c.HoldingPeriods.Validate(); err != nil {
+		return err
+	}
+
 	return c.BaseClaim.Validate()
 }
 
@@ -134,7 +152,8 @@ func (c USDXMintingClaim) Validate() error {
 func (c USDXMintingClaim) String() string {
 	return fmt.Sprintf(`%s
 	Reward Indexes: %s,
-	`, c.BaseClaim, c.RewardIndexes)
+	Holding Periods: %s,
+	`, c.BaseClaim, c.RewardIndexes, c.HoldingPeriods)
 }
 
 // HasRewardIndex check if a claim has a reward index for the input collateral type
@@ -168,6 +187,7 @@ type HardLiquidityProviderClaim struct {
 	SupplyRewardIndexes    MultiRewardIndexes `json:"supply_reward_indexes" yaml:"supply_reward_indexes"`
 	BorrowRewardIndexes    MultiRewardIndexes `json:"borrow_reward_indexes" yaml:"borrow_reward_indexes"`
 	DelegatorRewardIndexes RewardIndexes      `json:"delegator_reward_indexes" yaml:"delegator_reward_indexes"`
+	SupplyHoldingPeriods   HoldingPeriods     `json:"supply_holding_periods" yaml:"supply_holding_periods"`
 }
 
 // NewHardLiquidityProviderClaim returns a new HardLiquidityProviderClaim
@@ -207,6 +227,10 @@ func (c HardLiquidityProviderClaim) Validate() error {
 		return err
 	}
 
+	if err := c.SupplyHoldingPeriods.Validate(); err != nil {
+		return err
+	}
+
 	return c.BaseMultiClaim.Validate()
 }
 
@@ -216,7 +240,8 @@ func (c HardLiquidityProviderClaim) String() string {
 	Supply Reward Indexes: %s,
 	Borrow Reward Indexes: %s,
 	Delegator Reward Indexes: %s,
-	`, c.BaseMultiClaim, c.SupplyRewardIndexes, c.BorrowRewardIndexes, c.DelegatorRewardIndexes)
+	Supply Holding Periods: %s,
+	`, c.BaseMultiClaim, c.SupplyRewardIndexes, c.BorrowRewardIndexes, c.DelegatorRewardIndexes, c.SupplyHoldingPeriods)
 }
 
 // HasSupplyRewardIndex check if a claim has a supply reward index for the input collateral type
@@ -273,6 +298,7 @@ type MultiRewardPeriod struct {
 	Start            time.Time `json:"start" yaml:"start"`
 	End              time.Time `json:"end" yaml:"end"`
 	RewardsPerSecond sdk.Coins `json:"rewards_per_second" yaml:"rewards_per_second"` // per second reward payouts
+	Budget           sdk.Coins `json:"budget" yaml:"budget"`                         // total rewards that can be accrued over the life of the period, unbounded if empty
 }
 
 // String implements fmt.Stringer
@@ -282,8 +308,9 @@ func (mrp MultiRewardPeriod) String() string {
 	Start: %s,
 	End: %s,
 	Rewards Per Second: %s,
+	Budget: %s,
 	Active %t,
-	`, mrp.CollateralType, mrp.Start, mrp.End, mrp.RewardsPerSecond, mrp.Active)
+	`, mrp.CollateralType, mrp.Start, mrp.End, mrp.RewardsPerSecond, mrp.Budget, mrp.Active)
 }
 
 // NewMultiRewardPeriod returns a new MultiRewardPeriod
@@ -314,7 +341,7 @@ func (mrp MultiRewardPeriod) Validate() error {
 	if strings.TrimSpace(mrp.CollateralType) == "" {
 		return fmt.Errorf("reward period collateral type cannot be blank: %s", mrp)
 	}
-	return nil
+	return validateRewardBudget(mrp.Budget, mrp.RewardsPerSecond)
 }
 
 // MultiRewardPeriods array of MultiRewardPeriod
@@ -495,3 +522,81 @@ func (mris MultiRewardIndexes) Validate() error {
 	}
 	return nil
 }
+
+// ---------------------- Holding periods are used internally in the store ----------------------
+
+// HoldingPeriod records since when a position has been held continuously above its boost threshold,
+// along with the position amount observed and the time of the last synchronization
+type HoldingPeriod struct {
+	CollateralType string    `json:"collateral_type" yaml:"collateral_type"`
+	Since          time.Time `json:"since" yaml:"since"`
+	Amount         sdk.Int   `json:"amount" yaml:"amount"`
+	LastSync       time.Time `json:"last_sync" yaml:"last_sync"`
+}
+
+// NewHoldingPeriod returns a new HoldingPeriod that was last synchronized when it started
+func NewHoldingPeriod(collateralType string, since time.Time, amount sdk.Int) HoldingPeriod {
+	return HoldingPeriod{
+		CollateralType: collateralType,
+		Since:          since,
+		Amount:         amount,
+		LastSync:       since,
+	}
+}
+
+func (hp HoldingPeriod) String() string {
+	return fmt.Sprintf(`Collateral Type: %s, Since: %s, Amount: %s, Last Sync: %s`, hp.CollateralType, hp.Since, hp.Amount, hp.LastSync)
+}
+
+// Validate validates holding period
+func (hp HoldingPeriod) Validate() error {
+	if strings.TrimSpace(hp.CollateralType) == "" {
+		return fmt.Errorf("collateral type should not be empty")
+	}
+	if hp.Amount.IsNil() || hp.Amount.IsNegative() {
+		return fmt.Errorf("holding period amount should be positive, is %s for %s", hp.Amount, hp.CollateralType)
+	}
+	if !hp.LastSync.IsZero() && hp.LastSync.Before(hp.Since) {
+		return fmt.Errorf("holding period last sync %s is before its start %s for %s", hp.LastSync, hp.Since, hp.CollateralType)
+	}
+	return nil
+}
+
+// HoldingPeriods slice of HoldingPeriod
+type HoldingPeriods []HoldingPeriod
+
+// GetHoldingPeriod fetches a HoldingPeriod by its collateral type
+func (hps HoldingPeriods) GetHoldingPeriod(collateralType string) (HoldingPeriod, int, bool) {
+	for i, hp := range hps {
+		if hp.CollateralType == collateralType {
+			return hp, i, true
+		}
+	}
+	return HoldingPeriod{}, -1, false
+}
+
+// RemoveHoldingPeriod returns a copy of the holding periods without the input collateral type
+func (hps HoldingPeriods) RemoveHoldingPeriod(collateralType string) HoldingPeriods {
+	var updated HoldingPeriods
+	for _, hp := range hps {
+		if hp.CollateralType != collateralType {
+			updated = append(updated, hp)
+		}
+	}
+	return updated
+}
+
+// Validate validation for holding periods
+func (hps HoldingPeriods) Validate() error {
+	seenPeriods := make(map[string]bool)
+	for _, hp := range hps {
+		if seenPeriods[hp.CollateralType] {
+			return fmt.Errorf("duplicated holding period with collateral type %s", hp.CollateralType)
+		}
+		if err := hp.Validate(); err != nil {
+			return err
+		}
+		seenPeriods[hp.CollateralType] = true
+	}
+	return nil
+}
@@ -6,11 +6,18 @@ const (
 	EventTypeRewardPeriod      = "new_reward_period"
 	EventTypeClaimPeriod       = "new_claim_period"
 	EventTypeClaimPeriodExpiry = "claim_period_expiry"
+	EventTypeBudgetExhausted   = "reward_budget_exhausted"
 
-	AttributeValueCategory   = ModuleName
-	AttributeKeyClaimedBy    = "claimed_by"
-	AttributeKeyClaimAmount  = "claim_amount"
-	AttributeKeyClaimType    = "claim_type"
-	AttributeKeyRewardPeriod = "reward_period"
-	AttributeKeyClaimPeriod  = "claim_period"
+	AttributeValueCategory     = ModuleName
+	AttributeKeyClaimedBy      = "claimed_by"
+	AttributeKeyClaimAmount    = "claim_amount"
+	AttributeKeyClaimType      = "claim_type"
+	AttributeKeyRewardPeriod   = "reward_period"
+	AttributeKeyClaimPeriod    = "claim_period"
+	AttributeKeyClaimEnd       = "claim_end"
+	AttributeKeySweptAmount    = "swept_amount"
+	AttributeKeyDestination    = "destination"
+	AttributeKeyRewardType     = "reward_type"
+	AttributeKeyCollateralType = "collateral_type"
+	AttributeKeyBudget         = "budget"
 )
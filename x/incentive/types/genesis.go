@@ -17,10 +17,12 @@ type GenesisState struct {
 	HardDelegatorAccumulationTimes GenesisAccumulationTimes    `json:"hard_delegator_accumulation_times" yaml:"hard_delegator_accumulation_times"`
 	USDXMintingClaims              USDXMintingClaims           `json:"usdx_minting_claims" yaml:"usdx_minting_claims"`
 	HardLiquidityProviderClaims    HardLiquidityProviderClaims `json:"hard_liquidity_provider_claims" yaml:"hard_liquidity_provider_claims"`
+	RewardBudgetSpends             GenesisRewardBudgetSpends   `json:"reward_budget_spends" yaml:"reward_budget_spends"`
+	ClaimSweepEnds                 GenesisClaimSweepEnds       `json:"claim_sweep_ends" yaml:"claim_sweep_ends"`
 }
 
 // NewGenesisState returns a new genesis state
-func NewGenesisState(params Params, usdxAccumTimes, hardSupplyAccumTimes, hardBorrowAccumTimes, hardDelegatorAccumTimes GenesisAccumulationTimes, c USDXMintingClaims, hc HardLiquidityProviderClaims) GenesisState {
+func NewGenesisState(params Params, usdxAccumTimes, hardSupplyAccumTimes, hardBorrowAccumTimes, hardDelegatorAccumTimes GenesisAccumulationTimes, c USDXMintingClaims, hc HardLiquidityProviderClaims, budgetSpends GenesisRewardBudgetSpends, sweepEnds GenesisClaimSweepEnds) GenesisState {
 	return GenesisState{
 		Params:                         params,
 		USDXAccumulationTimes:          usdxAccumTimes,
@@ -29,6 +31,8 @@ func NewGenesisState(params Params, usdxAccumTimes, hardSupplyAccumTimes, hardBo
 		HardDelegatorAccumulationTimes: hardDelegatorAccumTimes,
 		USDXMintingClaims:              c,
 		HardLiquidityProviderClaims:    hc,
+		RewardBudgetSpends:             budgetSpends,
+		ClaimSweepEnds:                 sweepEnds,
 	}
 }
 
@@ -42,6 +46,8 @@ func DefaultGenesisState() GenesisState {
 		HardDelegatorAccumulationTimes: GenesisAccumulationTimes{},
 		USDXMintingClaims:              DefaultUSDXClaims,
 		HardLiquidityProviderClaims:    DefaultHardClaims,
+		RewardBudgetSpends:             DefaultRewardBudgetSpends,
+		ClaimSweepEnds:                 DefaultClaimSweepEnds,
 	}
 }
 
@@ -67,6 +73,12 @@ func (gs GenesisState) Validate() error {
 	if err := gs.HardLiquidityProviderClaims.Validate(); err != nil {
 		return err
 	}
+	if err := gs.RewardBudgetSpends.Validate(); err != nil {
+		return err
+	}
+	if err := gs.ClaimSweepEnds.Validate(); err != nil {
+		return err
+	}
 	return gs.USDXMintingClaims.Validate()
 }
 
@@ -118,3 +130,46 @@ func (gat GenesisAccumulationTime) Validate() error {
 	}
 	return nil
 }
+
+// GenesisClaimSweepEnd stores the claim end at which the claims of a claim type were last swept
+type GenesisClaimSweepEnd struct {
+	ClaimType string    `json:"claim_type" yaml:"claim_type"`
+	ClaimEnd  time.Time `json:"claim_end" yaml:"claim_end"`
+}
+
+// NewGenesisClaimSweepEnd returns a new GenesisClaimSweepEnd
+func NewGenesisClaimSweepEnd(claimType string, claimEnd time.Time) GenesisClaimSweepEnd {
+	return GenesisClaimSweepEnd{
+		ClaimType: claimType,
+		ClaimEnd:  claimEnd,
+	}
+}
+
+// Validate performs validation of GenesisClaimSweepEnd
+func (gcse GenesisClaimSweepEnd) Validate() error {
+	if err := ValidateClaimType(gcse.ClaimType); err != nil {
+		return err
+	}
+	if gcse.ClaimEnd.IsZero() {
+		return fmt.Errorf("claim sweep end cannot be zero for %s", gcse.ClaimType)
+	}
+	return nil
+}
+
+// GenesisClaimSweepEnds slice of GenesisClaimSweepEnd
+type GenesisClaimSweepEnds []GenesisClaimSweepEnd
+
+// Validate performs validation of GenesisClaimSweepEnds
+func (gcses GenesisClaimSweepEnds) Validate() error {
+	seen := make(map[string]bool)
+	for _, gcse := range gcses {
+		if err := gcse.Validate(); err != nil {
+			return err
+		}
+		if seen[gcse.ClaimType] {
+			return fmt.Errorf("duplicated claim sweep end for %s", gcse.ClaimType)
+		}
+		seen[gcse.ClaimType] = true
+	}
+	return nil
+}
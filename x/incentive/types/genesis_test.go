@@ -59,6 +59,8 @@ func TestGenesisStateValidate(t *testing.T) {
 						NewMultiplier(Small, 1, sdk.MustNewDecFromStr("0.33")),
 					},
 					time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+					DefaultClaimGracePeriods, DefaultUnclaimedRewardsDestination,
+					DefaultHoldingBoosts, DefaultHoldingBoosts,
 				),
 				genAccTimes: GenesisAccumulationTimes{GenesisAccumulationTime{
 					CollateralType:           "bnb-a",
@@ -131,7 +133,7 @@ func TestGenesisStateValidate(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			gs := NewGenesisState(tc.args.params, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.claims, DefaultHardClaims)
+			gs := NewGenesisState(tc.args.params, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.claims, DefaultHardClaims, DefaultRewardBudgetSpends, DefaultClaimSweepEnds)
 			err := gs.Validate()
 			if tc.errArgs.expectPass {
 				require.NoError(t, err, tc.name)
@@ -142,3 +144,62 @@ func TestGenesisStateValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisClaimSweepEndsValidate(t *testing.T) {
+	claimEnd := time.Date(2021, 6, 1, 14, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name       string
+		sweepEnds  GenesisClaimSweepEnds
+		expectPass bool
+		contains   string
+	}{
+		{
+			name:       "default",
+			sweepEnds:  DefaultClaimSweepEnds,
+			expectPass: true,
+		},
+		{
+			name: "valid",
+			sweepEnds: GenesisClaimSweepEnds{
+				NewGenesisClaimSweepEnd(USDXMintingClaimType, claimEnd),
+				NewGenesisClaimSweepEnd(HardLiquidityProviderClaimType, claimEnd),
+			},
+			expectPass: true,
+		},
+		{
+			name:       "invalid claim type",
+			sweepEnds:  GenesisClaimSweepEnds{NewGenesisClaimSweepEnd("swp", claimEnd)},
+			expectPass: false,
+			contains:   "invalid claim type",
+		},
+		{
+			name:       "zero claim end",
+			sweepEnds:  GenesisClaimSweepEnds{NewGenesisClaimSweepEnd(USDXMintingClaimType, time.Time{})},
+			expectPass: false,
+			contains:   "claim sweep end cannot be zero",
+		},
+		{
+			name: "duplicated claim type",
+			sweepEnds: GenesisClaimSweepEnds{
+				NewGenesisClaimSweepEnd(USDXMintingClaimType, claimEnd),
+				NewGenesisClaimSweepEnd(USDXMintingClaimType, claimEnd.Add(time.Hour)),
+			},
+			expectPass: false,
+			contains:   "duplicated claim sweep end",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			gs.ClaimSweepEnds = tc.sweepEnds
+			err := gs.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.contains)
+			}
+		})
+	}
+}
@@ -33,7 +33,14 @@ var (
 	PreviousHardBorrowRewardAccrualTimeKeyPrefix    = []byte{0x08} // prefix for key that stores the previous time Hard borrow rewards accrued
 	HardDelegatorRewardFactorKeyPrefix              = []byte{0x09} // prefix for key that stores Hard delegator reward factors
 	PreviousHardDelegatorRewardAccrualTimeKeyPrefix = []byte{0x10} // prefix for key that stores the previous time Hard delegator rewards accrued
+	ClaimSweepKeyPrefix                             = []byte{0x11} // prefix for key that stores the claim end at which each claim type was last swept
+	RewardBudgetSpentKeyPrefix                      = []byte{0x12} // prefix for keys that store the rewards accrued against each reward period budget
 
 	USDXMintingRewardDenom   = "ukava"
 	HardLiquidityRewardDenom = "hard"
 )
+
+// GetRewardBudgetSpentKey returns the key for the rewards accrued against the budget of a reward period
+func GetRewardBudgetSpentKey(rewardType, collateralType string) []byte {
+	return []byte(rewardType + "/" + collateralType)
+}
@@ -24,52 +24,72 @@ const (
 
 // Parameter keys and default values
 var (
-	KeyUSDXMintingRewardPeriods     = []byte("USDXMintingRewardPeriods")
-	KeyHardSupplyRewardPeriods      = []byte("HardSupplyRewardPeriods")
-	KeyHardBorrowRewardPeriods      = []byte("HardBorrowRewardPeriods")
-	KeyHardDelegatorRewardPeriods   = []byte("HardDelegatorRewardPeriods")
-	KeyClaimEnd                     = []byte("ClaimEnd")
-	KeyMultipliers                  = []byte("ClaimMultipliers")
-	DefaultActive                   = false
-	DefaultRewardPeriods            = RewardPeriods{}
-	DefaultMultiRewardPeriods       = MultiRewardPeriods{}
-	DefaultMultipliers              = Multipliers{}
-	DefaultUSDXClaims               = USDXMintingClaims{}
-	DefaultHardClaims               = HardLiquidityProviderClaims{}
-	DefaultGenesisAccumulationTimes = GenesisAccumulationTimes{}
-	DefaultClaimEnd                 = tmtime.Canonical(time.Unix(1, 0))
-	GovDenom                        = cdptypes.DefaultGovDenom
-	PrincipalDenom                  = "usdx"
-	IncentiveMacc                   = kavadistTypes.ModuleName
+	KeyUSDXMintingRewardPeriods        = []byte("USDXMintingRewardPeriods")
+	KeyHardSupplyRewardPeriods         = []byte("HardSupplyRewardPeriods")
+	KeyHardBorrowRewardPeriods         = []byte("HardBorrowRewardPeriods")
+	KeyHardDelegatorRewardPeriods      = []byte("HardDelegatorRewardPeriods")
+	KeyClaimEnd                        = []byte("ClaimEnd")
+	KeyMultipliers                     = []byte("ClaimMultipliers")
+	KeyClaimGracePeriods               = []byte("ClaimGracePeriods")
+	KeyUnclaimedRewardsDestination     = []byte("UnclaimedRewardsDestination")
+	KeyUSDXMintingHoldingBoosts        = []byte("USDXMintingHoldingBoosts")
+	KeyHardSupplyHoldingBoosts         = []byte("HardSupplyHoldingBoosts")
+	DefaultActive                      = false
+	DefaultRewardPeriods               = RewardPeriods{}
+	DefaultMultiRewardPeriods          = MultiRewardPeriods{}
+	DefaultMultipliers                 = Multipliers{}
+	DefaultUSDXClaims                  = USDXMintingClaims{}
+	DefaultHardClaims                  = HardLiquidityProviderClaims{}
+	DefaultGenesisAccumulationTimes    = GenesisAccumulationTimes{}
+	DefaultRewardBudgetSpends          = GenesisRewardBudgetSpends{}
+	DefaultClaimSweepEnds              = GenesisClaimSweepEnds{}
+	DefaultClaimEnd                    = tmtime.Canonical(time.Unix(1, 0))
+	DefaultClaimGracePeriods           = ClaimGracePeriods{}
+	DefaultUnclaimedRewardsDestination = sdk.AccAddress{}
+	DefaultHoldingBoosts               = HoldingBoosts{}
+	GovDenom                           = cdptypes.DefaultGovDenom
+	PrincipalDenom                     = "usdx"
+	IncentiveMacc                      = kavadistTypes.ModuleName
 )
 
 // Params governance parameters for the incentive module
 type Params struct {
-	USDXMintingRewardPeriods   RewardPeriods      `json:"usdx_minting_reward_periods" yaml:"usdx_minting_reward_periods"`
-	HardSupplyRewardPeriods    MultiRewardPeriods `json:"hard_supply_reward_periods" yaml:"hard_supply_reward_periods"`
-	HardBorrowRewardPeriods    MultiRewardPeriods `json:"hard_borrow_reward_periods" yaml:"hard_borrow_reward_periods"`
-	HardDelegatorRewardPeriods RewardPeriods      `json:"hard_delegator_reward_periods" yaml:"hard_delegator_reward_periods"`
-	ClaimMultipliers           Multipliers        `json:"claim_multipliers" yaml:"claim_multipliers"`
-	ClaimEnd                   time.Time          `json:"claim_end" yaml:"claim_end"`
+	USDXMintingRewardPeriods    RewardPeriods      `json:"usdx_minting_reward_periods" yaml:"usdx_minting_reward_periods"`
+	HardSupplyRewardPeriods     MultiRewardPeriods `json:"hard_supply_reward_periods" yaml:"hard_supply_reward_periods"`
+	HardBorrowRewardPeriods     MultiRewardPeriods `json:"hard_borrow_reward_periods" yaml:"hard_borrow_reward_periods"`
+	HardDelegatorRewardPeriods  RewardPeriods      `json:"hard_delegator_reward_periods" yaml:"hard_delegator_reward_periods"`
+	ClaimMultipliers            Multipliers        `json:"claim_multipliers" yaml:"claim_multipliers"`
+	ClaimEnd                    time.Time          `json:"claim_end" yaml:"claim_end"`
+	ClaimGracePeriods           ClaimGracePeriods  `json:"claim_grace_periods" yaml:"claim_grace_periods"`
+	UnclaimedRewardsDestination sdk.AccAddress     `json:"unclaimed_rewards_destination" yaml:"unclaimed_rewards_destination"`
+	USDXMintingHoldingBoosts    HoldingBoosts      `json:"usdx_minting_holding_boosts" yaml:"usdx_minting_holding_boosts"`
+	HardSupplyHoldingBoosts     HoldingBoosts      `json:"hard_supply_holding_boosts" yaml:"hard_supply_holding_boosts"`
 }
 
 // NewParams returns a new params object
 func NewParams(usdxMinting RewardPeriods, hardSupply, hardBorrow MultiRewardPeriods,
-	hardDelegator RewardPeriods, multipliers Multipliers, claimEnd time.Time) Params {
+	hardDelegator RewardPeriods, multipliers Multipliers, claimEnd time.Time,
+	gracePeriods ClaimGracePeriods, unclaimedRewardsDestination sdk.AccAddress,
+	usdxMintingBoosts, hardSupplyBoosts HoldingBoosts) Params {
 	return Params{
-		USDXMintingRewardPeriods:   usdxMinting,
-		HardSupplyRewardPeriods:    hardSupply,
-		HardBorrowRewardPeriods:    hardBorrow,
-		HardDelegatorRewardPeriods: hardDelegator,
-		ClaimMultipliers:           multipliers,
-		ClaimEnd:                   claimEnd,
+		USDXMintingRewardPeriods:    usdxMinting,
+		HardSupplyRewardPeriods:     hardSupply,
+		HardBorrowRewardPeriods:     hardBorrow,
+		HardDelegatorRewardPeriods:  hardDelegator,
+		ClaimMultipliers:            multipliers,
+		ClaimEnd:                    claimEnd,
+		ClaimGracePeriods:           gracePeriods,
+		UnclaimedRewardsDestination: unclaimedRewardsDestination,
+		USDXMintingHoldingBoosts:    usdxMintingBoosts,
+		HardSupplyHoldingBoosts:     hardSupplyBoosts,
 	}
 }
 
 // DefaultParams returns default params for incentive module
 func DefaultParams() Params {
 	return NewParams(DefaultRewardPeriods, DefaultMultiRewardPeriods,
-		DefaultMultiRewardPeriods, DefaultRewardPeriods, DefaultMultipliers, DefaultClaimEnd)
+		DefaultMultiRewardPeriods, DefaultRewardPeriods, DefaultMultipliers, DefaultClaimEnd,
+		DefaultClaimGracePeriods, DefaultUnclaimedRewardsDestination, DefaultHoldingBoosts, DefaultHoldingBoosts)
 }
 
 // String implements fmt.Stringer
@@ -81,8 +101,13 @@ func (p Params) String() string {
 	Hard Delegator Reward Periods: %s
 	Claim Multipliers :%s
 	Claim End Time: %s
+	Claim Grace Periods: %s
+	Unclaimed Rewards Destination: %s
+	USDX Minting Holding Boosts: %s
+	Hard Supply Holding Boosts: %s
 	`, p.USDXMintingRewardPeriods, p.HardSupplyRewardPeriods, p.HardBorrowRewardPeriods,
-		p.HardDelegatorRewardPeriods, p.ClaimMultipliers, p.ClaimEnd)
+		p.HardDelegatorRewardPeriods, p.ClaimMultipliers, p.ClaimEnd, p.ClaimGracePeriods,
+		p.UnclaimedRewardsDestination, p.USDXMintingHoldingBoosts, p.HardSupplyHoldingBoosts)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -99,6 +124,10 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyHardDelegatorRewardPeriods, &p.HardDelegatorRewardPeriods, validateRewardPeriodsParam),
 		params.NewParamSetPair(KeyClaimEnd, &p.ClaimEnd, validateClaimEndParam),
 		params.NewParamSetPair(KeyMultipliers, &p.ClaimMultipliers, validateMultipliersParam),
+		params.NewParamSetPair(KeyClaimGracePeriods, &p.ClaimGracePeriods, validateClaimGracePeriodsParam),
+		params.NewParamSetPair(KeyUnclaimedRewardsDestination, &p.UnclaimedRewardsDestination, validateUnclaimedRewardsDestinationParam),
+		params.NewParamSetPair(KeyUSDXMintingHoldingBoosts, &p.USDXMintingHoldingBoosts, validateHoldingBoostsParam),
+		params.NewParamSetPair(KeyHardSupplyHoldingBoosts, &p.HardSupplyHoldingBoosts, validateHoldingBoostsParam),
 	}
 }
 
@@ -121,7 +150,23 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateRewardPeriodsParam(p.HardDelegatorRewardPeriods)
+	if err := validateRewardPeriodsParam(p.HardDelegatorRewardPeriods); err != nil {
+		return err
+	}
+
+	if err := validateClaimGracePeriodsParam(p.ClaimGracePeriods); err != nil {
+		return err
+	}
+
+	if err := validateUnclaimedRewardsDestinationParam(p.UnclaimedRewardsDestination); err != nil {
+		return err
+	}
+
+	if err := validateHoldingBoostsParam(p.USDXMintingHoldingBoosts); err != nil {
+		return err
+	}
+
+	return validateHoldingBoostsParam(p.HardSupplyHoldingBoosts)
 }
 
 func validateRewardPeriodsParam(i interface{}) error {
@@ -161,6 +206,34 @@ func validateClaimEndParam(i interface{}) error {
 	return nil
 }
 
+func validateClaimGracePeriodsParam(i interface{}) error {
+	gracePeriods, ok := i.(ClaimGracePeriods)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return gracePeriods.Validate()
+}
+
+func validateUnclaimedRewardsDestinationParam(i interface{}) error {
+	dest, ok := i.(sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// an empty destination disables sweeping of unclaimed rewards
+	if dest.Empty() {
+		return nil
+	}
+	return sdk.VerifyAddressFormat(dest)
+}
+
+func validateHoldingBoostsParam(i interface{}) error {
+	boosts, ok := i.(HoldingBoosts)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return boosts.Validate()
+}
+
 // RewardPeriod stores the state of an ongoing reward
 type RewardPeriod struct {
 	Active           bool      `json:"active" yaml:"active"`
@@ -168,6 +241,7 @@ type RewardPeriod struct {
 	Start            time.Time `json:"start" yaml:"start"`
 	End              time.Time `json:"end" yaml:"end"`
 	RewardsPerSecond sdk.Coin  `json:"rewards_per_second" yaml:"rewards_per_second"` // per second reward payouts
+	Budget           sdk.Coins `json:"budget" yaml:"budget"`                         // total rewards that can be accrued over the life of the period, unbounded if empty
 }
 
 // String implements fmt.Stringer
@@ -177,8 +251,9 @@ func (rp RewardPeriod) String() string {
 	Start: %s,
 	End: %s,
 	Rewards Per Second: %s,
+	Budget: %s,
 	Active %t,
-	`, rp.CollateralType, rp.Start, rp.End, rp.RewardsPerSecond, rp.Active)
+	`, rp.CollateralType, rp.Start, rp.End, rp.RewardsPerSecond, rp.Budget, rp.Active)
 }
 
 // NewRewardPeriod returns a new RewardPeriod
@@ -209,7 +284,7 @@ func (rp RewardPeriod) Validate() error {
 	if strings.TrimSpace(rp.CollateralType) == "" {
 		return fmt.Errorf("reward period collateral type cannot be blank: %s", rp)
 	}
-	return nil
+	return validateRewardBudget(rp.Budget, sdk.NewCoins(rp.RewardsPerSecond))
 }
 
 // RewardPeriods array of RewardPeriod
@@ -306,3 +381,177 @@ func (mn MultiplierName) IsValid() error {
 	}
 	return fmt.Errorf("invalid multiplier name: %s", mn)
 }
+
+// ClaimGracePeriod extends the claim end of a single claim type past the global claim end
+type ClaimGracePeriod struct {
+	ClaimType string        `json:"claim_type" yaml:"claim_type"`
+	Duration  time.Duration `json:"duration" yaml:"duration"`
+}
+
+// NewClaimGracePeriod returns a new ClaimGracePeriod
+func NewClaimGracePeriod(claimType string, duration time.Duration) ClaimGracePeriod {
+	return ClaimGracePeriod{
+		ClaimType: claimType,
+		Duration:  duration,
+	}
+}
+
+// Validate performs a basic check of a ClaimGracePeriod fields
+func (gp ClaimGracePeriod) Validate() error {
+	if err := ValidateClaimType(gp.ClaimType); err != nil {
+		return err
+	}
+	if gp.Duration < 0 {
+		return fmt.Errorf("expected non-negative grace period duration, got %s", gp.Duration)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (gp ClaimGracePeriod) String() string {
+	return fmt.Sprintf(`Claim Grace Period:
+	Claim Type: %s
+	Duration: %s
+	`, gp.ClaimType, gp.Duration)
+}
+
+// ClaimGracePeriods slice of ClaimGracePeriod
+type ClaimGracePeriods []ClaimGracePeriod
+
+// Validate checks if all the grace periods are valid and there are no duplicated
+// entries.
+func (gps ClaimGracePeriods) Validate() error {
+	seenClaimTypes := make(map[string]bool)
+	for _, gp := range gps {
+		if seenClaimTypes[gp.ClaimType] {
+			return fmt.Errorf("duplicated grace period with claim type %s", gp.ClaimType)
+		}
+		if err := gp.Validate(); err != nil {
+			return err
+		}
+		seenClaimTypes[gp.ClaimType] = true
+	}
+	return nil
+}
+
+// GetGracePeriod returns the grace period duration for the input claim type, zero if none is set
+func (gps ClaimGracePeriods) GetGracePeriod(claimType string) time.Duration {
+	for _, gp := range gps {
+		if gp.ClaimType == claimType {
+			return gp.Duration
+		}
+	}
+	return 0
+}
+
+// HoldingBoost increases the rewards of positions that have been held continuously above a threshold.
+// The boost grows linearly from 1 to MaxBoost over RampDuration and resets when the position is reduced.
+type HoldingBoost struct {
+	CollateralType string        `json:"collateral_type" yaml:"collateral_type"`
+	Threshold      sdk.Int       `json:"threshold" yaml:"threshold"`
+	MaxBoost       sdk.Dec       `json:"max_boost" yaml:"max_boost"`
+	RampDuration   time.Duration `json:"ramp_duration" yaml:"ramp_duration"`
+}
+
+// NewHoldingBoost returns a new HoldingBoost
+func NewHoldingBoost(collateralType string, threshold sdk.Int, maxBoost sdk.Dec, rampDuration time.Duration) HoldingBoost {
+	return HoldingBoost{
+		CollateralType: collateralType,
+		Threshold:      threshold,
+		MaxBoost:       maxBoost,
+		RampDuration:   rampDuration,
+	}
+}
+
+// Validate performs a basic check of a HoldingBoost fields
+func (hb HoldingBoost) Validate() error {
+	if strings.TrimSpace(hb.CollateralType) == "" {
+		return fmt.Errorf("holding boost collateral type cannot be blank: %s", hb)
+	}
+	if hb.Threshold.IsNil() || hb.Threshold.IsNegative() {
+		return fmt.Errorf("expected non-negative holding boost threshold, got %s", hb.Threshold)
+	}
+	if hb.MaxBoost.IsNil() || hb.MaxBoost.LT(sdk.OneDec()) {
+		return fmt.Errorf("expected max boost ≥ 1.0, got %s", hb.MaxBoost)
+	}
+	if hb.RampDuration <= 0 {
+		return fmt.Errorf("expected positive ramp duration, got %s", hb.RampDuration)
+	}
+	return nil
+}
+
+// BoostFactor returns the factor applied to rewards of a position that has been held for the input duration
+func (hb HoldingBoost) BoostFactor(heldFor time.Duration) sdk.Dec {
+	if heldFor <= 0 {
+		return sdk.OneDec()
+	}
+	if heldFor >= hb.RampDuration {
+		return hb.MaxBoost
+	}
+	progress := sdk.NewDec(int64(heldFor)).QuoInt64(int64(hb.RampDuration))
+	return sdk.OneDec().Add(hb.MaxBoost.Sub(sdk.OneDec()).Mul(progress))
+}
+
+// AverageBoostFactor returns the boost factor averaged over the time a position was held between heldFrom and heldTo,
+// so that rewards accrued over that window are boosted as if the position had been synchronized continuously
+func (hb HoldingBoost) AverageBoostFactor(heldFrom, heldTo time.Duration) sdk.Dec {
+	if heldFrom < 0 {
+		heldFrom = 0
+	}
+	if heldTo <= heldFrom {
+		return hb.BoostFactor(heldTo)
+	}
+	integral := hb.boostIntegral(heldTo).Sub(hb.boostIntegral(heldFrom))
+	return integral.QuoInt64(int64(heldTo - heldFrom))
+}
+
+// boostIntegral returns the integral of the boost factor from zero to the input holding duration
+func (hb HoldingBoost) boostIntegral(heldFor time.Duration) sdk.Dec {
+	extraBoost := hb.MaxBoost.Sub(sdk.OneDec())
+	if heldFor <= hb.RampDuration {
+		held := sdk.NewDec(int64(heldFor))
+		return held.Add(extraBoost.Mul(held).Mul(held).QuoInt64(2 * int64(hb.RampDuration)))
+	}
+	ramp := sdk.NewDec(int64(hb.RampDuration))
+	rampIntegral := ramp.Add(extraBoost.Mul(ramp).QuoInt64(2))
+	return rampIntegral.Add(hb.MaxBoost.MulInt64(int64(heldFor - hb.RampDuration)))
+}
+
+// String implements fmt.Stringer
+func (hb HoldingBoost) String() string {
+	return fmt.Sprintf(`Holding Boost:
+	Collateral Type: %s
+	Threshold: %s
+	Max Boost: %s
+	Ramp Duration: %s
+	`, hb.CollateralType, hb.Threshold, hb.MaxBoost, hb.RampDuration)
+}
+
+// HoldingBoosts slice of HoldingBoost
+type HoldingBoosts []HoldingBoost
+
+// GetHoldingBoost returns the holding boost for the input collateral type
+func (hbs HoldingBoosts) GetHoldingBoost(collateralType string) (HoldingBoost, bool) {
+	for _, hb := range hbs {
+		if hb.CollateralType == collateralType {
+			return hb, true
+		}
+	}
+	return HoldingBoost{}, false
+}
+
+// Validate checks if all the holding boosts are valid and there are no duplicated
+// entries.
+func (hbs HoldingBoosts) Validate() error {
+	seenBoosts := make(map[string]bool)
+	for _, hb := range hbs {
+		if seenBoosts[hb.CollateralType] {
+			return fmt.Errorf("duplicated holding boost with collateral type %s", hb.CollateralType)
+		}
+		if err := hb.Validate(); err != nil {
+			return err
+		}
+		seenBoosts[hb.CollateralType] = true
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ func (suite *ParamTestSuite) TestParamValidation() {
 		hardDelegatorRewardPeriods types.RewardPeriods
 		multipliers                types.Multipliers
 		end                        time.Time
+		gracePeriods               types.ClaimGracePeriods
 	}
 
 	type errArgs struct {
@@ -78,12 +79,70 @@ func (suite *ParamTestSuite) TestParamValidation() {
 				contains:   "",
 			},
 		},
+		{
+			"valid grace periods",
+			args{
+				usdxMintingRewardPeriods:   types.DefaultRewardPeriods,
+				hardSupplyRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardBorrowRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardDelegatorRewardPeriods: types.DefaultRewardPeriods,
+				multipliers:                types.DefaultMultipliers,
+				end:                        time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+				gracePeriods: types.ClaimGracePeriods{
+					types.NewClaimGracePeriod(types.USDXMintingClaimType, time.Hour*24*30),
+					types.NewClaimGracePeriod(types.HardLiquidityProviderClaimType, time.Hour*24*90),
+				},
+			},
+			errArgs{
+				expectPass: true,
+				contains:   "",
+			},
+		},
+		{
+			"invalid grace period claim type",
+			args{
+				usdxMintingRewardPeriods:   types.DefaultRewardPeriods,
+				hardSupplyRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardBorrowRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardDelegatorRewardPeriods: types.DefaultRewardPeriods,
+				multipliers:                types.DefaultMultipliers,
+				end:                        time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+				gracePeriods: types.ClaimGracePeriods{
+					types.NewClaimGracePeriod("savings", time.Hour*24*30),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "invalid claim type",
+			},
+		},
+		{
+			"invalid duplicate grace periods",
+			args{
+				usdxMintingRewardPeriods:   types.DefaultRewardPeriods,
+				hardSupplyRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardBorrowRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardDelegatorRewardPeriods: types.DefaultRewardPeriods,
+				multipliers:                types.DefaultMultipliers,
+				end:                        time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+				gracePeriods: types.ClaimGracePeriods{
+					types.NewClaimGracePeriod(types.USDXMintingClaimType, time.Hour*24*30),
+					types.NewClaimGracePeriod(types.USDXMintingClaimType, time.Hour*24*90),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "duplicated grace period",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			params := types.NewParams(tc.args.usdxMintingRewardPeriods, tc.args.hardSupplyRewardPeriods,
 				tc.args.hardBorrowRewardPeriods, tc.args.hardDelegatorRewardPeriods, tc.args.multipliers, tc.args.end,
+				tc.args.gracePeriods, types.DefaultUnclaimedRewardsDestination,
+				types.DefaultHoldingBoosts, types.DefaultHoldingBoosts,
 			)
 			err := params.Validate()
 			if tc.errArgs.expectPass {
@@ -96,6 +155,39 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamTestSuite) TestHoldingBoostFactor() {
+	boost := types.NewHoldingBoost("bnb-a", sdk.NewInt(1000), sdk.MustNewDecFromStr("1.5"), time.Hour*24*10)
+	suite.Require().NoError(boost.Validate())
+
+	suite.Require().Equal(sdk.OneDec(), boost.BoostFactor(0))
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.25"), boost.BoostFactor(time.Hour*24*5))
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.5"), boost.BoostFactor(time.Hour*24*10))
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.5"), boost.BoostFactor(time.Hour*24*100))
+
+	invalidBoost := types.NewHoldingBoost("bnb-a", sdk.NewInt(1000), sdk.MustNewDecFromStr("0.5"), time.Hour*24*10)
+	suite.Require().Error(invalidBoost.Validate())
+	invalidBoost = types.NewHoldingBoost("bnb-a", sdk.NewInt(1000), sdk.MustNewDecFromStr("1.5"), 0)
+	suite.Require().Error(invalidBoost.Validate())
+}
+
+func (suite *ParamTestSuite) TestRewardPeriodBudgetValidation() {
+	start := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour * 24 * 365)
+
+	rp := types.NewRewardPeriod(true, "bnb-a", start, end, sdk.NewCoin("ukava", sdk.NewInt(1000)))
+	suite.Require().NoError(rp.Validate())
+	rp.Budget = sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1000000)))
+	suite.Require().NoError(rp.Validate())
+	rp.Budget = sdk.NewCoins(sdk.NewCoin("hard", sdk.NewInt(1000000)))
+	suite.Require().Error(rp.Validate())
+
+	mrp := types.NewMultiRewardPeriod(true, "bnb", start, end, sdk.NewCoins(sdk.NewCoin("hard", sdk.NewInt(1000)), sdk.NewCoin("ukava", sdk.NewInt(1000))))
+	mrp.Budget = sdk.NewCoins(sdk.NewCoin("hard", sdk.NewInt(1000000)), sdk.NewCoin("ukava", sdk.NewInt(1000000)))
+	suite.Require().NoError(mrp.Validate())
+	mrp.Budget = sdk.NewCoins(sdk.NewCoin("hard", sdk.NewInt(1000000)))
+	suite.Require().Error(mrp.Validate())
+}
+
 func TestParamTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
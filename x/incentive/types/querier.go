@@ -13,6 +13,7 @@ const (
 	QueryGetParams             = "parameters"
 	QueryGetRewardPeriods      = "reward-periods"
 	QueryGetClaimPeriods       = "claim-periods"
+	QueryGetRewardBudgets      = "reward-budgets"
 	RestClaimCollateralType    = "collateral_type"
 	RestClaimOwner             = "owner"
 	RestClaimType              = "type"
@@ -68,6 +69,20 @@ func NewQueryHardRewardsParams(page, limit int, owner sdk.AccAddress) QueryHardR
 	}
 }
 
+// QueryRewardBudgetsParams params for query /incentive/reward-budgets
+type QueryRewardBudgetsParams struct {
+	RewardType     string `json:"reward_type" yaml:"reward_type"`
+	CollateralType string `json:"collateral_type" yaml:"collateral_type"`
+}
+
+// NewQueryRewardBudgetsParams returns QueryRewardBudgetsParams
+func NewQueryRewardBudgetsParams(rewardType, collateralType string) QueryRewardBudgetsParams {
+	return QueryRewardBudgetsParams{
+		RewardType:     rewardType,
+		CollateralType: collateralType,
+	}
+}
+
 // PostClaimReq defines the properties of claim transaction's request body.
 type PostClaimReq struct {
 	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
@@ -33,26 +33,28 @@ const (
 	QuerierRoute             = types.QuerierRoute
 	QueryGetParams           = types.QueryGetParams
 	QueryGetAsset            = types.QueryGetAsset
+	ProposalTypeSeizeCoins   = types.ProposalTypeSeizeCoins
 )
 
 var (
 	// functions aliases
-	NewKeeper            = keeper.NewKeeper
-	NewQuerier           = keeper.NewQuerier
-	RegisterCodec        = types.RegisterCodec
-	NewGenesisState      = types.NewGenesisState
-	DefaultGenesisState  = types.DefaultGenesisState
-	NewMsgIssueTokens    = types.NewMsgIssueTokens
-	NewMsgRedeemTokens   = types.NewMsgRedeemTokens
-	NewMsgBlockAddress   = types.NewMsgBlockAddress
-	NewMsgUnblockAddress = types.NewMsgUnblockAddress
-	NewMsgSetPauseStatus = types.NewMsgSetPauseStatus
-	NewParams            = types.NewParams
-	DefaultParams        = types.DefaultParams
-	ParamKeyTable        = types.ParamKeyTable
-	NewAsset             = types.NewAsset
-	NewRateLimit         = types.NewRateLimit
-	NewAssetSupply       = types.NewAssetSupply
+	NewKeeper             = keeper.NewKeeper
+	NewQuerier            = keeper.NewQuerier
+	RegisterCodec         = types.RegisterCodec
+	NewGenesisState       = types.NewGenesisState
+	DefaultGenesisState   = types.DefaultGenesisState
+	NewMsgIssueTokens     = types.NewMsgIssueTokens
+	NewMsgRedeemTokens    = types.NewMsgRedeemTokens
+	NewMsgBlockAddress    = types.NewMsgBlockAddress
+	NewMsgUnblockAddress  = types.NewMsgUnblockAddress
+	NewMsgSetPauseStatus  = types.NewMsgSetPauseStatus
+	NewParams             = types.NewParams
+	DefaultParams         = types.DefaultParams
+	ParamKeyTable         = types.ParamKeyTable
+	NewAsset              = types.NewAsset
+	NewRateLimit          = types.NewRateLimit
+	NewAssetSupply        = types.NewAssetSupply
+	NewSeizeCoinsProposal = types.NewSeizeCoinsProposal
 
 	// variable aliases
 	ModuleCdc                  = types.ModuleCdc
@@ -65,6 +67,7 @@ var (
 	ErrIssueToModuleAccount    = types.ErrIssueToModuleAccount
 	ErrExceedsSupplyLimit      = types.ErrExceedsSupplyLimit
 	ErrAssetUnblockable        = types.ErrAssetUnblockable
+	ErrInvalidPubProposal      = types.ErrInvalidPubProposal
 	AssetSupplyPrefix          = types.AssetSupplyPrefix
 	PreviousBlockTimeKey       = types.PreviousBlockTimeKey
 	KeyAssets                  = types.KeyAssets
@@ -73,18 +76,19 @@ var (
 )
 
 type (
-	Keeper            = keeper.Keeper
-	GenesisState      = types.GenesisState
-	MsgIssueTokens    = types.MsgIssueTokens
-	MsgRedeemTokens   = types.MsgRedeemTokens
-	MsgBlockAddress   = types.MsgBlockAddress
-	MsgUnblockAddress = types.MsgUnblockAddress
-	MsgSetPauseStatus = types.MsgSetPauseStatus
-	Params            = types.Params
-	Asset             = types.Asset
-	Assets            = types.Assets
-	RateLimit         = types.RateLimit
-	QueryAssetParams  = types.QueryAssetParams
-	AssetSupply       = types.AssetSupply
-	AssetSupplies     = types.AssetSupplies
+	Keeper             = keeper.Keeper
+	GenesisState       = types.GenesisState
+	MsgIssueTokens     = types.MsgIssueTokens
+	MsgRedeemTokens    = types.MsgRedeemTokens
+	SeizeCoinsProposal = types.SeizeCoinsProposal
+	MsgBlockAddress    = types.MsgBlockAddress
+	MsgUnblockAddress  = types.MsgUnblockAddress
+	MsgSetPauseStatus  = types.MsgSetPauseStatus
+	Params             = types.Params
+	Asset              = types.Asset
+	Assets             = types.Assets
+	RateLimit          = types.RateLimit
+	QueryAssetParams   = types.QueryAssetParams
+	AssetSupply        = types.AssetSupply
+	AssetSupplies      = types.AssetSupplies
 )
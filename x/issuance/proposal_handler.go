@@ -0,0 +1,30 @@
+package issuance
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kava-labs/kava/x/issuance/keeper"
+	"github.com/kava-labs/kava/x/issuance/types"
+)
+
+// NewProposalHandler creates a handler for issuance proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.SeizeCoinsProposal:
+			return handleSeizeCoinsProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleSeizeCoinsProposal(ctx sdk.Context, k keeper.Keeper, p types.SeizeCoinsProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidPubProposal, err.Error())
+	}
+	return k.SeizeCoinsFromBlockedAddresses(ctx, p.Denom)
+}
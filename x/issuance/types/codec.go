@@ -20,4 +20,5 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUnblockAddress{}, "issuance/MsgUnblockAddress", nil)
 	cdc.RegisterConcrete(MsgSetPauseStatus{}, "issuance/MsgChangePauseStatus", nil)
 	cdc.RegisterConcrete(Asset{}, "issuance/Asset", nil)
+	cdc.RegisterConcrete(SeizeCoinsProposal{}, "issuance/SeizeCoinsProposal", nil)
 }
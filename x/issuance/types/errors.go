@@ -18,4 +18,5 @@ var (
 	ErrExceedsSupplyLimit      = sdkerrors.Register(ModuleName, 9, "asset supply over limit")
 	ErrAssetUnblockable        = sdkerrors.Register(ModuleName, 10, "asset does not support block/unblock functionality")
 	ErrAccountNotFound         = sdkerrors.Register(ModuleName, 11, "cannot block account that does not exist in state")
+	ErrInvalidPubProposal      = sdkerrors.Register(ModuleName, 12, "invalid pubproposal")
 )
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSeizeCoins is the type of proposals that seize coins from blocked addresses
+	ProposalTypeSeizeCoins = "SeizeCoins"
+)
+
+// ensure proposal types fulfill the gov Content interface.
+var _ govtypes.Content = SeizeCoinsProposal{}
+
+// SeizeCoinsProposal is a proposal for seizing an asset from the asset's blocked addresses and sending it to the asset owner.
+type SeizeCoinsProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Denom       string `json:"denom" yaml:"denom"`
+}
+
+// NewSeizeCoinsProposal returns a new SeizeCoinsProposal
+func NewSeizeCoinsProposal(title, description, denom string) SeizeCoinsProposal {
+	return SeizeCoinsProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (scp SeizeCoinsProposal) GetTitle() string { return scp.Title }
+
+// GetDescription returns the description of the proposal.
+func (scp SeizeCoinsProposal) GetDescription() string { return scp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (scp SeizeCoinsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (scp SeizeCoinsProposal) ProposalType() string { return ProposalTypeSeizeCoins }
+
+// ValidateBasic runs basic stateless validity checks
+func (scp SeizeCoinsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(scp); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(scp.Denom); err != nil {
+		return fmt.Errorf("invalid asset denom: %w", err)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (scp SeizeCoinsProposal) String() string {
+	bz, _ := yaml.Marshal(scp)
+	return string(bz)
+}
@@ -10,7 +10,12 @@ import (
 // EndBlocker updates the current pricefeed
 func EndBlocker(ctx sdk.Context, k Keeper) {
 	// Update the current price of each asset.
-	for _, market := range k.GetMarkets(ctx) {
+	// Derived markets are updated after their inputs, and TWAP markets last, after the markets they average have observed this block's price.
+	markets, err := k.GetMarkets(ctx).UpdateOrder()
+	if err != nil {
+		panic(err)
+	}
+	for _, market := range markets {
 		if !market.Active {
 			continue
 		}
@@ -20,4 +25,6 @@ func EndBlocker(ctx sdk.Context, k Keeper) {
 			panic(err)
 		}
 	}
+
+	k.DeleteStalePriceCommits(ctx)
 }
@@ -8,71 +8,188 @@ import (
 )
 
 const (
+	AttributeAmount             = types.AttributeAmount
 	AttributeExpiry             = types.AttributeExpiry
+	AttributeJailedUntil        = types.AttributeJailedUntil
 	AttributeMarketID           = types.AttributeMarketID
 	AttributeMarketPrice        = types.AttributeMarketPrice
 	AttributeOracle             = types.AttributeOracle
+	AttributePrevPrice          = types.AttributePrevPrice
+	AttributeReason             = types.AttributeReason
+	AttributeSlashed            = types.AttributeSlashed
 	AttributeValueCategory      = types.AttributeValueCategory
+	AttributeVotePeriod         = types.AttributeVotePeriod
 	DefaultParamspace           = types.DefaultParamspace
+	EventTypeMarketGuardTripped = types.EventTypeMarketGuardTripped
 	EventTypeMarketPriceUpdated = types.EventTypeMarketPriceUpdated
+	EventTypeMarketRecovered    = types.EventTypeMarketRecovered
 	EventTypeNoValidPrices      = types.EventTypeNoValidPrices
+	EventTypeOracleBonded       = types.EventTypeOracleBonded
+	EventTypeOracleCommitted    = types.EventTypeOracleCommitted
+	EventTypeOraclePenalized    = types.EventTypeOraclePenalized
+	EventTypeOracleUnbonded     = types.EventTypeOracleUnbonded
 	EventTypeOracleUpdatedPrice = types.EventTypeOracleUpdatedPrice
+	GuardTripDeviation          = types.GuardTripDeviation
+	GuardTripMinOracles         = types.GuardTripMinOracles
+	GuardTripStale              = types.GuardTripStale
 	MaxExpiry                   = types.MaxExpiry
+	MaxSaltLength               = types.MaxSaltLength
 	ModuleName                  = types.ModuleName
+	PenaltyMisses               = types.PenaltyMisses
+	PenaltyOutliers             = types.PenaltyOutliers
 	QuerierRoute                = types.QuerierRoute
 	QueryGetParams              = types.QueryGetParams
+	QueryGuardTrips             = types.QueryGuardTrips
+	QueryHistoricalPrices       = types.QueryHistoricalPrices
+	QueryMarketHealth           = types.QueryMarketHealth
 	QueryMarkets                = types.QueryMarkets
+	QueryOracleBond             = types.QueryOracleBond
+	QueryOracleRecords          = types.QueryOracleRecords
 	QueryOracles                = types.QueryOracles
 	QueryPrice                  = types.QueryPrice
+	QueryPriceCommits           = types.QueryPriceCommits
 	QueryRawPrices              = types.QueryRawPrices
 	RouterKey                   = types.RouterKey
 	StoreKey                    = types.StoreKey
+	TypeMsgBondOracle           = types.TypeMsgBondOracle
+	TypeMsgCommitPrice          = types.TypeMsgCommitPrice
 	TypeMsgPostPrice            = types.TypeMsgPostPrice
+	TypeMsgPostPrices           = types.TypeMsgPostPrices
+	TypeMsgRevealPrice          = types.TypeMsgRevealPrice
+	TypeMsgUnbondOracle         = types.TypeMsgUnbondOracle
 )
 
 var (
 	// function aliases
-	NewKeeper                  = keeper.NewKeeper
-	NewQuerier                 = keeper.NewQuerier
-	CurrentPriceKey            = types.CurrentPriceKey
-	DefaultGenesisState        = types.DefaultGenesisState
-	DefaultParams              = types.DefaultParams
-	NewCurrentPrice            = types.NewCurrentPrice
-	NewGenesisState            = types.NewGenesisState
-	NewMarket                  = types.NewMarket
-	NewMsgPostPrice            = types.NewMsgPostPrice
-	NewParams                  = types.NewParams
-	NewPostedPrice             = types.NewPostedPrice
-	NewQueryWithMarketIDParams = types.NewQueryWithMarketIDParams
-	ParamKeyTable              = types.ParamKeyTable
-	RawPriceKey                = types.RawPriceKey
-	RegisterCodec              = types.RegisterCodec
+	NewKeeper                      = keeper.NewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	CurrentPriceKey                = types.CurrentPriceKey
+	DefaultGenesisState            = types.DefaultGenesisState
+	DefaultParams                  = types.DefaultParams
+	DurationSeconds                = types.DurationSeconds
+	GuardTripKey                   = types.GuardTripKey
+	GuardTripMarketKey             = types.GuardTripMarketKey
+	HistoricalPriceKey             = types.HistoricalPriceKey
+	HistoricalPriceMarketKey       = types.HistoricalPriceMarketKey
+	LastPostTimeKey                = types.LastPostTimeKey
+	NewCurrentPrice                = types.NewCurrentPrice
+	NewDerivedInput                = types.NewDerivedInput
+	NewDerivedMarket               = types.NewDerivedMarket
+	NewDerivedSource               = types.NewDerivedSource
+	NewGenesisState                = types.NewGenesisState
+	NewGuardTrip                   = types.NewGuardTrip
+	NewHistoricalPrice             = types.NewHistoricalPrice
+	NewMarket                      = types.NewMarket
+	NewMarketHealth                = types.NewMarketHealth
+	NewMsgBondOracle               = types.NewMsgBondOracle
+	NewMsgCommitPrice              = types.NewMsgCommitPrice
+	NewMsgPostPrice                = types.NewMsgPostPrice
+	NewMsgPostPrices               = types.NewMsgPostPrices
+	NewMsgRevealPrice              = types.NewMsgRevealPrice
+	NewMsgUnbondOracle             = types.NewMsgUnbondOracle
+	NewOracleBond                  = types.NewOracleBond
+	NewOracleBondParams            = types.NewOracleBondParams
+	NewOracleRecord                = types.NewOracleRecord
+	NewOracleWeight                = types.NewOracleWeight
+	NewParams                      = types.NewParams
+	NewPostedPrice                 = types.NewPostedPrice
+	NewPriceCommit                 = types.NewPriceCommit
+	NewPriceGuard                  = types.NewPriceGuard
+	NewPriceObservation            = types.NewPriceObservation
+	NewPriceUpdate                 = types.NewPriceUpdate
+	NewQueryHistoricalPricesParams = types.NewQueryHistoricalPricesParams
+	NewQueryOracleBondParams       = types.NewQueryOracleBondParams
+	NewQueryWithMarketIDParams     = types.NewQueryWithMarketIDParams
+	NewTWAPMarket                  = types.NewTWAPMarket
+	NewTWAPSource                  = types.NewTWAPSource
+	OracleBondKey                  = types.OracleBondKey
+	OracleRecordKey                = types.OracleRecordKey
+	OracleRecordMarketKey          = types.OracleRecordMarketKey
+	ParamKeyTable                  = types.ParamKeyTable
+	PriceCommitHash                = types.PriceCommitHash
+	PriceCommitKey                 = types.PriceCommitKey
+	PriceCommitMarketKey           = types.PriceCommitMarketKey
+	PriceObservationKey            = types.PriceObservationKey
+	PriceObservationMarketKey      = types.PriceObservationMarketKey
+	RawPriceKey                    = types.RawPriceKey
+	RegisterCodec                  = types.RegisterCodec
 
 	// variable aliases
-	CurrentPricePrefix = types.CurrentPricePrefix
-	DefaultMarkets     = types.DefaultMarkets
-	ErrAssetNotFound   = types.ErrAssetNotFound
-	ErrEmptyInput      = types.ErrEmptyInput
-	ErrExpired         = types.ErrExpired
-	ErrInvalidMarket   = types.ErrInvalidMarket
-	ErrInvalidOracle   = types.ErrInvalidOracle
-	ErrNoValidPrice    = types.ErrNoValidPrice
-	KeyMarkets         = types.KeyMarkets
-	ModuleCdc          = types.ModuleCdc
-	RawPriceFeedPrefix = types.RawPriceFeedPrefix
+	CurrentPricePrefix      = types.CurrentPricePrefix
+	DefaultMarkets          = types.DefaultMarkets
+	DefaultOracleBond       = types.DefaultOracleBond
+	ErrAssetNotFound        = types.ErrAssetNotFound
+	ErrBondNotFound         = types.ErrBondNotFound
+	ErrCommitNotFound       = types.ErrCommitNotFound
+	ErrCommitRevealRequired = types.ErrCommitRevealRequired
+	ErrEmptyInput           = types.ErrEmptyInput
+	ErrExpired              = types.ErrExpired
+	ErrInsufficientBond     = types.ErrInsufficientBond
+	ErrInvalidMarket        = types.ErrInvalidMarket
+	ErrInvalidOracle        = types.ErrInvalidOracle
+	ErrInvalidRevealPeriod  = types.ErrInvalidRevealPeriod
+	ErrNoValidPrice         = types.ErrNoValidPrice
+	ErrNotCommitReveal      = types.ErrNotCommitReveal
+	ErrOracleJailed         = types.ErrOracleJailed
+	ErrRevealMismatch       = types.ErrRevealMismatch
+	GuardTripPrefix         = types.GuardTripPrefix
+	HistoricalPricePrefix   = types.HistoricalPricePrefix
+	KeyMarkets              = types.KeyMarkets
+	KeyOracleBond           = types.KeyOracleBond
+	LastPostTimePrefix      = types.LastPostTimePrefix
+	ModuleCdc               = types.ModuleCdc
+	OracleBondPrefix        = types.OracleBondPrefix
+	OracleRecordPrefix      = types.OracleRecordPrefix
+	PriceCommitPrefix       = types.PriceCommitPrefix
+	PriceObservationPrefix  = types.PriceObservationPrefix
+	RawPriceFeedPrefix      = types.RawPriceFeedPrefix
 )
 
 type (
-	Keeper                  = keeper.Keeper
-	CurrentPrice            = types.CurrentPrice
-	CurrentPrices           = types.CurrentPrices
-	GenesisState            = types.GenesisState
-	Market                  = types.Market
-	Markets                 = types.Markets
-	MsgPostPrice            = types.MsgPostPrice
-	Params                  = types.Params
-	PostedPrice             = types.PostedPrice
-	PostedPrices            = types.PostedPrices
-	QueryWithMarketIDParams = types.QueryWithMarketIDParams
-	SortDecs                = types.SortDecs
+	Keeper                      = keeper.Keeper
+	CurrentPrice                = types.CurrentPrice
+	CurrentPrices               = types.CurrentPrices
+	DerivedInput                = types.DerivedInput
+	DerivedInputs               = types.DerivedInputs
+	DerivedSource               = types.DerivedSource
+	GenesisState                = types.GenesisState
+	GuardTrip                   = types.GuardTrip
+	GuardTrips                  = types.GuardTrips
+	HistoricalPrice             = types.HistoricalPrice
+	HistoricalPrices            = types.HistoricalPrices
+	Market                      = types.Market
+	MarketHealth                = types.MarketHealth
+	MarketHealths               = types.MarketHealths
+	Markets                     = types.Markets
+	MsgBondOracle               = types.MsgBondOracle
+	MsgCommitPrice              = types.MsgCommitPrice
+	MsgPostPrice                = types.MsgPostPrice
+	MsgPostPrices               = types.MsgPostPrices
+	MsgRevealPrice              = types.MsgRevealPrice
+	MsgUnbondOracle             = types.MsgUnbondOracle
+	OracleBond                  = types.OracleBond
+	OracleBondParams            = types.OracleBondParams
+	OracleBonds                 = types.OracleBonds
+	OracleRecord                = types.OracleRecord
+	OracleRecords               = types.OracleRecords
+	OracleWeight                = types.OracleWeight
+	OracleWeights               = types.OracleWeights
+	Params                      = types.Params
+	PostedPrice                 = types.PostedPrice
+	PostedPrices                = types.PostedPrices
+	PriceCommit                 = types.PriceCommit
+	PriceCommits                = types.PriceCommits
+	PriceGuard                  = types.PriceGuard
+	PriceObservation            = types.PriceObservation
+	PriceObservations           = types.PriceObservations
+	PriceUpdate                 = types.PriceUpdate
+	PriceUpdates                = types.PriceUpdates
+	QueryHistoricalPricesParams = types.QueryHistoricalPricesParams
+	QueryOracleBondParams       = types.QueryOracleBondParams
+	QueryWithMarketIDParams     = types.QueryWithMarketIDParams
+	SortDecs                    = types.SortDecs
+	SupplyKeeper                = types.SupplyKeeper
+	TWAPSource                  = types.TWAPSource
+	WeightedPrice               = types.WeightedPrice
+	WeightedPrices              = types.WeightedPrices
 )
@@ -2,8 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -14,6 +17,14 @@ import (
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
 
+// Query historical price flags
+const (
+	flagMinHeight = "min-height"
+	flagMaxHeight = "max-height"
+	flagStartTime = "start-time"
+	flagEndTime   = "end-time"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	// Group nameservice queries under a subcommand
@@ -32,6 +43,12 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdOracles(queryRoute, cdc),
 		GetCmdMarkets(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdMarketHealth(queryRoute, cdc),
+		GetCmdGuardTrips(queryRoute, cdc),
+		GetCmdOracleRecords(queryRoute, cdc),
+		GetCmdOracleBond(queryRoute, cdc),
+		GetCmdPriceCommits(queryRoute, cdc),
+		GetCmdHistoricalPrices(queryRoute, cdc),
 	)...)
 
 	return pricefeedQueryCmd
@@ -193,3 +210,217 @@ func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdMarketHealth queries the health of each market
+func GetCmdMarketHealth(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "health",
+		Short: "get the health of each market",
+		Long:  "Get the health of each market in the pricefeed module. A market is unhealthy from when its price guard trips until a price update passes all the guard's limits.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryMarketHealth)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.MarketHealths
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGuardTrips queries the price guard trips of a market, or of all markets
+func GetCmdGuardTrips(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "guard-trips [marketID]",
+		Short: "get the price guard trips of a market, or of all markets if no market is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			var marketID string
+			if len(args) > 0 {
+				marketID = args[0]
+			}
+
+			bz, err := cdc.MarshalJSON(types.QueryWithMarketIDParams{
+				MarketID: marketID,
+			})
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGuardTrips)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var trips types.GuardTrips
+			cdc.MustUnmarshalJSON(res, &trips)
+			return cliCtx.PrintOutput(trips)
+		},
+	}
+}
+
+// GetCmdOracleRecords queries the records of oracles' posts to a market, or to all markets
+func GetCmdOracleRecords(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-records [marketID]",
+		Short: "get the records of oracles' posts to a market, or to all markets if no market is given",
+		Long:  "Get each oracle's uptime, deviation from the median price, outliers and penalties in a market.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			var marketID string
+			if len(args) > 0 {
+				marketID = args[0]
+			}
+
+			bz, err := cdc.MarshalJSON(types.QueryWithMarketIDParams{
+				MarketID: marketID,
+			})
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOracleRecords)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var records types.OracleRecords
+			cdc.MustUnmarshalJSON(res, &records)
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
+
+// GetCmdOracleBond queries the bond of an oracle
+func GetCmdOracleBond(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-bond [oracle-addr]",
+		Short: "get the bond of an oracle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			oracle, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryOracleBondParams(oracle))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOracleBond)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var bond types.OracleBond
+			cdc.MustUnmarshalJSON(res, &bond)
+			return cliCtx.PrintOutput(bond)
+		},
+	}
+}
+
+// GetCmdPriceCommits queries the unrevealed price commits to a market, or to all markets
+func GetCmdPriceCommits(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "price-commits [marketID]",
+		Short: "get the unrevealed price commits to a market, or to all markets if no market is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			var marketID string
+			if len(args) > 0 {
+				marketID = args[0]
+			}
+
+			bz, err := cdc.MarshalJSON(types.QueryWithMarketIDParams{
+				MarketID: marketID,
+			})
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceCommits)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var commits types.PriceCommits
+			cdc.MustUnmarshalJSON(res, &commits)
+			return cliCtx.PrintOutput(commits)
+		},
+	}
+}
+
+// GetCmdHistoricalPrices queries the historical current prices of a market
+func GetCmdHistoricalPrices(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-prices [marketID]",
+		Short: "get the historical current prices of a market, with optional height and time ranges",
+		Long: strings.TrimSpace(`Get the historical current prices kept for a market, oldest first, set within optional height and time ranges.
+The ranges are inclusive, and times are in RFC3339 format:
+Example:
+$ kvcli q pricefeed historical-prices bnb:usd --min-height=1000 --max-height=2000
+$ kvcli q pricefeed historical-prices bnb:usd --start-time=2021-01-01T00:00:00Z --end-time=2021-02-01T00:00:00Z
+`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			strStartTime := viper.GetString(flagStartTime)
+			strEndTime := viper.GetString(flagEndTime)
+
+			var (
+				startTime, endTime time.Time
+				err                error
+			)
+
+			if len(strStartTime) != 0 {
+				startTime, err = time.Parse(time.RFC3339, strings.TrimSpace(strStartTime))
+				if err != nil {
+					return fmt.Errorf("cannot parse start time %s: %w", strStartTime, err)
+				}
+			}
+			if len(strEndTime) != 0 {
+				endTime, err = time.Parse(time.RFC3339, strings.TrimSpace(strEndTime))
+				if err != nil {
+					return fmt.Errorf("cannot parse end time %s: %w", strEndTime, err)
+				}
+			}
+
+			params := types.NewQueryHistoricalPricesParams(args[0], viper.GetInt64(flagMinHeight), viper.GetInt64(flagMaxHeight), startTime, endTime)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHistoricalPrices)
+
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var prices types.HistoricalPrices
+			cdc.MustUnmarshalJSON(res, &prices)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(prices)
+		},
+	}
+
+	cmd.Flags().Int64(flagMinHeight, 0, "(optional) earliest height of prices to query for")
+	cmd.Flags().Int64(flagMaxHeight, 0, "(optional) latest height of prices to query for")
+	cmd.Flags().String(flagStartTime, "", "(optional) earliest time of prices to query for")
+	cmd.Flags().String(flagEndTime, "", "(optional) latest time of prices to query for")
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -34,6 +35,11 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	pricefeedTxCmd.AddCommand(flags.PostCommands(
 		GetCmdPostPrice(cdc),
+		GetCmdPostPrices(cdc),
+		GetCmdCommitPrice(cdc),
+		GetCmdRevealPrice(cdc),
+		GetCmdBondOracle(cdc),
+		GetCmdUnbondOracle(cdc),
 	)...)
 
 	return pricefeedTxCmd
@@ -77,3 +83,181 @@ func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdPostPrices cli command for posting prices for many markets in one message.
+func GetCmdPostPrices(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "postprices [marketID,price,expiry]...",
+		Short: "post the latest prices for several markets, each with a given expiry as a UNIX time",
+		Long:  "Post the latest prices for several markets in one message. If any of the prices cannot be posted, none of them are.",
+		Example: fmt.Sprintf("%s tx %s postprices bnb:usd,25,9999999999 btc:usd,20000,9999999999 --from validator",
+			version.ClientName, types.ModuleName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var prices types.PriceUpdates
+			for _, arg := range args {
+				fields := strings.Split(arg, ",")
+				if len(fields) != 3 {
+					return fmt.Errorf("invalid price %s, expected marketID,price,expiry", arg)
+				}
+
+				price, err := sdk.NewDecFromStr(fields[1])
+				if err != nil {
+					return err
+				}
+
+				expiryInt, err := strconv.ParseInt(fields[2], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid expiry %s: %w", fields[2], err)
+				}
+
+				if expiryInt > types.MaxExpiry {
+					return fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+				}
+
+				expiry := tmtime.Canonical(time.Unix(expiryInt, 0))
+				prices = append(prices, types.NewPriceUpdate(fields[0], price, expiry))
+			}
+
+			msg := types.NewMsgPostPrices(cliCtx.GetFromAddress(), prices)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCommitPrice cli command for committing to a price in a commit-reveal market.
+func GetCmdCommitPrice(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commitprice [marketID] [price] [salt]",
+		Short: "commit to a price for a commit-reveal market, to be revealed with the same salt in the next vote period",
+		Long:  "Commit to a price for a market that requires prices to be committed and revealed. Only the hash of the price and salt is posted. Keep the salt secret until the price is revealed in the next vote period.",
+		Example: fmt.Sprintf("%s tx %s commitprice bnb:usd 25 mysecretsalt --from validator",
+			version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			price, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			if len(args[2]) > types.MaxSaltLength {
+				return fmt.Errorf("salt too long; got %d, max: %d", len(args[2]), types.MaxSaltLength)
+			}
+
+			hash := types.PriceCommitHash(args[2], price, args[0], cliCtx.GetFromAddress())
+			msg := types.NewMsgCommitPrice(cliCtx.GetFromAddress(), args[0], hash)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevealPrice cli command for revealing a committed price in a commit-reveal market.
+func GetCmdRevealPrice(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revealprice [marketID] [price] [salt] [expiry]",
+		Short: "reveal a price committed in the previous vote period, with a given expiry as a UNIX time",
+		Example: fmt.Sprintf("%s tx %s revealprice bnb:usd 25 mysecretsalt 9999999999 --from validator",
+			version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			price, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			expiryInt, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid expiry %s: %w", args[3], err)
+			}
+
+			if expiryInt > types.MaxExpiry {
+				return fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+			}
+
+			expiry := tmtime.Canonical(time.Unix(expiryInt, 0))
+
+			msg := types.NewMsgRevealPrice(cliCtx.GetFromAddress(), args[0], price, args[2], expiry)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdBondOracle cli command for bonding coins as an oracle.
+func GetCmdBondOracle(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bond [amount]",
+		Short: "lock coins as an oracle's bond, which is slashed if the oracle repeatedly posts outliers or misses posts",
+		Example: fmt.Sprintf("%s tx %s bond 1000000000ukava --from oracle",
+			version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBondOracle(cliCtx.GetFromAddress(), amount)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdUnbondOracle cli command for returning coins from an oracle's bond.
+func GetCmdUnbondOracle(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unbond [amount]",
+		Short: "return coins from an oracle's bond, which is not possible while the oracle is jailed",
+		Example: fmt.Sprintf("%s tx %s unbond 1000000000ukava --from oracle",
+			version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnbondOracle(cliCtx.GetFromAddress(), amount)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
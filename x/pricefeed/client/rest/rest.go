@@ -4,11 +4,18 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 const (
-	RestMarketID = "market_id"
+	RestMarketID  = "market_id"
+	RestOracle    = "oracle"
+	RestMinHeight = "min_height"
+	RestMaxHeight = "max_height"
+	RestStartTime = "start_time"
+	RestEndTime   = "end_time"
 )
 
 // PostPriceReq defines the properties of a PostPrice request's body.
@@ -19,6 +26,42 @@ type PostPriceReq struct {
 	Expiry   string       `json:"expiry"`
 }
 
+// PostPricesReq defines the properties of a PostPrices request's body.
+type PostPricesReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Prices  []PriceReq   `json:"prices"`
+}
+
+// PriceReq defines the properties of one of the prices in a PostPrices request's body.
+type PriceReq struct {
+	MarketID string `json:"market_id"`
+	Price    string `json:"price"`
+	Expiry   string `json:"expiry"`
+}
+
+// CommitPriceReq defines the properties of a CommitPrice request's body.
+type CommitPriceReq struct {
+	BaseReq  rest.BaseReq     `json:"base_req"`
+	MarketID string           `json:"market_id"`
+	Hash     tmbytes.HexBytes `json:"hash"`
+}
+
+// RevealPriceReq defines the properties of a RevealPrice request's body.
+type RevealPriceReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	MarketID string       `json:"market_id"`
+	Price    string       `json:"price"`
+	Salt     string       `json:"salt"`
+	Expiry   string       `json:"expiry"`
+}
+
+// BondOracleReq defines the properties of a bond or unbond request's body.
+type BondOracleReq struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Oracle  sdk.AccAddress `json:"oracle"`
+	Amount  sdk.Coins      `json:"amount"`
+}
+
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
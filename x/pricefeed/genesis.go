@@ -1,7 +1,12 @@
 package pricefeed
 
 import (
+	"errors"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // InitGenesis sets distribution information for genesis.
@@ -18,11 +23,37 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 			}
 		}
 	}
-	params := keeper.GetParams(ctx)
+	for _, po := range gs.Observations {
+		keeper.SetPriceObservation(ctx, po)
+	}
+	for _, trip := range gs.GuardTrips {
+		keeper.SetGuardTrip(ctx, trip)
+	}
+	for _, record := range gs.OracleRecords {
+		keeper.SetOracleRecord(ctx, record)
+	}
+	for _, bond := range gs.OracleBonds {
+		keeper.SetOracleBond(ctx, bond)
+	}
+	for _, commit := range gs.PriceCommits {
+		keeper.SetPriceCommit(ctx, commit)
+	}
+	for _, price := range gs.PriceHistory {
+		keeper.SetHistoricalPrice(ctx, price)
+	}
+	bonded := keeper.GetModuleAccount(ctx).GetCoins()
+	if total := gs.OracleBonds.Total(); !(bonded.IsAllGTE(total) && total.IsAllGTE(bonded)) {
+		panic(fmt.Sprintf("%s module account coins %s do not match oracle bonds %s", ModuleName, bonded, total))
+	}
+
+	markets, err := keeper.GetMarkets(ctx).UpdateOrder()
+	if err != nil {
+		panic(err)
+	}
 
 	// Set the current price (if any) based on what's now in the store
-	for _, market := range params.Markets {
-		if !market.Active {
+	for _, market := range markets {
+		if !market.Active || market.IsTWAP() || market.IsDerived() {
 			continue
 		}
 		rps, err := keeper.GetRawPrices(ctx, market.MarketID)
@@ -37,6 +68,17 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 			panic(err)
 		}
 	}
+
+	// Derived and TWAP markets are set after the markets they are priced from
+	for _, market := range markets {
+		if !market.Active || !(market.IsTWAP() || market.IsDerived()) {
+			continue
+		}
+		err := keeper.SetCurrentPrices(ctx, market.MarketID)
+		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -54,5 +96,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return NewGenesisState(params, postedPrices)
+	return NewGenesisState(params, postedPrices, keeper.GetPriceObservations(ctx), keeper.GetGuardTrips(ctx),
+		keeper.GetOracleRecords(ctx), keeper.GetOracleBonds(ctx), keeper.GetPriceCommits(ctx),
+		keeper.GetHistoricalPrices(ctx))
 }
@@ -2,9 +2,12 @@ package pricefeed_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/pricefeed"
 
@@ -35,6 +38,39 @@ func (suite *GenesisTestSuite) TestValidGenState() {
 	})
 }
 
+func (suite *GenesisTestSuite) TestDerivedMarketPricedAtGenesis() {
+	tApp := app.NewTestApp()
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	genTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiry := genTime.Add(time.Hour)
+	gs := pricefeed.NewGenesisState(
+		pricefeed.NewParams(pricefeed.Markets{
+			pricefeed.NewDerivedMarket("xrp:btc", "xrp", "btc", pricefeed.DerivedInputs{
+				pricefeed.NewDerivedInput("xrp:usd", false),
+				pricefeed.NewDerivedInput("btc:usd", true),
+			}, true),
+			pricefeed.NewMarket("btc:usd", "btc", "usd", addrs, true),
+			pricefeed.NewMarket("xrp:usd", "xrp", "usd", addrs, true),
+		}, pricefeed.DefaultOracleBond),
+		pricefeed.PostedPrices{
+			pricefeed.NewPostedPrice("btc:usd", addrs[0], sdk.MustNewDecFromStr("8000"), expiry),
+			pricefeed.NewPostedPrice("xrp:usd", addrs[0], sdk.MustNewDecFromStr("0.4"), expiry),
+		},
+		pricefeed.PriceObservations{},
+		pricefeed.GuardTrips{},
+		pricefeed.OracleRecords{},
+		pricefeed.OracleBonds{},
+		pricefeed.PriceCommits{},
+		pricefeed.HistoricalPrices{},
+	)
+	tApp.InitializeFromGenesisStatesWithTime(genTime, app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(gs)})
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: genTime})
+
+	price, err := tApp.GetPriceFeedKeeper().GetCurrentPrice(ctx, "xrp:btc")
+	suite.NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("0.00005"), price.Price)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
@@ -12,6 +12,16 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgPostPrice:
 			return HandleMsgPostPrice(ctx, k, msg)
+		case MsgPostPrices:
+			return handleMsgPostPrices(ctx, k, msg)
+		case MsgCommitPrice:
+			return handleMsgCommitPrice(ctx, k, msg)
+		case MsgRevealPrice:
+			return handleMsgRevealPrice(ctx, k, msg)
+		case MsgBondOracle:
+			return handleMsgBondOracle(ctx, k, msg)
+		case MsgUnbondOracle:
+			return handleMsgUnbondOracle(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -31,6 +41,14 @@ func HandleMsgPostPrice(
 	if err != nil {
 		return nil, err
 	}
+	err = k.ValidateOraclePost(ctx, msg.MarketID, msg.From)
+	if err != nil {
+		return nil, err
+	}
+	err = validateDirectPost(ctx, k, msg.MarketID)
+	if err != nil {
+		return nil, err
+	}
 	_, err = k.SetPrice(ctx, msg.From, msg.MarketID, msg.Price, msg.Expiry)
 	if err != nil {
 		return nil, err
@@ -46,3 +64,132 @@ func HandleMsgPostPrice(
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// handleMsgPostPrices handles many prices posted by an oracle at once. No prices are posted unless the oracle can post all of them.
+func handleMsgPostPrices(ctx sdk.Context, k Keeper, msg MsgPostPrices) (*sdk.Result, error) {
+	for _, pu := range msg.Prices {
+		_, err := k.GetOracle(ctx, pu.MarketID, msg.From)
+		if err != nil {
+			return nil, err
+		}
+		err = k.ValidateOraclePost(ctx, pu.MarketID, msg.From)
+		if err != nil {
+			return nil, err
+		}
+		err = validateDirectPost(ctx, k, pu.MarketID)
+		if err != nil {
+			return nil, err
+		}
+		if !pu.Expiry.After(ctx.BlockTime()) {
+			return nil, sdkerrors.Wrapf(ErrExpired, "price for market %s", pu.MarketID)
+		}
+	}
+	for _, pu := range msg.Prices {
+		_, err := k.SetPrice(ctx, msg.From, pu.MarketID, pu.Price, pu.Expiry)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// validateDirectPost returns an error if oracles must commit and reveal prices for a market rather than posting them directly
+func validateDirectPost(ctx sdk.Context, k Keeper, marketID string) error {
+	if market, found := k.GetMarket(ctx, marketID); found && market.IsCommitReveal() {
+		return sdkerrors.Wrap(ErrCommitRevealRequired, marketID)
+	}
+	return nil
+}
+
+func handleMsgCommitPrice(ctx sdk.Context, k Keeper, msg MsgCommitPrice) (*sdk.Result, error) {
+	_, err := k.GetOracle(ctx, msg.MarketID, msg.From)
+	if err != nil {
+		return nil, err
+	}
+	err = k.ValidateOraclePost(ctx, msg.MarketID, msg.From)
+	if err != nil {
+		return nil, err
+	}
+	err = k.CommitPrice(ctx, msg.From, msg.MarketID, msg.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevealPrice(ctx sdk.Context, k Keeper, msg MsgRevealPrice) (*sdk.Result, error) {
+	_, err := k.GetOracle(ctx, msg.MarketID, msg.From)
+	if err != nil {
+		return nil, err
+	}
+	err = k.ValidateOraclePost(ctx, msg.MarketID, msg.From)
+	if err != nil {
+		return nil, err
+	}
+	err = k.RevealPrice(ctx, msg.From, msg.MarketID, msg.Price, msg.Salt, msg.Expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgBondOracle(ctx sdk.Context, k Keeper, msg MsgBondOracle) (*sdk.Result, error) {
+	err := k.BondOracle(ctx, msg.Oracle, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Oracle.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUnbondOracle(ctx sdk.Context, k Keeper, msg MsgUnbondOracle) (*sdk.Result, error) {
+	err := k.UnbondOracle(ctx, msg.Oracle, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Oracle.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
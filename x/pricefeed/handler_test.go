@@ -0,0 +1,95 @@
+package pricefeed_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func TestHandleMsgPostPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	oracle, other := addrs[0], addrs[1]
+	tApp := app.NewTestApp()
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: now})
+	k := tApp.GetPriceFeedKeeper()
+	handler := pricefeed.NewHandler(k)
+
+	k.SetParams(ctx, pricefeed.NewParams(pricefeed.Markets{
+		pricefeed.NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{oracle}, true),
+		pricefeed.NewMarket("xrp:usd", "xrp", "usd", []sdk.AccAddress{oracle}, true),
+		pricefeed.NewMarket("bnb:usd", "bnb", "usd", []sdk.AccAddress{other}, true),
+	}, pricefeed.DefaultOracleBond))
+	expiry := now.Add(time.Hour)
+
+	// A price for a market the oracle is not authorized for rejects the whole message
+	_, err := handler(ctx, pricefeed.NewMsgPostPrices(oracle, pricefeed.PriceUpdates{
+		pricefeed.NewPriceUpdate("btc:usd", sdk.MustNewDecFromStr("20000"), expiry),
+		pricefeed.NewPriceUpdate("bnb:usd", sdk.MustNewDecFromStr("40"), expiry),
+	}))
+	require.True(t, errors.Is(err, pricefeed.ErrInvalidOracle))
+
+	// So does an expired price
+	_, err = handler(ctx, pricefeed.NewMsgPostPrices(oracle, pricefeed.PriceUpdates{
+		pricefeed.NewPriceUpdate("btc:usd", sdk.MustNewDecFromStr("20000"), expiry),
+		pricefeed.NewPriceUpdate("xrp:usd", sdk.MustNewDecFromStr("0.25"), now),
+	}))
+	require.True(t, errors.Is(err, pricefeed.ErrExpired))
+	prices, err := k.GetRawPrices(ctx, "btc:usd")
+	require.NoError(t, err)
+	require.Empty(t, prices)
+
+	// Otherwise each price is posted
+	_, err = handler(ctx, pricefeed.NewMsgPostPrices(oracle, pricefeed.PriceUpdates{
+		pricefeed.NewPriceUpdate("btc:usd", sdk.MustNewDecFromStr("20000"), expiry),
+		pricefeed.NewPriceUpdate("xrp:usd", sdk.MustNewDecFromStr("0.25"), expiry),
+	}))
+	require.NoError(t, err)
+	prices, err = k.GetRawPrices(ctx, "btc:usd")
+	require.NoError(t, err)
+	require.Equal(t, pricefeed.PostedPrices{pricefeed.NewPostedPrice("btc:usd", oracle, sdk.MustNewDecFromStr("20000"), expiry)}, prices)
+	prices, err = k.GetRawPrices(ctx, "xrp:usd")
+	require.NoError(t, err)
+	require.Equal(t, pricefeed.PostedPrices{pricefeed.NewPostedPrice("xrp:usd", oracle, sdk.MustNewDecFromStr("0.25"), expiry)}, prices)
+}
+
+func TestHandleCommitRevealPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	oracle := addrs[0]
+	tApp := app.NewTestApp()
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 10, Time: now})
+	k := tApp.GetPriceFeedKeeper()
+	handler := pricefeed.NewHandler(k)
+
+	market := pricefeed.NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{oracle}, true)
+	market.VotePeriod = 10
+	k.SetParams(ctx, pricefeed.NewParams(pricefeed.Markets{market}, pricefeed.DefaultOracleBond))
+	price := sdk.MustNewDecFromStr("20000")
+	expiry := now.Add(time.Hour)
+
+	// Prices cannot be posted directly to commit-reveal markets
+	_, err := handler(ctx, pricefeed.NewMsgPostPrice(oracle, "btc:usd", price, expiry))
+	require.True(t, errors.Is(err, pricefeed.ErrCommitRevealRequired))
+	_, err = handler(ctx, pricefeed.NewMsgPostPrices(oracle, pricefeed.PriceUpdates{pricefeed.NewPriceUpdate("btc:usd", price, expiry)}))
+	require.True(t, errors.Is(err, pricefeed.ErrCommitRevealRequired))
+
+	_, err = handler(ctx, pricefeed.NewMsgCommitPrice(oracle, "btc:usd", pricefeed.PriceCommitHash("salt", price, "btc:usd", oracle)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(20)
+	_, err = handler(ctx, pricefeed.NewMsgRevealPrice(oracle, "btc:usd", price, "salt", expiry))
+	require.NoError(t, err)
+	prices, err := k.GetRawPrices(ctx, "btc:usd")
+	require.NoError(t, err)
+	require.Equal(t, pricefeed.PostedPrices{pricefeed.NewPostedPrice("btc:usd", oracle, price, expiry)}, prices)
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// CommitPrice stores an oracle's commitment to a price for a commit-reveal market, replacing any commit the oracle made earlier in the same vote period.
+// Commits are stored by vote period, so committing in a vote period does not replace the commit from the previous one, which can still be revealed.
+func (k Keeper) CommitPrice(ctx sdk.Context, oracle sdk.AccAddress, marketID string, hash []byte) error {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if !market.IsCommitReveal() {
+		return sdkerrors.Wrap(types.ErrNotCommitReveal, marketID)
+	}
+
+	votePeriod := market.VotePeriodAt(ctx.BlockHeight())
+	k.SetPriceCommit(ctx, types.NewPriceCommit(marketID, oracle, hash, votePeriod))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleCommitted,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeVotePeriod, fmt.Sprintf("%d", votePeriod)),
+		),
+	)
+	return nil
+}
+
+// RevealPrice posts the price an oracle committed to in the previous vote period, if the price and salt match the commit
+func (k Keeper) RevealPrice(ctx sdk.Context, oracle sdk.AccAddress, marketID string, price sdk.Dec, salt string, expiry time.Time) error {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	if !market.IsCommitReveal() {
+		return sdkerrors.Wrap(types.ErrNotCommitReveal, marketID)
+	}
+	votePeriod := market.VotePeriodAt(ctx.BlockHeight())
+	commit, found := k.GetPriceCommit(ctx, marketID, oracle, votePeriod-1)
+	if !found {
+		if _, found := k.GetPriceCommit(ctx, marketID, oracle, votePeriod); found {
+			return sdkerrors.Wrapf(types.ErrInvalidRevealPeriod, "committed in the current vote period %d", votePeriod)
+		}
+		return sdkerrors.Wrapf(types.ErrCommitNotFound, "oracle %s in market %s", oracle, marketID)
+	}
+	if !commit.Matches(salt, price) {
+		return sdkerrors.Wrapf(types.ErrRevealMismatch, "oracle %s in market %s", oracle, marketID)
+	}
+
+	if _, err := k.SetPrice(ctx, oracle, marketID, price, expiry); err != nil {
+		return err
+	}
+	k.DeletePriceCommit(ctx, marketID, oracle, commit.VotePeriod)
+	return nil
+}
+
+// DeleteStalePriceCommits deletes commits that can no longer be revealed, because the vote period after the one they were committed in has ended.
+// Commits for markets that no longer use commit-reveal are deleted too.
+func (k Keeper) DeleteStalePriceCommits(ctx sdk.Context) {
+	markets := k.GetMarkets(ctx)
+	var stale types.PriceCommits
+	k.IteratePriceCommits(ctx, func(commit types.PriceCommit) (stop bool) {
+		market, found := markets.Get(commit.MarketID)
+		if !found || !market.IsCommitReveal() || market.VotePeriodAt(ctx.BlockHeight()) > commit.VotePeriod+1 {
+			stale = append(stale, commit)
+		}
+		return false
+	})
+	for _, commit := range stale {
+		k.DeletePriceCommit(ctx, commit.MarketID, commit.Oracle, commit.VotePeriod)
+	}
+}
+
+// GetPriceCommit returns the price an oracle has committed to for a market in a vote period
+func (k Keeper) GetPriceCommit(ctx sdk.Context, marketID string, oracle sdk.AccAddress, votePeriod int64) (types.PriceCommit, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PriceCommitKey(marketID, oracle, votePeriod))
+	if bz == nil {
+		return types.PriceCommit{}, false
+	}
+	var commit types.PriceCommit
+	k.cdc.MustUnmarshalBinaryBare(bz, &commit)
+	return commit, true
+}
+
+// SetPriceCommit stores the price an oracle has committed to for a market
+func (k Keeper) SetPriceCommit(ctx sdk.Context, commit types.PriceCommit) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceCommitKey(commit.MarketID, commit.Oracle, commit.VotePeriod), k.cdc.MustMarshalBinaryBare(commit))
+}
+
+// DeletePriceCommit deletes the price an oracle has committed to for a market in a vote period
+func (k Keeper) DeletePriceCommit(ctx sdk.Context, marketID string, oracle sdk.AccAddress, votePeriod int64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.PriceCommitKey(marketID, oracle, votePeriod))
+}
+
+// IteratePriceCommits iterates over all price commits in the store and performs a callback function
+func (k Keeper) IteratePriceCommits(ctx sdk.Context, cb func(commit types.PriceCommit) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceCommitPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var commit types.PriceCommit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &commit)
+		if cb(commit) {
+			break
+		}
+	}
+}
+
+// GetPriceCommits returns all price commits from the store
+func (k Keeper) GetPriceCommits(ctx sdk.Context) types.PriceCommits {
+	commits := types.PriceCommits{}
+	k.IteratePriceCommits(ctx, func(commit types.PriceCommit) (stop bool) {
+		commits = append(commits, commit)
+		return false
+	})
+	return commits
+}
+
+// GetMarketPriceCommits returns the price commits for a market
+func (k Keeper) GetMarketPriceCommits(ctx sdk.Context, marketID string) types.PriceCommits {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceCommitMarketKey(marketID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	commits := types.PriceCommits{}
+	for ; iterator.Valid(); iterator.Next() {
+		var commit types.PriceCommit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &commit)
+		commits = append(commits, commit)
+	}
+	return commits
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_CommitReveal tests only prices revealed in the vote period after they were committed, and matching the commit, are posted
+func TestKeeper_CommitReveal(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiry := startTime.Add(time.Hour)
+	ctx := tApp.NewContext(true, abci.Header{Height: 10, Time: startTime})
+	k := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("bnb:usd", "bnb", "usd", addrs, true)
+	market.VotePeriod = 5
+	k.SetParams(ctx, types.NewParams(types.Markets{
+		market,
+		types.NewMarket("btc:usd", "btc", "usd", addrs, true),
+	}, types.DefaultOracleBond))
+	commit := func(ctx sdk.Context, oracle sdk.AccAddress, price, salt string) error {
+		hash := types.PriceCommitHash(salt, sdk.MustNewDecFromStr(price), "bnb:usd", oracle)
+		return k.CommitPrice(ctx, oracle, "bnb:usd", hash)
+	}
+	reveal := func(ctx sdk.Context, oracle sdk.AccAddress, price, salt string) error {
+		return k.RevealPrice(ctx, oracle, "bnb:usd", sdk.MustNewDecFromStr(price), salt, expiry)
+	}
+
+	// Markets without a vote period cannot be committed to
+	err := k.CommitPrice(ctx, addrs[0], "btc:usd", types.PriceCommitHash("salt", sdk.OneDec(), "btc:usd", addrs[0]))
+	require.True(t, errors.Is(err, types.ErrNotCommitReveal))
+
+	// Prices cannot be revealed without a commit
+	err = reveal(ctx, addrs[0], "20", "salt0")
+	require.True(t, errors.Is(err, types.ErrCommitNotFound))
+
+	// Commit in vote period 2
+	require.NoError(t, commit(ctx, addrs[0], "20", "salt0"))
+	require.NoError(t, commit(ctx, addrs[1], "22", "salt1"))
+	require.NoError(t, commit(ctx, addrs[2], "21", "salt2"))
+	require.Len(t, k.GetMarketPriceCommits(ctx, "bnb:usd"), 3)
+	storedCommit, found := k.GetPriceCommit(ctx, "bnb:usd", addrs[0], 2)
+	require.True(t, found)
+	require.Equal(t, int64(2), storedCommit.VotePeriod)
+
+	// Prices cannot be revealed in the vote period they were committed in
+	ctx = ctx.WithBlockHeight(14)
+	err = reveal(ctx, addrs[0], "20", "salt0")
+	require.True(t, errors.Is(err, types.ErrInvalidRevealPeriod))
+
+	// Prices and salts must match the commit
+	ctx = ctx.WithBlockHeight(15)
+	err = reveal(ctx, addrs[0], "20.1", "salt0")
+	require.True(t, errors.Is(err, types.ErrRevealMismatch))
+	err = reveal(ctx, addrs[0], "20", "salt1")
+	require.True(t, errors.Is(err, types.ErrRevealMismatch))
+	rawPrices, err := k.GetRawPrices(ctx, "bnb:usd")
+	require.NoError(t, err)
+	require.Len(t, rawPrices, 0)
+
+	// Matching reveals are posted and enter the median
+	require.NoError(t, reveal(ctx, addrs[0], "20", "salt0"))
+	require.NoError(t, reveal(ctx, addrs[1], "22", "salt1"))
+	_, found = k.GetPriceCommit(ctx, "bnb:usd", addrs[0], 2)
+	require.False(t, found)
+	pricefeed.EndBlocker(ctx, k)
+	currentPrice, err := k.GetCurrentPrice(ctx, "bnb:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("21"), currentPrice.Price)
+
+	// Revealed commits cannot be revealed again
+	err = reveal(ctx, addrs[0], "20", "salt0")
+	require.True(t, errors.Is(err, types.ErrCommitNotFound))
+
+	// Unrevealed commits are deleted once the reveal vote period ends
+	ctx = ctx.WithBlockHeight(19)
+	pricefeed.EndBlocker(ctx, k)
+	_, found = k.GetPriceCommit(ctx, "bnb:usd", addrs[2], 2)
+	require.True(t, found)
+	ctx = ctx.WithBlockHeight(20)
+	pricefeed.EndBlocker(ctx, k)
+	_, found = k.GetPriceCommit(ctx, "bnb:usd", addrs[2], 2)
+	require.False(t, found)
+	err = reveal(ctx, addrs[2], "21", "salt2")
+	require.True(t, errors.Is(err, types.ErrCommitNotFound))
+}
+
+// TestKeeper_CommitEveryVotePeriod tests an oracle committing in consecutive vote periods can still reveal its previous commit
+func TestKeeper_CommitEveryVotePeriod(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	oracle := addrs[0]
+	tApp := app.NewTestApp()
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiry := startTime.Add(time.Hour)
+	ctx := tApp.NewContext(true, abci.Header{Height: 10, Time: startTime})
+	k := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("bnb:usd", "bnb", "usd", addrs, true)
+	market.VotePeriod = 5
+	k.SetParams(ctx, types.NewParams(types.Markets{market}, types.DefaultOracleBond))
+	commit := func(ctx sdk.Context, price, salt string) error {
+		return k.CommitPrice(ctx, oracle, "bnb:usd", types.PriceCommitHash(salt, sdk.MustNewDecFromStr(price), "bnb:usd", oracle))
+	}
+
+	// Commit in vote period 2, then commit again in vote period 3 before revealing
+	require.NoError(t, commit(ctx, "20", "salt2"))
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, commit(ctx, "21", "salt3"))
+	require.Len(t, k.GetMarketPriceCommits(ctx, "bnb:usd"), 2)
+
+	// The vote period 2 commit can still be revealed
+	require.NoError(t, k.RevealPrice(ctx, oracle, "bnb:usd", sdk.MustNewDecFromStr("20"), "salt2", expiry))
+	_, found := k.GetPriceCommit(ctx, "bnb:usd", oracle, 2)
+	require.False(t, found)
+	rawPrices, err := k.GetRawPrices(ctx, "bnb:usd")
+	require.NoError(t, err)
+	require.Len(t, rawPrices, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("20"), rawPrices[0].Price)
+
+	// The vote period 3 commit is kept, and is revealed in vote period 4
+	pricefeed.EndBlocker(ctx, k)
+	_, found = k.GetPriceCommit(ctx, "bnb:usd", oracle, 3)
+	require.True(t, found)
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.RevealPrice(ctx, oracle, "bnb:usd", sdk.MustNewDecFromStr("21"), "salt3", expiry))
+	require.Empty(t, k.GetMarketPriceCommits(ctx, "bnb:usd"))
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetDerivedPrice calculates a derived market's price from the current prices of its inputs.
+// It returns an error if any of the inputs has no valid price.
+func (k Keeper) GetDerivedPrice(ctx sdk.Context, market types.Market) (sdk.Dec, error) {
+	price := sdk.OneDec()
+	for _, input := range market.Derived.Inputs {
+		inputPrice, err := k.GetCurrentPrice(ctx, input.MarketID)
+		if err != nil {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoValidPrice, "no price for input %s of derived market %s", input.MarketID, market.MarketID)
+		}
+		if input.Invert {
+			price = price.Quo(inputPrice.Price)
+		} else {
+			price = price.Mul(inputPrice.Price)
+		}
+	}
+	return price, nil
+}
+
+// setDerivedCurrentPrice updates the price of a derived market from the current prices of its inputs.
+// Derived markets have no valid price while any of their inputs has none.
+func (k Keeper) setDerivedCurrentPrice(ctx sdk.Context, market types.Market) error {
+	price, err := k.GetDerivedPrice(ctx, market)
+	if err == nil && !price.IsPositive() {
+		// a product too small for the decimal precision is not a valid price
+		err = types.ErrNoValidPrice
+	}
+	if err != nil {
+		k.setCurrentPrice(ctx, market.MarketID, types.CurrentPrice{})
+		return types.ErrNoValidPrice
+	}
+	k.updateCurrentPrice(ctx, market.MarketID, price)
+	k.recordPriceObservation(ctx, market.MarketID, price)
+	return nil
+}
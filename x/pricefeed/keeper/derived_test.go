@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_DerivedMarkets tests derived markets are priced at the product of their inputs' prices, and have no price while any input has none
+func TestKeeper_DerivedMarkets(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	oracle := addrs[0]
+	tApp := app.NewTestApp()
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	k := tApp.GetPriceFeedKeeper()
+
+	guard := types.NewPriceGuard(sdk.MustNewDecFromStr("0.5"), 0, 0, 0)
+	bnbUSD := types.NewMarket("bnb:usd", "bnb", "usd", []sdk.AccAddress{oracle}, true)
+	bnbUSD.Guard = &guard
+	k.SetParams(ctx, types.NewParams(types.Markets{
+		// derived markets are listed before their inputs to check they are updated after them
+		types.NewDerivedMarket("hard:btc", "hard", "btc", types.DerivedInputs{
+			types.NewDerivedInput("hard:usd", false),
+			types.NewDerivedInput("btc:usd", true),
+		}, true),
+		types.NewDerivedMarket("hard:usd", "hard", "usd", types.DerivedInputs{
+			types.NewDerivedInput("hard:bnb", false),
+			types.NewDerivedInput("bnb:usd", false),
+		}, true),
+		types.NewMarket("hard:bnb", "hard", "bnb", []sdk.AccAddress{oracle}, true),
+		bnbUSD,
+		types.NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{oracle}, true),
+	}, types.DefaultOracleBond))
+	postPrice := func(ctx sdk.Context, marketID, price string, expiry time.Time) {
+		_, err := k.SetPrice(ctx, oracle, marketID, sdk.MustNewDecFromStr(price), expiry)
+		require.NoError(t, err)
+	}
+	requirePrice := func(ctx sdk.Context, marketID, price string) {
+		currentPrice, err := k.GetCurrentPrice(ctx, marketID)
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr(price), currentPrice.Price)
+	}
+
+	// Derived markets have no price until their inputs have one
+	err := k.SetCurrentPrices(ctx, "hard:usd")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+
+	postPrice(ctx, "hard:bnb", "0.05", startTime.Add(time.Hour))
+	postPrice(ctx, "bnb:usd", "40", startTime.Add(2*time.Hour))
+	postPrice(ctx, "btc:usd", "20000", startTime.Add(2*time.Hour))
+	pricefeed.EndBlocker(ctx, k)
+	requirePrice(ctx, "hard:usd", "2")
+	requirePrice(ctx, "hard:btc", "0.0001")
+
+	// A price change in an input is reflected in the same block
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(time.Minute))
+	postPrice(ctx, "bnb:usd", "50", startTime.Add(2*time.Hour))
+	pricefeed.EndBlocker(ctx, k)
+	requirePrice(ctx, "hard:usd", "2.5")
+	requirePrice(ctx, "hard:btc", "0.000125")
+
+	// Derived markets are unhealthy while any input is
+	require.True(t, k.IsMarketHealthy(ctx, "hard:btc"))
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(2 * time.Minute))
+	postPrice(ctx, "bnb:usd", "100", startTime.Add(2*time.Hour))
+	pricefeed.EndBlocker(ctx, k)
+	requirePrice(ctx, "hard:usd", "5")
+	require.False(t, k.IsMarketHealthy(ctx, "hard:usd"))
+	require.False(t, k.IsMarketHealthy(ctx, "hard:btc"))
+	health := k.GetMarketHealth(ctx, "hard:btc")
+	require.False(t, health.Healthy)
+	require.Equal(t, "bnb:usd", health.Trip.MarketID)
+
+	// When an input has no valid price, neither do the markets derived from it
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(time.Hour))
+	pricefeed.EndBlocker(ctx, k)
+	_, err = k.GetCurrentPrice(ctx, "hard:usd")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+	_, err = k.GetCurrentPrice(ctx, "hard:btc")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+	requirePrice(ctx, "bnb:usd", "100")
+
+	// Once the input has a price again, so do the derived markets
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(startTime.Add(time.Hour + time.Minute))
+	postPrice(ctx, "hard:bnb", "0.04", startTime.Add(2*time.Hour))
+	pricefeed.EndBlocker(ctx, k)
+	requirePrice(ctx, "hard:usd", "4")
+	requirePrice(ctx, "hard:btc", "0.0002")
+}
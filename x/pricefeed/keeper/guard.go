@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// checkGuardBeforeMedian checks a guarded market has enough live oracles and a recent enough price post to take the median from.
+// It returns true if the guard tripped, in which case the market's previous price is kept.
+func (k Keeper) checkGuardBeforeMedian(ctx sdk.Context, market types.Market, liveOracles int) bool {
+	guard := market.Guard
+	prevPrice := k.getPrevPrice(ctx, market.MarketID)
+	if guard.MinOracles > 0 && uint64(liveOracles) < guard.MinOracles {
+		k.tripGuard(ctx, types.NewGuardTrip(market.MarketID, types.GuardTripMinOracles, ctx.BlockHeight(), ctx.BlockTime(), sdk.ZeroDec(), prevPrice))
+		return true
+	}
+	if guard.MaxStaleness > 0 {
+		lastPost, found := k.GetLastPostTime(ctx, market.MarketID)
+		if found && ctx.BlockTime().Sub(lastPost) > guard.MaxStaleness {
+			k.tripGuard(ctx, types.NewGuardTrip(market.MarketID, types.GuardTripStale, ctx.BlockHeight(), ctx.BlockTime(), sdk.ZeroDec(), prevPrice))
+			return true
+		}
+	}
+	return false
+}
+
+// exceedsMaxDeviation returns true if a guarded market's median price moved further from its last healthy price than the guard allows
+func exceedsMaxDeviation(guard *types.PriceGuard, healthyPrice, price sdk.Dec) bool {
+	if guard == nil || guard.MaxDeviation.IsZero() || !healthyPrice.IsPositive() {
+		return false
+	}
+	return price.Sub(healthyPrice).Abs().Quo(healthyPrice).GT(guard.MaxDeviation)
+}
+
+// getLastHealthyPrice returns a market's price before its guard tripped if it is unhealthy, or its current price if it is healthy.
+// Measuring deviation from it stops a price that tripped the guard from becoming the reference the market recovers against.
+func (k Keeper) getLastHealthyPrice(ctx sdk.Context, marketID string) sdk.Dec {
+	if latest, found := k.GetLatestGuardTrip(ctx, marketID); found && !latest.IsRecovered() {
+		return latest.PrevPrice
+	}
+	return k.getPrevPrice(ctx, marketID)
+}
+
+// getPrevPrice returns a market's current price, or zero if it has none
+func (k Keeper) getPrevPrice(ctx sdk.Context, marketID string) sdk.Dec {
+	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
+	if err != nil {
+		return sdk.ZeroDec()
+	}
+	return prevPrice.Price
+}
+
+// tripGuard marks a market unhealthy. A market that is already unhealthy keeps the trip that first made it so,
+// and must start its recovery again.
+func (k Keeper) tripGuard(ctx sdk.Context, trip types.GuardTrip) {
+	if latest, found := k.GetLatestGuardTrip(ctx, trip.MarketID); found && !latest.IsRecovered() {
+		if latest.PassedUpdates > 0 {
+			latest.PassedUpdates = 0
+			k.SetGuardTrip(ctx, latest)
+		}
+		return
+	}
+	k.SetGuardTrip(ctx, trip)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketGuardTripped,
+			sdk.NewAttribute(types.AttributeMarketID, trip.MarketID),
+			sdk.NewAttribute(types.AttributeReason, trip.Reason),
+			sdk.NewAttribute(types.AttributeMarketPrice, trip.Price.String()),
+			sdk.NewAttribute(types.AttributePrevPrice, trip.PrevPrice.String()),
+		),
+	)
+}
+
+// recoverMarket records a price update that passed all of an unhealthy market's guard checks,
+// marking the market healthy again once enough consecutive updates have passed
+func (k Keeper) recoverMarket(ctx sdk.Context, market types.Market) {
+	latest, found := k.GetLatestGuardTrip(ctx, market.MarketID)
+	if !found || latest.IsRecovered() {
+		return
+	}
+	latest.PassedUpdates++
+	if market.Guard != nil && latest.PassedUpdates < market.Guard.GetRecoveryUpdates() {
+		k.SetGuardTrip(ctx, latest)
+		return
+	}
+	latest.RecoveredHeight = ctx.BlockHeight()
+	latest.RecoveredTime = ctx.BlockTime()
+	k.SetGuardTrip(ctx, latest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketRecovered,
+			sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+			sdk.NewAttribute(types.AttributeReason, latest.Reason),
+		),
+	)
+}
+
+// IsMarketHealthy returns true if no price guard has tripped for a market since it last recovered.
+// TWAP markets are as healthy as the market they average, and derived markets are unhealthy while any of their inputs is.
+func (k Keeper) IsMarketHealthy(ctx sdk.Context, marketID string) bool {
+	_, unhealthy := k.getUnrecoveredTrip(ctx, marketID)
+	return !unhealthy
+}
+
+// GetMarketHealth returns the health of a market, with the trip that made it unhealthy
+func (k Keeper) GetMarketHealth(ctx sdk.Context, marketID string) types.MarketHealth {
+	trip, unhealthy := k.getUnrecoveredTrip(ctx, marketID)
+	if !unhealthy {
+		return types.NewMarketHealth(marketID, true, nil)
+	}
+	return types.NewMarketHealth(marketID, false, &trip)
+}
+
+// getUnrecoveredTrip returns the trip that made a market unhealthy. TWAP markets are as healthy as the market they average,
+// and derived markets are unhealthy while any of their inputs is.
+func (k Keeper) getUnrecoveredTrip(ctx sdk.Context, marketID string) (types.GuardTrip, bool) {
+	market, found := k.GetMarket(ctx, marketID)
+	if found && market.IsTWAP() {
+		return k.getUnrecoveredTrip(ctx, market.TWAP.MarketID)
+	}
+	if found && market.IsDerived() {
+		for _, input := range market.Derived.Inputs {
+			if trip, unhealthy := k.getUnrecoveredTrip(ctx, input.MarketID); unhealthy {
+				return trip, true
+			}
+		}
+		return types.GuardTrip{}, false
+	}
+	latest, found := k.GetLatestGuardTrip(ctx, marketID)
+	if !found || latest.IsRecovered() {
+		return types.GuardTrip{}, false
+	}
+	return latest, true
+}
+
+// SetGuardTrip stores a guard trip
+func (k Keeper) SetGuardTrip(ctx sdk.Context, trip types.GuardTrip) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.GuardTripKey(trip.MarketID, trip.Time), k.cdc.MustMarshalBinaryBare(trip))
+}
+
+// GetLatestGuardTrip returns the most recent guard trip of a market
+func (k Keeper) GetLatestGuardTrip(ctx sdk.Context, marketID string) (types.GuardTrip, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GuardTripMarketKey(marketID))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.GuardTrip{}, false
+	}
+	var trip types.GuardTrip
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &trip)
+	return trip, true
+}
+
+// IterateGuardTrips iterates over all guard trips in the store, ordered by market then time, and performs a callback function
+func (k Keeper) IterateGuardTrips(ctx sdk.Context, cb func(trip types.GuardTrip) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.GuardTripPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var trip types.GuardTrip
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &trip)
+		if cb(trip) {
+			break
+		}
+	}
+}
+
+// GetGuardTrips returns all guard trips from the store
+func (k Keeper) GetGuardTrips(ctx sdk.Context) types.GuardTrips {
+	trips := types.GuardTrips{}
+	k.IterateGuardTrips(ctx, func(trip types.GuardTrip) (stop bool) {
+		trips = append(trips, trip)
+		return false
+	})
+	return trips
+}
+
+// GetMarketGuardTrips returns the guard trips of a market, oldest first
+func (k Keeper) GetMarketGuardTrips(ctx sdk.Context, marketID string) types.GuardTrips {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GuardTripMarketKey(marketID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	trips := types.GuardTrips{}
+	for ; iterator.Valid(); iterator.Next() {
+		var trip types.GuardTrip
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &trip)
+		trips = append(trips, trip)
+	}
+	return trips
+}
+
+// setLastPostTime stores the time an oracle last posted a price for a market
+func (k Keeper) setLastPostTime(ctx sdk.Context, marketID string, t time.Time) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.LastPostTimeKey(marketID), sdk.FormatTimeBytes(t))
+}
+
+// GetLastPostTime returns the time an oracle last posted a price for a market
+func (k Keeper) GetLastPostTime(ctx sdk.Context, marketID string) (time.Time, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.LastPostTimeKey(marketID))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	t, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return t, true
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_PriceGuard tests a market's guard marks it unhealthy when an update breaks its limits, and healthy again when enough consecutive updates pass them
+func TestKeeper_PriceGuard(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	k := tApp.GetPriceFeedKeeper()
+
+	guard := types.NewPriceGuard(sdk.MustNewDecFromStr("0.1"), 2, time.Hour, 2)
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.Guard = &guard
+	k.SetParams(ctx, types.NewParams(types.Markets{
+		market,
+		types.NewTWAPMarket("tstusd:twap", "tst", "usd", "tstusd", time.Hour, true),
+	}, types.DefaultOracleBond))
+
+	postPrices := func(ctx sdk.Context, price string, oracles ...sdk.AccAddress) {
+		for _, oracle := range oracles {
+			_, err := k.SetPrice(ctx, oracle, "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(30*time.Minute))
+			require.NoError(t, err)
+		}
+	}
+	requireHealth := func(ctx sdk.Context, healthy bool, price string) {
+		require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+		require.Equal(t, healthy, k.IsMarketHealthy(ctx, "tstusd"))
+		require.Equal(t, healthy, k.IsMarketHealthy(ctx, "tstusd:twap"))
+		currentPrice, err := k.GetCurrentPrice(ctx, "tstusd")
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr(price), currentPrice.Price)
+	}
+
+	// Prices within the guard's limits keep the market healthy
+	postPrices(ctx, "100", addrs...)
+	requireHealth(ctx, true, "100")
+
+	// Too few live oracles trips the guard, keeping the previous price
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(40 * time.Minute))
+	postPrices(ctx, "105", addrs[0])
+	requireHealth(ctx, false, "100")
+
+	// Enough oracles with a price within the max deviation start the market's recovery, which takes two consecutive passing updates
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(45 * time.Minute))
+	postPrices(ctx, "105", addrs[1:]...)
+	requireHealth(ctx, false, "105")
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(46 * time.Minute))
+	requireHealth(ctx, true, "105")
+
+	// A price moving more than the max deviation trips the guard, but is still used
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(startTime.Add(50 * time.Minute))
+	postPrices(ctx, "120", addrs...)
+	requireHealth(ctx, false, "120")
+
+	// The market stays unhealthy with the original trip while the guard keeps tripping
+	ctx = ctx.WithBlockHeight(6).WithBlockTime(startTime.Add(55 * time.Minute))
+	postPrices(ctx, "150", addrs...)
+	requireHealth(ctx, false, "150")
+
+	// Deviation is measured from the last healthy price, so the market does not recover while the price stays away from it
+	ctx = ctx.WithBlockHeight(7).WithBlockTime(startTime.Add(56 * time.Minute))
+	requireHealth(ctx, false, "150")
+
+	// An update breaking the limits during recovery restarts it
+	ctx = ctx.WithBlockHeight(8).WithBlockTime(startTime.Add(57 * time.Minute))
+	postPrices(ctx, "110", addrs...)
+	requireHealth(ctx, false, "110")
+	require.Equal(t, uint64(1), k.GetMarketHealth(ctx, "tstusd").Trip.PassedUpdates)
+	ctx = ctx.WithBlockHeight(9).WithBlockTime(startTime.Add(58 * time.Minute))
+	postPrices(ctx, "130", addrs...)
+	requireHealth(ctx, false, "130")
+	require.Equal(t, uint64(0), k.GetMarketHealth(ctx, "tstusd").Trip.PassedUpdates)
+
+	// The market recovers once the price has settled back within the limits for enough updates
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(startTime.Add(59 * time.Minute))
+	postPrices(ctx, "110", addrs...)
+	requireHealth(ctx, false, "110")
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(startTime.Add(60 * time.Minute))
+	requireHealth(ctx, true, "110")
+
+	trips := k.GetMarketGuardTrips(ctx, "tstusd")
+	require.Len(t, trips, 2)
+	require.Equal(t, types.GuardTripMinOracles, trips[0].Reason)
+	require.Equal(t, int64(2), trips[0].Height)
+	require.Equal(t, sdk.MustNewDecFromStr("100"), trips[0].PrevPrice)
+	require.Equal(t, int64(4), trips[0].RecoveredHeight)
+	require.Equal(t, types.NewGuardTrip("tstusd", types.GuardTripDeviation, 5, startTime.Add(50*time.Minute), sdk.MustNewDecFromStr("120"), sdk.MustNewDecFromStr("105")), types.GuardTrip{
+		MarketID:  trips[1].MarketID,
+		Reason:    trips[1].Reason,
+		Height:    trips[1].Height,
+		Time:      trips[1].Time,
+		Price:     trips[1].Price,
+		PrevPrice: trips[1].PrevPrice,
+	})
+	require.Equal(t, int64(11), trips[1].RecoveredHeight)
+	require.NoError(t, trips.Validate())
+}
+
+// TestKeeper_PriceGuardStale tests a market's guard trips when no oracle has posted a price for longer than the max staleness
+func TestKeeper_PriceGuardStale(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	k := tApp.GetPriceFeedKeeper()
+
+	guard := types.NewPriceGuard(sdk.ZeroDec(), 0, time.Hour, 0)
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.Guard = &guard
+	k.SetParams(ctx, types.NewParams(types.Markets{market}, types.DefaultOracleBond))
+
+	_, err := k.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("100"), startTime.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	require.True(t, k.IsMarketHealthy(ctx, "tstusd"))
+
+	// The price has not expired, but is older than the max staleness
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(time.Hour + time.Second))
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	require.False(t, k.IsMarketHealthy(ctx, "tstusd"))
+	health := k.GetMarketHealth(ctx, "tstusd")
+	require.False(t, health.Healthy)
+	require.Equal(t, types.GuardTripStale, health.Trip.Reason)
+
+	// A new price post recovers the market
+	ctx = ctx.WithBlockHeight(3)
+	_, err = k.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr("100"), startTime.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	require.True(t, k.IsMarketHealthy(ctx, "tstusd"))
+	require.Equal(t, types.NewMarketHealth("tstusd", true, nil), k.GetMarketHealth(ctx, "tstusd"))
+
+	// Trips are visible through queries
+	querier := keeper.NewQuerier(k)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{"custom", types.QuerierRoute, types.QueryGuardTrips}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryWithMarketIDParams("tstusd")),
+	}
+	bz, err := querier(ctx, []string{types.QueryGuardTrips}, query)
+	require.NoError(t, err)
+	var trips types.GuardTrips
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &trips))
+	require.Len(t, trips, 1)
+	require.Equal(t, types.GuardTripStale, trips[0].Reason)
+	require.Equal(t, int64(3), trips[0].RecoveredHeight)
+
+	query.Path = strings.Join([]string{"custom", types.QuerierRoute, types.QueryMarketHealth}, "/")
+	bz, err = querier(ctx, []string{types.QueryMarketHealth}, query)
+	require.NoError(t, err)
+	var healths types.MarketHealths
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &healths))
+	require.Equal(t, types.MarketHealths{types.NewMarketHealth("tstusd", true, nil)}, healths)
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// recordHistoricalPrice adds a market's new current price to its price history.
+// Prices beyond the number the market keeps are pruned, oldest first, so the history is a ring buffer of the market's most recent prices.
+func (k Keeper) recordHistoricalPrice(ctx sdk.Context, marketID string, price sdk.Dec) {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found {
+		return
+	}
+
+	var sequence uint64
+	if latest, found := k.getLatestHistoricalPrice(ctx, marketID); found {
+		sequence = latest.Sequence + 1
+	}
+	if market.PriceHistory > 0 {
+		k.SetHistoricalPrice(ctx, types.NewHistoricalPrice(marketID, sequence, ctx.BlockHeight(), ctx.BlockTime(), price))
+	}
+
+	// keep the prices with sequences from sequence+1-PriceHistory, pruning all of them if the market keeps none
+	if sequence+1 > market.PriceHistory {
+		k.pruneHistoricalPrices(ctx, marketID, sequence+1-market.PriceHistory)
+	}
+}
+
+// pruneHistoricalPrices deletes a market's historical prices with sequences before a cutoff
+func (k Keeper) pruneHistoricalPrices(ctx sdk.Context, marketID string, cutoff uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.HistoricalPriceMarketKey(marketID))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(cutoff))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getLatestHistoricalPrice returns the most recent price in a market's price history
+func (k Keeper) getLatestHistoricalPrice(ctx sdk.Context, marketID string) (types.HistoricalPrice, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.HistoricalPriceMarketKey(marketID))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.HistoricalPrice{}, false
+	}
+	var price types.HistoricalPrice
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &price)
+	return price, true
+}
+
+// SetHistoricalPrice stores a historical price
+func (k Keeper) SetHistoricalPrice(ctx sdk.Context, price types.HistoricalPrice) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.HistoricalPriceKey(price.MarketID, price.Sequence), k.cdc.MustMarshalBinaryBare(price))
+}
+
+// IterateHistoricalPrices iterates over all historical prices in the store and performs a callback function
+func (k Keeper) IterateHistoricalPrices(ctx sdk.Context, cb func(price types.HistoricalPrice) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.HistoricalPricePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.HistoricalPrice
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &price)
+		if cb(price) {
+			break
+		}
+	}
+}
+
+// GetHistoricalPrices returns all historical prices from the store
+func (k Keeper) GetHistoricalPrices(ctx sdk.Context) types.HistoricalPrices {
+	prices := types.HistoricalPrices{}
+	k.IterateHistoricalPrices(ctx, func(price types.HistoricalPrice) (stop bool) {
+		prices = append(prices, price)
+		return false
+	})
+	return prices
+}
+
+// GetMarketHistoricalPrices returns a market's price history, oldest first
+func (k Keeper) GetMarketHistoricalPrices(ctx sdk.Context, marketID string) types.HistoricalPrices {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.HistoricalPriceMarketKey(marketID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	prices := types.HistoricalPrices{}
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.HistoricalPrice
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+	return prices
+}
+
+// GetMarketHistoricalPricesInRange returns a market's historical prices set from minHeight to maxHeight and from startTime to endTime inclusive, oldest first.
+// A zero bound is not checked. Prices are stored in the order they were set, so iteration starts at the first price within the lower bounds and stops at the first past the upper bounds.
+func (k Keeper) GetMarketHistoricalPricesInRange(ctx sdk.Context, marketID string, minHeight, maxHeight int64, startTime, endTime time.Time) types.HistoricalPrices {
+	prices := types.HistoricalPrices{}
+	first, found := k.getFirstHistoricalPrice(ctx, marketID)
+	if !found {
+		return prices
+	}
+	latest, _ := k.getLatestHistoricalPrice(ctx, marketID)
+
+	// binary search the sequences, which have no gaps, for the first price within the lower bounds
+	offset := sort.Search(int(latest.Sequence-first.Sequence+1), func(i int) bool {
+		price, found := k.getHistoricalPrice(ctx, marketID, first.Sequence+uint64(i))
+		if !found {
+			return false
+		}
+		return (minHeight == 0 || price.Height >= minHeight) && (startTime.IsZero() || !price.Time.Before(startTime))
+	})
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.HistoricalPriceMarketKey(marketID))
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(first.Sequence+uint64(offset)), nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.HistoricalPrice
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &price)
+		if (maxHeight != 0 && price.Height > maxHeight) || (!endTime.IsZero() && price.Time.After(endTime)) {
+			break
+		}
+		prices = append(prices, price)
+	}
+	return prices
+}
+
+// getFirstHistoricalPrice returns the oldest price in a market's price history
+func (k Keeper) getFirstHistoricalPrice(ctx sdk.Context, marketID string) (types.HistoricalPrice, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.HistoricalPriceMarketKey(marketID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.HistoricalPrice{}, false
+	}
+	var price types.HistoricalPrice
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &price)
+	return price, true
+}
+
+// getHistoricalPrice returns a market's historical price with a sequence
+func (k Keeper) getHistoricalPrice(ctx sdk.Context, marketID string, sequence uint64) (types.HistoricalPrice, bool) {
+	bz := ctx.KVStore(k.key).Get(types.HistoricalPriceKey(marketID, sequence))
+	if bz == nil {
+		return types.HistoricalPrice{}, false
+	}
+	var price types.HistoricalPrice
+	k.cdc.MustUnmarshalBinaryBare(bz, &price)
+	return price, true
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_PriceHistory tests a market keeps a ring buffer of its most recent current prices, which can be queried by height and time
+func TestKeeper_PriceHistory(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	oracle := addrs[0]
+	tApp := app.NewTestApp()
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	k := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("bnb:usd", "bnb", "usd", []sdk.AccAddress{oracle}, true)
+	market.PriceHistory = 3
+	setMarket := func(market types.Market) {
+		k.SetParams(ctx, types.NewParams(types.Markets{
+			market,
+			types.NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{oracle}, true),
+		}, types.DefaultOracleBond))
+	}
+	setMarket(market)
+	// postAndUpdate posts prices at heights 1, 2, 3... a minute apart, starting from a height
+	postAndUpdate := func(fromHeight int64, prices ...string) {
+		for i, price := range prices {
+			height := fromHeight + int64(i)
+			ctx = ctx.WithBlockHeight(height).WithBlockTime(startTime.Add(time.Duration(height-1) * time.Minute))
+			_, err := k.SetPrice(ctx, oracle, "bnb:usd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+			require.NoError(t, err)
+			_, err = k.SetPrice(ctx, oracle, "btc:usd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+			require.NoError(t, err)
+			pricefeed.EndBlocker(ctx, k)
+		}
+	}
+	historicalPrice := func(sequence uint64, height int64, price string) types.HistoricalPrice {
+		return types.NewHistoricalPrice("bnb:usd", sequence, height, startTime.Add(time.Duration(height-1)*time.Minute), sdk.MustNewDecFromStr(price))
+	}
+
+	// Only the most recent prices are kept
+	postAndUpdate(1, "10", "11", "12", "13", "14")
+	require.Equal(t, types.HistoricalPrices{
+		historicalPrice(2, 3, "12"),
+		historicalPrice(3, 4, "13"),
+		historicalPrice(4, 5, "14"),
+	}, k.GetMarketHistoricalPrices(ctx, "bnb:usd"))
+
+	// Markets that keep no history have none
+	require.Empty(t, k.GetMarketHistoricalPrices(ctx, "btc:usd"))
+
+	// Prices are queryable by height and time
+	querier := keeper.NewQuerier(k)
+	queryHistory := func(params types.QueryHistoricalPricesParams) types.HistoricalPrices {
+		bz, err := querier(ctx, []string{types.QueryHistoricalPrices}, abci.RequestQuery{Data: types.ModuleCdc.MustMarshalJSON(params)})
+		require.NoError(t, err)
+		var prices types.HistoricalPrices
+		types.ModuleCdc.MustUnmarshalJSON(bz, &prices)
+		return prices
+	}
+	require.Equal(t, types.HistoricalPrices{historicalPrice(2, 3, "12"), historicalPrice(3, 4, "13")},
+		queryHistory(types.NewQueryHistoricalPricesParams("bnb:usd", 0, 4, time.Time{}, time.Time{})))
+	require.Equal(t, types.HistoricalPrices{historicalPrice(3, 4, "13"), historicalPrice(4, 5, "14")},
+		queryHistory(types.NewQueryHistoricalPricesParams("bnb:usd", 0, 0, startTime.Add(3*time.Minute), time.Time{})))
+	require.Equal(t, types.HistoricalPrices{historicalPrice(3, 4, "13")},
+		queryHistory(types.NewQueryHistoricalPricesParams("bnb:usd", 4, 0, time.Time{}, startTime.Add(3*time.Minute))))
+	require.Equal(t, types.HistoricalPrices{historicalPrice(2, 3, "12"), historicalPrice(3, 4, "13"), historicalPrice(4, 5, "14")},
+		queryHistory(types.NewQueryHistoricalPricesParams("bnb:usd", 1, 0, startTime, time.Time{})))
+	require.Empty(t, queryHistory(types.NewQueryHistoricalPricesParams("bnb:usd", 6, 0, time.Time{}, time.Time{})))
+	require.Empty(t, queryHistory(types.NewQueryHistoricalPricesParams("bnb:usd", 0, 2, time.Time{}, time.Time{})))
+	require.Empty(t, queryHistory(types.NewQueryHistoricalPricesParams("btc:usd", 0, 0, time.Time{}, time.Time{})))
+	_, err := querier(ctx, []string{types.QueryHistoricalPrices}, abci.RequestQuery{
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryHistoricalPricesParams("xrp:usd", 0, 0, time.Time{}, time.Time{})),
+	})
+	require.Error(t, err)
+
+	// Reducing the number of prices kept prunes the oldest on the next update
+	market.PriceHistory = 2
+	setMarket(market)
+	postAndUpdate(6, "15")
+	require.Equal(t, types.HistoricalPrices{
+		historicalPrice(4, 5, "14"),
+		historicalPrice(5, 6, "15"),
+	}, k.GetMarketHistoricalPrices(ctx, "bnb:usd"))
+
+	// Keeping no prices prunes them all
+	market.PriceHistory = 0
+	setMarket(market)
+	postAndUpdate(7, "16")
+	require.Empty(t, k.GetMarketHistoricalPrices(ctx, "bnb:usd"))
+}
@@ -24,11 +24,13 @@ type Keeper struct {
 	cdc *codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace subspace.Subspace
+	// Holds and burns oracle bonds
+	supplyKeeper types.SupplyKeeper
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
+	cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, sk types.SupplyKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -38,6 +40,7 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		supplyKeeper:  sk,
 	}
 }
 
@@ -93,32 +96,43 @@ func (k Keeper) SetPrice(
 	)
 
 	store.Set(types.RawPriceKey(marketID), k.cdc.MustMarshalBinaryBare(prices))
+	k.setLastPostTime(ctx, marketID, ctx.BlockTime())
 	return prices[index], nil
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs, weighted by the market's oracle weights.
+// TWAP markets are instead updated to the time weighted average price of the market they average, and derived markets to the product of their inputs' prices.
+// If the market has a guard and the update breaks one of its limits, the market is marked unhealthy.
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
 	}
-	// store current price
-	validPrevPrice := true
-	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
-	if err != nil {
-		validPrevPrice = false
+	if market.IsTWAP() {
+		return k.setTWAPCurrentPrice(ctx, market)
+	}
+	if market.IsDerived() {
+		return k.setDerivedCurrentPrice(ctx, market)
 	}
 
 	prices, err := k.GetRawPrices(ctx, marketID)
 	if err != nil {
 		return err
 	}
-	var notExpiredPrices types.CurrentPrices
-	// filter out expired prices
+	bondParams := k.GetOracleBondParams(ctx)
+	var notExpiredPrices types.PostedPrices
+	// filter out expired prices, prices from oracles jailed from the market, and prices from oracles that no longer have the required bond
 	for _, v := range prices {
-		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+		if !v.Expiry.After(ctx.BlockTime()) {
+			continue
+		}
+		if record, found := k.GetOracleRecord(ctx, marketID, v.OracleAddress); found && record.IsJailed(ctx.BlockTime()) {
+			continue
+		}
+		if !k.hasRequiredBond(ctx, v.OracleAddress, bondParams) {
+			continue
 		}
+		notExpiredPrices = append(notExpiredPrices, v)
 	}
 
 	if len(notExpiredPrices) == 0 {
@@ -130,24 +144,58 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 		return types.ErrNoValidPrice
 	}
 
-	medianPrice := k.CalculateMedianPrice(ctx, notExpiredPrices)
+	if market.Guard != nil && k.checkGuardBeforeMedian(ctx, market, len(notExpiredPrices)) {
+		return nil
+	}
+
+	healthyPrice := k.getLastHealthyPrice(ctx, marketID)
+	var medianPrice sdk.Dec
+	if len(market.OracleWeights) > 0 {
+		var weightedPrices types.WeightedPrices
+		for _, v := range notExpiredPrices {
+			weightedPrices = append(weightedPrices, types.WeightedPrice{Price: v.Price, Weight: market.OracleWeights.WeightOf(v.OracleAddress)})
+		}
+		medianPrice = k.CalculateWeightedMedianPrice(ctx, weightedPrices)
+	} else {
+		var currentPrices types.CurrentPrices
+		for _, v := range notExpiredPrices {
+			currentPrices = append(currentPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+		}
+		medianPrice = k.CalculateMedianPrice(ctx, currentPrices)
+	}
+	k.updateCurrentPrice(ctx, marketID, medianPrice)
+	k.recordPriceObservation(ctx, marketID, medianPrice)
+	k.updateOracleRecords(ctx, market, notExpiredPrices, medianPrice)
+
+	if exceedsMaxDeviation(market.Guard, healthyPrice, medianPrice) {
+		// the new price is kept so the market can recover once the price settles
+		k.tripGuard(ctx, types.NewGuardTrip(marketID, types.GuardTripDeviation, ctx.BlockHeight(), ctx.BlockTime(), medianPrice, healthyPrice))
+		return nil
+	}
+	k.recoverMarket(ctx, market)
+
+	return nil
+}
+
+// updateCurrentPrice stores a market's new current price, emitting an event if it has changed, and adds it to the market's price history
+func (k Keeper) updateCurrentPrice(ctx sdk.Context, marketID string, price sdk.Dec) {
+	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
 
 	// check case that market price was not set in genesis
-	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
+	if err == nil && !price.Equal(prevPrice.Price) {
 		// only emit event if price has changed
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeMarketPriceUpdated,
 				sdk.NewAttribute(types.AttributeMarketID, marketID),
-				sdk.NewAttribute(types.AttributeMarketPrice, medianPrice.String()),
+				sdk.NewAttribute(types.AttributeMarketPrice, price.String()),
 			),
 		)
 	}
 
-	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
+	currentPrice := types.NewCurrentPrice(marketID, price)
 	k.setCurrentPrice(ctx, marketID, currentPrice)
-
-	return nil
+	k.recordHistoricalPrice(ctx, marketID, price)
 }
 
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
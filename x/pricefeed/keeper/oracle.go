@@ -0,0 +1,350 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// ValidateOraclePost returns an error if an oracle is jailed from a market, or does not have the bond required to post prices
+func (k Keeper) ValidateOraclePost(ctx sdk.Context, marketID string, oracle sdk.AccAddress) error {
+	if record, found := k.GetOracleRecord(ctx, marketID, oracle); found && record.IsJailed(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrOracleJailed, "oracle %s is jailed from market %s until %s", oracle, marketID, record.JailedUntil)
+	}
+	bondParams := k.GetOracleBondParams(ctx)
+	if !k.hasRequiredBond(ctx, oracle, bondParams) {
+		return sdkerrors.Wrapf(types.ErrInsufficientBond, "oracle %s must bond %s", oracle, bondParams.Amount)
+	}
+	return nil
+}
+
+// hasRequiredBond returns true if an oracle's bond meets the amount required to post prices
+func (k Keeper) hasRequiredBond(ctx sdk.Context, oracle sdk.AccAddress, bondParams types.OracleBondParams) bool {
+	if bondParams.Amount.Empty() {
+		return true
+	}
+	bond, found := k.GetOracleBond(ctx, oracle)
+	return found && bond.Amount.IsAllGTE(bondParams.Amount)
+}
+
+// CalculateWeightedMedianPrice calculates the weighted median of the input prices.
+// The median is the lowest price with at least half the total weight at or below it. If exactly half the weight is at or below a price, the median is the mean of that price and the next.
+// With equal weights this is the same as CalculateMedianPrice.
+func (k Keeper) CalculateWeightedMedianPrice(ctx sdk.Context, prices types.WeightedPrices) sdk.Dec {
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].Price.LT(prices[j].Price)
+	})
+	var total uint64
+	for _, p := range prices {
+		total += p.Weight
+	}
+
+	var cumulative uint64
+	for i, p := range prices {
+		cumulative += p.Weight
+		// compare 2 * cumulative with total to avoid rounding the half way point
+		if 2*cumulative == total && i+1 < len(prices) {
+			return p.Price.Add(prices[i+1].Price).Quo(sdk.NewDec(2))
+		}
+		if 2*cumulative >= total {
+			return p.Price
+		}
+	}
+	return prices[len(prices)-1].Price
+}
+
+// updateOracleRecords records each of a market's oracles' deviation from the new median price the first time each of its posts is in a median,
+// or a miss each time an oracle goes a miss interval without a live price. A post stays live over many medians, so it is only recorded once.
+// Oracles that repeatedly post outliers or miss posts are penalized. Jailed oracles are not recorded.
+func (k Keeper) updateOracleRecords(ctx sdk.Context, market types.Market, livePrices types.PostedPrices, median sdk.Dec) {
+	bondParams := k.GetOracleBondParams(ctx)
+	for _, oracle := range market.Oracles {
+		record, found := k.GetOracleRecord(ctx, market.MarketID, oracle)
+		if !found {
+			record = types.NewOracleRecord(market.MarketID, oracle)
+		}
+		if record.IsJailed(ctx.BlockTime()) {
+			continue
+		}
+
+		livePrice, hasLivePrice := findOraclePrice(livePrices, oracle)
+		switch {
+		case hasLivePrice && livePrice.Expiry.Equal(record.LastPostExpiry):
+			// the post has already been recorded
+			continue
+		case hasLivePrice:
+			deviation := sdk.ZeroDec()
+			if median.IsPositive() {
+				deviation = livePrice.Price.Sub(median).Abs().Quo(median)
+			}
+			record.Posts++
+			record.TotalDeviation = record.TotalDeviation.Add(deviation)
+			record.LastDeviation = deviation
+			record.LastPostExpiry = livePrice.Expiry
+			record.ConsecutiveMisses = 0
+			if outlierThreshold := bondParams.GetOutlierThreshold(); outlierThreshold.IsPositive() && deviation.GT(outlierThreshold) {
+				record.Outliers++
+				record.ConsecutiveOutliers++
+			} else {
+				record.ConsecutiveOutliers = 0
+			}
+		default:
+			if !recordMiss(&record, bondParams.MissInterval, ctx.BlockTime()) {
+				k.SetOracleRecord(ctx, record)
+				continue
+			}
+		}
+
+		switch {
+		case bondParams.MaxConsecutiveOutliers > 0 && record.ConsecutiveOutliers >= bondParams.MaxConsecutiveOutliers:
+			record = k.penalizeOracle(ctx, record, bondParams, types.PenaltyOutliers)
+		case bondParams.MaxConsecutiveMisses > 0 && record.ConsecutiveMisses >= bondParams.MaxConsecutiveMisses:
+			record = k.penalizeOracle(ctx, record, bondParams, types.PenaltyMisses)
+		}
+		k.SetOracleRecord(ctx, record)
+	}
+}
+
+// recordMiss adds a miss to the record of an oracle without a live price if it has gone a miss interval without one, returning true if it did.
+// The interval is counted from the later of the oracle's last post expiring and its last miss. Records that have neither start counting from the block time.
+func recordMiss(record *types.OracleRecord, missInterval time.Duration, blockTime time.Time) bool {
+	if missInterval <= 0 {
+		return false
+	}
+	since := record.LastMissTime
+	if record.LastPostExpiry.After(since) {
+		since = record.LastPostExpiry
+	}
+	if since.IsZero() {
+		record.LastMissTime = blockTime
+		return false
+	}
+	if blockTime.Before(since.Add(missInterval)) {
+		return false
+	}
+	record.Misses++
+	record.ConsecutiveMisses++
+	record.LastMissTime = blockTime
+	return true
+}
+
+func findOraclePrice(prices types.PostedPrices, oracle sdk.AccAddress) (types.PostedPrice, bool) {
+	for _, p := range prices {
+		if p.OracleAddress.Equals(oracle) {
+			return p, true
+		}
+	}
+	return types.PostedPrice{}, false
+}
+
+// penalizeOracle burns a fraction of an oracle's bond and jails it from the market, returning its updated record
+func (k Keeper) penalizeOracle(ctx sdk.Context, record types.OracleRecord, bondParams types.OracleBondParams, reason string) types.OracleRecord {
+	slashed := sdk.NewCoins()
+	if bond, found := k.GetOracleBond(ctx, record.Oracle); found {
+		for _, coin := range bond.Amount {
+			slashed = slashed.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(bondParams.GetSlashFraction()).TruncateInt()))
+		}
+		if !slashed.IsZero() {
+			if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, slashed); err != nil {
+				panic(err)
+			}
+			bond.Amount = bond.Amount.Sub(slashed)
+			k.SetOracleBond(ctx, bond)
+		}
+	}
+
+	if bondParams.JailDuration > 0 {
+		record.JailedUntil = ctx.BlockTime().Add(bondParams.JailDuration)
+	}
+	// misses are counted again from when the oracle is released
+	record.LastMissTime = record.JailedUntil
+	if record.LastMissTime.Before(ctx.BlockTime()) {
+		record.LastMissTime = ctx.BlockTime()
+	}
+	record.Penalties++
+	record.ConsecutiveOutliers = 0
+	record.ConsecutiveMisses = 0
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOraclePenalized,
+			sdk.NewAttribute(types.AttributeMarketID, record.MarketID),
+			sdk.NewAttribute(types.AttributeOracle, record.Oracle.String()),
+			sdk.NewAttribute(types.AttributeReason, reason),
+			sdk.NewAttribute(types.AttributeSlashed, slashed.String()),
+			sdk.NewAttribute(types.AttributeJailedUntil, record.JailedUntil.UTC().String()),
+		),
+	)
+	return record
+}
+
+// BondOracle locks coins from an oracle's account in the pricefeed module as the oracle's bond
+func (k Keeper) BondOracle(ctx sdk.Context, oracle sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, oracle, types.ModuleName, amount); err != nil {
+		return err
+	}
+	bond, found := k.GetOracleBond(ctx, oracle)
+	if !found {
+		bond = types.NewOracleBond(oracle, sdk.NewCoins())
+	}
+	bond.Amount = bond.Amount.Add(amount...)
+	k.SetOracleBond(ctx, bond)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleBonded,
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// UnbondOracle returns coins from an oracle's bond to the oracle. Oracles jailed from any market cannot unbond.
+// Prices posted by oracles left with less than the required bond are dropped from market medians, so unbonding cannot be used to escape a penalty.
+func (k Keeper) UnbondOracle(ctx sdk.Context, oracle sdk.AccAddress, amount sdk.Coins) error {
+	bond, found := k.GetOracleBond(ctx, oracle)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrBondNotFound, "%s", oracle)
+	}
+	var jailedErr error
+	k.IterateOracleRecords(ctx, func(record types.OracleRecord) (stop bool) {
+		if record.Oracle.Equals(oracle) && record.IsJailed(ctx.BlockTime()) {
+			jailedErr = sdkerrors.Wrapf(types.ErrOracleJailed, "oracle %s is jailed from market %s until %s", oracle, record.MarketID, record.JailedUntil)
+			return true
+		}
+		return false
+	})
+	if jailedErr != nil {
+		return jailedErr
+	}
+	remaining, isNegative := bond.Amount.SafeSub(amount)
+	if isNegative {
+		return sdkerrors.Wrapf(types.ErrInsufficientBond, "cannot unbond %s from bond of %s", amount, bond.Amount)
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, oracle, amount); err != nil {
+		return err
+	}
+	bond.Amount = remaining
+	k.SetOracleBond(ctx, bond)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOracleUnbonded,
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// GetModuleAccount returns the pricefeed module account, which holds the oracle bonds
+func (k Keeper) GetModuleAccount(ctx sdk.Context) supplyexported.ModuleAccountI {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// GetOracleBond returns the bond of an oracle
+func (k Keeper) GetOracleBond(ctx sdk.Context, oracle sdk.AccAddress) (types.OracleBond, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleBondKey(oracle))
+	if bz == nil {
+		return types.OracleBond{}, false
+	}
+	var bond types.OracleBond
+	k.cdc.MustUnmarshalBinaryBare(bz, &bond)
+	return bond, true
+}
+
+// SetOracleBond stores the bond of an oracle, deleting it if it is empty
+func (k Keeper) SetOracleBond(ctx sdk.Context, bond types.OracleBond) {
+	store := ctx.KVStore(k.key)
+	if bond.Amount.IsZero() {
+		store.Delete(types.OracleBondKey(bond.Oracle))
+		return
+	}
+	store.Set(types.OracleBondKey(bond.Oracle), k.cdc.MustMarshalBinaryBare(bond))
+}
+
+// IterateOracleBonds iterates over all oracle bonds in the store and performs a callback function
+func (k Keeper) IterateOracleBonds(ctx sdk.Context, cb func(bond types.OracleBond) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OracleBondPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bond types.OracleBond
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &bond)
+		if cb(bond) {
+			break
+		}
+	}
+}
+
+// GetOracleBonds returns all oracle bonds from the store
+func (k Keeper) GetOracleBonds(ctx sdk.Context) types.OracleBonds {
+	bonds := types.OracleBonds{}
+	k.IterateOracleBonds(ctx, func(bond types.OracleBond) (stop bool) {
+		bonds = append(bonds, bond)
+		return false
+	})
+	return bonds
+}
+
+// GetOracleRecord returns the record of an oracle's posts to a market
+func (k Keeper) GetOracleRecord(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OracleRecord, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleRecordKey(marketID, oracle))
+	if bz == nil {
+		return types.OracleRecord{}, false
+	}
+	var record types.OracleRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, true
+}
+
+// SetOracleRecord stores the record of an oracle's posts to a market
+func (k Keeper) SetOracleRecord(ctx sdk.Context, record types.OracleRecord) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleRecordKey(record.MarketID, record.Oracle), k.cdc.MustMarshalBinaryBare(record))
+}
+
+// IterateOracleRecords iterates over all oracle records in the store, ordered by market, and performs a callback function
+func (k Keeper) IterateOracleRecords(ctx sdk.Context, cb func(record types.OracleRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OracleRecordPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.OracleRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetOracleRecords returns all oracle records from the store
+func (k Keeper) GetOracleRecords(ctx sdk.Context) types.OracleRecords {
+	records := types.OracleRecords{}
+	k.IterateOracleRecords(ctx, func(record types.OracleRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// GetMarketOracleRecords returns the records of oracles' posts to a market
+func (k Keeper) GetMarketOracleRecords(ctx sdk.Context, marketID string) types.OracleRecords {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OracleRecordMarketKey(marketID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	records := types.OracleRecords{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.OracleRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
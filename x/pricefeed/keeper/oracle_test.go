@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestKeeper_CalculateWeightedMedianPrice(t *testing.T) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{})
+	k := tApp.GetPriceFeedKeeper()
+
+	wp := func(price string, weight uint64) types.WeightedPrice {
+		return types.WeightedPrice{Price: sdk.MustNewDecFromStr(price), Weight: weight}
+	}
+	testCases := []struct {
+		name     string
+		prices   types.WeightedPrices
+		expected string
+	}{
+		{"single price", types.WeightedPrices{wp("100", 1)}, "100"},
+		{"equal weights, odd count", types.WeightedPrices{wp("120", 1), wp("100", 1), wp("110", 1)}, "110"},
+		{"equal weights, even count", types.WeightedPrices{wp("120", 1), wp("100", 1), wp("110", 1), wp("130", 1)}, "115"},
+		{"heavy high price", types.WeightedPrices{wp("120", 3), wp("100", 1), wp("110", 1)}, "120"},
+		{"heavy low price", types.WeightedPrices{wp("120", 1), wp("100", 2), wp("110", 1)}, "105"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, sdk.MustNewDecFromStr(tc.expected), k.CalculateWeightedMedianPrice(ctx, tc.prices))
+		})
+	}
+}
+
+// TestKeeper_OracleWeights tests a market with oracle weights is priced at the weighted median
+func TestKeeper_OracleWeights(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)})
+	k := tApp.GetPriceFeedKeeper()
+
+	market := types.NewMarket("tstusd", "tst", "usd", addrs, true)
+	market.OracleWeights = types.OracleWeights{types.NewOracleWeight(addrs[2], 3)}
+	k.SetParams(ctx, types.NewParams(types.Markets{market}, types.DefaultOracleBond))
+
+	for i, price := range []string{"100", "110", "120"} {
+		_, err := k.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+	}
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	price, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("120"), price.Price)
+}
+
+// TestKeeper_OracleBond tests oracles must bond to post prices, and oracles that repeatedly post outliers are slashed and jailed
+func TestKeeper_OracleBond(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	coins := []sdk.Coins{}
+	for range addrs {
+		coins = append(coins, ukava(1000))
+	}
+	tApp.InitializeFromGenesisStates(app.NewAuthGenState(addrs, coins))
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: startTime})
+	k := tApp.GetPriceFeedKeeper()
+
+	bondParams := types.NewOracleBondParams(ukava(100), sdk.MustNewDecFromStr("0.1"), 2, 2, 30*time.Minute, sdk.MustNewDecFromStr("0.5"), time.Hour)
+	k.SetParams(ctx, types.NewParams(types.Markets{
+		types.NewMarket("tstusd", "tst", "usd", addrs, true),
+	}, bondParams))
+
+	// Oracles without a bond cannot post
+	err := k.ValidateOraclePost(ctx, "tstusd", addrs[0])
+	require.True(t, errors.Is(err, types.ErrInsufficientBond))
+	require.True(t, errors.Is(k.BondOracle(ctx, addrs[0], ukava(2000)), sdkerrors.ErrInsufficientFunds))
+
+	for _, oracle := range addrs {
+		require.NoError(t, k.BondOracle(ctx, oracle, ukava(100)))
+		require.NoError(t, k.ValidateOraclePost(ctx, "tstusd", oracle))
+	}
+	tApp.CheckBalance(t, ctx, addrs[0], ukava(900))
+	require.Equal(t, ukava(300), k.GetModuleAccount(ctx).GetCoins())
+
+	// Two posts in a row that are outliers penalize the oracle that posted them
+	for i, price := range []string{"100", "100", "150"} {
+		_, err := k.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), startTime.Add(time.Hour))
+		require.NoError(t, err)
+	}
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	record, found := k.GetOracleRecord(ctx, "tstusd", addrs[2])
+	require.True(t, found)
+	require.Equal(t, uint64(1), record.ConsecutiveOutliers)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), record.LastDeviation)
+
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(time.Minute))
+	_, err = k.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("150"), startTime.Add(time.Hour+time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	record, _ = k.GetOracleRecord(ctx, "tstusd", addrs[2])
+	require.Equal(t, uint64(2), record.Posts)
+	require.Equal(t, uint64(2), record.Outliers)
+	require.Equal(t, uint64(0), record.ConsecutiveOutliers)
+	require.Equal(t, uint64(1), record.Penalties)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), record.AverageDeviation())
+	require.Equal(t, startTime.Add(time.Minute+time.Hour), record.JailedUntil)
+
+	bond, found := k.GetOracleBond(ctx, addrs[2])
+	require.True(t, found)
+	require.Equal(t, ukava(50), bond.Amount)
+	require.Equal(t, ukava(250), k.GetModuleAccount(ctx).GetCoins())
+
+	// Jailed oracles cannot post or unbond, and their prices are left out of the median
+	err = k.ValidateOraclePost(ctx, "tstusd", addrs[2])
+	require.True(t, errors.Is(err, types.ErrOracleJailed))
+	err = k.UnbondOracle(ctx, addrs[2], ukava(50))
+	require.True(t, errors.Is(err, types.ErrOracleJailed))
+
+	// Oracles that post close to the median have full uptime and no outliers
+	record, _ = k.GetOracleRecord(ctx, "tstusd", addrs[0])
+	require.Equal(t, sdk.OneDec(), record.Uptime())
+	require.Equal(t, uint64(0), record.Outliers)
+	require.Equal(t, sdk.ZeroDec(), record.AverageDeviation())
+
+	// Once the prices expire, oracles miss a post each miss interval and are penalized for missing too many
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(2 * time.Hour))
+	require.True(t, errors.Is(k.SetCurrentPrices(ctx, "tstusd"), types.ErrNoValidPrice))
+	_, err = k.SetPrice(ctx, addrs[1], "tstusd", sdk.MustNewDecFromStr("100"), startTime.Add(3*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	record, _ = k.GetOracleRecord(ctx, "tstusd", addrs[0])
+	require.Equal(t, uint64(1), record.ConsecutiveMisses)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), record.Uptime())
+
+	// Medians within the miss interval do not add misses
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(2*time.Hour + time.Minute))
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	record, _ = k.GetOracleRecord(ctx, "tstusd", addrs[0])
+	require.Equal(t, uint64(1), record.Misses)
+
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(startTime.Add(2*time.Hour + 30*time.Minute))
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	record, _ = k.GetOracleRecord(ctx, "tstusd", addrs[0])
+	require.Equal(t, uint64(1), record.Penalties)
+	require.True(t, record.IsJailed(ctx.BlockTime()))
+	record, _ = k.GetOracleRecord(ctx, "tstusd", addrs[2])
+	require.Equal(t, uint64(2), record.Penalties)
+	bond, _ = k.GetOracleBond(ctx, addrs[2])
+	require.Equal(t, ukava(25), bond.Amount)
+
+	// Unbonding returns the bond once the oracle is released
+	ctx = ctx.WithBlockHeight(6).WithBlockTime(startTime.Add(4 * time.Hour))
+	require.NoError(t, k.UnbondOracle(ctx, addrs[2], ukava(25)))
+	_, found = k.GetOracleBond(ctx, addrs[2])
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, addrs[2], ukava(925))
+
+	err = k.UnbondOracle(ctx, addrs[1], ukava(200))
+	require.True(t, errors.Is(err, types.ErrInsufficientBond))
+	err = k.UnbondOracle(ctx, addrs[2], ukava(1))
+	require.True(t, errors.Is(err, types.ErrBondNotFound))
+}
+
+// TestKeeper_OracleStalePost tests a post that stays live over many medians is only recorded once, so a single outlier is not penalized repeatedly
+func TestKeeper_OracleStalePost(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	coins := []sdk.Coins{}
+	for range addrs {
+		coins = append(coins, ukava(1000))
+	}
+	tApp.InitializeFromGenesisStates(app.NewAuthGenState(addrs, coins))
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: startTime})
+	k := tApp.GetPriceFeedKeeper()
+
+	bondParams := types.NewOracleBondParams(ukava(100), sdk.MustNewDecFromStr("0.1"), 3, 3, 30*time.Minute, sdk.MustNewDecFromStr("0.5"), time.Hour)
+	k.SetParams(ctx, types.NewParams(types.Markets{
+		types.NewMarket("tstusd", "tst", "usd", addrs, true),
+	}, bondParams))
+	for _, oracle := range addrs {
+		require.NoError(t, k.BondOracle(ctx, oracle, ukava(100)))
+	}
+	for i, price := range []string{"100", "100", "150"} {
+		_, err := k.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), startTime.Add(time.Hour))
+		require.NoError(t, err)
+	}
+
+	// The outlier stays live for many more medians than the outliers that penalize an oracle
+	for height := int64(1); height <= 50; height++ {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(startTime.Add(time.Duration(height) * 6 * time.Second))
+		require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	}
+	record, found := k.GetOracleRecord(ctx, "tstusd", addrs[2])
+	require.True(t, found)
+	require.Equal(t, uint64(1), record.Posts)
+	require.Equal(t, uint64(1), record.Outliers)
+	require.Equal(t, uint64(1), record.ConsecutiveOutliers)
+	require.Equal(t, uint64(0), record.Misses)
+	require.Equal(t, uint64(0), record.Penalties)
+	require.False(t, record.IsJailed(ctx.BlockTime()))
+	bond, _ := k.GetOracleBond(ctx, addrs[2])
+	require.Equal(t, ukava(100), bond.Amount)
+}
+
+// TestKeeper_OracleUnbondAfterPost tests an oracle cannot post a price and unbond to escape being penalized for it
+func TestKeeper_OracleUnbondAfterPost(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	coins := []sdk.Coins{}
+	for range addrs {
+		coins = append(coins, ukava(1000))
+	}
+	tApp.InitializeFromGenesisStates(app.NewAuthGenState(addrs, coins))
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: startTime})
+	k := tApp.GetPriceFeedKeeper()
+
+	bondParams := types.NewOracleBondParams(ukava(100), sdk.MustNewDecFromStr("0.1"), 1, 2, 30*time.Minute, sdk.MustNewDecFromStr("0.5"), time.Hour)
+	k.SetParams(ctx, types.NewParams(types.Markets{
+		types.NewMarket("tstusd", "tst", "usd", addrs, true),
+	}, bondParams))
+	for _, oracle := range addrs {
+		require.NoError(t, k.BondOracle(ctx, oracle, ukava(100)))
+	}
+
+	// An oracle posts an outlier with a long expiry, then unbonds in the same block
+	for i, price := range []string{"100", "110", "1000"} {
+		_, err := k.SetPrice(ctx, addrs[i], "tstusd", sdk.MustNewDecFromStr(price), startTime.Add(24*time.Hour))
+		require.NoError(t, err)
+	}
+	require.NoError(t, k.UnbondOracle(ctx, addrs[2], ukava(1)))
+
+	// Its price is left out of the median, and is not recorded rather than slashed
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	price, err := k.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("105"), price.Price)
+	record, found := k.GetOracleRecord(ctx, "tstusd", addrs[2])
+	require.True(t, found)
+	require.Equal(t, uint64(0), record.Posts)
+	require.Equal(t, uint64(0), record.Outliers)
+
+	// Topping the bond back up restores the oracle's price, which is then penalized as an outlier
+	require.NoError(t, k.BondOracle(ctx, addrs[2], ukava(1)))
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(time.Minute))
+	require.NoError(t, k.SetCurrentPrices(ctx, "tstusd"))
+	record, _ = k.GetOracleRecord(ctx, "tstusd", addrs[2])
+	require.Equal(t, uint64(1), record.Penalties)
+	bond, _ := k.GetOracleBond(ctx, addrs[2])
+	require.Equal(t, ukava(50), bond.Amount)
+}
+
+func ukava(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("ukava", amount))
+}
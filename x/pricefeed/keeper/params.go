@@ -24,6 +24,11 @@ func (k Keeper) GetMarkets(ctx sdk.Context) types.Markets {
 	return k.GetParams(ctx).Markets
 }
 
+// GetOracleBondParams returns the oracle bond params
+func (k Keeper) GetOracleBondParams(ctx sdk.Context) types.OracleBondParams {
+	return k.GetParams(ctx).OracleBond
+}
+
 // GetOracles returns the oracles in the pricefeed store
 func (k Keeper) GetOracles(ctx sdk.Context, marketID string) ([]sdk.AccAddress, error) {
 	for _, m := range k.GetMarkets(ctx) {
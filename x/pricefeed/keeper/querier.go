@@ -26,6 +26,18 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryMarkets(ctx, req, keeper)
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, keeper)
+		case types.QueryMarketHealth:
+			return queryMarketHealth(ctx, req, keeper)
+		case types.QueryGuardTrips:
+			return queryGuardTrips(ctx, req, keeper)
+		case types.QueryOracleRecords:
+			return queryOracleRecords(ctx, req, keeper)
+		case types.QueryOracleBond:
+			return queryOracleBond(ctx, req, keeper)
+		case types.QueryPriceCommits:
+			return queryPriceCommits(ctx, req, keeper)
+		case types.QueryHistoricalPrices:
+			return queryHistoricalPrices(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -130,3 +142,126 @@ func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]by
 	}
 	return bz, nil
 }
+
+func queryMarketHealth(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	healths := types.MarketHealths{}
+	for _, market := range keeper.GetMarkets(ctx) {
+		healths = append(healths, keeper.GetMarketHealth(ctx, market.MarketID))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, healths)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGuardTrips(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryWithMarketIDParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var trips types.GuardTrips
+	if requestParams.MarketID == "" {
+		trips = keeper.GetGuardTrips(ctx)
+	} else {
+		if _, found := keeper.GetMarket(ctx, requestParams.MarketID); !found {
+			return nil, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+		}
+		trips = keeper.GetMarketGuardTrips(ctx, requestParams.MarketID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, trips)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryOracleRecords(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryWithMarketIDParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var records types.OracleRecords
+	if requestParams.MarketID == "" {
+		records = keeper.GetOracleRecords(ctx)
+	} else {
+		if _, found := keeper.GetMarket(ctx, requestParams.MarketID); !found {
+			return nil, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+		}
+		records = keeper.GetMarketOracleRecords(ctx, requestParams.MarketID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryOracleBond(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryOracleBondParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	bond, found := keeper.GetOracleBond(ctx, requestParams.Oracle)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrBondNotFound, "%s", requestParams.Oracle)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, bond)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPriceCommits(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryWithMarketIDParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var commits types.PriceCommits
+	if requestParams.MarketID == "" {
+		commits = keeper.GetPriceCommits(ctx)
+	} else {
+		if _, found := keeper.GetMarket(ctx, requestParams.MarketID); !found {
+			return nil, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+		}
+		commits = keeper.GetMarketPriceCommits(ctx, requestParams.MarketID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, commits)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryHistoricalPrices(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryHistoricalPricesParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if _, found := keeper.GetMarket(ctx, requestParams.MarketID); !found {
+		return nil, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+	prices := keeper.GetMarketHistoricalPricesInRange(ctx, requestParams.MarketID, requestParams.MinHeight, requestParams.MaxHeight, requestParams.StartTime, requestParams.EndTime)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, prices)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
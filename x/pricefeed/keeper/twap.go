@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// recordPriceObservation adds a market's new median price to its price accumulator.
+// Observations older than the longest window any TWAP market averages the market over are pruned.
+func (k Keeper) recordPriceObservation(ctx sdk.Context, marketID string, price sdk.Dec) {
+	cumulative := sdk.ZeroDec()
+	if latest, found := k.getLatestPriceObservation(ctx, marketID); found {
+		cumulative = latest.CumulativeAt(ctx.BlockTime())
+	}
+	k.SetPriceObservation(ctx, types.NewPriceObservation(marketID, ctx.BlockTime(), price, cumulative))
+
+	k.prunePriceObservations(ctx, marketID, ctx.BlockTime().Add(-k.GetMarkets(ctx).MaxTWAPWindow(marketID)))
+}
+
+// prunePriceObservations deletes a market's observations from before a cutoff time, except the last one, which is needed to find the accumulator's value at the cutoff.
+func (k Keeper) prunePriceObservations(ctx sdk.Context, marketID string, cutoff time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationMarketKey(marketID))
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(cutoff.Add(time.Nanosecond)))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for i := 0; i < len(keys)-1; i++ {
+		store.Delete(keys[i])
+	}
+}
+
+// SetPriceObservation stores a price observation
+func (k Keeper) SetPriceObservation(ctx sdk.Context, observation types.PriceObservation) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceObservationKey(observation.MarketID, observation.Time), k.cdc.MustMarshalBinaryBare(observation))
+}
+
+// IteratePriceObservations iterates over all price observations in the store, ordered by market then time, and performs a callback function
+func (k Keeper) IteratePriceObservations(ctx sdk.Context, cb func(po types.PriceObservation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceObservationPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var po types.PriceObservation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &po)
+		if cb(po) {
+			break
+		}
+	}
+}
+
+// GetPriceObservations returns all price observations from the store
+func (k Keeper) GetPriceObservations(ctx sdk.Context) types.PriceObservations {
+	observations := types.PriceObservations{}
+	k.IteratePriceObservations(ctx, func(po types.PriceObservation) (stop bool) {
+		observations = append(observations, po)
+		return false
+	})
+	return observations
+}
+
+func (k Keeper) getLatestPriceObservation(ctx sdk.Context, marketID string) (types.PriceObservation, bool) {
+	return k.getLastPriceObservationBefore(ctx, marketID, nil)
+}
+
+// getLastPriceObservationBefore returns a market's last observation with a key before end. A nil end returns the market's latest observation.
+func (k Keeper) getLastPriceObservationBefore(ctx sdk.Context, marketID string, end []byte) (types.PriceObservation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationMarketKey(marketID))
+	iterator := store.ReverseIterator(nil, end)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PriceObservation{}, false
+	}
+	var po types.PriceObservation
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &po)
+	return po, true
+}
+
+func (k Keeper) getFirstPriceObservation(ctx sdk.Context, marketID string) (types.PriceObservation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceObservationMarketKey(marketID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PriceObservation{}, false
+	}
+	var po types.PriceObservation
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &po)
+	return po, true
+}
+
+// GetTWAP calculates the time weighted average of a market's median price over a window ending at the current block time.
+// If the market's observations do not cover the whole window, the average is taken over the time they do cover.
+func (k Keeper) GetTWAP(ctx sdk.Context, marketID string, window time.Duration) (sdk.Dec, error) {
+	now := ctx.BlockTime()
+	latest, found := k.getLatestPriceObservation(ctx, marketID)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoValidPrice, "no price observations for market %s", marketID)
+	}
+
+	start := now.Add(-window)
+	startObservation, found := k.getLastPriceObservationBefore(ctx, marketID, sdk.FormatTimeBytes(start.Add(time.Nanosecond)))
+	if !found {
+		startObservation, _ = k.getFirstPriceObservation(ctx, marketID)
+		start = startObservation.Time
+	}
+	if !now.After(start) {
+		return latest.Price, nil
+	}
+
+	total := latest.CumulativeAt(now).Sub(startObservation.CumulativeAt(start))
+	return total.Quo(types.DurationSeconds(now.Sub(start))), nil
+}
+
+// setTWAPCurrentPrice updates the price of a TWAP market to the time weighted average price of the market it averages.
+// TWAP markets have no valid price while the market they average has none.
+func (k Keeper) setTWAPCurrentPrice(ctx sdk.Context, market types.Market) error {
+	if _, err := k.GetCurrentPrice(ctx, market.TWAP.MarketID); err != nil {
+		k.setCurrentPrice(ctx, market.MarketID, types.CurrentPrice{})
+		return types.ErrNoValidPrice
+	}
+	twap, err := k.GetTWAP(ctx, market.TWAP.MarketID, market.TWAP.Window)
+	if err != nil {
+		k.setCurrentPrice(ctx, market.MarketID, types.CurrentPrice{})
+		return types.ErrNoValidPrice
+	}
+	k.updateCurrentPrice(ctx, market.MarketID, twap)
+	return nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_TWAP tests TWAP markets are priced at the time weighted average of the market they average
+func TestKeeper_TWAP(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	oracle := addrs[0]
+	tApp := app.NewTestApp()
+	startTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.NewParams(types.Markets{
+		types.NewMarket("tstusd", "tst", "usd", []sdk.AccAddress{oracle}, true),
+		types.NewTWAPMarket("tstusd:twap", "tst", "usd", "tstusd", time.Hour, true),
+	}, types.DefaultOracleBond))
+	expiry := startTime.Add(24 * time.Hour)
+	updatePrices := func(ctx sdk.Context) {
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd:twap"))
+	}
+
+	// TWAP markets have no price until the market they average has one
+	err := keeper.SetCurrentPrices(ctx, "tstusd:twap")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+
+	// With a single observation the TWAP is the observed price
+	_, err = keeper.SetPrice(ctx, oracle, "tstusd", sdk.MustNewDecFromStr("100"), expiry)
+	require.NoError(t, err)
+	updatePrices(ctx)
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd:twap")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("100"), price.Price)
+
+	// Before the observations cover the window, the average is taken over the time they do cover
+	ctx = ctx.WithBlockTime(startTime.Add(15 * time.Minute))
+	_, err = keeper.SetPrice(ctx, oracle, "tstusd", sdk.MustNewDecFromStr("200"), expiry)
+	require.NoError(t, err)
+	updatePrices(ctx)
+	ctx = ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	updatePrices(ctx)
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd:twap")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("150"), price.Price) // 100 for 15 minutes, 200 for 15 minutes
+
+	// The last observed price is held until the next observation
+	ctx = ctx.WithBlockTime(startTime.Add(80 * time.Minute))
+	twap, err := keeper.GetTWAP(ctx, "tstusd", 80*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("181.25"), twap) // 100 for 15 minutes, 200 for 65 minutes
+
+	// Once the window is covered, prices from before it are not included
+	updatePrices(ctx)
+	price, err = keeper.GetCurrentPrice(ctx, "tstusd:twap")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("200"), price.Price)
+
+	// Observations older than the window are pruned, keeping the last one before it
+	observations := keeper.GetPriceObservations(ctx)
+	require.Equal(t, types.PriceObservations{
+		types.NewPriceObservation("tstusd", startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("200"), sdk.MustNewDecFromStr("90000")),
+		types.NewPriceObservation("tstusd", startTime.Add(30*time.Minute), sdk.MustNewDecFromStr("200"), sdk.MustNewDecFromStr("270000")),
+		types.NewPriceObservation("tstusd", startTime.Add(80*time.Minute), sdk.MustNewDecFromStr("200"), sdk.MustNewDecFromStr("870000")),
+	}, observations)
+
+	// TWAP markets have no price while the market they average has none
+	ctx = ctx.WithBlockTime(expiry)
+	err = keeper.SetCurrentPrices(ctx, "tstusd")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+	err = keeper.SetCurrentPrices(ctx, "tstusd:twap")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd:twap")
+	require.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/tendermint/tendermint/libs/kv"
 
@@ -14,6 +15,53 @@ import (
 // DecodeStore unmarshals the KVPair's Value to the corresponding pricefeed type
 func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 	switch {
+	case bytes.Equal(kvA.Key[:1], types.PriceObservationPrefix):
+		var observationA, observationB types.PriceObservation
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &observationA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &observationB)
+		return fmt.Sprintf("%s\n%s", observationA, observationB)
+
+	case bytes.Equal(kvA.Key[:1], types.GuardTripPrefix):
+		var tripA, tripB types.GuardTrip
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &tripA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &tripB)
+		return fmt.Sprintf("%s\n%s", tripA, tripB)
+
+	case bytes.Equal(kvA.Key[:1], types.LastPostTimePrefix):
+		timeA, err := sdk.ParseTimeBytes(kvA.Value)
+		if err != nil {
+			panic(err)
+		}
+		timeB, err := sdk.ParseTimeBytes(kvB.Value)
+		if err != nil {
+			panic(err)
+		}
+		return fmt.Sprintf("%s\n%s", timeA, timeB)
+
+	case bytes.Equal(kvA.Key[:1], types.OracleRecordPrefix):
+		var recordA, recordB types.OracleRecord
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+		return fmt.Sprintf("%s\n%s", recordA, recordB)
+
+	case bytes.Equal(kvA.Key[:1], types.OracleBondPrefix):
+		var bondA, bondB types.OracleBond
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &bondA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &bondB)
+		return fmt.Sprintf("%s\n%s", bondA, bondB)
+
+	case bytes.Equal(kvA.Key[:1], types.PriceCommitPrefix):
+		var commitA, commitB types.PriceCommit
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &commitA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &commitB)
+		return fmt.Sprintf("%s\n%s", commitA, commitB)
+
+	case bytes.Equal(kvA.Key[:1], types.HistoricalPricePrefix):
+		var priceA, priceB types.HistoricalPrice
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &priceA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &priceB)
+		return fmt.Sprintf("%s\n%s", priceA, priceB)
+
 	case bytes.Contains(kvA.Key, []byte(types.CurrentPricePrefix)):
 		var priceA, priceB types.CurrentPrice
 		cdc.MustUnmarshalBinaryBare(kvA.Value, &priceA)
@@ -28,10 +28,12 @@ func TestDecodeDistributionStore(t *testing.T) {
 
 	currentPrice := types.CurrentPrice{MarketID: "current", Price: sdk.OneDec()}
 	postedPrice := []types.PostedPrice{{MarketID: "posted", Price: sdk.OneDec(), Expiry: time.Now().UTC()}}
+	observation := types.NewPriceObservation("observed", time.Now().UTC(), sdk.OneDec(), sdk.OneDec())
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: []byte(types.CurrentPricePrefix), Value: cdc.MustMarshalBinaryBare(currentPrice)},
 		kv.Pair{Key: []byte(types.RawPriceFeedPrefix), Value: cdc.MustMarshalBinaryBare(postedPrice)},
+		kv.Pair{Key: types.PriceObservationKey(observation.MarketID, observation.Time), Value: cdc.MustMarshalBinaryBare(observation)},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -41,6 +43,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 	}{
 		{"CurrentPrice", fmt.Sprintf("%v\n%v", currentPrice, currentPrice)},
 		{"PostedPrice", fmt.Sprintf("%s\n%s", postedPrice, postedPrice)},
+		{"PriceObservation", fmt.Sprintf("%s\n%s", observation, observation)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
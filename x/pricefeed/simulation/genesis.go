@@ -54,8 +54,8 @@ func loadPricefeedGenState(simState *module.SimulationState) pricefeed.GenesisSt
 		markets = append(markets, market)
 		postedPrices = append(postedPrices, postedPrice)
 	}
-	params := pricefeed.NewParams(markets)
-	return pricefeed.NewGenesisState(params, postedPrices)
+	params := pricefeed.NewParams(markets, pricefeed.DefaultOracleBond)
+	return pricefeed.NewGenesisState(params, postedPrices, nil, nil, nil, nil, nil, nil)
 }
 
 // getInitialPrice gets the starting price for each of the base assets
@@ -13,4 +13,9 @@ func init() {
 // RegisterCodec registers concrete types on the Amino code
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgPostPrice{}, "pricefeed/MsgPostPrice", nil)
+	cdc.RegisterConcrete(MsgPostPrices{}, "pricefeed/MsgPostPrices", nil)
+	cdc.RegisterConcrete(MsgCommitPrice{}, "pricefeed/MsgCommitPrice", nil)
+	cdc.RegisterConcrete(MsgRevealPrice{}, "pricefeed/MsgRevealPrice", nil)
+	cdc.RegisterConcrete(MsgBondOracle{}, "pricefeed/MsgBondOracle", nil)
+	cdc.RegisterConcrete(MsgUnbondOracle{}, "pricefeed/MsgUnbondOracle", nil)
 }
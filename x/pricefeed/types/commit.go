@@ -0,0 +1,88 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// MaxSaltLength is the longest salt an oracle can commit a price with
+const MaxSaltLength = 64
+
+// PriceCommit is an oracle's commitment to a market price, which the oracle reveals in the vote period after the one it committed in.
+type PriceCommit struct {
+	MarketID   string           `json:"market_id" yaml:"market_id"`
+	Oracle     sdk.AccAddress   `json:"oracle" yaml:"oracle"`
+	Hash       tmbytes.HexBytes `json:"hash" yaml:"hash"`               // hash of the price with a salt, see PriceCommitHash
+	VotePeriod int64            `json:"vote_period" yaml:"vote_period"` // vote period the price was committed in
+}
+
+// NewPriceCommit returns a new PriceCommit
+func NewPriceCommit(marketID string, oracle sdk.AccAddress, hash []byte, votePeriod int64) PriceCommit {
+	return PriceCommit{
+		MarketID:   marketID,
+		Oracle:     oracle,
+		Hash:       hash,
+		VotePeriod: votePeriod,
+	}
+}
+
+// PriceCommitHash returns the hash an oracle commits to a price with. The market and oracle are included so commits cannot be copied.
+func PriceCommitHash(salt string, price sdk.Dec, marketID string, oracle sdk.AccAddress) []byte {
+	return tmhash.Sum([]byte(fmt.Sprintf("%s:%s:%s:%s", salt, price, marketID, oracle)))
+}
+
+// Matches returns true if a revealed price and salt match the commit
+func (pc PriceCommit) Matches(salt string, price sdk.Dec) bool {
+	return bytes.Equal(pc.Hash, PriceCommitHash(salt, price, pc.MarketID, pc.Oracle))
+}
+
+// Validate performs a basic check of a PriceCommit
+func (pc PriceCommit) Validate() error {
+	if strings.TrimSpace(pc.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if pc.Oracle.Empty() {
+		return errors.New("oracle cannot be empty")
+	}
+	if len(pc.Hash) != tmhash.Size {
+		return fmt.Errorf("commit hash must be %d bytes: %s", tmhash.Size, pc.Hash)
+	}
+	if pc.VotePeriod < 0 {
+		return fmt.Errorf("vote period cannot be negative: %d", pc.VotePeriod)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (pc PriceCommit) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Oracle: %s
+Hash: %s
+Vote Period: %d`, pc.MarketID, pc.Oracle, pc.Hash, pc.VotePeriod))
+}
+
+// PriceCommits type for an array of PriceCommit
+type PriceCommits []PriceCommit
+
+// Validate checks all the commits are valid and there is at most one per market, oracle and vote period
+func (pcs PriceCommits) Validate() error {
+	seenCommits := make(map[string]bool)
+	for _, pc := range pcs {
+		if err := pc.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s/%s/%d", pc.MarketID, pc.Oracle, pc.VotePeriod)
+		if seenCommits[key] {
+			return fmt.Errorf("duplicated commit for oracle %s in market %s in vote period %d", pc.Oracle, pc.MarketID, pc.VotePeriod)
+		}
+		seenCommits[key] = true
+	}
+	return nil
+}
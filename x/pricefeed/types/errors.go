@@ -19,4 +19,20 @@ var (
 	ErrInvalidOracle = sdkerrors.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = sdkerrors.Register(ModuleName, 7, "asset not found")
+	// ErrOracleJailed error for posted price messages from oracles jailed from the market
+	ErrOracleJailed = sdkerrors.Register(ModuleName, 8, "oracle is jailed")
+	// ErrInsufficientBond error for posted price messages from oracles without the required bond
+	ErrInsufficientBond = sdkerrors.Register(ModuleName, 9, "oracle bond is insufficient")
+	// ErrBondNotFound error for unbonding an oracle with no bond
+	ErrBondNotFound = sdkerrors.Register(ModuleName, 10, "oracle bond not found")
+	// ErrCommitRevealRequired error for posted price messages for markets oracles must commit prices to
+	ErrCommitRevealRequired = sdkerrors.Register(ModuleName, 11, "market requires prices to be committed and revealed")
+	// ErrNotCommitReveal error for commit and reveal messages for markets oracles post prices to directly
+	ErrNotCommitReveal = sdkerrors.Register(ModuleName, 12, "market does not use commit-reveal")
+	// ErrCommitNotFound error for revealing a price without a commit
+	ErrCommitNotFound = sdkerrors.Register(ModuleName, 13, "price commit not found")
+	// ErrInvalidRevealPeriod error for revealing a price outside the vote period after the one it was committed in
+	ErrInvalidRevealPeriod = sdkerrors.Register(ModuleName, 14, "price must be revealed in the vote period after it was committed")
+	// ErrRevealMismatch error for revealing a price and salt that do not match the commit
+	ErrRevealMismatch = sdkerrors.Register(ModuleName, 15, "revealed price does not match commit")
 )
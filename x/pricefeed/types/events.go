@@ -5,10 +5,22 @@ const (
 	EventTypeMarketPriceUpdated = "market_price_updated"
 	EventTypeOracleUpdatedPrice = "oracle_updated_price"
 	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketGuardTripped = "market_guard_tripped"
+	EventTypeMarketRecovered    = "market_recovered"
+	EventTypeOracleBonded       = "oracle_bonded"
+	EventTypeOracleUnbonded     = "oracle_unbonded"
+	EventTypeOraclePenalized    = "oracle_penalized"
+	EventTypeOracleCommitted    = "oracle_committed_price"
 
 	AttributeValueCategory = ModuleName
 	AttributeMarketID      = "market_id"
 	AttributeMarketPrice   = "market_price"
 	AttributeOracle        = "oracle"
 	AttributeExpiry        = "expiry"
+	AttributeReason        = "reason"
+	AttributePrevPrice     = "prev_market_price"
+	AttributeAmount        = "amount"
+	AttributeSlashed       = "slashed"
+	AttributeJailedUntil   = "jailed_until"
+	AttributeVotePeriod    = "vote_period"
 )
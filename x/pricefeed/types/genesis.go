@@ -6,15 +6,28 @@ import (
 
 // GenesisState - pricefeed state that must be provided at genesis
 type GenesisState struct {
-	Params       Params       `json:"params" yaml:"params"`
-	PostedPrices PostedPrices `json:"posted_prices" yaml:"posted_prices"`
+	Params        Params            `json:"params" yaml:"params"`
+	PostedPrices  PostedPrices      `json:"posted_prices" yaml:"posted_prices"`
+	Observations  PriceObservations `json:"observations" yaml:"observations"`
+	GuardTrips    GuardTrips        `json:"guard_trips" yaml:"guard_trips"`
+	OracleRecords OracleRecords     `json:"oracle_records" yaml:"oracle_records"`
+	OracleBonds   OracleBonds       `json:"oracle_bonds" yaml:"oracle_bonds"`
+	PriceCommits  PriceCommits      `json:"price_commits" yaml:"price_commits"`
+	PriceHistory  HistoricalPrices  `json:"price_history" yaml:"price_history"`
 }
 
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, observations PriceObservations, trips GuardTrips,
+	records OracleRecords, bonds OracleBonds, commits PriceCommits, history HistoricalPrices) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:        p,
+		PostedPrices:  pp,
+		Observations:  observations,
+		GuardTrips:    trips,
+		OracleRecords: records,
+		OracleBonds:   bonds,
+		PriceCommits:  commits,
+		PriceHistory:  history,
 	}
 }
 
@@ -23,6 +36,12 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		PriceObservations{},
+		GuardTrips{},
+		OracleRecords{},
+		OracleBonds{},
+		PriceCommits{},
+		HistoricalPrices{},
 	)
 }
 
@@ -44,5 +63,23 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+	if err := gs.Observations.Validate(); err != nil {
+		return err
+	}
+	if err := gs.GuardTrips.Validate(); err != nil {
+		return err
+	}
+	if err := gs.OracleRecords.Validate(); err != nil {
+		return err
+	}
+	if err := gs.OracleBonds.Validate(); err != nil {
+		return err
+	}
+	if err := gs.PriceCommits.Validate(); err != nil {
+		return err
+	}
+	return gs.PriceHistory.Validate()
 }
@@ -32,9 +32,15 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultOracleBond),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
 			),
 			expPass: true,
 		},
@@ -42,9 +48,15 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultOracleBond),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
 			),
 			expPass: false,
 		},
@@ -52,29 +64,473 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+				}, DefaultOracleBond),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
 			),
 			expPass: false,
 		},
 		{
 			msg: "invalid posted price",
 			genesisState: NewGenesisState(
-				NewParams(Markets{}),
+				NewParams(Markets{}, DefaultOracleBond),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
 			),
 			expPass: false,
 		},
 		{
 			msg: "duplicated posted price",
 			genesisState: NewGenesisState(
-				NewParams(Markets{}),
+				NewParams(Markets{}, DefaultOracleBond),
 				[]PostedPrice{
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid twap market",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					NewTWAPMarket("market:30", "xrp", "bnb", "market", time.Hour, true),
+				}, DefaultOracleBond),
+				[]PostedPrice{NewPostedPrice("market", addr, sdk.OneDec(), now)},
+				PriceObservations{NewPriceObservation("market", now, sdk.OneDec(), sdk.ZeroDec())},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "twap market averaging missing market",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					NewTWAPMarket("market:30", "xrp", "bnb", "market", time.Hour, true),
+				}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "twap market averaging market with other assets",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					NewTWAPMarket("market:30", "xrp", "usd", "market", time.Hour, true),
+				}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "twap market with oracles",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					{MarketID: "market:30", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true, TWAP: NewTWAPSource("market", time.Hour)},
+				}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "twap market with no window",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					NewTWAPMarket("market:30", "xrp", "bnb", "market", 0, true),
+				}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid price observation",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{NewPriceObservation("market", now, sdk.ZeroDec(), sdk.ZeroDec())},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated price observation",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{
+					NewPriceObservation("market", now, sdk.OneDec(), sdk.ZeroDec()),
+					NewPriceObservation("market", now, sdk.OneDec(), sdk.OneDec()),
+				},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid guarded market",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					guardedMarket("market", NewPriceGuard(sdk.MustNewDecFromStr("0.1"), 2, time.Hour, 0)),
+				}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{
+					NewGuardTrip("market", GuardTripDeviation, 1, now, sdk.MustNewDecFromStr("1.2"), sdk.OneDec()),
+					NewGuardTrip("market", GuardTripStale, 5, now.Add(time.Hour), sdk.ZeroDec(), sdk.OneDec()),
+				},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "invalid market guard",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					guardedMarket("market", NewPriceGuard(sdk.MustNewDecFromStr("-0.1"), 2, time.Hour, 0)),
+				}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "guarded twap market",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					twapMarketWithGuard("market:30", "market", NewPriceGuard(sdk.MustNewDecFromStr("0.1"), 0, 0, 0)),
+				}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid guard trip reason",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{NewGuardTrip("market", "unknown", 1, now, sdk.OneDec(), sdk.OneDec())},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated guard trip",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{
+					NewGuardTrip("market", GuardTripStale, 1, now, sdk.ZeroDec(), sdk.OneDec()),
+					NewGuardTrip("market", GuardTripMinOracles, 1, now, sdk.ZeroDec(), sdk.OneDec()),
+				},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid oracle weights, records and bonds",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					weightedMarket("market", addr, 2),
+				}, NewOracleBondParams(sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)), sdk.MustNewDecFromStr("0.1"), 3, 5, time.Minute, sdk.MustNewDecFromStr("0.5"), time.Hour)),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{NewOracleRecord("market", addr)},
+				OracleBonds{NewOracleBond(addr, sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)))},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "zero oracle weight",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					weightedMarket("market", addr, 0),
+				}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "weight for an oracle not in the market",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true, OracleWeights: OracleWeights{NewOracleWeight(sdk.AccAddress("test_address"), 1)}},
+				}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "slash fraction above one",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, NewOracleBondParams(sdk.NewCoins(), sdk.MustNewDecFromStr("0.1"), 3, 5, time.Minute, sdk.MustNewDecFromStr("1.5"), time.Hour)),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "outliers penalized without an outlier threshold",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, NewOracleBondParams(sdk.NewCoins(), sdk.ZeroDec(), 3, 5, time.Minute, sdk.MustNewDecFromStr("0.5"), time.Hour)),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "misses penalized without a miss interval",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, NewOracleBondParams(sdk.NewCoins(), sdk.MustNewDecFromStr("0.1"), 3, 5, 0, sdk.MustNewDecFromStr("0.5"), time.Hour)),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated oracle record",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{NewOracleRecord("market", addr), NewOracleRecord("market", addr)},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "empty oracle bond",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{NewOracleBond(addr, sdk.NewCoins())},
+				PriceCommits{},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid price commit",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{NewPriceCommit("market", addr, PriceCommitHash("salt", sdk.OneDec(), "market", addr), 2)},
+				HistoricalPrices{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "invalid price commit hash",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{NewPriceCommit("market", addr, []byte("hash"), 2)},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "price commits in consecutive vote periods",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{
+					NewPriceCommit("market", addr, PriceCommitHash("salt", sdk.OneDec(), "market", addr), 2),
+					NewPriceCommit("market", addr, PriceCommitHash("salt", sdk.OneDec(), "market", addr), 3),
+				},
+				HistoricalPrices{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "duplicated price commit",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{
+					NewPriceCommit("market", addr, PriceCommitHash("salt", sdk.OneDec(), "market", addr), 2),
+					NewPriceCommit("market", addr, PriceCommitHash("salt2", sdk.OneDec(), "market", addr), 2),
+				},
+				HistoricalPrices{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid historical prices",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{
+					NewHistoricalPrice("market", 0, 1, now, sdk.OneDec()),
+					NewHistoricalPrice("market", 1, 2, now, sdk.OneDec()),
+				},
+			),
+			expPass: true,
+		},
+		{
+			msg: "duplicated historical price",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{
+					NewHistoricalPrice("market", 0, 1, now, sdk.OneDec()),
+					NewHistoricalPrice("market", 0, 2, now, sdk.OneDec()),
+				},
+			),
+			expPass: false,
+		},
+		{
+			msg: "zero historical price",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleBond),
+				[]PostedPrice{},
+				PriceObservations{},
+				GuardTrips{},
+				OracleRecords{},
+				OracleBonds{},
+				PriceCommits{},
+				HistoricalPrices{NewHistoricalPrice("market", 0, 1, now, sdk.ZeroDec())},
 			),
 			expPass: false,
 		},
@@ -89,3 +545,21 @@ func TestGenesisStateValidate(t *testing.T) {
 		}
 	}
 }
+
+func guardedMarket(marketID string, guard PriceGuard) Market {
+	market := NewMarket(marketID, "xrp", "bnb", []sdk.AccAddress{sdk.AccAddress("test_address")}, true)
+	market.Guard = &guard
+	return market
+}
+
+func twapMarketWithGuard(marketID, sourceMarketID string, guard PriceGuard) Market {
+	market := NewTWAPMarket(marketID, "xrp", "bnb", sourceMarketID, time.Hour, true)
+	market.Guard = &guard
+	return market
+}
+
+func weightedMarket(marketID string, oracle sdk.AccAddress, weight uint64) Market {
+	market := NewMarket(marketID, "xrp", "bnb", []sdk.AccAddress{oracle}, true)
+	market.OracleWeights = OracleWeights{NewOracleWeight(oracle, weight)}
+	return market
+}
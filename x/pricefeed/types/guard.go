@@ -0,0 +1,165 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reasons a price guard can trip
+const (
+	GuardTripDeviation  = "deviation"
+	GuardTripMinOracles = "min_oracles"
+	GuardTripStale      = "stale"
+)
+
+// PriceGuard sets limits on a market's price updates. A market whose update breaks a limit is marked unhealthy until enough
+// consecutive updates pass them all. A zero limit is not checked.
+type PriceGuard struct {
+	MaxDeviation    sdk.Dec       `json:"max_deviation" yaml:"max_deviation"`       // largest fractional change in the median price from the last healthy price
+	MinOracles      uint64        `json:"min_oracles" yaml:"min_oracles"`           // fewest oracles with unexpired prices the median can be taken from
+	MaxStaleness    time.Duration `json:"max_staleness" yaml:"max_staleness"`       // longest time since any oracle last posted a price
+	RecoveryUpdates uint64        `json:"recovery_updates" yaml:"recovery_updates"` // consecutive updates that must pass every limit before an unhealthy market recovers, one if zero
+}
+
+// NewPriceGuard returns a new PriceGuard
+func NewPriceGuard(maxDeviation sdk.Dec, minOracles uint64, maxStaleness time.Duration, recoveryUpdates uint64) PriceGuard {
+	return PriceGuard{
+		MaxDeviation:    maxDeviation,
+		MinOracles:      minOracles,
+		MaxStaleness:    maxStaleness,
+		RecoveryUpdates: recoveryUpdates,
+	}
+}
+
+// GetRecoveryUpdates returns the number of consecutive updates that must pass every limit before an unhealthy market recovers
+func (pg PriceGuard) GetRecoveryUpdates() uint64 {
+	if pg.RecoveryUpdates == 0 {
+		return 1
+	}
+	return pg.RecoveryUpdates
+}
+
+// Validate performs a basic validation of the price guard
+func (pg PriceGuard) Validate() error {
+	if pg.MaxDeviation.IsNil() || pg.MaxDeviation.IsNegative() {
+		return fmt.Errorf("max deviation cannot be negative: %s", pg.MaxDeviation)
+	}
+	if pg.MaxStaleness < 0 {
+		return fmt.Errorf("max staleness cannot be negative: %s", pg.MaxStaleness)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (pg PriceGuard) String() string {
+	return fmt.Sprintf("max deviation %s, min oracles %d, max staleness %s, recovery updates %d", pg.MaxDeviation, pg.MinOracles, pg.MaxStaleness, pg.RecoveryUpdates)
+}
+
+// GuardTrip records a market's price guard tripping, marking the market unhealthy until it recovers.
+type GuardTrip struct {
+	MarketID        string    `json:"market_id" yaml:"market_id"`
+	Reason          string    `json:"reason" yaml:"reason"`
+	Height          int64     `json:"height" yaml:"height"`
+	Time            time.Time `json:"time" yaml:"time"`
+	Price           sdk.Dec   `json:"price" yaml:"price"`                       // median price that tripped the guard, zero if the guard tripped before the median was taken
+	PrevPrice       sdk.Dec   `json:"prev_price" yaml:"prev_price"`             // market price before the guard tripped, zero if there was none
+	PassedUpdates   uint64    `json:"passed_updates" yaml:"passed_updates"`     // consecutive updates that have passed every limit since the guard last tripped
+	RecoveredHeight int64     `json:"recovered_height" yaml:"recovered_height"` // height the market became healthy again, zero while it is unhealthy
+	RecoveredTime   time.Time `json:"recovered_time" yaml:"recovered_time"`
+}
+
+// NewGuardTrip returns a new GuardTrip
+func NewGuardTrip(marketID, reason string, height int64, t time.Time, price, prevPrice sdk.Dec) GuardTrip {
+	return GuardTrip{
+		MarketID:  marketID,
+		Reason:    reason,
+		Height:    height,
+		Time:      t,
+		Price:     price,
+		PrevPrice: prevPrice,
+	}
+}
+
+// IsRecovered returns true if the market has become healthy since the trip
+func (gt GuardTrip) IsRecovered() bool {
+	return gt.RecoveredHeight > 0
+}
+
+// Validate performs a basic check of a GuardTrip
+func (gt GuardTrip) Validate() error {
+	if strings.TrimSpace(gt.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	switch gt.Reason {
+	case GuardTripDeviation, GuardTripMinOracles, GuardTripStale:
+	default:
+		return fmt.Errorf("invalid guard trip reason: %s", gt.Reason)
+	}
+	if gt.Height < 0 {
+		return fmt.Errorf("guard trip height cannot be negative: %d", gt.Height)
+	}
+	if gt.Price.IsNil() || gt.Price.IsNegative() {
+		return fmt.Errorf("guard trip price cannot be negative: %s", gt.Price)
+	}
+	if gt.PrevPrice.IsNil() || gt.PrevPrice.IsNegative() {
+		return fmt.Errorf("guard trip previous price cannot be negative: %s", gt.PrevPrice)
+	}
+	if gt.IsRecovered() && gt.RecoveredHeight < gt.Height {
+		return fmt.Errorf("guard trip recovered before it tripped: %d < %d", gt.RecoveredHeight, gt.Height)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (gt GuardTrip) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Reason: %s
+Height: %d
+Time: %s
+Price: %s
+Previous Price: %s
+Recovered Height: %d
+Recovered Time: %s`, gt.MarketID, gt.Reason, gt.Height, gt.Time, gt.Price, gt.PrevPrice, gt.RecoveredHeight, gt.RecoveredTime))
+}
+
+// GuardTrips type for an array of GuardTrip
+type GuardTrips []GuardTrip
+
+// Validate checks all the guard trips are valid and there is at most one per market and time
+func (gts GuardTrips) Validate() error {
+	seenTrips := make(map[string]bool)
+	for _, gt := range gts {
+		if err := gt.Validate(); err != nil {
+			return err
+		}
+		key := gt.MarketID + gt.Time.UTC().String()
+		if seenTrips[key] {
+			return fmt.Errorf("duplicated guard trip for market id %s at %s", gt.MarketID, gt.Time)
+		}
+		seenTrips[key] = true
+	}
+	return nil
+}
+
+// MarketHealth is the health of a market's price, with the trip that made it unhealthy
+type MarketHealth struct {
+	MarketID string     `json:"market_id" yaml:"market_id"`
+	Healthy  bool       `json:"healthy" yaml:"healthy"`
+	Trip     *GuardTrip `json:"trip" yaml:"trip"` // nil while the market is healthy
+}
+
+// NewMarketHealth returns a new MarketHealth
+func NewMarketHealth(marketID string, healthy bool, trip *GuardTrip) MarketHealth {
+	return MarketHealth{
+		MarketID: marketID,
+		Healthy:  healthy,
+		Trip:     trip,
+	}
+}
+
+// MarketHealths type for an array of MarketHealth
+type MarketHealths []MarketHealth
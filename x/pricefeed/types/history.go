@@ -0,0 +1,72 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HistoricalPrice is a market's current price at the block it was set in, kept in a ring buffer of the market's most recent prices.
+type HistoricalPrice struct {
+	MarketID string    `json:"market_id" yaml:"market_id"`
+	Sequence uint64    `json:"sequence" yaml:"sequence"` // position of the price in the market's history, increasing with each price set
+	Height   int64     `json:"height" yaml:"height"`
+	Time     time.Time `json:"time" yaml:"time"`
+	Price    sdk.Dec   `json:"price" yaml:"price"`
+}
+
+// NewHistoricalPrice returns a new HistoricalPrice
+func NewHistoricalPrice(marketID string, sequence uint64, height int64, t time.Time, price sdk.Dec) HistoricalPrice {
+	return HistoricalPrice{
+		MarketID: marketID,
+		Sequence: sequence,
+		Height:   height,
+		Time:     t,
+		Price:    price,
+	}
+}
+
+// Validate performs a basic check of a HistoricalPrice
+func (hp HistoricalPrice) Validate() error {
+	if strings.TrimSpace(hp.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if hp.Height < 0 {
+		return fmt.Errorf("height cannot be negative: %d", hp.Height)
+	}
+	if hp.Price.IsNil() || !hp.Price.IsPositive() {
+		return fmt.Errorf("historical price must be positive %s", hp.Price)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (hp HistoricalPrice) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Sequence: %d
+Height: %d
+Time: %s
+Price: %s`, hp.MarketID, hp.Sequence, hp.Height, hp.Time, hp.Price))
+}
+
+// HistoricalPrices type for an array of HistoricalPrice
+type HistoricalPrices []HistoricalPrice
+
+// Validate checks all the historical prices are valid and there is at most one per market and sequence
+func (hps HistoricalPrices) Validate() error {
+	seenPrices := make(map[string]bool)
+	for _, hp := range hps {
+		if err := hp.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s:%d", hp.MarketID, hp.Sequence)
+		if seenPrices[key] {
+			return fmt.Errorf("duplicated historical price for market id %s with sequence %d", hp.MarketID, hp.Sequence)
+		}
+		seenPrices[key] = true
+	}
+	return nil
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "pricefeed"
@@ -23,6 +29,27 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// PriceObservationPrefix prefix for the price accumulator observations of a market
+	PriceObservationPrefix = []byte{0x02}
+
+	// GuardTripPrefix prefix for the price guard trips of a market
+	GuardTripPrefix = []byte{0x03}
+
+	// LastPostTimePrefix prefix for the time an oracle last posted a price for a market
+	LastPostTimePrefix = []byte{0x04}
+
+	// OracleRecordPrefix prefix for the record of an oracle's posts to a market
+	OracleRecordPrefix = []byte{0x05}
+
+	// OracleBondPrefix prefix for the bond of an oracle
+	OracleBondPrefix = []byte{0x06}
+
+	// PriceCommitPrefix prefix for the price an oracle has committed to for a market
+	PriceCommitPrefix = []byte{0x07}
+
+	// HistoricalPricePrefix prefix for the historical current prices of a market
+	HistoricalPricePrefix = []byte{0x08}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -34,3 +61,65 @@ func CurrentPriceKey(marketID string) []byte {
 func RawPriceKey(marketID string) []byte {
 	return append(RawPriceFeedPrefix, []byte(marketID)...)
 }
+
+// PriceObservationMarketKey returns the prefix for the price observations of a market.
+// The market id is length prefixed so one market's observations are not included when iterating another's.
+func PriceObservationMarketKey(marketID string) []byte {
+	return append(append(PriceObservationPrefix, byte(len(marketID))), []byte(marketID)...)
+}
+
+// PriceObservationKey returns the key for a market's price observation at a time
+func PriceObservationKey(marketID string, t time.Time) []byte {
+	return append(PriceObservationMarketKey(marketID), sdk.FormatTimeBytes(t)...)
+}
+
+// GuardTripMarketKey returns the prefix for the guard trips of a market.
+// The market id is length prefixed so one market's trips are not included when iterating another's.
+func GuardTripMarketKey(marketID string) []byte {
+	return append(append(GuardTripPrefix, byte(len(marketID))), []byte(marketID)...)
+}
+
+// GuardTripKey returns the key for a market's guard trip at a time
+func GuardTripKey(marketID string, t time.Time) []byte {
+	return append(GuardTripMarketKey(marketID), sdk.FormatTimeBytes(t)...)
+}
+
+// LastPostTimeKey returns the key for the time an oracle last posted a price for a market
+func LastPostTimeKey(marketID string) []byte {
+	return append(LastPostTimePrefix, []byte(marketID)...)
+}
+
+// OracleRecordMarketKey returns the prefix for the oracle records of a market
+func OracleRecordMarketKey(marketID string) []byte {
+	return append(append(OracleRecordPrefix, byte(len(marketID))), []byte(marketID)...)
+}
+
+// OracleRecordKey returns the key for the record of an oracle's posts to a market
+func OracleRecordKey(marketID string, oracle sdk.AccAddress) []byte {
+	return append(OracleRecordMarketKey(marketID), oracle...)
+}
+
+// OracleBondKey returns the key for the bond of an oracle
+func OracleBondKey(oracle sdk.AccAddress) []byte {
+	return append(OracleBondPrefix, oracle...)
+}
+
+// PriceCommitMarketKey returns the prefix for the price commits of a market
+func PriceCommitMarketKey(marketID string) []byte {
+	return append(append(PriceCommitPrefix, byte(len(marketID))), []byte(marketID)...)
+}
+
+// PriceCommitKey returns the key for the price an oracle has committed to for a market in a vote period
+func PriceCommitKey(marketID string, oracle sdk.AccAddress, votePeriod int64) []byte {
+	return append(append(PriceCommitMarketKey(marketID), oracle...), sdk.Uint64ToBigEndian(uint64(votePeriod))...)
+}
+
+// HistoricalPriceMarketKey returns the prefix for the historical prices of a market
+func HistoricalPriceMarketKey(marketID string) []byte {
+	return append(append(HistoricalPricePrefix, byte(len(marketID))), []byte(marketID)...)
+}
+
+// HistoricalPriceKey returns the key for a market's historical price with a sequence
+func HistoricalPriceKey(marketID string, sequence uint64) []byte {
+	return append(HistoricalPriceMarketKey(marketID), sdk.Uint64ToBigEndian(sequence)...)
+}
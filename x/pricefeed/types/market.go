@@ -9,13 +9,22 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxPriceHistory is the largest number of current prices a market can keep in its price history
+const MaxPriceHistory uint64 = 100000
+
 // Market an asset in the pricefeed
 type Market struct {
-	MarketID   string           `json:"market_id" yaml:"market_id"`
-	BaseAsset  string           `json:"base_asset" yaml:"base_asset"`
-	QuoteAsset string           `json:"quote_asset" yaml:"quote_asset"`
-	Oracles    []sdk.AccAddress `json:"oracles" yaml:"oracles"`
-	Active     bool             `json:"active" yaml:"active"`
+	MarketID      string           `json:"market_id" yaml:"market_id"`
+	BaseAsset     string           `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset    string           `json:"quote_asset" yaml:"quote_asset"`
+	Oracles       []sdk.AccAddress `json:"oracles" yaml:"oracles"`
+	Active        bool             `json:"active" yaml:"active"`
+	TWAP          TWAPSource       `json:"twap" yaml:"twap"`                     // set for markets derived from the time weighted average price of another market
+	Derived       DerivedSource    `json:"derived" yaml:"derived"`               // set for markets derived from the product of other markets' prices
+	Guard         *PriceGuard      `json:"guard" yaml:"guard"`                   // limits on price updates, nil for markets without limits
+	OracleWeights OracleWeights    `json:"oracle_weights" yaml:"oracle_weights"` // weights of oracles in the median, oracles without a weight have a weight of one
+	VotePeriod    int64            `json:"vote_period" yaml:"vote_period"`       // blocks in each commit-reveal vote period, zero for markets oracles post prices to directly
+	PriceHistory  uint64           `json:"price_history" yaml:"price_history"`   // number of the market's most recent current prices kept, zero to keep none
 }
 
 // NewMarket returns a new Market
@@ -29,6 +38,51 @@ func NewMarket(id, base, quote string, oracles []sdk.AccAddress, active bool) Ma
 	}
 }
 
+// NewTWAPMarket returns a new Market priced at the time weighted average price of another market over a window, rather than by oracles
+func NewTWAPMarket(id, base, quote, sourceMarketID string, window time.Duration, active bool) Market {
+	return Market{
+		MarketID:   id,
+		BaseAsset:  base,
+		QuoteAsset: quote,
+		Active:     active,
+		TWAP:       NewTWAPSource(sourceMarketID, window),
+	}
+}
+
+// NewDerivedMarket returns a new Market priced at the product of other markets' prices, rather than by oracles
+func NewDerivedMarket(id, base, quote string, inputs DerivedInputs, active bool) Market {
+	return Market{
+		MarketID:   id,
+		BaseAsset:  base,
+		QuoteAsset: quote,
+		Active:     active,
+		Derived:    NewDerivedSource(inputs),
+	}
+}
+
+// IsTWAP returns true if the market's price is derived from the time weighted average price of another market
+func (m Market) IsTWAP() bool {
+	return m.TWAP.IsSet()
+}
+
+// IsCommitReveal returns true if oracles must commit to prices in one vote period and reveal them in the next, rather than posting them directly
+func (m Market) IsCommitReveal() bool {
+	return m.VotePeriod > 0
+}
+
+// VotePeriodAt returns the commit-reveal vote period a block height is in
+func (m Market) VotePeriodAt(height int64) int64 {
+	if !m.IsCommitReveal() {
+		return 0
+	}
+	return height / m.VotePeriod
+}
+
+// IsDerived returns true if the market's price is derived from the product of other markets' prices
+func (m Market) IsDerived() bool {
+	return m.Derived.IsSet()
+}
+
 // String implement fmt.Stringer
 func (m Market) String() string {
 	return fmt.Sprintf(`Asset:
@@ -36,8 +90,21 @@ func (m Market) String() string {
 	Base Asset: %s
 	Quote Asset: %s
 	Oracles: %s
-	Active: %t`,
-		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	Active: %t
+	TWAP: %s
+	Derived: %s
+	Guard: %s
+	Oracle Weights: %s
+	Vote Period: %d
+	Price History: %d`,
+		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active, m.TWAP, m.Derived, m.guardString(), m.OracleWeights, m.VotePeriod, m.PriceHistory)
+}
+
+func (m Market) guardString() string {
+	if m.Guard == nil {
+		return "none"
+	}
+	return m.Guard.String()
 }
 
 // Validate performs a basic validation of the market params
@@ -61,6 +128,59 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if m.IsTWAP() {
+		if err := m.TWAP.Validate(); err != nil {
+			return fmt.Errorf("invalid twap for market %s: %w", m.MarketID, err)
+		}
+		if m.TWAP.MarketID == m.MarketID {
+			return fmt.Errorf("twap market %s cannot average itself", m.MarketID)
+		}
+		if len(m.Oracles) > 0 {
+			return fmt.Errorf("twap market %s cannot have oracles", m.MarketID)
+		}
+		if m.Guard != nil {
+			return fmt.Errorf("twap market %s cannot have a guard", m.MarketID)
+		}
+		if m.IsCommitReveal() {
+			return fmt.Errorf("twap market %s cannot have a vote period", m.MarketID)
+		}
+	}
+	if m.IsDerived() {
+		if err := m.Derived.Validate(); err != nil {
+			return fmt.Errorf("invalid derived source for market %s: %w", m.MarketID, err)
+		}
+		if m.IsTWAP() {
+			return fmt.Errorf("derived market %s cannot be a twap market", m.MarketID)
+		}
+		if len(m.Oracles) > 0 {
+			return fmt.Errorf("derived market %s cannot have oracles", m.MarketID)
+		}
+		if m.Guard != nil {
+			return fmt.Errorf("derived market %s cannot have a guard", m.MarketID)
+		}
+		if m.IsCommitReveal() {
+			return fmt.Errorf("derived market %s cannot have a vote period", m.MarketID)
+		}
+		for _, input := range m.Derived.Inputs {
+			if input.MarketID == m.MarketID {
+				return fmt.Errorf("derived market %s cannot be derived from itself", m.MarketID)
+			}
+		}
+	}
+	if m.Guard != nil {
+		if err := m.Guard.Validate(); err != nil {
+			return fmt.Errorf("invalid guard for market %s: %w", m.MarketID, err)
+		}
+	}
+	if m.VotePeriod < 0 {
+		return fmt.Errorf("vote period for market %s cannot be negative: %d", m.MarketID, m.VotePeriod)
+	}
+	if err := m.OracleWeights.Validate(m.Oracles); err != nil {
+		return fmt.Errorf("invalid oracle weights for market %s: %w", m.MarketID, err)
+	}
+	if m.PriceHistory > MaxPriceHistory {
+		return fmt.Errorf("price history for market %s cannot exceed %d: %d", m.MarketID, MaxPriceHistory, m.PriceHistory)
+	}
 	return nil
 }
 
@@ -68,7 +188,8 @@ func (m Market) Validate() error {
 type Markets []Market
 
 // Validate checks if all the markets are valid and there are no duplicated
-// entries.
+// entries. TWAP markets must average a market with the same assets that is not itself a TWAP market.
+// Derived markets must chain the assets of markets that are not TWAP markets from their base asset to their quote asset, without cycles.
 func (ms Markets) Validate() error {
 	seenMarkets := make(map[string]bool)
 	for _, m := range ms {
@@ -80,9 +201,120 @@ func (ms Markets) Validate() error {
 		}
 		seenMarkets[m.MarketID] = true
 	}
+	for _, m := range ms {
+		if !m.IsTWAP() {
+			continue
+		}
+		source, found := ms.Get(m.TWAP.MarketID)
+		if !found {
+			return fmt.Errorf("twap market %s averages missing market %s", m.MarketID, m.TWAP.MarketID)
+		}
+		if source.IsTWAP() {
+			return fmt.Errorf("twap market %s averages twap market %s", m.MarketID, source.MarketID)
+		}
+		if source.BaseAsset != m.BaseAsset || source.QuoteAsset != m.QuoteAsset {
+			return fmt.Errorf("twap market %s assets do not match market %s", m.MarketID, source.MarketID)
+		}
+	}
+	for _, m := range ms {
+		if !m.IsDerived() {
+			continue
+		}
+		asset := m.BaseAsset
+		for _, input := range m.Derived.Inputs {
+			source, found := ms.Get(input.MarketID)
+			if !found {
+				return fmt.Errorf("derived market %s is derived from missing market %s", m.MarketID, input.MarketID)
+			}
+			if source.IsTWAP() {
+				return fmt.Errorf("derived market %s is derived from twap market %s", m.MarketID, source.MarketID)
+			}
+			base, quote := source.BaseAsset, source.QuoteAsset
+			if input.Invert {
+				base, quote = quote, base
+			}
+			if base != asset {
+				return fmt.Errorf("derived market %s cannot chain %s to market %s", m.MarketID, asset, source.MarketID)
+			}
+			asset = quote
+		}
+		if asset != m.QuoteAsset {
+			return fmt.Errorf("derived market %s inputs end in %s, not its quote asset %s", m.MarketID, asset, m.QuoteAsset)
+		}
+	}
+	if _, err := ms.UpdateOrder(); err != nil {
+		return err
+	}
 	return nil
 }
 
+// UpdateOrder returns the markets in the order their prices must be updated: oracle markets first, then derived markets after all their inputs, then TWAP markets.
+// It returns an error if derived markets are derived from each other in a cycle.
+func (ms Markets) UpdateOrder() (Markets, error) {
+	var ordered, derived, twaps Markets
+	for _, m := range ms {
+		switch {
+		case m.IsTWAP():
+			twaps = append(twaps, m)
+		case m.IsDerived():
+			derived = append(derived, m)
+		default:
+			ordered = append(ordered, m)
+		}
+	}
+
+	// add derived markets whose inputs are all ordered until none are left, or none can be added because of a cycle
+	isOrdered := make(map[string]bool)
+	for _, m := range ordered {
+		isOrdered[m.MarketID] = true
+	}
+	for len(derived) > 0 {
+		var remaining Markets
+		for _, m := range derived {
+			ready := true
+			for _, input := range m.Derived.Inputs {
+				if _, found := ms.Get(input.MarketID); found && !isOrdered[input.MarketID] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				ordered = append(ordered, m)
+				isOrdered[m.MarketID] = true
+			} else {
+				remaining = append(remaining, m)
+			}
+		}
+		if len(remaining) == len(derived) {
+			return nil, fmt.Errorf("derived market %s is derived from itself through other markets", remaining[0].MarketID)
+		}
+		derived = remaining
+	}
+
+	return append(ordered, twaps...), nil
+}
+
+// Get returns the market with a market id
+func (ms Markets) Get(marketID string) (Market, bool) {
+	for _, m := range ms {
+		if m.MarketID == marketID {
+			return m, true
+		}
+	}
+	return Market{}, false
+}
+
+// MaxTWAPWindow returns the longest window any TWAP market averages a market over, or zero if no TWAP market averages it
+func (ms Markets) MaxTWAPWindow(marketID string) time.Duration {
+	var window time.Duration
+	for _, m := range ms {
+		if m.IsTWAP() && m.TWAP.MarketID == marketID && m.TWAP.Window > window {
+			window = m.TWAP.Window
+		}
+	}
+	return window
+}
+
 // String implements fmt.Stringer
 func (ms Markets) String() string {
 	out := "Markets:\n"
@@ -92,6 +324,113 @@ func (ms Markets) String() string {
 	return strings.TrimSpace(out)
 }
 
+// TWAPSource describes the market a TWAP market averages and the window it averages over
+type TWAPSource struct {
+	MarketID string        `json:"market_id" yaml:"market_id"`
+	Window   time.Duration `json:"window" yaml:"window"`
+}
+
+// NewTWAPSource returns a new TWAPSource
+func NewTWAPSource(marketID string, window time.Duration) TWAPSource {
+	return TWAPSource{
+		MarketID: marketID,
+		Window:   window,
+	}
+}
+
+// IsSet returns true if the TWAP source has a market, false for markets that are not TWAP markets
+func (ts TWAPSource) IsSet() bool {
+	return ts.MarketID != ""
+}
+
+// Validate performs a basic validation of the TWAP source
+func (ts TWAPSource) Validate() error {
+	if strings.TrimSpace(ts.MarketID) == "" {
+		return errors.New("twap market id cannot be blank")
+	}
+	if ts.Window <= 0 {
+		return fmt.Errorf("twap window must be positive: %s", ts.Window)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ts TWAPSource) String() string {
+	if !ts.IsSet() {
+		return "none"
+	}
+	return fmt.Sprintf("%s over %s", ts.MarketID, ts.Window)
+}
+
+// DerivedSource describes the markets a derived market's price is the product of
+type DerivedSource struct {
+	Inputs DerivedInputs `json:"inputs" yaml:"inputs"`
+}
+
+// NewDerivedSource returns a new DerivedSource
+func NewDerivedSource(inputs DerivedInputs) DerivedSource {
+	return DerivedSource{
+		Inputs: inputs,
+	}
+}
+
+// IsSet returns true if the derived source has inputs, false for markets that are not derived markets
+func (ds DerivedSource) IsSet() bool {
+	return len(ds.Inputs) > 0
+}
+
+// Validate performs a basic validation of the derived source
+func (ds DerivedSource) Validate() error {
+	seenInputs := make(map[string]bool)
+	for _, input := range ds.Inputs {
+		if strings.TrimSpace(input.MarketID) == "" {
+			return errors.New("derived input market id cannot be blank")
+		}
+		if seenInputs[input.MarketID] {
+			return fmt.Errorf("duplicated derived input %s", input.MarketID)
+		}
+		seenInputs[input.MarketID] = true
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ds DerivedSource) String() string {
+	if !ds.IsSet() {
+		return "none"
+	}
+	inputs := make([]string, len(ds.Inputs))
+	for i, input := range ds.Inputs {
+		inputs[i] = input.String()
+	}
+	return strings.Join(inputs, " ")
+}
+
+// DerivedInput is a market a derived market's price is multiplied, or if inverted divided, by
+type DerivedInput struct {
+	MarketID string `json:"market_id" yaml:"market_id"`
+	Invert   bool   `json:"invert" yaml:"invert"` // divide by the market's price rather than multiply by it
+}
+
+// NewDerivedInput returns a new DerivedInput
+func NewDerivedInput(marketID string, invert bool) DerivedInput {
+	return DerivedInput{
+		MarketID: marketID,
+		Invert:   invert,
+	}
+}
+
+// String implements fmt.Stringer
+func (di DerivedInput) String() string {
+	if di.Invert {
+		return fmt.Sprintf("/ %s", di.MarketID)
+	}
+	return fmt.Sprintf("* %s", di.MarketID)
+}
+
+// DerivedInputs type for an array of DerivedInput
+type DerivedInputs []DerivedInput
+
 // CurrentPrice struct that contains the metadata of a current price for a particular market in the pricefeed module.
 type CurrentPrice struct {
 	MarketID string  `json:"market_id" yaml:"market_id"`
@@ -185,6 +524,76 @@ func (ps PostedPrices) String() string {
 	return strings.TrimSpace(out)
 }
 
+// PriceObservation is a snapshot of a market's price accumulator, taken when the market's median price is set.
+// The accumulator sums the market's price multiplied by the seconds it was held for, from which time weighted average prices are calculated.
+type PriceObservation struct {
+	MarketID   string    `json:"market_id" yaml:"market_id"`
+	Time       time.Time `json:"time" yaml:"time"`
+	Price      sdk.Dec   `json:"price" yaml:"price"`           // median price set at Time, held until the next observation
+	Cumulative sdk.Dec   `json:"cumulative" yaml:"cumulative"` // accumulated price × seconds up to Time
+}
+
+// NewPriceObservation returns a new PriceObservation
+func NewPriceObservation(marketID string, t time.Time, price, cumulative sdk.Dec) PriceObservation {
+	return PriceObservation{
+		MarketID:   marketID,
+		Time:       t,
+		Price:      price,
+		Cumulative: cumulative,
+	}
+}
+
+// CumulativeAt returns the accumulated price × seconds up to a time at or after the observation, assuming the observed price was held until then
+func (po PriceObservation) CumulativeAt(t time.Time) sdk.Dec {
+	return po.Cumulative.Add(po.Price.Mul(DurationSeconds(t.Sub(po.Time))))
+}
+
+// Validate performs a basic check of a PriceObservation
+func (po PriceObservation) Validate() error {
+	if strings.TrimSpace(po.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if po.Price.IsNil() || !po.Price.IsPositive() {
+		return fmt.Errorf("observed price must be positive %s", po.Price)
+	}
+	if po.Cumulative.IsNil() || po.Cumulative.IsNegative() {
+		return fmt.Errorf("cumulative price cannot be negative %s", po.Cumulative)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (po PriceObservation) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Time: %s
+Price: %s
+Cumulative: %s`, po.MarketID, po.Time, po.Price, po.Cumulative))
+}
+
+// PriceObservations type for an array of PriceObservation
+type PriceObservations []PriceObservation
+
+// Validate checks all the price observations are valid and there is at most one per market and time
+func (pos PriceObservations) Validate() error {
+	seenObservations := make(map[string]bool)
+	for _, po := range pos {
+		if err := po.Validate(); err != nil {
+			return err
+		}
+		key := po.MarketID + po.Time.UTC().String()
+		if seenObservations[key] {
+			return fmt.Errorf("duplicated price observation for market id %s at %s", po.MarketID, po.Time)
+		}
+		seenObservations[key] = true
+	}
+	return nil
+}
+
+// DurationSeconds converts a duration to a decimal number of seconds
+func DurationSeconds(d time.Duration) sdk.Dec {
+	return sdk.NewDec(d.Nanoseconds()).QuoInt64(int64(time.Second))
+}
+
 // SortDecs provides the interface needed to sort sdk.Dec slices
 type SortDecs []sdk.Dec
 
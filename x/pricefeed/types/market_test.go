@@ -77,6 +77,66 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid commit-reveal market",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Active:     true,
+				VotePeriod: 5,
+			},
+			true,
+		},
+		{
+			"negative vote period",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Active:     true,
+				VotePeriod: -1,
+			},
+			false,
+		},
+		{
+			"max price history",
+			Market{
+				MarketID:     "market",
+				BaseAsset:    "xrp",
+				QuoteAsset:   "bnb",
+				Oracles:      []sdk.AccAddress{addr},
+				Active:       true,
+				PriceHistory: MaxPriceHistory,
+			},
+			true,
+		},
+		{
+			"price history above max",
+			Market{
+				MarketID:     "market",
+				BaseAsset:    "xrp",
+				QuoteAsset:   "bnb",
+				Oracles:      []sdk.AccAddress{addr},
+				Active:       true,
+				PriceHistory: MaxPriceHistory + 1,
+			},
+			false,
+		},
+		{
+			"commit-reveal twap market",
+			Market{
+				MarketID:   "xrp:bnb:30",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Active:     true,
+				TWAP:       NewTWAPSource("xrp:bnb", time.Hour),
+				VotePeriod: 5,
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -89,6 +149,116 @@ func TestMarketValidate(t *testing.T) {
 	}
 }
 
+func TestMarketsValidateDerived(t *testing.T) {
+	addr := sdk.AccAddress("test_address")
+	hardBNB := NewMarket("hard:bnb", "hard", "bnb", []sdk.AccAddress{addr}, true)
+	bnbUSD := NewMarket("bnb:usd", "bnb", "usd", []sdk.AccAddress{addr}, true)
+	btcUSD := NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{addr}, true)
+	input := NewDerivedInput
+
+	testCases := []struct {
+		msg     string
+		markets Markets
+		expPass bool
+	}{
+		{
+			"product",
+			Markets{hardBNB, bnbUSD, NewDerivedMarket("hard:usd", "hard", "usd", DerivedInputs{input("hard:bnb", false), input("bnb:usd", false)}, true)},
+			true,
+		},
+		{
+			"quotient",
+			Markets{bnbUSD, btcUSD, NewDerivedMarket("bnb:btc", "bnb", "btc", DerivedInputs{input("bnb:usd", false), input("btc:usd", true)}, true)},
+			true,
+		},
+		{
+			"derived from derived market",
+			Markets{
+				NewDerivedMarket("hard:btc", "hard", "btc", DerivedInputs{input("hard:usd", false), input("btc:usd", true)}, true),
+				NewDerivedMarket("hard:usd", "hard", "usd", DerivedInputs{input("hard:bnb", false), input("bnb:usd", false)}, true),
+				hardBNB, bnbUSD, btcUSD,
+			},
+			true,
+		},
+		{
+			"twap of derived market",
+			Markets{hardBNB, bnbUSD,
+				NewDerivedMarket("hard:usd", "hard", "usd", DerivedInputs{input("hard:bnb", false), input("bnb:usd", false)}, true),
+				NewTWAPMarket("hard:usd:30", "hard", "usd", "hard:usd", time.Hour, true),
+			},
+			true,
+		},
+		{
+			"missing input",
+			Markets{hardBNB, NewDerivedMarket("hard:usd", "hard", "usd", DerivedInputs{input("hard:bnb", false), input("bnb:usd", false)}, true)},
+			false,
+		},
+		{
+			"inputs do not chain",
+			Markets{bnbUSD, btcUSD, NewDerivedMarket("bnb:btc", "bnb", "btc", DerivedInputs{input("bnb:usd", false), input("btc:usd", false)}, true)},
+			false,
+		},
+		{
+			"inputs do not end in quote asset",
+			Markets{hardBNB, bnbUSD, NewDerivedMarket("hard:btc", "hard", "btc", DerivedInputs{input("hard:bnb", false), input("bnb:usd", false)}, true)},
+			false,
+		},
+		{
+			"duplicated input",
+			Markets{bnbUSD, NewDerivedMarket("bnb:bnb", "bnb", "bnb", DerivedInputs{input("bnb:usd", false), input("bnb:usd", true)}, true)},
+			false,
+		},
+		{
+			"derived from twap market",
+			Markets{hardBNB, bnbUSD,
+				NewTWAPMarket("bnb:usd:30", "bnb", "usd", "bnb:usd", time.Hour, true),
+				NewDerivedMarket("hard:usd", "hard", "usd", DerivedInputs{input("hard:bnb", false), input("bnb:usd:30", false)}, true),
+			},
+			false,
+		},
+		{
+			"derived market with oracles",
+			Markets{bnbUSD, {MarketID: "usd:bnb", BaseAsset: "usd", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Derived: NewDerivedSource(DerivedInputs{input("bnb:usd", true)})}},
+			false,
+		},
+		{
+			"cycle",
+			Markets{
+				NewDerivedMarket("usd:bnb", "usd", "bnb", DerivedInputs{input("bnb:usd", true)}, true),
+				NewDerivedMarket("bnb:usd", "bnb", "usd", DerivedInputs{input("usd:bnb", true)}, true),
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		err := tc.markets.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestMarketsUpdateOrder(t *testing.T) {
+	addr := sdk.AccAddress("test_address")
+	markets := Markets{
+		NewTWAPMarket("hard:usd:30", "hard", "usd", "hard:usd", time.Hour, true),
+		NewDerivedMarket("hard:btc", "hard", "btc", DerivedInputs{NewDerivedInput("hard:usd", false), NewDerivedInput("btc:usd", true)}, true),
+		NewDerivedMarket("hard:usd", "hard", "usd", DerivedInputs{NewDerivedInput("hard:bnb", false), NewDerivedInput("bnb:usd", false)}, true),
+		NewMarket("hard:bnb", "hard", "bnb", []sdk.AccAddress{addr}, true),
+		NewMarket("bnb:usd", "bnb", "usd", []sdk.AccAddress{addr}, true),
+		NewMarket("btc:usd", "btc", "usd", []sdk.AccAddress{addr}, true),
+	}
+	ordered, err := markets.UpdateOrder()
+	require.NoError(t, err)
+	ids := []string{}
+	for _, m := range ordered {
+		ids = append(ids, m.MarketID)
+	}
+	require.Equal(t, []string{"hard:bnb", "bnb:usd", "btc:usd", "hard:usd", "hard:btc", "hard:usd:30"}, ids)
+}
+
 func TestPostedPriceValidate(t *testing.T) {
 	now := time.Now()
 	mockPrivKey := tmtypes.NewMockPV()
@@ -8,18 +8,38 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 const (
 	// TypeMsgPostPrice type of PostPrice msg
 	TypeMsgPostPrice = "post_price"
+	// TypeMsgPostPrices type of PostPrices msg
+	TypeMsgPostPrices = "post_prices"
+	// TypeMsgCommitPrice type of CommitPrice msg
+	TypeMsgCommitPrice = "commit_price"
+	// TypeMsgRevealPrice type of RevealPrice msg
+	TypeMsgRevealPrice = "reveal_price"
+	// TypeMsgBondOracle type of BondOracle msg
+	TypeMsgBondOracle = "bond_oracle"
+	// TypeMsgUnbondOracle type of UnbondOracle msg
+	TypeMsgUnbondOracle = "unbond_oracle"
 
 	// MaxExpiry defines the max expiry time defined as UNIX time (9999-12-31 23:59:59 +0000 UTC)
 	MaxExpiry = 253402300799
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPostPrice{}
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgPostPrices{}
+	_ sdk.Msg = &MsgCommitPrice{}
+	_ sdk.Msg = &MsgRevealPrice{}
+	_ sdk.Msg = &MsgBondOracle{}
+	_ sdk.Msg = &MsgUnbondOracle{}
+)
 
 // MsgPostPrice struct representing a posted price message.
 // Used by oracles to input prices to the pricefeed
@@ -77,3 +97,271 @@ func (msg MsgPostPrice) ValidateBasic() error {
 	}
 	return nil
 }
+
+// PriceUpdate is an oracle's price for a market with the time the price expires
+type PriceUpdate struct {
+	MarketID string    `json:"market_id" yaml:"market_id"`
+	Price    sdk.Dec   `json:"price" yaml:"price"`
+	Expiry   time.Time `json:"expiry" yaml:"expiry"`
+}
+
+// NewPriceUpdate returns a new PriceUpdate
+func NewPriceUpdate(marketID string, price sdk.Dec, expiry time.Time) PriceUpdate {
+	return PriceUpdate{
+		MarketID: marketID,
+		Price:    price,
+		Expiry:   expiry,
+	}
+}
+
+// Validate performs a basic check of a PriceUpdate
+func (pu PriceUpdate) Validate() error {
+	if strings.TrimSpace(pu.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if pu.Price.IsNil() || pu.Price.IsNegative() {
+		return fmt.Errorf("price cannot be negative: %s", pu.Price)
+	}
+	if pu.Expiry.Unix() <= 0 {
+		return errors.New("must set an expiration time")
+	}
+	return nil
+}
+
+// PriceUpdates type for an array of PriceUpdate
+type PriceUpdates []PriceUpdate
+
+// MsgPostPrices struct representing many posted prices in one message.
+// Used by oracles to input prices for several markets at once
+type MsgPostPrices struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Prices PriceUpdates   `json:"prices" yaml:"prices"`
+}
+
+// NewMsgPostPrices creates a new post prices msg
+func NewMsgPostPrices(from sdk.AccAddress, prices PriceUpdates) MsgPostPrices {
+	return MsgPostPrices{
+		From:   from,
+		Prices: prices,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPostPrices) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgPostPrices) Type() string { return TypeMsgPostPrices }
+
+// GetSignBytes Implements Msg.
+func (msg MsgPostPrices) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPostPrices) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPostPrices) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.Prices) == 0 {
+		return errors.New("must post at least one price")
+	}
+	seenMarkets := make(map[string]bool)
+	for _, pu := range msg.Prices {
+		if err := pu.Validate(); err != nil {
+			return err
+		}
+		if seenMarkets[pu.MarketID] {
+			return fmt.Errorf("duplicated price for market %s", pu.MarketID)
+		}
+		seenMarkets[pu.MarketID] = true
+	}
+	return nil
+}
+
+// MsgCommitPrice commits an oracle to a price for a commit-reveal market without making the price public
+type MsgCommitPrice struct {
+	From     sdk.AccAddress   `json:"from" yaml:"from"`
+	MarketID string           `json:"market_id" yaml:"market_id"`
+	Hash     tmbytes.HexBytes `json:"hash" yaml:"hash"` // hash of the price with a salt, see PriceCommitHash
+}
+
+// NewMsgCommitPrice returns a new MsgCommitPrice
+func NewMsgCommitPrice(from sdk.AccAddress, marketID string, hash []byte) MsgCommitPrice {
+	return MsgCommitPrice{
+		From:     from,
+		MarketID: marketID,
+		Hash:     hash,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCommitPrice) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCommitPrice) Type() string { return TypeMsgCommitPrice }
+
+// GetSignBytes Implements Msg.
+func (msg MsgCommitPrice) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCommitPrice) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgCommitPrice) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if strings.TrimSpace(msg.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if len(msg.Hash) != tmhash.Size {
+		return fmt.Errorf("commit hash must be %d bytes: %s", tmhash.Size, msg.Hash)
+	}
+	return nil
+}
+
+// MsgRevealPrice reveals the price an oracle committed to in the previous vote period, posting it if it matches the commit
+type MsgRevealPrice struct {
+	From     sdk.AccAddress `json:"from" yaml:"from"`
+	MarketID string         `json:"market_id" yaml:"market_id"`
+	Price    sdk.Dec        `json:"price" yaml:"price"`
+	Salt     string         `json:"salt" yaml:"salt"`
+	Expiry   time.Time      `json:"expiry" yaml:"expiry"`
+}
+
+// NewMsgRevealPrice returns a new MsgRevealPrice
+func NewMsgRevealPrice(from sdk.AccAddress, marketID string, price sdk.Dec, salt string, expiry time.Time) MsgRevealPrice {
+	return MsgRevealPrice{
+		From:     from,
+		MarketID: marketID,
+		Price:    price,
+		Salt:     salt,
+		Expiry:   expiry,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRevealPrice) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRevealPrice) Type() string { return TypeMsgRevealPrice }
+
+// GetSignBytes Implements Msg.
+func (msg MsgRevealPrice) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRevealPrice) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRevealPrice) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if err := NewPriceUpdate(msg.MarketID, msg.Price, msg.Expiry).Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(msg.Salt) == "" || len(msg.Salt) > MaxSaltLength {
+		return fmt.Errorf("salt must be between 1 and %d characters", MaxSaltLength)
+	}
+	return nil
+}
+
+// MsgBondOracle locks coins in the pricefeed module as an oracle's bond
+type MsgBondOracle struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgBondOracle returns a new MsgBondOracle
+func NewMsgBondOracle(oracle sdk.AccAddress, amount sdk.Coins) MsgBondOracle {
+	return MsgBondOracle{
+		Oracle: oracle,
+		Amount: amount,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgBondOracle) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgBondOracle) Type() string { return TypeMsgBondOracle }
+
+// GetSignBytes Implements Msg.
+func (msg MsgBondOracle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBondOracle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Oracle}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgBondOracle) ValidateBasic() error {
+	if msg.Oracle.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "oracle address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bond amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// MsgUnbondOracle returns coins from an oracle's bond to the oracle
+type MsgUnbondOracle struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgUnbondOracle returns a new MsgUnbondOracle
+func NewMsgUnbondOracle(oracle sdk.AccAddress, amount sdk.Coins) MsgUnbondOracle {
+	return MsgUnbondOracle{
+		Oracle: oracle,
+		Amount: amount,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgUnbondOracle) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUnbondOracle) Type() string { return TypeMsgUnbondOracle }
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnbondOracle) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnbondOracle) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Oracle}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgUnbondOracle) ValidateBasic() error {
+	if msg.Oracle.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "oracle address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "unbond amount %s", msg.Amount)
+	}
+	return nil
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -36,3 +38,86 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPostPrices_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	price := sdk.MustNewDecFromStr("0.3005")
+	expiry := tmtime.Now()
+
+	tests := []struct {
+		name       string
+		msg        MsgPostPrices
+		expectPass bool
+	}{
+		{"normal", NewMsgPostPrices(addr, PriceUpdates{NewPriceUpdate("xrp:usd", price, expiry), NewPriceUpdate("btc:usd", price, expiry)}), true},
+		{"emptyAddr", NewMsgPostPrices(sdk.AccAddress{}, PriceUpdates{NewPriceUpdate("xrp:usd", price, expiry)}), false},
+		{"noPrices", NewMsgPostPrices(addr, PriceUpdates{}), false},
+		{"emptyMarket", NewMsgPostPrices(addr, PriceUpdates{NewPriceUpdate("", price, expiry)}), false},
+		{"negativePrice", NewMsgPostPrices(addr, PriceUpdates{NewPriceUpdate("xrp:usd", sdk.MustNewDecFromStr("-3.05"), expiry)}), false},
+		{"noExpiry", NewMsgPostPrices(addr, PriceUpdates{NewPriceUpdate("xrp:usd", price, time.Time{})}), false},
+		{"duplicatedMarket", NewMsgPostPrices(addr, PriceUpdates{NewPriceUpdate("xrp:usd", price, expiry), NewPriceUpdate("xrp:usd", price, expiry)}), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgCommitPrice_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	hash := PriceCommitHash("salt", sdk.MustNewDecFromStr("0.3005"), "xrp:usd", addr)
+
+	tests := []struct {
+		name       string
+		msg        MsgCommitPrice
+		expectPass bool
+	}{
+		{"normal", NewMsgCommitPrice(addr, "xrp:usd", hash), true},
+		{"emptyAddr", NewMsgCommitPrice(sdk.AccAddress{}, "xrp:usd", hash), false},
+		{"emptyMarket", NewMsgCommitPrice(addr, "", hash), false},
+		{"emptyHash", NewMsgCommitPrice(addr, "xrp:usd", nil), false},
+		{"shortHash", NewMsgCommitPrice(addr, "xrp:usd", hash[:10]), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgRevealPrice_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	price := sdk.MustNewDecFromStr("0.3005")
+	expiry := tmtime.Now()
+
+	tests := []struct {
+		name       string
+		msg        MsgRevealPrice
+		expectPass bool
+	}{
+		{"normal", NewMsgRevealPrice(addr, "xrp:usd", price, "salt", expiry), true},
+		{"emptyAddr", NewMsgRevealPrice(sdk.AccAddress{}, "xrp:usd", price, "salt", expiry), false},
+		{"emptyMarket", NewMsgRevealPrice(addr, "", price, "salt", expiry), false},
+		{"negativePrice", NewMsgRevealPrice(addr, "xrp:usd", sdk.MustNewDecFromStr("-3.05"), "salt", expiry), false},
+		{"noExpiry", NewMsgRevealPrice(addr, "xrp:usd", price, "salt", time.Time{}), false},
+		{"longSalt", NewMsgRevealPrice(addr, "xrp:usd", price, strings.Repeat("s", MaxSaltLength+1), expiry), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.Nil(t, tc.msg.ValidateBasic())
+			} else {
+				require.NotNil(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
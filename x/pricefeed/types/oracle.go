@@ -0,0 +1,326 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reasons an oracle can be penalized
+const (
+	PenaltyOutliers = "outliers"
+	PenaltyMisses   = "misses"
+)
+
+// OracleWeight is the weight of an oracle's price in its market's median
+type OracleWeight struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+	Weight uint64         `json:"weight" yaml:"weight"`
+}
+
+// NewOracleWeight returns a new OracleWeight
+func NewOracleWeight(oracle sdk.AccAddress, weight uint64) OracleWeight {
+	return OracleWeight{
+		Oracle: oracle,
+		Weight: weight,
+	}
+}
+
+// String implements fmt.Stringer
+func (ow OracleWeight) String() string {
+	return fmt.Sprintf("%s: %d", ow.Oracle, ow.Weight)
+}
+
+// OracleWeights type for an array of OracleWeight
+type OracleWeights []OracleWeight
+
+// WeightOf returns the weight of an oracle. Oracles without a weight have a weight of one.
+func (ows OracleWeights) WeightOf(oracle sdk.AccAddress) uint64 {
+	for _, ow := range ows {
+		if ow.Oracle.Equals(oracle) {
+			return ow.Weight
+		}
+	}
+	return 1
+}
+
+// Validate checks the weights are positive and there is at most one for each of the oracles
+func (ows OracleWeights) Validate(oracles []sdk.AccAddress) error {
+	seenOracles := make(map[string]bool)
+	for _, ow := range ows {
+		if ow.Weight == 0 {
+			return fmt.Errorf("weight of oracle %s must be positive", ow.Oracle)
+		}
+		if seenOracles[ow.Oracle.String()] {
+			return fmt.Errorf("duplicated weight for oracle %s", ow.Oracle)
+		}
+		seenOracles[ow.Oracle.String()] = true
+
+		found := false
+		for _, oracle := range oracles {
+			if oracle.Equals(ow.Oracle) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("weighted oracle %s is not an oracle of the market", ow.Oracle)
+		}
+	}
+	return nil
+}
+
+// WeightedPrice is an oracle's price with the oracle's weight in the median
+type WeightedPrice struct {
+	Price  sdk.Dec
+	Weight uint64
+}
+
+// WeightedPrices type for an array of WeightedPrice
+type WeightedPrices []WeightedPrice
+
+// OracleBondParams configures the bond oracles lock to post prices, and the penalties for oracles that repeatedly post outliers or miss posts.
+// A zero limit is not checked, and an empty amount does not require oracles to bond.
+type OracleBondParams struct {
+	Amount                 sdk.Coins     `json:"amount" yaml:"amount"`                                     // bond each oracle must lock before posting prices
+	OutlierThreshold       sdk.Dec       `json:"outlier_threshold" yaml:"outlier_threshold"`               // fractional deviation from the median beyond which a price is an outlier
+	MaxConsecutiveOutliers uint64        `json:"max_consecutive_outliers" yaml:"max_consecutive_outliers"` // outliers in a row that penalize an oracle
+	MaxConsecutiveMisses   uint64        `json:"max_consecutive_misses" yaml:"max_consecutive_misses"`     // misses in a row that penalize an oracle
+	MissInterval           time.Duration `json:"miss_interval" yaml:"miss_interval"`                       // time without a live price that counts as one miss, zero to not record misses
+	SlashFraction          sdk.Dec       `json:"slash_fraction" yaml:"slash_fraction"`                     // fraction of a penalized oracle's bond that is burned
+	JailDuration           time.Duration `json:"jail_duration" yaml:"jail_duration"`                       // time a penalized oracle cannot post prices to the market
+}
+
+// NewOracleBondParams returns a new OracleBondParams
+func NewOracleBondParams(amount sdk.Coins, outlierThreshold sdk.Dec, maxConsecutiveOutliers, maxConsecutiveMisses uint64, missInterval time.Duration, slashFraction sdk.Dec, jailDuration time.Duration) OracleBondParams {
+	return OracleBondParams{
+		Amount:                 amount,
+		OutlierThreshold:       outlierThreshold,
+		MaxConsecutiveOutliers: maxConsecutiveOutliers,
+		MaxConsecutiveMisses:   maxConsecutiveMisses,
+		MissInterval:           missInterval,
+		SlashFraction:          slashFraction,
+		JailDuration:           jailDuration,
+	}
+}
+
+// Validate performs a basic validation of the oracle bond params
+func (obp OracleBondParams) Validate() error {
+	if !obp.Amount.IsValid() {
+		return fmt.Errorf("invalid bond amount: %s", obp.Amount)
+	}
+	outlierThreshold := obp.GetOutlierThreshold()
+	if outlierThreshold.IsNegative() {
+		return fmt.Errorf("outlier threshold cannot be negative: %s", obp.OutlierThreshold)
+	}
+	if obp.MaxConsecutiveOutliers > 0 && outlierThreshold.IsZero() {
+		return errors.New("outlier threshold must be positive to penalize outliers")
+	}
+	if obp.MissInterval < 0 {
+		return fmt.Errorf("miss interval cannot be negative: %s", obp.MissInterval)
+	}
+	if obp.MaxConsecutiveMisses > 0 && obp.MissInterval == 0 {
+		return errors.New("miss interval must be positive to penalize misses")
+	}
+	slashFraction := obp.GetSlashFraction()
+	if slashFraction.IsNegative() || slashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", obp.SlashFraction)
+	}
+	if obp.JailDuration < 0 {
+		return fmt.Errorf("jail duration cannot be negative: %s", obp.JailDuration)
+	}
+	return nil
+}
+
+// GetOutlierThreshold returns the outlier threshold, treating an unset threshold as zero
+func (obp OracleBondParams) GetOutlierThreshold() sdk.Dec {
+	if obp.OutlierThreshold.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return obp.OutlierThreshold
+}
+
+// GetSlashFraction returns the slash fraction, treating an unset fraction as zero
+func (obp OracleBondParams) GetSlashFraction() sdk.Dec {
+	if obp.SlashFraction.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return obp.SlashFraction
+}
+
+// String implements fmt.Stringer
+func (obp OracleBondParams) String() string {
+	return fmt.Sprintf(`Oracle Bond:
+	Amount: %s
+	Outlier Threshold: %s
+	Max Consecutive Outliers: %d
+	Max Consecutive Misses: %d
+	Miss Interval: %s
+	Slash Fraction: %s
+	Jail Duration: %s`,
+		obp.Amount, obp.GetOutlierThreshold(), obp.MaxConsecutiveOutliers, obp.MaxConsecutiveMisses, obp.MissInterval, obp.GetSlashFraction(), obp.JailDuration)
+}
+
+// OracleRecord tracks an oracle's posts to a market, from which its deviation from the market's median and its uptime are calculated.
+// The record is updated the first time each of the oracle's posts is in the market's median, and each time the oracle goes a miss interval without a live price.
+type OracleRecord struct {
+	MarketID            string         `json:"market_id" yaml:"market_id"`
+	Oracle              sdk.AccAddress `json:"oracle" yaml:"oracle"`
+	Posts               uint64         `json:"posts" yaml:"posts"`                               // posts the oracle had in a median
+	Misses              uint64         `json:"misses" yaml:"misses"`                             // miss intervals the oracle had no live price in
+	Outliers            uint64         `json:"outliers" yaml:"outliers"`                         // live prices further from the median than the outlier threshold
+	ConsecutiveOutliers uint64         `json:"consecutive_outliers" yaml:"consecutive_outliers"` // outliers since the oracle last posted a price close to the median or was penalized
+	ConsecutiveMisses   uint64         `json:"consecutive_misses" yaml:"consecutive_misses"`     // misses since the oracle last had a live price or was penalized
+	TotalDeviation      sdk.Dec        `json:"total_deviation" yaml:"total_deviation"`           // sum of the fractional deviations of the oracle's live prices from the median
+	LastDeviation       sdk.Dec        `json:"last_deviation" yaml:"last_deviation"`             // fractional deviation of the oracle's last live price from the median
+	Penalties           uint64         `json:"penalties" yaml:"penalties"`                       // times the oracle has been slashed or jailed
+	JailedUntil         time.Time      `json:"jailed_until" yaml:"jailed_until"`                 // time the oracle can post prices to the market again
+	LastPostExpiry      time.Time      `json:"last_post_expiry" yaml:"last_post_expiry"`         // expiry of the last post recorded, identifying it so it is only recorded once
+	LastMissTime        time.Time      `json:"last_miss_time" yaml:"last_miss_time"`             // time the last miss was recorded, or misses started being counted from
+}
+
+// NewOracleRecord returns a new OracleRecord with no posts
+func NewOracleRecord(marketID string, oracle sdk.AccAddress) OracleRecord {
+	return OracleRecord{
+		MarketID:       marketID,
+		Oracle:         oracle,
+		TotalDeviation: sdk.ZeroDec(),
+		LastDeviation:  sdk.ZeroDec(),
+	}
+}
+
+// Uptime returns the fraction of posts out of posts and misses, or one if there have been none
+func (or OracleRecord) Uptime() sdk.Dec {
+	total := or.Posts + or.Misses
+	if total == 0 {
+		return sdk.OneDec()
+	}
+	return sdk.NewDec(int64(or.Posts)).QuoInt64(int64(total))
+}
+
+// AverageDeviation returns the mean fractional deviation of the oracle's live prices from the median
+func (or OracleRecord) AverageDeviation() sdk.Dec {
+	if or.Posts == 0 {
+		return sdk.ZeroDec()
+	}
+	return or.TotalDeviation.QuoInt64(int64(or.Posts))
+}
+
+// IsJailed returns true if the oracle cannot post prices to the market at a time
+func (or OracleRecord) IsJailed(t time.Time) bool {
+	return t.Before(or.JailedUntil)
+}
+
+// Validate performs a basic check of an OracleRecord
+func (or OracleRecord) Validate() error {
+	if strings.TrimSpace(or.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if or.Oracle.Empty() {
+		return errors.New("oracle cannot be empty")
+	}
+	if or.Outliers > or.Posts {
+		return fmt.Errorf("outliers %d cannot exceed posts %d", or.Outliers, or.Posts)
+	}
+	if or.TotalDeviation.IsNil() || or.TotalDeviation.IsNegative() {
+		return fmt.Errorf("total deviation cannot be negative: %s", or.TotalDeviation)
+	}
+	if or.LastDeviation.IsNil() || or.LastDeviation.IsNegative() {
+		return fmt.Errorf("last deviation cannot be negative: %s", or.LastDeviation)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (or OracleRecord) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Oracle: %s
+Posts: %d
+Misses: %d
+Uptime: %s
+Outliers: %d
+Average Deviation: %s
+Last Deviation: %s
+Penalties: %d
+Jailed Until: %s
+Last Post Expiry: %s`, or.MarketID, or.Oracle, or.Posts, or.Misses, or.Uptime(), or.Outliers, or.AverageDeviation(), or.LastDeviation, or.Penalties, or.JailedUntil, or.LastPostExpiry))
+}
+
+// OracleRecords type for an array of OracleRecord
+type OracleRecords []OracleRecord
+
+// Validate checks all the records are valid and there is at most one per market and oracle
+func (ors OracleRecords) Validate() error {
+	seenRecords := make(map[string]bool)
+	for _, or := range ors {
+		if err := or.Validate(); err != nil {
+			return err
+		}
+		key := or.MarketID + or.Oracle.String()
+		if seenRecords[key] {
+			return fmt.Errorf("duplicated record for oracle %s in market %s", or.Oracle, or.MarketID)
+		}
+		seenRecords[key] = true
+	}
+	return nil
+}
+
+// OracleBond is the amount an oracle has locked in the pricefeed module, which is slashed if the oracle is penalized
+type OracleBond struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewOracleBond returns a new OracleBond
+func NewOracleBond(oracle sdk.AccAddress, amount sdk.Coins) OracleBond {
+	return OracleBond{
+		Oracle: oracle,
+		Amount: amount,
+	}
+}
+
+// Validate performs a basic check of an OracleBond
+func (ob OracleBond) Validate() error {
+	if ob.Oracle.Empty() {
+		return errors.New("oracle cannot be empty")
+	}
+	if !ob.Amount.IsValid() || ob.Amount.IsZero() {
+		return fmt.Errorf("invalid bond amount: %s", ob.Amount)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ob OracleBond) String() string {
+	return fmt.Sprintf("%s: %s", ob.Oracle, ob.Amount)
+}
+
+// OracleBonds type for an array of OracleBond
+type OracleBonds []OracleBond
+
+// Validate checks all the bonds are valid and there is at most one per oracle
+func (obs OracleBonds) Validate() error {
+	seenOracles := make(map[string]bool)
+	for _, ob := range obs {
+		if err := ob.Validate(); err != nil {
+			return err
+		}
+		if seenOracles[ob.Oracle.String()] {
+			return fmt.Errorf("duplicated bond for oracle %s", ob.Oracle)
+		}
+		seenOracles[ob.Oracle.String()] = true
+	}
+	return nil
+}
+
+// Total returns the sum of all the bonds
+func (obs OracleBonds) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, ob := range obs {
+		total = total.Add(ob.Amount...)
+	}
+	return total
+}
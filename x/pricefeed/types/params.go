@@ -9,25 +9,29 @@ import (
 
 // Parameter keys
 var (
-	KeyMarkets     = []byte("Markets")
-	DefaultMarkets = Markets{}
+	KeyMarkets        = []byte("Markets")
+	KeyOracleBond     = []byte("OracleBond")
+	DefaultMarkets    = Markets{}
+	DefaultOracleBond = OracleBondParams{}
 )
 
 // Params params for pricefeed. Can be altered via governance
 type Params struct {
-	Markets Markets `json:"markets" yaml:"markets"` //  Array containing the markets supported by the pricefeed
+	Markets    Markets          `json:"markets" yaml:"markets"`         //  Array containing the markets supported by the pricefeed
+	OracleBond OracleBondParams `json:"oracle_bond" yaml:"oracle_bond"` // bond and penalties for oracles, zero if oracles are not bonded or penalized
 }
 
 // NewParams creates a new AssetParams object
-func NewParams(markets Markets) Params {
+func NewParams(markets Markets, oracleBond OracleBondParams) Params {
 	return Params{
-		Markets: markets,
+		Markets:    markets,
+		OracleBond: oracleBond,
 	}
 }
 
 // DefaultParams default params for pricefeed
 func DefaultParams() Params {
-	return NewParams(DefaultMarkets)
+	return NewParams(DefaultMarkets, DefaultOracleBond)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -40,6 +44,7 @@ func ParamKeyTable() params.KeyTable {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyMarkets, &p.Markets, validateMarketParams),
+		params.NewParamSetPair(KeyOracleBond, &p.OracleBond, validateOracleBondParams),
 	}
 }
 
@@ -49,12 +54,16 @@ func (p Params) String() string {
 	for _, a := range p.Markets {
 		out += fmt.Sprintf("%s\n", a.String())
 	}
+	out += fmt.Sprintf("%s\n", p.OracleBond)
 	return strings.TrimSpace(out)
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateMarketParams(p.Markets)
+	if err := validateMarketParams(p.Markets); err != nil {
+		return err
+	}
+	return validateOracleBondParams(p.OracleBond)
 }
 
 func validateMarketParams(i interface{}) error {
@@ -65,3 +74,12 @@ func validateMarketParams(i interface{}) error {
 
 	return markets.Validate()
 }
+
+func validateOracleBondParams(i interface{}) error {
+	oracleBond, ok := i.(OracleBondParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return oracleBond.Validate()
+}
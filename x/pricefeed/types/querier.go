@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // price Takes an [assetcode] and returns CurrentPrice for that asset
 // pricefeed Takes an [assetcode] and returns the raw []PostedPrice for that asset
 // assets Returns []Assets in the pricefeed system
@@ -17,6 +23,18 @@ const (
 	QueryPrice = "price"
 	// QueryPrices command for quering all prices
 	QueryPrices = "prices"
+	// QueryMarketHealth command for querying the health of each market
+	QueryMarketHealth = "health"
+	// QueryGuardTrips command for querying the price guard trips of a market, or of all markets if no market is given
+	QueryGuardTrips = "guard-trips"
+	// QueryOracleRecords command for querying the records of oracles' posts to a market, or to all markets if no market is given
+	QueryOracleRecords = "oracle-records"
+	// QueryOracleBond command for querying the bond of an oracle
+	QueryOracleBond = "oracle-bond"
+	// QueryPriceCommits command for querying the unrevealed price commits to a market, or to all markets if no market is given
+	QueryPriceCommits = "price-commits"
+	// QueryHistoricalPrices command for querying the historical current prices of a market
+	QueryHistoricalPrices = "historical-prices"
 )
 
 // QueryWithMarketIDParams fields for querying information from a specific market
@@ -30,3 +48,35 @@ func NewQueryWithMarketIDParams(marketID string) QueryWithMarketIDParams {
 		MarketID: marketID,
 	}
 }
+
+// QueryOracleBondParams is the params for a query of an oracle's bond
+type QueryOracleBondParams struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+}
+
+// NewQueryOracleBondParams returns a new QueryOracleBondParams
+func NewQueryOracleBondParams(oracle sdk.AccAddress) QueryOracleBondParams {
+	return QueryOracleBondParams{
+		Oracle: oracle,
+	}
+}
+
+// QueryHistoricalPricesParams is the params for a query of a market's historical prices. A zero bound is not checked.
+type QueryHistoricalPricesParams struct {
+	MarketID  string    `json:"market_id" yaml:"market_id"`
+	MinHeight int64     `json:"min_height" yaml:"min_height"`
+	MaxHeight int64     `json:"max_height" yaml:"max_height"`
+	StartTime time.Time `json:"start_time" yaml:"start_time"`
+	EndTime   time.Time `json:"end_time" yaml:"end_time"`
+}
+
+// NewQueryHistoricalPricesParams returns a new QueryHistoricalPricesParams
+func NewQueryHistoricalPricesParams(marketID string, minHeight, maxHeight int64, startTime, endTime time.Time) QueryHistoricalPricesParams {
+	return QueryHistoricalPricesParams{
+		MarketID:  marketID,
+		MinHeight: minHeight,
+		MaxHeight: maxHeight,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+}